@@ -73,41 +73,44 @@ var xxx_messageInfo_RequestHeader proto.InternalMessageInfo
 // An InternalRaftRequest is the union of all requests which can be
 // sent via raft.
 type InternalRaftRequest struct {
-	Header                   *RequestHeader                            `protobuf:"bytes,100,opt,name=header,proto3" json:"header,omitempty"`
-	ID                       uint64                                    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	V2                       *Request                                  `protobuf:"bytes,2,opt,name=v2,proto3" json:"v2,omitempty"`
-	Range                    *RangeRequest                             `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
-	Put                      *PutRequest                               `protobuf:"bytes,4,opt,name=put,proto3" json:"put,omitempty"`
-	DeleteRange              *DeleteRangeRequest                       `protobuf:"bytes,5,opt,name=delete_range,json=deleteRange,proto3" json:"delete_range,omitempty"`
-	Txn                      *TxnRequest                               `protobuf:"bytes,6,opt,name=txn,proto3" json:"txn,omitempty"`
-	Compaction               *CompactionRequest                        `protobuf:"bytes,7,opt,name=compaction,proto3" json:"compaction,omitempty"`
-	LeaseGrant               *LeaseGrantRequest                        `protobuf:"bytes,8,opt,name=lease_grant,json=leaseGrant,proto3" json:"lease_grant,omitempty"`
-	LeaseRevoke              *LeaseRevokeRequest                       `protobuf:"bytes,9,opt,name=lease_revoke,json=leaseRevoke,proto3" json:"lease_revoke,omitempty"`
-	Alarm                    *AlarmRequest                             `protobuf:"bytes,10,opt,name=alarm,proto3" json:"alarm,omitempty"`
-	LeaseCheckpoint          *LeaseCheckpointRequest                   `protobuf:"bytes,11,opt,name=lease_checkpoint,json=leaseCheckpoint,proto3" json:"lease_checkpoint,omitempty"`
-	AuthEnable               *AuthEnableRequest                        `protobuf:"bytes,1000,opt,name=auth_enable,json=authEnable,proto3" json:"auth_enable,omitempty"`
-	AuthDisable              *AuthDisableRequest                       `protobuf:"bytes,1011,opt,name=auth_disable,json=authDisable,proto3" json:"auth_disable,omitempty"`
-	AuthStatus               *AuthStatusRequest                        `protobuf:"bytes,1013,opt,name=auth_status,json=authStatus,proto3" json:"auth_status,omitempty"`
-	Authenticate             *InternalAuthenticateRequest              `protobuf:"bytes,1012,opt,name=authenticate,proto3" json:"authenticate,omitempty"`
-	AuthUserAdd              *AuthUserAddRequest                       `protobuf:"bytes,1100,opt,name=auth_user_add,json=authUserAdd,proto3" json:"auth_user_add,omitempty"`
-	AuthUserDelete           *AuthUserDeleteRequest                    `protobuf:"bytes,1101,opt,name=auth_user_delete,json=authUserDelete,proto3" json:"auth_user_delete,omitempty"`
-	AuthUserGet              *AuthUserGetRequest                       `protobuf:"bytes,1102,opt,name=auth_user_get,json=authUserGet,proto3" json:"auth_user_get,omitempty"`
-	AuthUserChangePassword   *AuthUserChangePasswordRequest            `protobuf:"bytes,1103,opt,name=auth_user_change_password,json=authUserChangePassword,proto3" json:"auth_user_change_password,omitempty"`
-	AuthUserGrantRole        *AuthUserGrantRoleRequest                 `protobuf:"bytes,1104,opt,name=auth_user_grant_role,json=authUserGrantRole,proto3" json:"auth_user_grant_role,omitempty"`
-	AuthUserRevokeRole       *AuthUserRevokeRoleRequest                `protobuf:"bytes,1105,opt,name=auth_user_revoke_role,json=authUserRevokeRole,proto3" json:"auth_user_revoke_role,omitempty"`
-	AuthUserList             *AuthUserListRequest                      `protobuf:"bytes,1106,opt,name=auth_user_list,json=authUserList,proto3" json:"auth_user_list,omitempty"`
-	AuthRoleList             *AuthRoleListRequest                      `protobuf:"bytes,1107,opt,name=auth_role_list,json=authRoleList,proto3" json:"auth_role_list,omitempty"`
-	AuthRoleAdd              *AuthRoleAddRequest                       `protobuf:"bytes,1200,opt,name=auth_role_add,json=authRoleAdd,proto3" json:"auth_role_add,omitempty"`
-	AuthRoleDelete           *AuthRoleDeleteRequest                    `protobuf:"bytes,1201,opt,name=auth_role_delete,json=authRoleDelete,proto3" json:"auth_role_delete,omitempty"`
-	AuthRoleGet              *AuthRoleGetRequest                       `protobuf:"bytes,1202,opt,name=auth_role_get,json=authRoleGet,proto3" json:"auth_role_get,omitempty"`
-	AuthRoleGrantPermission  *AuthRoleGrantPermissionRequest           `protobuf:"bytes,1203,opt,name=auth_role_grant_permission,json=authRoleGrantPermission,proto3" json:"auth_role_grant_permission,omitempty"`
-	AuthRoleRevokePermission *AuthRoleRevokePermissionRequest          `protobuf:"bytes,1204,opt,name=auth_role_revoke_permission,json=authRoleRevokePermission,proto3" json:"auth_role_revoke_permission,omitempty"`
-	ClusterVersionSet        *membershippb.ClusterVersionSetRequest    `protobuf:"bytes,1300,opt,name=cluster_version_set,json=clusterVersionSet,proto3" json:"cluster_version_set,omitempty"`
-	ClusterMemberAttrSet     *membershippb.ClusterMemberAttrSetRequest `protobuf:"bytes,1301,opt,name=cluster_member_attr_set,json=clusterMemberAttrSet,proto3" json:"cluster_member_attr_set,omitempty"`
-	DowngradeInfoSet         *membershippb.DowngradeInfoSetRequest     `protobuf:"bytes,1302,opt,name=downgrade_info_set,json=downgradeInfoSet,proto3" json:"downgrade_info_set,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}                                  `json:"-"`
-	XXX_unrecognized         []byte                                    `json:"-"`
-	XXX_sizecache            int32                                     `json:"-"`
+	Header                    *RequestHeader                            `protobuf:"bytes,100,opt,name=header,proto3" json:"header,omitempty"`
+	ID                        uint64                                    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	V2                        *Request                                  `protobuf:"bytes,2,opt,name=v2,proto3" json:"v2,omitempty"`
+	Range                     *RangeRequest                             `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
+	Put                       *PutRequest                               `protobuf:"bytes,4,opt,name=put,proto3" json:"put,omitempty"`
+	DeleteRange               *DeleteRangeRequest                       `protobuf:"bytes,5,opt,name=delete_range,json=deleteRange,proto3" json:"delete_range,omitempty"`
+	Txn                       *TxnRequest                               `protobuf:"bytes,6,opt,name=txn,proto3" json:"txn,omitempty"`
+	Compaction                *CompactionRequest                        `protobuf:"bytes,7,opt,name=compaction,proto3" json:"compaction,omitempty"`
+	LeaseGrant                *LeaseGrantRequest                        `protobuf:"bytes,8,opt,name=lease_grant,json=leaseGrant,proto3" json:"lease_grant,omitempty"`
+	LeaseRevoke               *LeaseRevokeRequest                       `protobuf:"bytes,9,opt,name=lease_revoke,json=leaseRevoke,proto3" json:"lease_revoke,omitempty"`
+	Alarm                     *AlarmRequest                             `protobuf:"bytes,10,opt,name=alarm,proto3" json:"alarm,omitempty"`
+	LeaseCheckpoint           *LeaseCheckpointRequest                   `protobuf:"bytes,11,opt,name=lease_checkpoint,json=leaseCheckpoint,proto3" json:"lease_checkpoint,omitempty"`
+	RevisionTime              *RevisionTimeRequest                      `protobuf:"bytes,12,opt,name=revision_time,json=revisionTime,proto3" json:"revision_time,omitempty"`
+	CompactionRetentionPolicy *CompactionRetentionPolicyRequest         `protobuf:"bytes,13,opt,name=compaction_retention_policy,json=compactionRetentionPolicy,proto3" json:"compaction_retention_policy,omitempty"`
+	AutoDefragLock            *AutoDefragLockRequest                    `protobuf:"bytes,14,opt,name=auto_defrag_lock,json=autoDefragLock,proto3" json:"auto_defrag_lock,omitempty"`
+	AuthEnable                *AuthEnableRequest                        `protobuf:"bytes,1000,opt,name=auth_enable,json=authEnable,proto3" json:"auth_enable,omitempty"`
+	AuthDisable               *AuthDisableRequest                       `protobuf:"bytes,1011,opt,name=auth_disable,json=authDisable,proto3" json:"auth_disable,omitempty"`
+	AuthStatus                *AuthStatusRequest                        `protobuf:"bytes,1013,opt,name=auth_status,json=authStatus,proto3" json:"auth_status,omitempty"`
+	Authenticate              *InternalAuthenticateRequest              `protobuf:"bytes,1012,opt,name=authenticate,proto3" json:"authenticate,omitempty"`
+	AuthUserAdd               *AuthUserAddRequest                       `protobuf:"bytes,1100,opt,name=auth_user_add,json=authUserAdd,proto3" json:"auth_user_add,omitempty"`
+	AuthUserDelete            *AuthUserDeleteRequest                    `protobuf:"bytes,1101,opt,name=auth_user_delete,json=authUserDelete,proto3" json:"auth_user_delete,omitempty"`
+	AuthUserGet               *AuthUserGetRequest                       `protobuf:"bytes,1102,opt,name=auth_user_get,json=authUserGet,proto3" json:"auth_user_get,omitempty"`
+	AuthUserChangePassword    *AuthUserChangePasswordRequest            `protobuf:"bytes,1103,opt,name=auth_user_change_password,json=authUserChangePassword,proto3" json:"auth_user_change_password,omitempty"`
+	AuthUserGrantRole         *AuthUserGrantRoleRequest                 `protobuf:"bytes,1104,opt,name=auth_user_grant_role,json=authUserGrantRole,proto3" json:"auth_user_grant_role,omitempty"`
+	AuthUserRevokeRole        *AuthUserRevokeRoleRequest                `protobuf:"bytes,1105,opt,name=auth_user_revoke_role,json=authUserRevokeRole,proto3" json:"auth_user_revoke_role,omitempty"`
+	AuthUserList              *AuthUserListRequest                      `protobuf:"bytes,1106,opt,name=auth_user_list,json=authUserList,proto3" json:"auth_user_list,omitempty"`
+	AuthRoleList              *AuthRoleListRequest                      `protobuf:"bytes,1107,opt,name=auth_role_list,json=authRoleList,proto3" json:"auth_role_list,omitempty"`
+	AuthRoleAdd               *AuthRoleAddRequest                       `protobuf:"bytes,1200,opt,name=auth_role_add,json=authRoleAdd,proto3" json:"auth_role_add,omitempty"`
+	AuthRoleDelete            *AuthRoleDeleteRequest                    `protobuf:"bytes,1201,opt,name=auth_role_delete,json=authRoleDelete,proto3" json:"auth_role_delete,omitempty"`
+	AuthRoleGet               *AuthRoleGetRequest                       `protobuf:"bytes,1202,opt,name=auth_role_get,json=authRoleGet,proto3" json:"auth_role_get,omitempty"`
+	AuthRoleGrantPermission   *AuthRoleGrantPermissionRequest           `protobuf:"bytes,1203,opt,name=auth_role_grant_permission,json=authRoleGrantPermission,proto3" json:"auth_role_grant_permission,omitempty"`
+	AuthRoleRevokePermission  *AuthRoleRevokePermissionRequest          `protobuf:"bytes,1204,opt,name=auth_role_revoke_permission,json=authRoleRevokePermission,proto3" json:"auth_role_revoke_permission,omitempty"`
+	ClusterVersionSet         *membershippb.ClusterVersionSetRequest    `protobuf:"bytes,1300,opt,name=cluster_version_set,json=clusterVersionSet,proto3" json:"cluster_version_set,omitempty"`
+	ClusterMemberAttrSet      *membershippb.ClusterMemberAttrSetRequest `protobuf:"bytes,1301,opt,name=cluster_member_attr_set,json=clusterMemberAttrSet,proto3" json:"cluster_member_attr_set,omitempty"`
+	DowngradeInfoSet          *membershippb.DowngradeInfoSetRequest     `protobuf:"bytes,1302,opt,name=downgrade_info_set,json=downgradeInfoSet,proto3" json:"downgrade_info_set,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                                  `json:"-"`
+	XXX_unrecognized          []byte                                    `json:"-"`
+	XXX_sizecache             int32                                     `json:"-"`
 }
 
 func (m *InternalRaftRequest) Reset()         { *m = InternalRaftRequest{} }
@@ -182,6 +185,186 @@ func (m *EmptyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EmptyResponse proto.InternalMessageInfo
 
+// RevisionTimeRequest is proposed by the leader to record the revision
+// current when it is applied in the revision time index, at the time of
+// the leader, so that every member records the same times.
+type RevisionTimeRequest struct {
+	// timestamp is the time of the leader in nanoseconds since the Unix epoch.
+	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevisionTimeRequest) Reset()         { *m = RevisionTimeRequest{} }
+func (m *RevisionTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*RevisionTimeRequest) ProtoMessage()    {}
+func (*RevisionTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4c9a9be0cfca103, []int{3}
+}
+func (m *RevisionTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevisionTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevisionTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevisionTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionTimeRequest.Merge(m, src)
+}
+func (m *RevisionTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevisionTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevisionTimeRequest proto.InternalMessageInfo
+
+// CompactionRetentionPolicyRequest is proposed by the leader to set the
+// prefix retentions of its configuration as the ones every compaction of
+// the cluster keeps the history of, whichever member receives it.
+type CompactionRetentionPolicyRequest struct {
+	Retentions           []*CompactionPrefixRetention `protobuf:"bytes,1,rep,name=retentions,proto3" json:"retentions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *CompactionRetentionPolicyRequest) Reset()         { *m = CompactionRetentionPolicyRequest{} }
+func (m *CompactionRetentionPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*CompactionRetentionPolicyRequest) ProtoMessage()    {}
+func (*CompactionRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4c9a9be0cfca103, []int{4}
+}
+func (m *CompactionRetentionPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactionRetentionPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactionRetentionPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactionRetentionPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionRetentionPolicyRequest.Merge(m, src)
+}
+func (m *CompactionRetentionPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactionRetentionPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionRetentionPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionRetentionPolicyRequest proto.InternalMessageInfo
+
+type CompactionPrefixRetention struct {
+	// prefix is the key prefix the history is kept for.
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// revisions, if set, is the number of revisions the history is kept for.
+	Revisions int64 `protobuf:"varint,2,opt,name=revisions,proto3" json:"revisions,omitempty"`
+	// duration, if set, is the time in nanoseconds the history is kept for,
+	// as recorded in the revision time index.
+	Duration             int64    `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompactionPrefixRetention) Reset()         { *m = CompactionPrefixRetention{} }
+func (m *CompactionPrefixRetention) String() string { return proto.CompactTextString(m) }
+func (*CompactionPrefixRetention) ProtoMessage()    {}
+func (*CompactionPrefixRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4c9a9be0cfca103, []int{5}
+}
+func (m *CompactionPrefixRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactionPrefixRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactionPrefixRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactionPrefixRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionPrefixRetention.Merge(m, src)
+}
+func (m *CompactionPrefixRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactionPrefixRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionPrefixRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionPrefixRetention proto.InternalMessageInfo
+
+// AutoDefragLockRequest is proposed by a member to take the lock members
+// hold while they defragment automatically, so that they defragment one at
+// a time. The lock is held as long as its lease is alive.
+type AutoDefragLockRequest struct {
+	// member_id is the ID of the member taking the lock.
+	MemberId uint64 `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	// lease is the ID of the lease holding the lock, which the member keeps
+	// alive while it defragments.
+	Lease                int64    `protobuf:"varint,2,opt,name=lease,proto3" json:"lease,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AutoDefragLockRequest) Reset()         { *m = AutoDefragLockRequest{} }
+func (m *AutoDefragLockRequest) String() string { return proto.CompactTextString(m) }
+func (*AutoDefragLockRequest) ProtoMessage()    {}
+func (*AutoDefragLockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4c9a9be0cfca103, []int{6}
+}
+func (m *AutoDefragLockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoDefragLockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoDefragLockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoDefragLockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoDefragLockRequest.Merge(m, src)
+}
+func (m *AutoDefragLockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoDefragLockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoDefragLockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoDefragLockRequest proto.InternalMessageInfo
+
 // What is the difference between AuthenticateRequest (defined in rpc.proto) and InternalAuthenticateRequest?
 // InternalAuthenticateRequest has a member that is filled by etcdserver and shouldn't be user-facing.
 // For avoiding misusage the field, we have an internal version of AuthenticateRequest.
@@ -199,7 +382,7 @@ func (m *InternalAuthenticateRequest) Reset()         { *m = InternalAuthenticat
 func (m *InternalAuthenticateRequest) String() string { return proto.CompactTextString(m) }
 func (*InternalAuthenticateRequest) ProtoMessage()    {}
 func (*InternalAuthenticateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4c9a9be0cfca103, []int{3}
+	return fileDescriptor_b4c9a9be0cfca103, []int{7}
 }
 func (m *InternalAuthenticateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,80 +415,98 @@ func init() {
 	proto.RegisterType((*RequestHeader)(nil), "etcdserverpb.RequestHeader")
 	proto.RegisterType((*InternalRaftRequest)(nil), "etcdserverpb.InternalRaftRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "etcdserverpb.EmptyResponse")
+	proto.RegisterType((*RevisionTimeRequest)(nil), "etcdserverpb.RevisionTimeRequest")
+	proto.RegisterType((*CompactionRetentionPolicyRequest)(nil), "etcdserverpb.CompactionRetentionPolicyRequest")
+	proto.RegisterType((*CompactionPrefixRetention)(nil), "etcdserverpb.CompactionPrefixRetention")
+	proto.RegisterType((*AutoDefragLockRequest)(nil), "etcdserverpb.AutoDefragLockRequest")
 	proto.RegisterType((*InternalAuthenticateRequest)(nil), "etcdserverpb.InternalAuthenticateRequest")
 }
 
 func init() { proto.RegisterFile("raft_internal.proto", fileDescriptor_b4c9a9be0cfca103) }
 
 var fileDescriptor_b4c9a9be0cfca103 = []byte{
-	// 1072 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0x4b, 0x73, 0x1b, 0x45,
-	0x17, 0x8d, 0x6c, 0xc7, 0xb6, 0x5a, 0xb6, 0xe3, 0xb4, 0x9d, 0x2f, 0xfd, 0xd9, 0x55, 0xc6, 0x71,
-	0x48, 0x30, 0x10, 0xe4, 0x20, 0xc3, 0x02, 0x36, 0xa0, 0x48, 0x2e, 0xc7, 0x54, 0x48, 0xb9, 0x26,
-	0x81, 0x4a, 0x41, 0x51, 0x43, 0x6b, 0xe6, 0x5a, 0x9a, 0x78, 0x34, 0x33, 0x74, 0xb7, 0x14, 0x67,
-	0xcb, 0x92, 0x35, 0x50, 0xfc, 0x0c, 0x9e, 0xff, 0x21, 0x45, 0xf1, 0x08, 0xf0, 0x07, 0xc0, 0x6c,
-	0xd8, 0x03, 0x7b, 0xaa, 0x1f, 0xf3, 0x92, 0x5a, 0xde, 0x8d, 0xee, 0x3d, 0xf7, 0x9c, 0xd3, 0xdd,
-	0xb7, 0x5b, 0x17, 0xad, 0x30, 0x7a, 0x24, 0xdc, 0x20, 0x12, 0xc0, 0x22, 0x1a, 0xd6, 0x13, 0x16,
-	0x8b, 0x18, 0x2f, 0x80, 0xf0, 0x7c, 0x0e, 0x6c, 0x08, 0x2c, 0xe9, 0xac, 0xad, 0x76, 0xe3, 0x6e,
-	0xac, 0x12, 0x3b, 0xf2, 0x4b, 0x63, 0xd6, 0x96, 0x73, 0x8c, 0x89, 0x54, 0x59, 0xe2, 0x99, 0xcf,
-	0x4d, 0x99, 0xdc, 0xa1, 0x49, 0xb0, 0x33, 0x04, 0xc6, 0x83, 0x38, 0x4a, 0x3a, 0xe9, 0x97, 0x41,
-	0x5c, 0xcf, 0x10, 0x7d, 0xe8, 0x77, 0x80, 0xf1, 0x5e, 0x90, 0x24, 0x9d, 0xc2, 0x0f, 0x8d, 0xdb,
-	0x62, 0x68, 0xd1, 0x81, 0x8f, 0x06, 0xc0, 0xc5, 0x6d, 0xa0, 0x3e, 0x30, 0xbc, 0x84, 0xa6, 0x0e,
-	0xda, 0xa4, 0xb2, 0x59, 0xd9, 0x9e, 0x71, 0xa6, 0x0e, 0xda, 0x78, 0x0d, 0xcd, 0x0f, 0xb8, 0x34,
-	0xdf, 0x07, 0x32, 0xb5, 0x59, 0xd9, 0xae, 0x3a, 0xd9, 0x6f, 0x7c, 0x03, 0x2d, 0xd2, 0x81, 0xe8,
-	0xb9, 0x0c, 0x86, 0x81, 0xd4, 0x26, 0xd3, 0xb2, 0xec, 0xd6, 0xdc, 0x27, 0xdf, 0x91, 0xe9, 0xdd,
-	0xfa, 0xcb, 0xce, 0x82, 0xcc, 0x3a, 0x26, 0xf9, 0xfa, 0xdc, 0xc7, 0x2a, 0x7c, 0x73, 0xeb, 0x7b,
-	0x8c, 0x56, 0x0e, 0xcc, 0x8e, 0x38, 0xf4, 0x48, 0x18, 0x03, 0x78, 0x17, 0xcd, 0xf6, 0x94, 0x09,
-	0xe2, 0x6f, 0x56, 0xb6, 0x6b, 0x8d, 0xf5, 0x7a, 0x71, 0x9f, 0xea, 0x25, 0x9f, 0x8e, 0x81, 0x8e,
-	0xf9, 0xbd, 0x86, 0xa6, 0x86, 0x0d, 0xe5, 0xb4, 0xd6, 0xb8, 0x64, 0x25, 0x70, 0xa6, 0x86, 0x0d,
-	0x7c, 0x13, 0x9d, 0x67, 0x34, 0xea, 0x82, 0xb2, 0x5c, 0x6b, 0xac, 0x8d, 0x20, 0x65, 0x2a, 0x85,
-	0x6b, 0x20, 0x7e, 0x01, 0x4d, 0x27, 0x03, 0x41, 0x66, 0x14, 0x9e, 0x94, 0xf1, 0x87, 0x83, 0x74,
-	0x11, 0x8e, 0x04, 0xe1, 0x16, 0x5a, 0xf0, 0x21, 0x04, 0x01, 0xae, 0x16, 0x39, 0xaf, 0x8a, 0x36,
-	0xcb, 0x45, 0x6d, 0x85, 0x28, 0x49, 0xd5, 0xfc, 0x3c, 0x26, 0x05, 0xc5, 0x49, 0x44, 0x66, 0x6d,
-	0x82, 0xf7, 0x4f, 0xa2, 0x4c, 0x50, 0x9c, 0x44, 0xf8, 0x0d, 0x84, 0xbc, 0xb8, 0x9f, 0x50, 0x4f,
-	0xc8, 0x63, 0x98, 0x53, 0x25, 0xcf, 0x94, 0x4b, 0x5a, 0x59, 0x3e, 0xad, 0x2c, 0x94, 0xe0, 0x37,
-	0x51, 0x2d, 0x04, 0xca, 0xc1, 0xed, 0x32, 0x1a, 0x09, 0x32, 0x6f, 0x63, 0xb8, 0x23, 0x01, 0xfb,
-	0x32, 0x9f, 0x31, 0x84, 0x59, 0x48, 0xae, 0x59, 0x33, 0x30, 0x18, 0xc6, 0xc7, 0x40, 0xaa, 0xb6,
-	0x35, 0x2b, 0x0a, 0x47, 0x01, 0xb2, 0x35, 0x87, 0x79, 0x4c, 0x1e, 0x0b, 0x0d, 0x29, 0xeb, 0x13,
-	0x64, 0x3b, 0x96, 0xa6, 0x4c, 0x65, 0xc7, 0xa2, 0x80, 0xf8, 0x01, 0x5a, 0xd6, 0xb2, 0x5e, 0x0f,
-	0xbc, 0xe3, 0x24, 0x0e, 0x22, 0x41, 0x6a, 0xaa, 0xf8, 0x59, 0x8b, 0x74, 0x2b, 0x03, 0x19, 0x9a,
-	0xb4, 0x59, 0x5f, 0x71, 0x2e, 0x84, 0x65, 0x00, 0x6e, 0xa2, 0x9a, 0xea, 0x6e, 0x88, 0x68, 0x27,
-	0x04, 0xf2, 0x97, 0x75, 0x57, 0x9b, 0x03, 0xd1, 0xdb, 0x53, 0x80, 0x6c, 0x4f, 0x68, 0x16, 0xc2,
-	0x6d, 0xa4, 0xae, 0x80, 0xeb, 0x07, 0x5c, 0x71, 0xfc, 0x3d, 0x67, 0xdb, 0x14, 0xc9, 0xd1, 0xd6,
-	0x88, 0x6c, 0x53, 0x68, 0x1e, 0xc3, 0x6f, 0x19, 0x23, 0x5c, 0x50, 0x31, 0xe0, 0xe4, 0xdf, 0x89,
-	0x46, 0xee, 0x29, 0xc0, 0xc8, 0xca, 0x5e, 0xd5, 0x8e, 0x74, 0x0e, 0xdf, 0xd5, 0x8e, 0x20, 0x12,
-	0x81, 0x47, 0x05, 0x90, 0x7f, 0x34, 0xd9, 0xf3, 0x65, 0xb2, 0xf4, 0x76, 0x36, 0x0b, 0xd0, 0xd4,
-	0x5a, 0xa9, 0x1e, 0xef, 0x99, 0x27, 0x40, 0xbe, 0x09, 0x2e, 0xf5, 0x7d, 0xf2, 0xc3, 0xfc, 0xa4,
-	0x25, 0xbe, 0xc3, 0x81, 0x35, 0x7d, 0xbf, 0xb4, 0x44, 0x13, 0xc3, 0x77, 0xd1, 0x72, 0x4e, 0xa3,
-	0x2f, 0x01, 0xf9, 0x51, 0x33, 0x5d, 0xb5, 0x33, 0x99, 0xdb, 0x63, 0xc8, 0x96, 0x68, 0x29, 0x5c,
-	0xb6, 0xd5, 0x05, 0x41, 0x7e, 0x3a, 0xd3, 0xd6, 0x3e, 0x88, 0x31, 0x5b, 0xfb, 0x20, 0x70, 0x17,
-	0xfd, 0x3f, 0xa7, 0xf1, 0x7a, 0xf2, 0x5a, 0xba, 0x09, 0xe5, 0xfc, 0x51, 0xcc, 0x7c, 0xf2, 0xb3,
-	0xa6, 0x7c, 0xd1, 0x4e, 0xd9, 0x52, 0xe8, 0x43, 0x03, 0x4e, 0xd9, 0xff, 0x47, 0xad, 0x69, 0xfc,
-	0x00, 0xad, 0x16, 0xfc, 0xca, 0xfb, 0xe4, 0xb2, 0x38, 0x04, 0xf2, 0x54, 0x6b, 0x5c, 0x9f, 0x60,
-	0x5b, 0xdd, 0xc5, 0x38, 0x6f, 0x9b, 0x8b, 0x74, 0x34, 0x83, 0xdf, 0x47, 0x97, 0x72, 0x66, 0x7d,
-	0x35, 0x35, 0xf5, 0x2f, 0x9a, 0xfa, 0x39, 0x3b, 0xb5, 0xb9, 0xa3, 0x05, 0x6e, 0x4c, 0xc7, 0x52,
-	0xf8, 0x36, 0x5a, 0xca, 0xc9, 0xc3, 0x80, 0x0b, 0xf2, 0xab, 0x66, 0xbd, 0x62, 0x67, 0xbd, 0x13,
-	0x70, 0x51, 0xea, 0xa3, 0x34, 0x98, 0x31, 0x49, 0x6b, 0x9a, 0xe9, 0xb7, 0x89, 0x4c, 0x52, 0x7a,
-	0x8c, 0x29, 0x0d, 0x66, 0x47, 0xaf, 0x98, 0x64, 0x47, 0x7e, 0x59, 0x9d, 0x74, 0xf4, 0xb2, 0x66,
-	0xb4, 0x23, 0x4d, 0x2c, 0xeb, 0x48, 0x45, 0x63, 0x3a, 0xf2, 0xab, 0xea, 0xa4, 0x8e, 0x94, 0x55,
-	0x96, 0x8e, 0xcc, 0xc3, 0x65, 0x5b, 0xb2, 0x23, 0xbf, 0x3e, 0xd3, 0xd6, 0x68, 0x47, 0x9a, 0x18,
-	0x7e, 0x88, 0xd6, 0x0a, 0x34, 0xaa, 0x51, 0x12, 0x60, 0xfd, 0x80, 0xab, 0xff, 0xdf, 0x6f, 0x34,
-	0xe7, 0x8d, 0x09, 0x9c, 0x12, 0x7e, 0x98, 0xa1, 0x53, 0xfe, 0xcb, 0xd4, 0x9e, 0xc7, 0x7d, 0xb4,
-	0x9e, 0x6b, 0x99, 0xd6, 0x29, 0x88, 0x7d, 0xab, 0xc5, 0x5e, 0xb2, 0x8b, 0xe9, 0x2e, 0x19, 0x57,
-	0x23, 0x74, 0x02, 0x00, 0x7f, 0x88, 0x56, 0xbc, 0x70, 0xc0, 0x05, 0x30, 0xd7, 0xcc, 0x32, 0x2e,
-	0x07, 0x41, 0x3e, 0x45, 0xe6, 0x0a, 0x14, 0x07, 0x99, 0x7a, 0x4b, 0x23, 0xdf, 0xd5, 0xc0, 0x7b,
-	0x20, 0xc6, 0x5e, 0xbd, 0x8b, 0xde, 0x28, 0x04, 0x3f, 0x44, 0x97, 0x53, 0x05, 0x4d, 0xe6, 0x52,
-	0x21, 0x98, 0x52, 0xf9, 0x0c, 0x99, 0x77, 0xd0, 0xa6, 0xf2, 0xb6, 0x8a, 0x35, 0x85, 0x60, 0x36,
-	0xa1, 0x55, 0xcf, 0x82, 0xc2, 0x1f, 0x20, 0xec, 0xc7, 0x8f, 0xa2, 0x2e, 0xa3, 0x3e, 0xb8, 0x41,
-	0x74, 0x14, 0x2b, 0x99, 0xcf, 0xb5, 0xcc, 0xb5, 0xb2, 0x4c, 0x3b, 0x05, 0x1e, 0x44, 0x47, 0xb1,
-	0x4d, 0x62, 0xd9, 0x1f, 0x41, 0xe4, 0xc3, 0xd4, 0x05, 0xb4, 0xb8, 0xd7, 0x4f, 0xc4, 0x63, 0x07,
-	0x78, 0x12, 0x47, 0x1c, 0xb6, 0x1e, 0xa3, 0xf5, 0x33, 0x9e, 0x6f, 0x8c, 0xd1, 0x8c, 0x9a, 0xe5,
-	0x2a, 0x6a, 0x96, 0x53, 0xdf, 0x72, 0xc6, 0xcb, 0x5e, 0x35, 0x33, 0xe3, 0xa5, 0xbf, 0xf1, 0x15,
-	0xb4, 0xc0, 0x83, 0x7e, 0x12, 0x82, 0x2b, 0xe2, 0x63, 0xd0, 0x23, 0x5e, 0xd5, 0xa9, 0xe9, 0xd8,
-	0x7d, 0x19, 0xca, 0xbc, 0xdc, 0x7a, 0xed, 0xc9, 0x1f, 0x1b, 0xe7, 0x9e, 0x9c, 0x6e, 0x54, 0x9e,
-	0x9e, 0x6e, 0x54, 0x7e, 0x3f, 0xdd, 0xa8, 0x7c, 0xf1, 0xe7, 0xc6, 0xb9, 0xf7, 0xae, 0x76, 0x63,
-	0xd5, 0x22, 0xf5, 0x20, 0xde, 0xc9, 0xe7, 0xd6, 0xdd, 0x9d, 0x62, 0xdb, 0x74, 0x66, 0xd5, 0x38,
-	0xba, 0xfb, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x06, 0x59, 0x70, 0x10, 0x30, 0x0b, 0x00, 0x00,
+	// 1295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x57, 0x4b, 0x73, 0x1b, 0x45,
+	0x10, 0x8e, 0xac, 0xc4, 0x96, 0x46, 0xb2, 0xe3, 0x8c, 0x9d, 0x64, 0x6c, 0x53, 0x46, 0x71, 0x48,
+	0x30, 0x10, 0xe4, 0x60, 0x43, 0xaa, 0xa0, 0xa8, 0x02, 0xc5, 0x72, 0x39, 0xa6, 0x4c, 0x70, 0xad,
+	0x4d, 0x2a, 0x05, 0x45, 0x2d, 0xa3, 0xdd, 0x91, 0xb4, 0xf1, 0xbe, 0x98, 0x19, 0xc9, 0xf6, 0x95,
+	0x23, 0x67, 0xa0, 0xf8, 0x19, 0x3c, 0xff, 0x43, 0x0e, 0x3c, 0x02, 0xfc, 0x01, 0x30, 0x17, 0x0e,
+	0xdc, 0x80, 0x3b, 0x35, 0x8f, 0x7d, 0x49, 0xbb, 0xe6, 0xb6, 0xd3, 0xfd, 0xf5, 0xf7, 0xf5, 0xec,
+	0xf4, 0xf6, 0xf4, 0x82, 0x39, 0x8a, 0xbb, 0xdc, 0x74, 0x7c, 0x4e, 0xa8, 0x8f, 0xdd, 0x66, 0x48,
+	0x03, 0x1e, 0xc0, 0x3a, 0xe1, 0x96, 0xcd, 0x08, 0x1d, 0x12, 0x1a, 0x76, 0x16, 0xe7, 0x7b, 0x41,
+	0x2f, 0x90, 0x8e, 0x35, 0xf1, 0xa4, 0x30, 0x8b, 0xb3, 0x09, 0x46, 0x5b, 0xaa, 0x34, 0xb4, 0xf4,
+	0x63, 0x43, 0x38, 0xd7, 0x70, 0xe8, 0xac, 0x0d, 0x09, 0x65, 0x4e, 0xe0, 0x87, 0x9d, 0xe8, 0x49,
+	0x23, 0x6e, 0xc6, 0x08, 0x8f, 0x78, 0x1d, 0x42, 0x59, 0xdf, 0x09, 0xc3, 0x4e, 0x6a, 0xa1, 0x70,
+	0x2b, 0x14, 0x4c, 0x1b, 0xe4, 0xa3, 0x01, 0x61, 0xfc, 0x1e, 0xc1, 0x36, 0xa1, 0x70, 0x06, 0x4c,
+	0xec, 0xb4, 0x51, 0xa9, 0x51, 0x5a, 0x3d, 0x6f, 0x4c, 0xec, 0xb4, 0xe1, 0x22, 0xa8, 0x0c, 0x98,
+	0x48, 0xde, 0x23, 0x68, 0xa2, 0x51, 0x5a, 0xad, 0x1a, 0xf1, 0x1a, 0xde, 0x02, 0xd3, 0x78, 0xc0,
+	0xfb, 0x26, 0x25, 0x43, 0x47, 0x68, 0xa3, 0xb2, 0x08, 0xbb, 0x3b, 0xf5, 0xc9, 0x77, 0xa8, 0xbc,
+	0xd1, 0x7c, 0xc9, 0xa8, 0x0b, 0xaf, 0xa1, 0x9d, 0xaf, 0x4d, 0x7d, 0x2c, 0xcd, 0xb7, 0x57, 0xfe,
+	0x9a, 0x07, 0x73, 0x3b, 0xfa, 0x8d, 0x18, 0xb8, 0xcb, 0x75, 0x02, 0x70, 0x03, 0x4c, 0xf6, 0x65,
+	0x12, 0xc8, 0x6e, 0x94, 0x56, 0x6b, 0xeb, 0x4b, 0xcd, 0xf4, 0x7b, 0x6a, 0x66, 0xf2, 0x34, 0x34,
+	0x74, 0x2c, 0xdf, 0x1b, 0x60, 0x62, 0xb8, 0x2e, 0x33, 0xad, 0xad, 0x5f, 0xce, 0x25, 0x30, 0x26,
+	0x86, 0xeb, 0xf0, 0x36, 0xb8, 0x40, 0xb1, 0xdf, 0x23, 0x32, 0xe5, 0xda, 0xfa, 0xe2, 0x08, 0x52,
+	0xb8, 0x22, 0xb8, 0x02, 0xc2, 0xe7, 0x41, 0x39, 0x1c, 0x70, 0x74, 0x5e, 0xe2, 0x51, 0x16, 0xbf,
+	0x37, 0x88, 0x36, 0x61, 0x08, 0x10, 0xdc, 0x04, 0x75, 0x9b, 0xb8, 0x84, 0x13, 0x53, 0x89, 0x5c,
+	0x90, 0x41, 0x8d, 0x6c, 0x50, 0x5b, 0x22, 0x32, 0x52, 0x35, 0x3b, 0xb1, 0x09, 0x41, 0x7e, 0xec,
+	0xa3, 0xc9, 0x3c, 0xc1, 0x83, 0x63, 0x3f, 0x16, 0xe4, 0xc7, 0x3e, 0x7c, 0x03, 0x00, 0x2b, 0xf0,
+	0x42, 0x6c, 0x71, 0x71, 0x0c, 0x53, 0x32, 0xe4, 0xe9, 0x6c, 0xc8, 0x66, 0xec, 0x8f, 0x22, 0x53,
+	0x21, 0xf0, 0x4d, 0x50, 0x73, 0x09, 0x66, 0xc4, 0xec, 0x51, 0xec, 0x73, 0x54, 0xc9, 0x63, 0xd8,
+	0x15, 0x80, 0x6d, 0xe1, 0x8f, 0x19, 0xdc, 0xd8, 0x24, 0xf6, 0xac, 0x18, 0x28, 0x19, 0x06, 0x87,
+	0x04, 0x55, 0xf3, 0xf6, 0x2c, 0x29, 0x0c, 0x09, 0x88, 0xf7, 0xec, 0x26, 0x36, 0x71, 0x2c, 0xd8,
+	0xc5, 0xd4, 0x43, 0x20, 0xef, 0x58, 0x5a, 0xc2, 0x15, 0x1f, 0x8b, 0x04, 0xc2, 0x87, 0x60, 0x56,
+	0xc9, 0x5a, 0x7d, 0x62, 0x1d, 0x86, 0x81, 0xe3, 0x73, 0x54, 0x93, 0xc1, 0xcf, 0xe4, 0x48, 0x6f,
+	0xc6, 0x20, 0x4d, 0x13, 0x15, 0xeb, 0xcb, 0xc6, 0x45, 0x37, 0x0b, 0x80, 0xef, 0x80, 0xe9, 0xa8,
+	0xb0, 0x4d, 0xee, 0x78, 0x04, 0xd5, 0x25, 0xed, 0xb5, 0xd1, 0xa2, 0x52, 0x90, 0x03, 0xc7, 0x23,
+	0x23, 0x9c, 0x77, 0x8c, 0x3a, 0x4d, 0x79, 0xe1, 0x11, 0x58, 0x4a, 0xde, 0xb8, 0x49, 0x09, 0x27,
+	0xbe, 0x7c, 0x0a, 0x03, 0xd7, 0xb1, 0x4e, 0xd0, 0xb4, 0xa4, 0x6f, 0x16, 0x9f, 0x9a, 0xc6, 0xef,
+	0x49, 0xf8, 0x98, 0xd6, 0x82, 0x55, 0x04, 0x85, 0x0f, 0xc0, 0x2c, 0x1e, 0xf0, 0xc0, 0xb4, 0x49,
+	0x97, 0xe2, 0x9e, 0xe9, 0x06, 0xd6, 0x21, 0x9a, 0x91, 0x6a, 0xd7, 0x47, 0x5e, 0xf0, 0x80, 0x07,
+	0x6d, 0x09, 0xda, 0x0d, 0xac, 0xc3, 0x31, 0x89, 0x19, 0x9c, 0xf1, 0xc3, 0x16, 0xa8, 0xc9, 0xef,
+	0x9f, 0xf8, 0xb8, 0xe3, 0x12, 0xf4, 0x67, 0x6e, 0xdd, 0xb5, 0x06, 0xbc, 0xbf, 0x25, 0x01, 0x71,
+	0xd5, 0xe0, 0xd8, 0x04, 0xdb, 0x40, 0x36, 0x09, 0xd3, 0x76, 0x98, 0xe4, 0xf8, 0x7b, 0x2a, 0xaf,
+	0x6c, 0x04, 0x47, 0x5b, 0x21, 0xe2, 0xb2, 0xc1, 0x89, 0x0d, 0xbe, 0xa5, 0x13, 0x61, 0x1c, 0xf3,
+	0x01, 0x43, 0xff, 0x16, 0x26, 0xb2, 0x2f, 0x01, 0x23, 0x1b, 0x7b, 0x45, 0x65, 0xa4, 0x7c, 0xf0,
+	0xbe, 0xca, 0x48, 0xbc, 0x40, 0x0b, 0x73, 0x82, 0xfe, 0x51, 0x64, 0xcf, 0x65, 0xc9, 0xa2, 0xfe,
+	0xd5, 0x4a, 0x41, 0xa3, 0xd4, 0x32, 0xf1, 0x70, 0x4b, 0x37, 0x49, 0xd1, 0x35, 0x4d, 0x6c, 0xdb,
+	0xe8, 0xfb, 0x4a, 0xd1, 0x16, 0xdf, 0x65, 0x84, 0xb6, 0x6c, 0x3b, 0xb3, 0x45, 0x6d, 0x83, 0xf7,
+	0xe5, 0x19, 0x6a, 0x1a, 0xd5, 0x26, 0xd0, 0x0f, 0x95, 0x82, 0x43, 0x94, 0x51, 0xba, 0xbf, 0x68,
+	0xb2, 0x19, 0x9c, 0x31, 0x67, 0xd3, 0xea, 0x11, 0x8e, 0x7e, 0x3c, 0x33, 0xad, 0x6d, 0xc2, 0xc7,
+	0xd2, 0xda, 0x26, 0x1c, 0xf6, 0xc0, 0x42, 0x42, 0x63, 0xf5, 0x45, 0xe3, 0x32, 0x43, 0xcc, 0xd8,
+	0x51, 0x40, 0x6d, 0xf4, 0x93, 0xa2, 0x7c, 0x21, 0x9f, 0x72, 0x53, 0xa2, 0xf7, 0x34, 0x38, 0x62,
+	0xbf, 0x82, 0x73, 0xdd, 0xf0, 0x21, 0x98, 0x4f, 0xe5, 0x2b, 0x3a, 0x8e, 0x49, 0x03, 0x97, 0xa0,
+	0x27, 0x4a, 0xe3, 0x66, 0x41, 0xda, 0xb2, 0x5b, 0x05, 0x49, 0xd9, 0x5c, 0xc2, 0xa3, 0x1e, 0xf8,
+	0x3e, 0xb8, 0x9c, 0x30, 0xab, 0xe6, 0xa5, 0xa8, 0x7f, 0x56, 0xd4, 0xcf, 0xe6, 0x53, 0xeb, 0x2e,
+	0x96, 0xe2, 0x86, 0x78, 0xcc, 0x05, 0xef, 0x81, 0x99, 0x84, 0xdc, 0x75, 0x18, 0x47, 0xbf, 0x54,
+	0xf2, 0xda, 0x48, 0xc4, 0xba, 0xeb, 0x30, 0x9e, 0xa9, 0xa3, 0xc8, 0x18, 0x33, 0x89, 0xd4, 0x14,
+	0xd3, 0xaf, 0x85, 0x4c, 0x42, 0x7a, 0x8c, 0x29, 0x32, 0xc6, 0x47, 0x2f, 0x99, 0x44, 0x45, 0x7e,
+	0x59, 0x2d, 0x3a, 0x7a, 0x11, 0x33, 0x5a, 0x91, 0xda, 0x16, 0x57, 0xa4, 0xa4, 0xd1, 0x15, 0xf9,
+	0x55, 0xb5, 0xa8, 0x22, 0x45, 0x54, 0x4e, 0x45, 0x26, 0xe6, 0x6c, 0x5a, 0xa2, 0x22, 0xbf, 0x3e,
+	0x33, 0xad, 0xd1, 0x8a, 0xd4, 0x36, 0xf8, 0x08, 0x2c, 0xa6, 0x68, 0x64, 0xa1, 0x84, 0x84, 0x7a,
+	0x0e, 0x93, 0x13, 0xca, 0x37, 0x8a, 0xf3, 0x56, 0x01, 0xa7, 0x80, 0xef, 0xc5, 0xe8, 0x88, 0xff,
+	0x2a, 0xce, 0xf7, 0x43, 0x0f, 0x2c, 0x25, 0x5a, 0xba, 0x74, 0x52, 0x62, 0xdf, 0x2a, 0xb1, 0x17,
+	0xf3, 0xc5, 0x54, 0x95, 0x8c, 0xab, 0x21, 0x5c, 0x00, 0x80, 0x1f, 0x82, 0x39, 0xcb, 0x1d, 0x30,
+	0x4e, 0xa8, 0xa9, 0xa7, 0x3d, 0x93, 0x11, 0x8e, 0x3e, 0x05, 0xfa, 0x13, 0x48, 0x8f, 0x7a, 0xcd,
+	0x4d, 0x85, 0x7c, 0xa0, 0x80, 0xfb, 0x84, 0x8f, 0x75, 0xbd, 0x4b, 0xd6, 0x28, 0x04, 0x3e, 0x02,
+	0x57, 0x23, 0x05, 0x45, 0x66, 0x62, 0xce, 0xa9, 0x54, 0xf9, 0x0c, 0xe8, 0x3e, 0x98, 0xa7, 0xf2,
+	0xb6, 0xb4, 0xb5, 0x38, 0xa7, 0x79, 0x42, 0xf3, 0x56, 0x0e, 0x0a, 0x7e, 0x00, 0xa0, 0x1d, 0x1c,
+	0xf9, 0x3d, 0x8a, 0x6d, 0x62, 0x3a, 0x7e, 0x37, 0x90, 0x32, 0x9f, 0x2b, 0x99, 0x1b, 0x59, 0x99,
+	0x76, 0x04, 0xdc, 0xf1, 0xbb, 0x41, 0x9e, 0xc4, 0xac, 0x3d, 0x82, 0x48, 0xc6, 0xcd, 0x8b, 0x60,
+	0x7a, 0xcb, 0x0b, 0xf9, 0x89, 0x41, 0x58, 0x18, 0xf8, 0x8c, 0xac, 0xbc, 0x0e, 0xe6, 0x72, 0x6e,
+	0x6d, 0xf8, 0x14, 0xa8, 0x8a, 0x6b, 0x9e, 0x71, 0xec, 0x85, 0x72, 0xa0, 0x2c, 0x1b, 0x89, 0x21,
+	0xa2, 0xbb, 0xb3, 0xc2, 0x41, 0xe3, 0xff, 0x2e, 0x65, 0xb8, 0x0d, 0x40, 0x7c, 0xbd, 0x33, 0x54,
+	0x6a, 0x94, 0xc7, 0xdb, 0x48, 0xc2, 0xb1, 0x47, 0x49, 0xd7, 0x39, 0x8e, 0x99, 0x8c, 0x54, 0x68,
+	0xa2, 0x3a, 0x04, 0x0b, 0x85, 0x11, 0xf0, 0x0a, 0x98, 0x0c, 0xa5, 0x49, 0xa6, 0x5d, 0x35, 0xf4,
+	0x4a, 0xec, 0x28, 0x1a, 0x40, 0x98, 0x1c, 0x89, 0xcb, 0x46, 0x62, 0x10, 0x93, 0xbd, 0x3d, 0xa0,
+	0x98, 0x47, 0x83, 0x7b, 0xd9, 0x88, 0xd7, 0x89, 0xee, 0x3e, 0xb8, 0x9c, 0x3b, 0x14, 0xc0, 0x25,
+	0x50, 0xd5, 0x15, 0xe2, 0xd8, 0x7a, 0xfc, 0xae, 0x28, 0xc3, 0x8e, 0x0d, 0xe7, 0xc1, 0x05, 0x39,
+	0x4d, 0x69, 0x51, 0xb5, 0x48, 0x48, 0x4f, 0xc0, 0xd2, 0x19, 0xf7, 0x27, 0x84, 0xe0, 0xbc, 0xfc,
+	0xdd, 0x50, 0x9b, 0x91, 0xcf, 0x22, 0xd9, 0xf8, 0x5a, 0xd1, 0xbf, 0x21, 0xd1, 0x1a, 0x5e, 0x03,
+	0x75, 0xe6, 0x78, 0xa1, 0x4b, 0x4c, 0x1e, 0x1c, 0x12, 0xb5, 0x99, 0xaa, 0x51, 0x53, 0xb6, 0x03,
+	0x61, 0x8a, 0x8b, 0xe1, 0xee, 0xab, 0x8f, 0x7f, 0x5f, 0x3e, 0xf7, 0xf8, 0x74, 0xb9, 0xf4, 0xe4,
+	0x74, 0xb9, 0xf4, 0xdb, 0xe9, 0x72, 0xe9, 0x8b, 0x3f, 0x96, 0xcf, 0xbd, 0x77, 0xbd, 0x17, 0xc8,
+	0xd3, 0x69, 0x3a, 0xc1, 0x5a, 0xf2, 0x6b, 0xb5, 0xb1, 0x96, 0x3e, 0xb1, 0xce, 0xa4, 0xfc, 0x63,
+	0xda, 0xf8, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xae, 0x05, 0xe4, 0x36, 0xd3, 0x0d, 0x00, 0x00,
 }
 
 func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
@@ -670,6 +871,42 @@ func (m *InternalRaftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if m.AutoDefragLock != nil {
+		{
+			size, err := m.AutoDefragLock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.CompactionRetentionPolicy != nil {
+		{
+			size, err := m.CompactionRetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.RevisionTime != nil {
+		{
+			size, err := m.RevisionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.LeaseCheckpoint != nil {
 		{
 			size, err := m.LeaseCheckpoint.MarshalToSizedBuffer(dAtA[:i])
@@ -825,6 +1062,160 @@ func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RevisionTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevisionTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevisionTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompactionRetentionPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactionRetentionPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactionRetentionPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Retentions) > 0 {
+		for iNdEx := len(m.Retentions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Retentions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompactionPrefixRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactionPrefixRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactionPrefixRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Duration != 0 {
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Revisions != 0 {
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Revisions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintRaftInternal(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoDefragLockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoDefragLockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoDefragLockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Lease != 0 {
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Lease))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MemberId != 0 {
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.MemberId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *InternalAuthenticateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -955,6 +1346,18 @@ func (m *InternalRaftRequest) Size() (n int) {
 		l = m.LeaseCheckpoint.Size()
 		n += 1 + l + sovRaftInternal(uint64(l))
 	}
+	if m.RevisionTime != nil {
+		l = m.RevisionTime.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	if m.CompactionRetentionPolicy != nil {
+		l = m.CompactionRetentionPolicy.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	if m.AutoDefragLock != nil {
+		l = m.AutoDefragLock.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
@@ -1057,23 +1460,96 @@ func (m *EmptyResponse) Size() (n int) {
 	return n
 }
 
-func (m *InternalAuthenticateRequest) Size() (n int) {
+func (m *RevisionTimeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRaftInternal(uint64(l))
-	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovRaftInternal(uint64(l))
-	}
-	l = len(m.SimpleToken)
-	if l > 0 {
-		n += 1 + l + sovRaftInternal(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovRaftInternal(uint64(m.Timestamp))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompactionRetentionPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Retentions) > 0 {
+		for _, e := range m.Retentions {
+			l = e.Size()
+			n += 1 + l + sovRaftInternal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompactionPrefixRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	if m.Revisions != 0 {
+		n += 1 + sovRaftInternal(uint64(m.Revisions))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovRaftInternal(uint64(m.Duration))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AutoDefragLockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MemberId != 0 {
+		n += 1 + sovRaftInternal(uint64(m.MemberId))
+	}
+	if m.Lease != 0 {
+		n += 1 + sovRaftInternal(uint64(m.Lease))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InternalAuthenticateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	l = len(m.SimpleToken)
+	if l > 0 {
+		n += 1 + l + sovRaftInternal(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1616,6 +2092,114 @@ func (m *InternalRaftRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RevisionTime == nil {
+				m.RevisionTime = &RevisionTimeRequest{}
+			}
+			if err := m.RevisionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactionRetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompactionRetentionPolicy == nil {
+				m.CompactionRetentionPolicy = &CompactionRetentionPolicyRequest{}
+			}
+			if err := m.CompactionRetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDefragLock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoDefragLock == nil {
+				m.AutoDefragLock = &AutoDefragLockRequest{}
+			}
+			if err := m.AutoDefragLock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
@@ -2445,6 +3029,371 @@ func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RevisionTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactionRetentionPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactionRetentionPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactionRetentionPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retentions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Retentions = append(m.Retentions, &CompactionPrefixRetention{})
+			if err := m.Retentions[len(m.Retentions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactionPrefixRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactionPrefixRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactionPrefixRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			m.Revisions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revisions |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoDefragLockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoDefragLockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoDefragLockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberId", wireType)
+			}
+			m.MemberId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			m.Lease = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lease |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *InternalAuthenticateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -123,6 +123,41 @@ func (RangeRequest_SortTarget) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{1, 1}
 }
 
+type RangeFilter_FilterResult int32
+
+const (
+	RangeFilter_EQUAL     RangeFilter_FilterResult = 0
+	RangeFilter_GREATER   RangeFilter_FilterResult = 1
+	RangeFilter_LESS      RangeFilter_FilterResult = 2
+	RangeFilter_NOT_EQUAL RangeFilter_FilterResult = 3
+	// HAS_PREFIX matches values starting with the given value; it is only valid for the VALUE target.
+	RangeFilter_HAS_PREFIX RangeFilter_FilterResult = 4
+)
+
+var RangeFilter_FilterResult_name = map[int32]string{
+	0: "EQUAL",
+	1: "GREATER",
+	2: "LESS",
+	3: "NOT_EQUAL",
+	4: "HAS_PREFIX",
+}
+
+var RangeFilter_FilterResult_value = map[string]int32{
+	"EQUAL":      0,
+	"GREATER":    1,
+	"LESS":       2,
+	"NOT_EQUAL":  3,
+	"HAS_PREFIX": 4,
+}
+
+func (x RangeFilter_FilterResult) String() string {
+	return proto.EnumName(RangeFilter_FilterResult_name, int32(x))
+}
+
+func (RangeFilter_FilterResult) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{3, 0}
+}
+
 type Compare_CompareResult int32
 
 const (
@@ -151,7 +186,7 @@ func (x Compare_CompareResult) String() string {
 }
 
 func (Compare_CompareResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{9, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{13, 0}
 }
 
 type Compare_CompareTarget int32
@@ -185,7 +220,7 @@ func (x Compare_CompareTarget) String() string {
 }
 
 func (Compare_CompareTarget) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{9, 1}
+	return fileDescriptor_77a6da22d6a3feb1, []int{13, 1}
 }
 
 type WatchCreateRequest_FilterType int32
@@ -195,16 +230,24 @@ const (
 	WatchCreateRequest_NOPUT WatchCreateRequest_FilterType = 0
 	// filter out delete event.
 	WatchCreateRequest_NODELETE WatchCreateRequest_FilterType = 1
+	// filter out put event that does not change the value of an existing key.
+	WatchCreateRequest_NOUNCHANGED WatchCreateRequest_FilterType = 2
+	// filter out put event that updates an existing key, keeping only creations.
+	WatchCreateRequest_NOUPDATE WatchCreateRequest_FilterType = 3
 )
 
 var WatchCreateRequest_FilterType_name = map[int32]string{
 	0: "NOPUT",
 	1: "NODELETE",
+	2: "NOUNCHANGED",
+	3: "NOUPDATE",
 }
 
 var WatchCreateRequest_FilterType_value = map[string]int32{
-	"NOPUT":    0,
-	"NODELETE": 1,
+	"NOPUT":       0,
+	"NODELETE":    1,
+	"NOUNCHANGED": 2,
+	"NOUPDATE":    3,
 }
 
 func (x WatchCreateRequest_FilterType) String() string {
@@ -212,7 +255,37 @@ func (x WatchCreateRequest_FilterType) String() string {
 }
 
 func (WatchCreateRequest_FilterType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{21, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{25, 0}
+}
+
+type DefragmentRequest_DefragmentMode int32
+
+const (
+	// FULL rewrites the whole backend into a new file while blocking writes.
+	DefragmentRequest_FULL DefragmentRequest_DefragmentMode = 0
+	// INCREMENTAL rewrites the backend into a new file like FULL, but copies
+	// it bucket by bucket in bounded time slices while writes go on. Writes
+	// are only blocked at the end, to copy again the keys written during the
+	// copy and to replace the backend file.
+	DefragmentRequest_INCREMENTAL DefragmentRequest_DefragmentMode = 1
+)
+
+var DefragmentRequest_DefragmentMode_name = map[int32]string{
+	0: "FULL",
+	1: "INCREMENTAL",
+}
+
+var DefragmentRequest_DefragmentMode_value = map[string]int32{
+	"FULL":        0,
+	"INCREMENTAL": 1,
+}
+
+func (x DefragmentRequest_DefragmentMode) String() string {
+	return proto.EnumName(DefragmentRequest_DefragmentMode_name, int32(x))
+}
+
+func (DefragmentRequest_DefragmentMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{58, 0}
 }
 
 type AlarmRequest_AlarmAction int32
@@ -240,7 +313,7 @@ func (x AlarmRequest_AlarmAction) String() string {
 }
 
 func (AlarmRequest_AlarmAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{54, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{62, 0}
 }
 
 type DowngradeRequest_DowngradeAction int32
@@ -268,7 +341,7 @@ func (x DowngradeRequest_DowngradeAction) String() string {
 }
 
 func (DowngradeRequest_DowngradeAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{57, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{65, 0}
 }
 
 type ResponseHeader struct {
@@ -377,7 +450,9 @@ type RangeRequest struct {
 	// a serializable range request is served locally without needing to reach consensus
 	// with other nodes in the cluster.
 	Serializable bool `protobuf:"varint,7,opt,name=serializable,proto3" json:"serializable,omitempty"`
-	// keys_only when set returns only the keys and not the values.
+	// keys_only when set returns only the keys and their metadata (revisions, version
+	// and lease) and not the values. Filters on values are evaluated before the values
+	// are dropped.
 	KeysOnly bool `protobuf:"varint,8,opt,name=keys_only,json=keysOnly,proto3" json:"keys_only,omitempty"`
 	// count_only when set returns only the count of the keys in the range.
 	CountOnly bool `protobuf:"varint,9,opt,name=count_only,json=countOnly,proto3" json:"count_only,omitempty"`
@@ -392,10 +467,26 @@ type RangeRequest struct {
 	MinCreateRevision int64 `protobuf:"varint,12,opt,name=min_create_revision,json=minCreateRevision,proto3" json:"min_create_revision,omitempty"`
 	// max_create_revision is the upper bound for returned key create revisions; all keys with
 	// greater create revisions will be filtered away.
-	MaxCreateRevision    int64    `protobuf:"varint,13,opt,name=max_create_revision,json=maxCreateRevision,proto3" json:"max_create_revision,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	MaxCreateRevision int64 `protobuf:"varint,13,opt,name=max_create_revision,json=maxCreateRevision,proto3" json:"max_create_revision,omitempty"`
+	// continue_token resumes a paginated range from where a previous response stopped.
+	// It must be the continue_token of a previous RangeResponse for the same key range.
+	// The range is served at the revision pinned by the token; if that revision has been
+	// compacted, ErrCompacted is returned. Only ranges sorted by key in ascending order
+	// can be continued.
+	ContinueToken []byte `protobuf:"bytes,14,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
+	// filters are evaluated on the server against every key-value pair in the range;
+	// only pairs matching all filters are returned. limit and count apply to the
+	// filtered result. Filtering requires reading every value in the range, so its
+	// cost is proportional to the size of the range, not to the size of the result.
+	Filters []*RangeFilter `protobuf:"bytes,15,rep,name=filters,proto3" json:"filters,omitempty"`
+	// max_staleness lets a follower serve a linearizable range locally if it is known to be
+	// within the given bound of the leader's commit, as learned from the leader's heartbeats.
+	// Otherwise the follower waits for the bound to be met, or confirms the read with the
+	// leader as a linearizable range. It is ignored by serializable ranges.
+	MaxStaleness         *Staleness `protobuf:"bytes,16,opt,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *RangeRequest) Reset()         { *m = RangeRequest{} }
@@ -522,6 +613,235 @@ func (m *RangeRequest) GetMaxCreateRevision() int64 {
 	return 0
 }
 
+func (m *RangeRequest) GetContinueToken() []byte {
+	if m != nil {
+		return m.ContinueToken
+	}
+	return nil
+}
+
+func (m *RangeRequest) GetFilters() []*RangeFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *RangeRequest) GetMaxStaleness() *Staleness {
+	if m != nil {
+		return m.MaxStaleness
+	}
+	return nil
+}
+
+// Staleness bounds how stale a range served locally by a follower can be. The range is
+// served locally if any of the set bounds is met.
+type Staleness struct {
+	// milliseconds bounds the time since the follower learned the leader's commit index
+	// it has applied, as measured on the follower's clock.
+	Milliseconds int64 `protobuf:"varint,1,opt,name=milliseconds,proto3" json:"milliseconds,omitempty"`
+	// revisions bounds the number of revisions the follower is behind the leader's
+	// revision, as of the leader's last heartbeat if received within a heartbeat interval.
+	Revisions            int64    `protobuf:"varint,2,opt,name=revisions,proto3" json:"revisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Staleness) Reset()         { *m = Staleness{} }
+func (m *Staleness) String() string { return proto.CompactTextString(m) }
+func (*Staleness) ProtoMessage()    {}
+func (*Staleness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{2}
+}
+func (m *Staleness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Staleness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Staleness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Staleness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Staleness.Merge(m, src)
+}
+func (m *Staleness) XXX_Size() int {
+	return m.Size()
+}
+func (m *Staleness) XXX_DiscardUnknown() {
+	xxx_messageInfo_Staleness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Staleness proto.InternalMessageInfo
+
+func (m *Staleness) GetMilliseconds() int64 {
+	if m != nil {
+		return m.Milliseconds
+	}
+	return 0
+}
+
+func (m *Staleness) GetRevisions() int64 {
+	if m != nil {
+		return m.Revisions
+	}
+	return 0
+}
+
+// RangeFilter is a predicate on a key-value pair of a range. It uses the same
+// targets as Compare, but applies to each key in the range instead of a given key.
+type RangeFilter struct {
+	// result is logical comparison operation for this filter.
+	Result RangeFilter_FilterResult `protobuf:"varint,1,opt,name=result,proto3,enum=etcdserverpb.RangeFilter_FilterResult" json:"result,omitempty"`
+	// target is the key-value field to inspect for the filter.
+	Target Compare_CompareTarget `protobuf:"varint,2,opt,name=target,proto3,enum=etcdserverpb.Compare_CompareTarget" json:"target,omitempty"`
+	// Types that are valid to be assigned to TargetUnion:
+	//	*RangeFilter_Version
+	//	*RangeFilter_CreateRevision
+	//	*RangeFilter_ModRevision
+	//	*RangeFilter_Value
+	//	*RangeFilter_Lease
+	TargetUnion          isRangeFilter_TargetUnion `protobuf_oneof:"target_union"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *RangeFilter) Reset()         { *m = RangeFilter{} }
+func (m *RangeFilter) String() string { return proto.CompactTextString(m) }
+func (*RangeFilter) ProtoMessage()    {}
+func (*RangeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{3}
+}
+func (m *RangeFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RangeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeFilter.Merge(m, src)
+}
+func (m *RangeFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeFilter proto.InternalMessageInfo
+
+type isRangeFilter_TargetUnion interface {
+	isRangeFilter_TargetUnion()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type RangeFilter_Version struct {
+	Version int64 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"`
+}
+type RangeFilter_CreateRevision struct {
+	CreateRevision int64 `protobuf:"varint,4,opt,name=create_revision,json=createRevision,proto3,oneof" json:"create_revision,omitempty"`
+}
+type RangeFilter_ModRevision struct {
+	ModRevision int64 `protobuf:"varint,5,opt,name=mod_revision,json=modRevision,proto3,oneof" json:"mod_revision,omitempty"`
+}
+type RangeFilter_Value struct {
+	Value []byte `protobuf:"bytes,6,opt,name=value,proto3,oneof" json:"value,omitempty"`
+}
+type RangeFilter_Lease struct {
+	Lease int64 `protobuf:"varint,7,opt,name=lease,proto3,oneof" json:"lease,omitempty"`
+}
+
+func (*RangeFilter_Version) isRangeFilter_TargetUnion()        {}
+func (*RangeFilter_CreateRevision) isRangeFilter_TargetUnion() {}
+func (*RangeFilter_ModRevision) isRangeFilter_TargetUnion()    {}
+func (*RangeFilter_Value) isRangeFilter_TargetUnion()          {}
+func (*RangeFilter_Lease) isRangeFilter_TargetUnion()          {}
+
+func (m *RangeFilter) GetTargetUnion() isRangeFilter_TargetUnion {
+	if m != nil {
+		return m.TargetUnion
+	}
+	return nil
+}
+
+func (m *RangeFilter) GetResult() RangeFilter_FilterResult {
+	if m != nil {
+		return m.Result
+	}
+	return RangeFilter_EQUAL
+}
+
+func (m *RangeFilter) GetTarget() Compare_CompareTarget {
+	if m != nil {
+		return m.Target
+	}
+	return Compare_VERSION
+}
+
+func (m *RangeFilter) GetVersion() int64 {
+	if x, ok := m.GetTargetUnion().(*RangeFilter_Version); ok {
+		return x.Version
+	}
+	return 0
+}
+
+func (m *RangeFilter) GetCreateRevision() int64 {
+	if x, ok := m.GetTargetUnion().(*RangeFilter_CreateRevision); ok {
+		return x.CreateRevision
+	}
+	return 0
+}
+
+func (m *RangeFilter) GetModRevision() int64 {
+	if x, ok := m.GetTargetUnion().(*RangeFilter_ModRevision); ok {
+		return x.ModRevision
+	}
+	return 0
+}
+
+func (m *RangeFilter) GetValue() []byte {
+	if x, ok := m.GetTargetUnion().(*RangeFilter_Value); ok {
+		return x.Value
+	}
+	return nil
+}
+
+func (m *RangeFilter) GetLease() int64 {
+	if x, ok := m.GetTargetUnion().(*RangeFilter_Lease); ok {
+		return x.Lease
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*RangeFilter) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*RangeFilter_Version)(nil),
+		(*RangeFilter_CreateRevision)(nil),
+		(*RangeFilter_ModRevision)(nil),
+		(*RangeFilter_Value)(nil),
+		(*RangeFilter_Lease)(nil),
+	}
+}
+
 type RangeResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	// kvs is the list of key-value pairs matched by the range request.
@@ -530,7 +850,10 @@ type RangeResponse struct {
 	// more indicates if there are more keys to return in the requested range.
 	More bool `protobuf:"varint,3,opt,name=more,proto3" json:"more,omitempty"`
 	// count is set to the number of keys within the range when requested.
-	Count                int64    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Count int64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// continue_token is set when more is true and the range is sorted by key in ascending
+	// order. Passing it back in a RangeRequest returns the next page at the same revision.
+	ContinueToken        []byte   `protobuf:"bytes,5,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -540,7 +863,7 @@ func (m *RangeResponse) Reset()         { *m = RangeResponse{} }
 func (m *RangeResponse) String() string { return proto.CompactTextString(m) }
 func (*RangeResponse) ProtoMessage()    {}
 func (*RangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{2}
+	return fileDescriptor_77a6da22d6a3feb1, []int{4}
 }
 func (m *RangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -597,6 +920,143 @@ func (m *RangeResponse) GetCount() int64 {
 	return 0
 }
 
+func (m *RangeResponse) GetContinueToken() []byte {
+	if m != nil {
+		return m.ContinueToken
+	}
+	return nil
+}
+
+type MultiRangeRequest struct {
+	// ranges is the list of range requests to serve. Each range must either leave
+	// revision unset or set it to the revision of the multi-range request, and may only
+	// be serializable if the multi-range request is serializable. A range resuming from
+	// a continue token must be served at the revision the token was issued at. The number
+	// of ranges is limited by --max-txn-ops, like the operations of a txn.
+	Ranges []*RangeRequest `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	// revision is the point-in-time of the key-value store to use for all ranges.
+	// If revision is less or equal to zero, the ranges are served at the newest revision.
+	// If the revision has been compacted, ErrCompacted is returned as a response.
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// serializable sets the ranges to use serializable member-local reads.
+	// Otherwise a single linearizable read is performed for all ranges.
+	Serializable         bool     `protobuf:"varint,3,opt,name=serializable,proto3" json:"serializable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiRangeRequest) Reset()         { *m = MultiRangeRequest{} }
+func (m *MultiRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*MultiRangeRequest) ProtoMessage()    {}
+func (*MultiRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{5}
+}
+func (m *MultiRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiRangeRequest.Merge(m, src)
+}
+func (m *MultiRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiRangeRequest proto.InternalMessageInfo
+
+func (m *MultiRangeRequest) GetRanges() []*RangeRequest {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+func (m *MultiRangeRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *MultiRangeRequest) GetSerializable() bool {
+	if m != nil {
+		return m.Serializable
+	}
+	return false
+}
+
+type MultiRangeResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// responses is the list of range responses, in the same order as the ranges
+	// of the multi-range request.
+	Responses            []*RangeResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MultiRangeResponse) Reset()         { *m = MultiRangeResponse{} }
+func (m *MultiRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MultiRangeResponse) ProtoMessage()    {}
+func (*MultiRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{6}
+}
+func (m *MultiRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiRangeResponse.Merge(m, src)
+}
+func (m *MultiRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiRangeResponse proto.InternalMessageInfo
+
+func (m *MultiRangeResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *MultiRangeResponse) GetResponses() []*RangeResponse {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
 type PutRequest struct {
 	// key is the key, in bytes, to put into the key-value store.
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -623,7 +1083,7 @@ func (m *PutRequest) Reset()         { *m = PutRequest{} }
 func (m *PutRequest) String() string { return proto.CompactTextString(m) }
 func (*PutRequest) ProtoMessage()    {}
 func (*PutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{3}
+	return fileDescriptor_77a6da22d6a3feb1, []int{7}
 }
 func (m *PutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +1167,7 @@ func (m *PutResponse) Reset()         { *m = PutResponse{} }
 func (m *PutResponse) String() string { return proto.CompactTextString(m) }
 func (*PutResponse) ProtoMessage()    {}
 func (*PutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{4}
+	return fileDescriptor_77a6da22d6a3feb1, []int{8}
 }
 func (m *PutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,7 +1231,7 @@ func (m *DeleteRangeRequest) Reset()         { *m = DeleteRangeRequest{} }
 func (m *DeleteRangeRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRangeRequest) ProtoMessage()    {}
 func (*DeleteRangeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{5}
+	return fileDescriptor_77a6da22d6a3feb1, []int{9}
 }
 func (m *DeleteRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +1296,7 @@ func (m *DeleteRangeResponse) Reset()         { *m = DeleteRangeResponse{} }
 func (m *DeleteRangeResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteRangeResponse) ProtoMessage()    {}
 func (*DeleteRangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{6}
+	return fileDescriptor_77a6da22d6a3feb1, []int{10}
 }
 func (m *DeleteRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -904,7 +1364,7 @@ func (m *RequestOp) Reset()         { *m = RequestOp{} }
 func (m *RequestOp) String() string { return proto.CompactTextString(m) }
 func (*RequestOp) ProtoMessage()    {}
 func (*RequestOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{7}
+	return fileDescriptor_77a6da22d6a3feb1, []int{11}
 }
 func (m *RequestOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1020,7 +1480,7 @@ func (m *ResponseOp) Reset()         { *m = ResponseOp{} }
 func (m *ResponseOp) String() string { return proto.CompactTextString(m) }
 func (*ResponseOp) ProtoMessage()    {}
 func (*ResponseOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{8}
+	return fileDescriptor_77a6da22d6a3feb1, []int{12}
 }
 func (m *ResponseOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1144,7 +1604,7 @@ func (m *Compare) Reset()         { *m = Compare{} }
 func (m *Compare) String() string { return proto.CompactTextString(m) }
 func (*Compare) ProtoMessage()    {}
 func (*Compare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{9}
+	return fileDescriptor_77a6da22d6a3feb1, []int{13}
 }
 func (m *Compare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1317,7 +1777,7 @@ func (m *TxnRequest) Reset()         { *m = TxnRequest{} }
 func (m *TxnRequest) String() string { return proto.CompactTextString(m) }
 func (*TxnRequest) ProtoMessage()    {}
 func (*TxnRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{10}
+	return fileDescriptor_77a6da22d6a3feb1, []int{14}
 }
 func (m *TxnRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1843,7 @@ func (m *TxnResponse) Reset()         { *m = TxnResponse{} }
 func (m *TxnResponse) String() string { return proto.CompactTextString(m) }
 func (*TxnResponse) ProtoMessage()    {}
 func (*TxnResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{11}
+	return fileDescriptor_77a6da22d6a3feb1, []int{15}
 }
 func (m *TxnResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1451,7 +1911,7 @@ func (m *CompactionRequest) Reset()         { *m = CompactionRequest{} }
 func (m *CompactionRequest) String() string { return proto.CompactTextString(m) }
 func (*CompactionRequest) ProtoMessage()    {}
 func (*CompactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{12}
+	return fileDescriptor_77a6da22d6a3feb1, []int{16}
 }
 func (m *CompactionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1505,7 +1965,7 @@ func (m *CompactionResponse) Reset()         { *m = CompactionResponse{} }
 func (m *CompactionResponse) String() string { return proto.CompactTextString(m) }
 func (*CompactionResponse) ProtoMessage()    {}
 func (*CompactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{13}
+	return fileDescriptor_77a6da22d6a3feb1, []int{17}
 }
 func (m *CompactionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +2011,7 @@ func (m *HashRequest) Reset()         { *m = HashRequest{} }
 func (m *HashRequest) String() string { return proto.CompactTextString(m) }
 func (*HashRequest) ProtoMessage()    {}
 func (*HashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{14}
+	return fileDescriptor_77a6da22d6a3feb1, []int{18}
 }
 func (m *HashRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1592,7 +2052,7 @@ func (m *HashKVRequest) Reset()         { *m = HashKVRequest{} }
 func (m *HashKVRequest) String() string { return proto.CompactTextString(m) }
 func (*HashKVRequest) ProtoMessage()    {}
 func (*HashKVRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{15}
+	return fileDescriptor_77a6da22d6a3feb1, []int{19}
 }
 func (m *HashKVRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1645,7 +2105,7 @@ func (m *HashKVResponse) Reset()         { *m = HashKVResponse{} }
 func (m *HashKVResponse) String() string { return proto.CompactTextString(m) }
 func (*HashKVResponse) ProtoMessage()    {}
 func (*HashKVResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{16}
+	return fileDescriptor_77a6da22d6a3feb1, []int{20}
 }
 func (m *HashKVResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1715,7 +2175,7 @@ func (m *HashResponse) Reset()         { *m = HashResponse{} }
 func (m *HashResponse) String() string { return proto.CompactTextString(m) }
 func (*HashResponse) ProtoMessage()    {}
 func (*HashResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{17}
+	return fileDescriptor_77a6da22d6a3feb1, []int{21}
 }
 func (m *HashResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1759,6 +2219,12 @@ func (m *HashResponse) GetHash() uint32 {
 }
 
 type SnapshotRequest struct {
+	// since_revision, when set, requests a delta snapshot holding only the revisions
+	// after since_revision, along with all the other buckets of the backend, to be
+	// layered on a full snapshot taken at since_revision or later. If the backend
+	// is compacted after since_revision, the delta also lists the revisions up to
+	// since_revision that are kept, for the compacted ones to be dropped on restore.
+	SinceRevision        int64    `protobuf:"varint,1,opt,name=since_revision,json=sinceRevision,proto3" json:"since_revision,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1768,7 +2234,7 @@ func (m *SnapshotRequest) Reset()         { *m = SnapshotRequest{} }
 func (m *SnapshotRequest) String() string { return proto.CompactTextString(m) }
 func (*SnapshotRequest) ProtoMessage()    {}
 func (*SnapshotRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{18}
+	return fileDescriptor_77a6da22d6a3feb1, []int{22}
 }
 func (m *SnapshotRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1797,6 +2263,13 @@ func (m *SnapshotRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SnapshotRequest proto.InternalMessageInfo
 
+func (m *SnapshotRequest) GetSinceRevision() int64 {
+	if m != nil {
+		return m.SinceRevision
+	}
+	return 0
+}
+
 type SnapshotResponse struct {
 	// header has the current key-value store information. The first header in the snapshot
 	// stream indicates the point in time of the snapshot.
@@ -1818,7 +2291,7 @@ func (m *SnapshotResponse) Reset()         { *m = SnapshotResponse{} }
 func (m *SnapshotResponse) String() string { return proto.CompactTextString(m) }
 func (*SnapshotResponse) ProtoMessage()    {}
 func (*SnapshotResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{19}
+	return fileDescriptor_77a6da22d6a3feb1, []int{23}
 }
 func (m *SnapshotResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1882,6 +2355,7 @@ type WatchRequest struct {
 	//	*WatchRequest_CreateRequest
 	//	*WatchRequest_CancelRequest
 	//	*WatchRequest_ProgressRequest
+	//	*WatchRequest_CreditRequest
 	RequestUnion         isWatchRequest_RequestUnion `protobuf_oneof:"request_union"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
@@ -1892,7 +2366,7 @@ func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
 func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchRequest) ProtoMessage()    {}
 func (*WatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{20}
+	return fileDescriptor_77a6da22d6a3feb1, []int{24}
 }
 func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1936,10 +2410,14 @@ type WatchRequest_CancelRequest struct {
 type WatchRequest_ProgressRequest struct {
 	ProgressRequest *WatchProgressRequest `protobuf:"bytes,3,opt,name=progress_request,json=progressRequest,proto3,oneof" json:"progress_request,omitempty"`
 }
+type WatchRequest_CreditRequest struct {
+	CreditRequest *WatchCreditRequest `protobuf:"bytes,4,opt,name=credit_request,json=creditRequest,proto3,oneof" json:"credit_request,omitempty"`
+}
 
 func (*WatchRequest_CreateRequest) isWatchRequest_RequestUnion()   {}
 func (*WatchRequest_CancelRequest) isWatchRequest_RequestUnion()   {}
 func (*WatchRequest_ProgressRequest) isWatchRequest_RequestUnion() {}
+func (*WatchRequest_CreditRequest) isWatchRequest_RequestUnion()   {}
 
 func (m *WatchRequest) GetRequestUnion() isWatchRequest_RequestUnion {
 	if m != nil {
@@ -1969,12 +2447,20 @@ func (m *WatchRequest) GetProgressRequest() *WatchProgressRequest {
 	return nil
 }
 
+func (m *WatchRequest) GetCreditRequest() *WatchCreditRequest {
+	if x, ok := m.GetRequestUnion().(*WatchRequest_CreditRequest); ok {
+		return x.CreditRequest
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*WatchRequest) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*WatchRequest_CreateRequest)(nil),
 		(*WatchRequest_CancelRequest)(nil),
 		(*WatchRequest_ProgressRequest)(nil),
+		(*WatchRequest_CreditRequest)(nil),
 	}
 }
 
@@ -2006,7 +2492,28 @@ type WatchCreateRequest struct {
 	// use on the stream will cause an error to be returned.
 	WatchId int64 `protobuf:"varint,7,opt,name=watch_id,json=watchId,proto3" json:"watch_id,omitempty"`
 	// fragment enables splitting large revisions into multiple watch responses.
-	Fragment             bool     `protobuf:"varint,8,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	Fragment bool `protobuf:"varint,8,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	// event_filters are evaluated at server side against every event in the watched
+	// range; only events matching all of them are sent back to the watcher.
+	EventFilters []*WatchFilter `protobuf:"bytes,9,rep,name=event_filters,json=eventFilters,proto3" json:"event_filters,omitempty"`
+	// initial_state makes the server first send the key-value pairs in the range at the
+	// revision before start_revision (or at the current revision if start_revision is not
+	// given) as put events with initial_state set, then a response with initial_state_done
+	// set, before sending the events from start_revision on.
+	InitialState bool `protobuf:"varint,10,opt,name=initial_state,json=initialState,proto3" json:"initial_state,omitempty"`
+	// resume_token is a token from a previous watch response of the watcher to resume.
+	// The watcher is resumed at the revision the token was issued for, unless start_revision
+	// is later. Members running with watch resume retention hold back auto compaction of
+	// that revision for a while after the token is issued, provided the leader runs with it
+	// too. A manual compaction is not held back and fails the resume with ErrCompacted once
+	// it compacts the revision.
+	ResumeToken []byte `protobuf:"bytes,11,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// coalesce makes the server send only the latest event of each key out of the events
+	// it would send in a single response, with skipped_revisions counting the dropped ones.
+	Coalesce bool `protobuf:"varint,12,opt,name=coalesce,proto3" json:"coalesce,omitempty"`
+	// coalesce_window_ms makes a coalescing watcher collect events for up to the given
+	// number of milliseconds before sending the latest event of each key.
+	CoalesceWindowMs     int64    `protobuf:"varint,13,opt,name=coalesce_window_ms,json=coalesceWindowMs,proto3" json:"coalesce_window_ms,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2016,7 +2523,7 @@ func (m *WatchCreateRequest) Reset()         { *m = WatchCreateRequest{} }
 func (m *WatchCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchCreateRequest) ProtoMessage()    {}
 func (*WatchCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{21}
+	return fileDescriptor_77a6da22d6a3feb1, []int{25}
 }
 func (m *WatchCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2101,6 +2608,143 @@ func (m *WatchCreateRequest) GetFragment() bool {
 	return false
 }
 
+func (m *WatchCreateRequest) GetEventFilters() []*WatchFilter {
+	if m != nil {
+		return m.EventFilters
+	}
+	return nil
+}
+
+func (m *WatchCreateRequest) GetInitialState() bool {
+	if m != nil {
+		return m.InitialState
+	}
+	return false
+}
+
+func (m *WatchCreateRequest) GetResumeToken() []byte {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return nil
+}
+
+func (m *WatchCreateRequest) GetCoalesce() bool {
+	if m != nil {
+		return m.Coalesce
+	}
+	return false
+}
+
+func (m *WatchCreateRequest) GetCoalesceWindowMs() int64 {
+	if m != nil {
+		return m.CoalesceWindowMs
+	}
+	return 0
+}
+
+type WatchFilter struct {
+	// Types that are valid to be assigned to Filter:
+	//
+	//	*WatchFilter_KeyGlob
+	//	*WatchFilter_KeyRegex
+	//	*WatchFilter_Lease
+	Filter               isWatchFilter_Filter `protobuf_oneof:"filter"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *WatchFilter) Reset()         { *m = WatchFilter{} }
+func (m *WatchFilter) String() string { return proto.CompactTextString(m) }
+func (*WatchFilter) ProtoMessage()    {}
+func (*WatchFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{26}
+}
+func (m *WatchFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchFilter.Merge(m, src)
+}
+func (m *WatchFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchFilter proto.InternalMessageInfo
+
+type isWatchFilter_Filter interface {
+	isWatchFilter_Filter()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type WatchFilter_KeyGlob struct {
+	KeyGlob string `protobuf:"bytes,1,opt,name=key_glob,json=keyGlob,proto3,oneof" json:"key_glob,omitempty"`
+}
+type WatchFilter_KeyRegex struct {
+	KeyRegex string `protobuf:"bytes,2,opt,name=key_regex,json=keyRegex,proto3,oneof" json:"key_regex,omitempty"`
+}
+type WatchFilter_Lease struct {
+	Lease int64 `protobuf:"varint,3,opt,name=lease,proto3,oneof" json:"lease,omitempty"`
+}
+
+func (*WatchFilter_KeyGlob) isWatchFilter_Filter()  {}
+func (*WatchFilter_KeyRegex) isWatchFilter_Filter() {}
+func (*WatchFilter_Lease) isWatchFilter_Filter()    {}
+
+func (m *WatchFilter) GetFilter() isWatchFilter_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *WatchFilter) GetKeyGlob() string {
+	if x, ok := m.GetFilter().(*WatchFilter_KeyGlob); ok {
+		return x.KeyGlob
+	}
+	return ""
+}
+
+func (m *WatchFilter) GetKeyRegex() string {
+	if x, ok := m.GetFilter().(*WatchFilter_KeyRegex); ok {
+		return x.KeyRegex
+	}
+	return ""
+}
+
+func (m *WatchFilter) GetLease() int64 {
+	if x, ok := m.GetFilter().(*WatchFilter_Lease); ok {
+		return x.Lease
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*WatchFilter) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*WatchFilter_KeyGlob)(nil),
+		(*WatchFilter_KeyRegex)(nil),
+		(*WatchFilter_Lease)(nil),
+	}
+}
+
 type WatchCancelRequest struct {
 	// watch_id is the watcher id to cancel so that no more events are transmitted.
 	WatchId              int64    `protobuf:"varint,1,opt,name=watch_id,json=watchId,proto3" json:"watch_id,omitempty"`
@@ -2113,7 +2757,7 @@ func (m *WatchCancelRequest) Reset()         { *m = WatchCancelRequest{} }
 func (m *WatchCancelRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchCancelRequest) ProtoMessage()    {}
 func (*WatchCancelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{22}
+	return fileDescriptor_77a6da22d6a3feb1, []int{27}
 }
 func (m *WatchCancelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2161,7 +2805,7 @@ func (m *WatchProgressRequest) Reset()         { *m = WatchProgressRequest{} }
 func (m *WatchProgressRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchProgressRequest) ProtoMessage()    {}
 func (*WatchProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{23}
+	return fileDescriptor_77a6da22d6a3feb1, []int{28}
 }
 func (m *WatchProgressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2190,6 +2834,60 @@ func (m *WatchProgressRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WatchProgressRequest proto.InternalMessageInfo
 
+// Grants the server credits to send more events over the watch stream.
+// Once a client sends the first credit request on a stream, the server only
+// sends event responses while the stream has credits left, buffering the rest.
+// Once the buffered responses of a stream grow too large, the watchers with the
+// most buffered are canceled as lagging. Each event sent takes one credit. A response is never split to fit the
+// credits, so the credits of a stream may become negative.
+type WatchCreditRequest struct {
+	// credits is the number of additional events the client can buffer.
+	Credits              int64    `protobuf:"varint,1,opt,name=credits,proto3" json:"credits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchCreditRequest) Reset()         { *m = WatchCreditRequest{} }
+func (m *WatchCreditRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchCreditRequest) ProtoMessage()    {}
+func (*WatchCreditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{29}
+}
+func (m *WatchCreditRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchCreditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchCreditRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchCreditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchCreditRequest.Merge(m, src)
+}
+func (m *WatchCreditRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchCreditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchCreditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchCreditRequest proto.InternalMessageInfo
+
+func (m *WatchCreditRequest) GetCredits() int64 {
+	if m != nil {
+		return m.Credits
+	}
+	return 0
+}
+
 type WatchResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	// watch_id is the ID of the watcher that corresponds to the response.
@@ -2215,7 +2913,17 @@ type WatchResponse struct {
 	// cancel_reason indicates the reason for canceling the watcher.
 	CancelReason string `protobuf:"bytes,6,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
 	// framgment is true if large watch response was split over multiple responses.
-	Fragment             bool            `protobuf:"varint,7,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	Fragment bool `protobuf:"varint,7,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	// initial_state is true if the events are key-value pairs of the initial state
+	// requested by the watch create request rather than changes to the keys.
+	InitialState bool `protobuf:"varint,8,opt,name=initial_state,json=initialState,proto3" json:"initial_state,omitempty"`
+	// initial_state_done is true if the response marks the end of the initial state.
+	// The header revision is the revision of the initial state; the events following
+	// it start at the next revision.
+	InitialStateDone bool `protobuf:"varint,9,opt,name=initial_state_done,json=initialStateDone,proto3" json:"initial_state_done,omitempty"`
+	// resume_token is set by members running with watch resume retention. It can be passed
+	// to a watch create request to resume the watcher after the response.
+	ResumeToken          []byte          `protobuf:"bytes,10,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 	Events               []*mvccpb.Event `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
@@ -2226,7 +2934,7 @@ func (m *WatchResponse) Reset()         { *m = WatchResponse{} }
 func (m *WatchResponse) String() string { return proto.CompactTextString(m) }
 func (*WatchResponse) ProtoMessage()    {}
 func (*WatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{24}
+	return fileDescriptor_77a6da22d6a3feb1, []int{30}
 }
 func (m *WatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2304,6 +3012,27 @@ func (m *WatchResponse) GetFragment() bool {
 	return false
 }
 
+func (m *WatchResponse) GetInitialState() bool {
+	if m != nil {
+		return m.InitialState
+	}
+	return false
+}
+
+func (m *WatchResponse) GetInitialStateDone() bool {
+	if m != nil {
+		return m.InitialStateDone
+	}
+	return false
+}
+
+func (m *WatchResponse) GetResumeToken() []byte {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return nil
+}
+
 func (m *WatchResponse) GetEvents() []*mvccpb.Event {
 	if m != nil {
 		return m.Events
@@ -2325,7 +3054,7 @@ func (m *LeaseGrantRequest) Reset()         { *m = LeaseGrantRequest{} }
 func (m *LeaseGrantRequest) String() string { return proto.CompactTextString(m) }
 func (*LeaseGrantRequest) ProtoMessage()    {}
 func (*LeaseGrantRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{25}
+	return fileDescriptor_77a6da22d6a3feb1, []int{31}
 }
 func (m *LeaseGrantRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2384,7 +3113,7 @@ func (m *LeaseGrantResponse) Reset()         { *m = LeaseGrantResponse{} }
 func (m *LeaseGrantResponse) String() string { return proto.CompactTextString(m) }
 func (*LeaseGrantResponse) ProtoMessage()    {}
 func (*LeaseGrantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{26}
+	return fileDescriptor_77a6da22d6a3feb1, []int{32}
 }
 func (m *LeaseGrantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2453,7 +3182,7 @@ func (m *LeaseRevokeRequest) Reset()         { *m = LeaseRevokeRequest{} }
 func (m *LeaseRevokeRequest) String() string { return proto.CompactTextString(m) }
 func (*LeaseRevokeRequest) ProtoMessage()    {}
 func (*LeaseRevokeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{27}
+	return fileDescriptor_77a6da22d6a3feb1, []int{33}
 }
 func (m *LeaseRevokeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2500,7 +3229,7 @@ func (m *LeaseRevokeResponse) Reset()         { *m = LeaseRevokeResponse{} }
 func (m *LeaseRevokeResponse) String() string { return proto.CompactTextString(m) }
 func (*LeaseRevokeResponse) ProtoMessage()    {}
 func (*LeaseRevokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{28}
+	return fileDescriptor_77a6da22d6a3feb1, []int{34}
 }
 func (m *LeaseRevokeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2550,7 +3279,7 @@ func (m *LeaseCheckpoint) Reset()         { *m = LeaseCheckpoint{} }
 func (m *LeaseCheckpoint) String() string { return proto.CompactTextString(m) }
 func (*LeaseCheckpoint) ProtoMessage()    {}
 func (*LeaseCheckpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{29}
+	return fileDescriptor_77a6da22d6a3feb1, []int{35}
 }
 func (m *LeaseCheckpoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2604,7 +3333,7 @@ func (m *LeaseCheckpointRequest) Reset()         { *m = LeaseCheckpointRequest{}
 func (m *LeaseCheckpointRequest) String() string { return proto.CompactTextString(m) }
 func (*LeaseCheckpointRequest) ProtoMessage()    {}
 func (*LeaseCheckpointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{30}
+	return fileDescriptor_77a6da22d6a3feb1, []int{36}
 }
 func (m *LeaseCheckpointRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2651,7 +3380,7 @@ func (m *LeaseCheckpointResponse) Reset()         { *m = LeaseCheckpointResponse
 func (m *LeaseCheckpointResponse) String() string { return proto.CompactTextString(m) }
 func (*LeaseCheckpointResponse) ProtoMessage()    {}
 func (*LeaseCheckpointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{31}
+	return fileDescriptor_77a6da22d6a3feb1, []int{37}
 }
 func (m *LeaseCheckpointResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2699,7 +3428,7 @@ func (m *LeaseKeepAliveRequest) Reset()         { *m = LeaseKeepAliveRequest{} }
 func (m *LeaseKeepAliveRequest) String() string { return proto.CompactTextString(m) }
 func (*LeaseKeepAliveRequest) ProtoMessage()    {}
 func (*LeaseKeepAliveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{32}
+	return fileDescriptor_77a6da22d6a3feb1, []int{38}
 }
 func (m *LeaseKeepAliveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2750,7 +3479,7 @@ func (m *LeaseKeepAliveResponse) Reset()         { *m = LeaseKeepAliveResponse{}
 func (m *LeaseKeepAliveResponse) String() string { return proto.CompactTextString(m) }
 func (*LeaseKeepAliveResponse) ProtoMessage()    {}
 func (*LeaseKeepAliveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{33}
+	return fileDescriptor_77a6da22d6a3feb1, []int{39}
 }
 func (m *LeaseKeepAliveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2814,7 +3543,7 @@ func (m *LeaseTimeToLiveRequest) Reset()         { *m = LeaseTimeToLiveRequest{}
 func (m *LeaseTimeToLiveRequest) String() string { return proto.CompactTextString(m) }
 func (*LeaseTimeToLiveRequest) ProtoMessage()    {}
 func (*LeaseTimeToLiveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{34}
+	return fileDescriptor_77a6da22d6a3feb1, []int{40}
 }
 func (m *LeaseTimeToLiveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2876,7 +3605,7 @@ func (m *LeaseTimeToLiveResponse) Reset()         { *m = LeaseTimeToLiveResponse
 func (m *LeaseTimeToLiveResponse) String() string { return proto.CompactTextString(m) }
 func (*LeaseTimeToLiveResponse) ProtoMessage()    {}
 func (*LeaseTimeToLiveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{35}
+	return fileDescriptor_77a6da22d6a3feb1, []int{41}
 }
 func (m *LeaseTimeToLiveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2950,7 +3679,7 @@ func (m *LeaseLeasesRequest) Reset()         { *m = LeaseLeasesRequest{} }
 func (m *LeaseLeasesRequest) String() string { return proto.CompactTextString(m) }
 func (*LeaseLeasesRequest) ProtoMessage()    {}
 func (*LeaseLeasesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{36}
+	return fileDescriptor_77a6da22d6a3feb1, []int{42}
 }
 func (m *LeaseLeasesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2990,7 +3719,7 @@ func (m *LeaseStatus) Reset()         { *m = LeaseStatus{} }
 func (m *LeaseStatus) String() string { return proto.CompactTextString(m) }
 func (*LeaseStatus) ProtoMessage()    {}
 func (*LeaseStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{37}
+	return fileDescriptor_77a6da22d6a3feb1, []int{43}
 }
 func (m *LeaseStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3038,7 +3767,7 @@ func (m *LeaseLeasesResponse) Reset()         { *m = LeaseLeasesResponse{} }
 func (m *LeaseLeasesResponse) String() string { return proto.CompactTextString(m) }
 func (*LeaseLeasesResponse) ProtoMessage()    {}
 func (*LeaseLeasesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{38}
+	return fileDescriptor_77a6da22d6a3feb1, []int{44}
 }
 func (m *LeaseLeasesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3091,7 +3820,11 @@ type Member struct {
 	// clientURLs is the list of URLs the member exposes to clients for communication. If the member is not started, clientURLs will be empty.
 	ClientURLs []string `protobuf:"bytes,4,rep,name=clientURLs,proto3" json:"clientURLs,omitempty"`
 	// isLearner indicates if the member is raft learner.
-	IsLearner            bool     `protobuf:"varint,5,opt,name=isLearner,proto3" json:"isLearner,omitempty"`
+	IsLearner bool `protobuf:"varint,5,opt,name=isLearner,proto3" json:"isLearner,omitempty"`
+	// isWitness indicates if the member is a witness, which votes and acknowledges the log but does not store the key-value data.
+	IsWitness bool `protobuf:"varint,6,opt,name=isWitness,proto3" json:"isWitness,omitempty"`
+	// autoPromote indicates if the member is a learner the leader promotes once it caught up with the leader.
+	AutoPromote          bool     `protobuf:"varint,7,opt,name=autoPromote,proto3" json:"autoPromote,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3101,7 +3834,7 @@ func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{39}
+	return fileDescriptor_77a6da22d6a3feb1, []int{45}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3165,11 +3898,29 @@ func (m *Member) GetIsLearner() bool {
 	return false
 }
 
+func (m *Member) GetIsWitness() bool {
+	if m != nil {
+		return m.IsWitness
+	}
+	return false
+}
+
+func (m *Member) GetAutoPromote() bool {
+	if m != nil {
+		return m.AutoPromote
+	}
+	return false
+}
+
 type MemberAddRequest struct {
 	// peerURLs is the list of URLs the added member will use to communicate with the cluster.
 	PeerURLs []string `protobuf:"bytes,1,rep,name=peerURLs,proto3" json:"peerURLs,omitempty"`
 	// isLearner indicates if the added member is raft learner.
-	IsLearner            bool     `protobuf:"varint,2,opt,name=isLearner,proto3" json:"isLearner,omitempty"`
+	IsLearner bool `protobuf:"varint,2,opt,name=isLearner,proto3" json:"isLearner,omitempty"`
+	// isWitness indicates if the added member is a witness.
+	IsWitness bool `protobuf:"varint,3,opt,name=isWitness,proto3" json:"isWitness,omitempty"`
+	// autoPromote indicates if the leader promotes the added learner once it caught up with the leader.
+	AutoPromote          bool     `protobuf:"varint,4,opt,name=autoPromote,proto3" json:"autoPromote,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3179,7 +3930,7 @@ func (m *MemberAddRequest) Reset()         { *m = MemberAddRequest{} }
 func (m *MemberAddRequest) String() string { return proto.CompactTextString(m) }
 func (*MemberAddRequest) ProtoMessage()    {}
 func (*MemberAddRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{40}
+	return fileDescriptor_77a6da22d6a3feb1, []int{46}
 }
 func (m *MemberAddRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3222,6 +3973,20 @@ func (m *MemberAddRequest) GetIsLearner() bool {
 	return false
 }
 
+func (m *MemberAddRequest) GetIsWitness() bool {
+	if m != nil {
+		return m.IsWitness
+	}
+	return false
+}
+
+func (m *MemberAddRequest) GetAutoPromote() bool {
+	if m != nil {
+		return m.AutoPromote
+	}
+	return false
+}
+
 type MemberAddResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	// member is the member information for the added member.
@@ -3237,7 +4002,7 @@ func (m *MemberAddResponse) Reset()         { *m = MemberAddResponse{} }
 func (m *MemberAddResponse) String() string { return proto.CompactTextString(m) }
 func (*MemberAddResponse) ProtoMessage()    {}
 func (*MemberAddResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{41}
+	return fileDescriptor_77a6da22d6a3feb1, []int{47}
 }
 func (m *MemberAddResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3299,7 +4064,7 @@ func (m *MemberRemoveRequest) Reset()         { *m = MemberRemoveRequest{} }
 func (m *MemberRemoveRequest) String() string { return proto.CompactTextString(m) }
 func (*MemberRemoveRequest) ProtoMessage()    {}
 func (*MemberRemoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{42}
+	return fileDescriptor_77a6da22d6a3feb1, []int{48}
 }
 func (m *MemberRemoveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +4113,7 @@ func (m *MemberRemoveResponse) Reset()         { *m = MemberRemoveResponse{} }
 func (m *MemberRemoveResponse) String() string { return proto.CompactTextString(m) }
 func (*MemberRemoveResponse) ProtoMessage()    {}
 func (*MemberRemoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{43}
+	return fileDescriptor_77a6da22d6a3feb1, []int{49}
 }
 func (m *MemberRemoveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3405,7 +4170,7 @@ func (m *MemberUpdateRequest) Reset()         { *m = MemberUpdateRequest{} }
 func (m *MemberUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*MemberUpdateRequest) ProtoMessage()    {}
 func (*MemberUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{44}
+	return fileDescriptor_77a6da22d6a3feb1, []int{50}
 }
 func (m *MemberUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3461,7 +4226,7 @@ func (m *MemberUpdateResponse) Reset()         { *m = MemberUpdateResponse{} }
 func (m *MemberUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*MemberUpdateResponse) ProtoMessage()    {}
 func (*MemberUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{45}
+	return fileDescriptor_77a6da22d6a3feb1, []int{51}
 }
 func (m *MemberUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3515,7 +4280,7 @@ func (m *MemberListRequest) Reset()         { *m = MemberListRequest{} }
 func (m *MemberListRequest) String() string { return proto.CompactTextString(m) }
 func (*MemberListRequest) ProtoMessage()    {}
 func (*MemberListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{46}
+	return fileDescriptor_77a6da22d6a3feb1, []int{52}
 }
 func (m *MemberListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3564,7 +4329,7 @@ func (m *MemberListResponse) Reset()         { *m = MemberListResponse{} }
 func (m *MemberListResponse) String() string { return proto.CompactTextString(m) }
 func (*MemberListResponse) ProtoMessage()    {}
 func (*MemberListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{47}
+	return fileDescriptor_77a6da22d6a3feb1, []int{53}
 }
 func (m *MemberListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3619,7 +4384,7 @@ func (m *MemberPromoteRequest) Reset()         { *m = MemberPromoteRequest{} }
 func (m *MemberPromoteRequest) String() string { return proto.CompactTextString(m) }
 func (*MemberPromoteRequest) ProtoMessage()    {}
 func (*MemberPromoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{48}
+	return fileDescriptor_77a6da22d6a3feb1, []int{54}
 }
 func (m *MemberPromoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3668,7 +4433,7 @@ func (m *MemberPromoteResponse) Reset()         { *m = MemberPromoteResponse{} }
 func (m *MemberPromoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MemberPromoteResponse) ProtoMessage()    {}
 func (*MemberPromoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{49}
+	return fileDescriptor_77a6da22d6a3feb1, []int{55}
 }
 func (m *MemberPromoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3711,24 +4476,157 @@ func (m *MemberPromoteResponse) GetMembers() []*Member {
 	return nil
 }
 
-type DefragmentRequest struct {
+type MemberReconfigureRequest struct {
+	// add is the list of the members to add.
+	Add []*MemberAddRequest `protobuf:"bytes,1,rep,name=add,proto3" json:"add,omitempty"`
+	// remove is the list of the member IDs of the members to remove.
+	Remove []uint64 `protobuf:"varint,2,rep,packed,name=remove,proto3" json:"remove,omitempty"`
+	// promote is the list of the member IDs of the learners to promote.
+	Promote              []uint64 `protobuf:"varint,3,rep,packed,name=promote,proto3" json:"promote,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DefragmentRequest) Reset()         { *m = DefragmentRequest{} }
-func (m *DefragmentRequest) String() string { return proto.CompactTextString(m) }
-func (*DefragmentRequest) ProtoMessage()    {}
-func (*DefragmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{50}
+func (m *MemberReconfigureRequest) Reset()         { *m = MemberReconfigureRequest{} }
+func (m *MemberReconfigureRequest) String() string { return proto.CompactTextString(m) }
+func (*MemberReconfigureRequest) ProtoMessage()    {}
+func (*MemberReconfigureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{56}
 }
-func (m *DefragmentRequest) XXX_Unmarshal(b []byte) error {
+func (m *MemberReconfigureRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DefragmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MemberReconfigureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DefragmentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MemberReconfigureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemberReconfigureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberReconfigureRequest.Merge(m, src)
+}
+func (m *MemberReconfigureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberReconfigureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberReconfigureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberReconfigureRequest proto.InternalMessageInfo
+
+func (m *MemberReconfigureRequest) GetAdd() []*MemberAddRequest {
+	if m != nil {
+		return m.Add
+	}
+	return nil
+}
+
+func (m *MemberReconfigureRequest) GetRemove() []uint64 {
+	if m != nil {
+		return m.Remove
+	}
+	return nil
+}
+
+func (m *MemberReconfigureRequest) GetPromote() []uint64 {
+	if m != nil {
+		return m.Promote
+	}
+	return nil
+}
+
+type MemberReconfigureResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// added is the member information for the added members, in the order of the request.
+	Added []*Member `protobuf:"bytes,2,rep,name=added,proto3" json:"added,omitempty"`
+	// members is a list of all members after the reconfiguration.
+	Members              []*Member `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *MemberReconfigureResponse) Reset()         { *m = MemberReconfigureResponse{} }
+func (m *MemberReconfigureResponse) String() string { return proto.CompactTextString(m) }
+func (*MemberReconfigureResponse) ProtoMessage()    {}
+func (*MemberReconfigureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{57}
+}
+func (m *MemberReconfigureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberReconfigureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberReconfigureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemberReconfigureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberReconfigureResponse.Merge(m, src)
+}
+func (m *MemberReconfigureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberReconfigureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberReconfigureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberReconfigureResponse proto.InternalMessageInfo
+
+func (m *MemberReconfigureResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *MemberReconfigureResponse) GetAdded() []*Member {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *MemberReconfigureResponse) GetMembers() []*Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type DefragmentRequest struct {
+	// mode is the defragmentation mode.
+	Mode                 DefragmentRequest_DefragmentMode `protobuf:"varint,1,opt,name=mode,proto3,enum=etcdserverpb.DefragmentRequest_DefragmentMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *DefragmentRequest) Reset()         { *m = DefragmentRequest{} }
+func (m *DefragmentRequest) String() string { return proto.CompactTextString(m) }
+func (*DefragmentRequest) ProtoMessage()    {}
+func (*DefragmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{58}
+}
+func (m *DefragmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DefragmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DefragmentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3750,6 +4648,13 @@ func (m *DefragmentRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DefragmentRequest proto.InternalMessageInfo
 
+func (m *DefragmentRequest) GetMode() DefragmentRequest_DefragmentMode {
+	if m != nil {
+		return m.Mode
+	}
+	return DefragmentRequest_FULL
+}
+
 type DefragmentResponse struct {
 	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -3761,7 +4666,7 @@ func (m *DefragmentResponse) Reset()         { *m = DefragmentResponse{} }
 func (m *DefragmentResponse) String() string { return proto.CompactTextString(m) }
 func (*DefragmentResponse) ProtoMessage()    {}
 func (*DefragmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{51}
+	return fileDescriptor_77a6da22d6a3feb1, []int{59}
 }
 func (m *DefragmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3809,7 +4714,7 @@ func (m *MoveLeaderRequest) Reset()         { *m = MoveLeaderRequest{} }
 func (m *MoveLeaderRequest) String() string { return proto.CompactTextString(m) }
 func (*MoveLeaderRequest) ProtoMessage()    {}
 func (*MoveLeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{52}
+	return fileDescriptor_77a6da22d6a3feb1, []int{60}
 }
 func (m *MoveLeaderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3856,7 +4761,7 @@ func (m *MoveLeaderResponse) Reset()         { *m = MoveLeaderResponse{} }
 func (m *MoveLeaderResponse) String() string { return proto.CompactTextString(m) }
 func (*MoveLeaderResponse) ProtoMessage()    {}
 func (*MoveLeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{53}
+	return fileDescriptor_77a6da22d6a3feb1, []int{61}
 }
 func (m *MoveLeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3911,7 +4816,7 @@ func (m *AlarmRequest) Reset()         { *m = AlarmRequest{} }
 func (m *AlarmRequest) String() string { return proto.CompactTextString(m) }
 func (*AlarmRequest) ProtoMessage()    {}
 func (*AlarmRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{54}
+	return fileDescriptor_77a6da22d6a3feb1, []int{62}
 }
 func (m *AlarmRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3975,7 +4880,7 @@ func (m *AlarmMember) Reset()         { *m = AlarmMember{} }
 func (m *AlarmMember) String() string { return proto.CompactTextString(m) }
 func (*AlarmMember) ProtoMessage()    {}
 func (*AlarmMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{55}
+	return fileDescriptor_77a6da22d6a3feb1, []int{63}
 }
 func (m *AlarmMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4031,7 +4936,7 @@ func (m *AlarmResponse) Reset()         { *m = AlarmResponse{} }
 func (m *AlarmResponse) String() string { return proto.CompactTextString(m) }
 func (*AlarmResponse) ProtoMessage()    {}
 func (*AlarmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{56}
+	return fileDescriptor_77a6da22d6a3feb1, []int{64}
 }
 func (m *AlarmResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4090,7 +4995,7 @@ func (m *DowngradeRequest) Reset()         { *m = DowngradeRequest{} }
 func (m *DowngradeRequest) String() string { return proto.CompactTextString(m) }
 func (*DowngradeRequest) ProtoMessage()    {}
 func (*DowngradeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{57}
+	return fileDescriptor_77a6da22d6a3feb1, []int{65}
 }
 func (m *DowngradeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4146,7 +5051,7 @@ func (m *DowngradeResponse) Reset()         { *m = DowngradeResponse{} }
 func (m *DowngradeResponse) String() string { return proto.CompactTextString(m) }
 func (*DowngradeResponse) ProtoMessage()    {}
 func (*DowngradeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{58}
+	return fileDescriptor_77a6da22d6a3feb1, []int{66}
 }
 func (m *DowngradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4189,24 +5094,24 @@ func (m *DowngradeResponse) GetVersion() string {
 	return ""
 }
 
-type StatusRequest struct {
+type WatchLagRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
-func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
-func (*StatusRequest) ProtoMessage()    {}
-func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{59}
+func (m *WatchLagRequest) Reset()         { *m = WatchLagRequest{} }
+func (m *WatchLagRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchLagRequest) ProtoMessage()    {}
+func (*WatchLagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{67}
 }
-func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
+func (m *WatchLagRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchLagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StatusRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchLagRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4216,59 +5121,51 @@ func (m *StatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *StatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StatusRequest.Merge(m, src)
+func (m *WatchLagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchLagRequest.Merge(m, src)
 }
-func (m *StatusRequest) XXX_Size() int {
+func (m *WatchLagRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StatusRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_StatusRequest proto.InternalMessageInfo
-
-type StatusResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// version is the cluster protocol version used by the responding member.
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	// dbSize is the size of the backend database physically allocated, in bytes, of the responding member.
-	DbSize int64 `protobuf:"varint,3,opt,name=dbSize,proto3" json:"dbSize,omitempty"`
-	// leader is the member ID which the responding member believes is the current leader.
-	Leader uint64 `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`
-	// raftIndex is the current raft committed index of the responding member.
-	RaftIndex uint64 `protobuf:"varint,5,opt,name=raftIndex,proto3" json:"raftIndex,omitempty"`
-	// raftTerm is the current raft term of the responding member.
-	RaftTerm uint64 `protobuf:"varint,6,opt,name=raftTerm,proto3" json:"raftTerm,omitempty"`
-	// raftAppliedIndex is the current raft applied index of the responding member.
-	RaftAppliedIndex uint64 `protobuf:"varint,7,opt,name=raftAppliedIndex,proto3" json:"raftAppliedIndex,omitempty"`
-	// errors contains alarm/health information and status.
-	Errors []string `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
-	// dbSizeInUse is the size of the backend database logically in use, in bytes, of the responding member.
-	DbSizeInUse int64 `protobuf:"varint,9,opt,name=dbSizeInUse,proto3" json:"dbSizeInUse,omitempty"`
-	// isLearner indicates if the member is raft learner.
-	IsLearner bool `protobuf:"varint,10,opt,name=isLearner,proto3" json:"isLearner,omitempty"`
-	// storageVersion is the version of the db file. It might be get updated with delay in relationship to the target cluster version.
-	StorageVersion string `protobuf:"bytes,11,opt,name=storageVersion,proto3" json:"storageVersion,omitempty"`
-	// dbSizeQuota is the configured etcd storage quota in bytes (the value passed to etcd instance by flag --quota-backend-bytes)
-	DbSizeQuota          int64    `protobuf:"varint,12,opt,name=dbSizeQuota,proto3" json:"dbSizeQuota,omitempty"`
+func (m *WatchLagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchLagRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchLagRequest proto.InternalMessageInfo
+
+type WatchStreamLag struct {
+	// stream_id is the member local ID of the watch stream.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// remote_addr is the address of the client of the watch stream.
+	RemoteAddr string `protobuf:"bytes,2,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	// watchers is the number of watchers on the watch stream.
+	Watchers int64 `protobuf:"varint,3,opt,name=watchers,proto3" json:"watchers,omitempty"`
+	// revision_gap is the number of revisions between the current revision
+	// and the oldest response not sent to the client yet.
+	RevisionGap int64 `protobuf:"varint,4,opt,name=revision_gap,json=revisionGap,proto3" json:"revision_gap,omitempty"`
+	// buffered_bytes is the size of the responses held back by flow control.
+	BufferedBytes int64 `protobuf:"varint,5,opt,name=buffered_bytes,json=bufferedBytes,proto3" json:"buffered_bytes,omitempty"`
+	// flow_control is set if the client grants credits to the watch stream.
+	FlowControl bool `protobuf:"varint,6,opt,name=flow_control,json=flowControl,proto3" json:"flow_control,omitempty"`
+	// credits is the number of events the server can still send to the client.
+	Credits              int64    `protobuf:"varint,7,opt,name=credits,proto3" json:"credits,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
-func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
-func (*StatusResponse) ProtoMessage()    {}
-func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{60}
+func (m *WatchStreamLag) Reset()         { *m = WatchStreamLag{} }
+func (m *WatchStreamLag) String() string { return proto.CompactTextString(m) }
+func (*WatchStreamLag) ProtoMessage()    {}
+func (*WatchStreamLag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{68}
 }
-func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
+func (m *WatchStreamLag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchStreamLag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchStreamLag.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4278,120 +5175,143 @@ func (m *StatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *StatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StatusResponse.Merge(m, src)
+func (m *WatchStreamLag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchStreamLag.Merge(m, src)
 }
-func (m *StatusResponse) XXX_Size() int {
+func (m *WatchStreamLag) XXX_Size() int {
 	return m.Size()
 }
-func (m *StatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StatusResponse.DiscardUnknown(m)
+func (m *WatchStreamLag) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchStreamLag.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_WatchStreamLag proto.InternalMessageInfo
 
-func (m *StatusResponse) GetHeader() *ResponseHeader {
+func (m *WatchStreamLag) GetStreamId() uint64 {
 	if m != nil {
-		return m.Header
+		return m.StreamId
 	}
-	return nil
+	return 0
 }
 
-func (m *StatusResponse) GetVersion() string {
+func (m *WatchStreamLag) GetRemoteAddr() string {
 	if m != nil {
-		return m.Version
+		return m.RemoteAddr
 	}
 	return ""
 }
 
-func (m *StatusResponse) GetDbSize() int64 {
+func (m *WatchStreamLag) GetWatchers() int64 {
 	if m != nil {
-		return m.DbSize
+		return m.Watchers
 	}
 	return 0
 }
 
-func (m *StatusResponse) GetLeader() uint64 {
+func (m *WatchStreamLag) GetRevisionGap() int64 {
 	if m != nil {
-		return m.Leader
+		return m.RevisionGap
 	}
 	return 0
 }
 
-func (m *StatusResponse) GetRaftIndex() uint64 {
+func (m *WatchStreamLag) GetBufferedBytes() int64 {
 	if m != nil {
-		return m.RaftIndex
+		return m.BufferedBytes
 	}
 	return 0
 }
 
-func (m *StatusResponse) GetRaftTerm() uint64 {
+func (m *WatchStreamLag) GetFlowControl() bool {
 	if m != nil {
-		return m.RaftTerm
+		return m.FlowControl
 	}
-	return 0
+	return false
 }
 
-func (m *StatusResponse) GetRaftAppliedIndex() uint64 {
+func (m *WatchStreamLag) GetCredits() int64 {
 	if m != nil {
-		return m.RaftAppliedIndex
+		return m.Credits
 	}
 	return 0
 }
 
-func (m *StatusResponse) GetErrors() []string {
-	if m != nil {
-		return m.Errors
-	}
-	return nil
+type WatchLagResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// streams are the watch streams of the member.
+	Streams              []*WatchStreamLag `protobuf:"bytes,2,rep,name=streams,proto3" json:"streams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *StatusResponse) GetDbSizeInUse() int64 {
-	if m != nil {
-		return m.DbSizeInUse
-	}
-	return 0
+func (m *WatchLagResponse) Reset()         { *m = WatchLagResponse{} }
+func (m *WatchLagResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchLagResponse) ProtoMessage()    {}
+func (*WatchLagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{69}
 }
-
-func (m *StatusResponse) GetIsLearner() bool {
-	if m != nil {
-		return m.IsLearner
+func (m *WatchLagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchLagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchLagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *WatchLagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchLagResponse.Merge(m, src)
+}
+func (m *WatchLagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchLagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchLagResponse.DiscardUnknown(m)
 }
 
-func (m *StatusResponse) GetStorageVersion() string {
+var xxx_messageInfo_WatchLagResponse proto.InternalMessageInfo
+
+func (m *WatchLagResponse) GetHeader() *ResponseHeader {
 	if m != nil {
-		return m.StorageVersion
+		return m.Header
 	}
-	return ""
+	return nil
 }
 
-func (m *StatusResponse) GetDbSizeQuota() int64 {
+func (m *WatchLagResponse) GetStreams() []*WatchStreamLag {
 	if m != nil {
-		return m.DbSizeQuota
+		return m.Streams
 	}
-	return 0
+	return nil
 }
 
-type AuthEnableRequest struct {
+type RevisionAtRequest struct {
+	// timestamp is the time to look up, in nanoseconds since the Unix epoch.
+	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthEnableRequest) Reset()         { *m = AuthEnableRequest{} }
-func (m *AuthEnableRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthEnableRequest) ProtoMessage()    {}
-func (*AuthEnableRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{61}
+func (m *RevisionAtRequest) Reset()         { *m = RevisionAtRequest{} }
+func (m *RevisionAtRequest) String() string { return proto.CompactTextString(m) }
+func (*RevisionAtRequest) ProtoMessage()    {}
+func (*RevisionAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{70}
 }
-func (m *AuthEnableRequest) XXX_Unmarshal(b []byte) error {
+func (m *RevisionAtRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthEnableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevisionAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthEnableRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RevisionAtRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4401,36 +5321,51 @@ func (m *AuthEnableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *AuthEnableRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthEnableRequest.Merge(m, src)
+func (m *RevisionAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionAtRequest.Merge(m, src)
 }
-func (m *AuthEnableRequest) XXX_Size() int {
+func (m *RevisionAtRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthEnableRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthEnableRequest.DiscardUnknown(m)
+func (m *RevisionAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionAtRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthEnableRequest proto.InternalMessageInfo
+var xxx_messageInfo_RevisionAtRequest proto.InternalMessageInfo
 
-type AuthDisableRequest struct {
+func (m *RevisionAtRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type RevisionAtResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// revision is the latest revision recorded at or before the requested
+	// time. The leader records the revision at most once per second, so
+	// revisions created shortly before the requested time may be missed.
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// timestamp is the time the revision was recorded at, in nanoseconds
+	// since the Unix epoch.
+	Timestamp            int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthDisableRequest) Reset()         { *m = AuthDisableRequest{} }
-func (m *AuthDisableRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthDisableRequest) ProtoMessage()    {}
-func (*AuthDisableRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62}
+func (m *RevisionAtResponse) Reset()         { *m = RevisionAtResponse{} }
+func (m *RevisionAtResponse) String() string { return proto.CompactTextString(m) }
+func (*RevisionAtResponse) ProtoMessage()    {}
+func (*RevisionAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{71}
 }
-func (m *AuthDisableRequest) XXX_Unmarshal(b []byte) error {
+func (m *RevisionAtResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthDisableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevisionAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthDisableRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RevisionAtResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4440,36 +5375,57 @@ func (m *AuthDisableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *AuthDisableRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthDisableRequest.Merge(m, src)
+func (m *RevisionAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionAtResponse.Merge(m, src)
 }
-func (m *AuthDisableRequest) XXX_Size() int {
+func (m *RevisionAtResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthDisableRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthDisableRequest.DiscardUnknown(m)
+func (m *RevisionAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionAtResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthDisableRequest proto.InternalMessageInfo
+var xxx_messageInfo_RevisionAtResponse proto.InternalMessageInfo
 
-type AuthStatusRequest struct {
+func (m *RevisionAtResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *RevisionAtResponse) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *RevisionAtResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type StatusRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthStatusRequest) Reset()         { *m = AuthStatusRequest{} }
-func (m *AuthStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthStatusRequest) ProtoMessage()    {}
-func (*AuthStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{63}
+func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
+func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
+func (*StatusRequest) ProtoMessage()    {}
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{72}
 }
-func (m *AuthStatusRequest) XXX_Unmarshal(b []byte) error {
+func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthStatusRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4479,38 +5435,59 @@ func (m *AuthStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *AuthStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthStatusRequest.Merge(m, src)
+func (m *StatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusRequest.Merge(m, src)
 }
-func (m *AuthStatusRequest) XXX_Size() int {
+func (m *StatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthStatusRequest.DiscardUnknown(m)
+func (m *StatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthStatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_StatusRequest proto.InternalMessageInfo
 
-type AuthenticateRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+type StatusResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// version is the cluster protocol version used by the responding member.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// dbSize is the size of the backend database physically allocated, in bytes, of the responding member.
+	DbSize int64 `protobuf:"varint,3,opt,name=dbSize,proto3" json:"dbSize,omitempty"`
+	// leader is the member ID which the responding member believes is the current leader.
+	Leader uint64 `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`
+	// raftIndex is the current raft committed index of the responding member.
+	RaftIndex uint64 `protobuf:"varint,5,opt,name=raftIndex,proto3" json:"raftIndex,omitempty"`
+	// raftTerm is the current raft term of the responding member.
+	RaftTerm uint64 `protobuf:"varint,6,opt,name=raftTerm,proto3" json:"raftTerm,omitempty"`
+	// raftAppliedIndex is the current raft applied index of the responding member.
+	RaftAppliedIndex uint64 `protobuf:"varint,7,opt,name=raftAppliedIndex,proto3" json:"raftAppliedIndex,omitempty"`
+	// errors contains alarm/health information and status.
+	Errors []string `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
+	// dbSizeInUse is the size of the backend database logically in use, in bytes, of the responding member.
+	DbSizeInUse int64 `protobuf:"varint,9,opt,name=dbSizeInUse,proto3" json:"dbSizeInUse,omitempty"`
+	// isLearner indicates if the member is raft learner.
+	IsLearner bool `protobuf:"varint,10,opt,name=isLearner,proto3" json:"isLearner,omitempty"`
+	// storageVersion is the version of the db file. It might be get updated with delay in relationship to the target cluster version.
+	StorageVersion string `protobuf:"bytes,11,opt,name=storageVersion,proto3" json:"storageVersion,omitempty"`
+	// dbSizeQuota is the configured etcd storage quota in bytes (the value passed to etcd instance by flag --quota-backend-bytes)
+	DbSizeQuota          int64    `protobuf:"varint,12,opt,name=dbSizeQuota,proto3" json:"dbSizeQuota,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthenticateRequest) Reset()         { *m = AuthenticateRequest{} }
-func (m *AuthenticateRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthenticateRequest) ProtoMessage()    {}
-func (*AuthenticateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{64}
+func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
+func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
+func (*StatusResponse) ProtoMessage()    {}
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{73}
 }
-func (m *AuthenticateRequest) XXX_Unmarshal(b []byte) error {
+func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthenticateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthenticateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4520,122 +5497,120 @@ func (m *AuthenticateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *AuthenticateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthenticateRequest.Merge(m, src)
+func (m *StatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusResponse.Merge(m, src)
 }
-func (m *AuthenticateRequest) XXX_Size() int {
+func (m *StatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthenticateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthenticateRequest.DiscardUnknown(m)
+func (m *StatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthenticateRequest proto.InternalMessageInfo
+var xxx_messageInfo_StatusResponse proto.InternalMessageInfo
 
-func (m *AuthenticateRequest) GetName() string {
+func (m *StatusResponse) GetHeader() *ResponseHeader {
 	if m != nil {
-		return m.Name
+		return m.Header
 	}
-	return ""
+	return nil
 }
 
-func (m *AuthenticateRequest) GetPassword() string {
+func (m *StatusResponse) GetVersion() string {
 	if m != nil {
-		return m.Password
+		return m.Version
 	}
 	return ""
 }
 
-type AuthUserAddRequest struct {
-	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Password             string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Options              *authpb.UserAddOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
-	HashedPassword       string                 `protobuf:"bytes,4,opt,name=hashedPassword,proto3" json:"hashedPassword,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+func (m *StatusResponse) GetDbSize() int64 {
+	if m != nil {
+		return m.DbSize
+	}
+	return 0
 }
 
-func (m *AuthUserAddRequest) Reset()         { *m = AuthUserAddRequest{} }
-func (m *AuthUserAddRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthUserAddRequest) ProtoMessage()    {}
-func (*AuthUserAddRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{65}
-}
-func (m *AuthUserAddRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *AuthUserAddRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_AuthUserAddRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *StatusResponse) GetLeader() uint64 {
+	if m != nil {
+		return m.Leader
 	}
+	return 0
 }
-func (m *AuthUserAddRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthUserAddRequest.Merge(m, src)
-}
-func (m *AuthUserAddRequest) XXX_Size() int {
-	return m.Size()
+
+func (m *StatusResponse) GetRaftIndex() uint64 {
+	if m != nil {
+		return m.RaftIndex
+	}
+	return 0
 }
-func (m *AuthUserAddRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthUserAddRequest.DiscardUnknown(m)
+
+func (m *StatusResponse) GetRaftTerm() uint64 {
+	if m != nil {
+		return m.RaftTerm
+	}
+	return 0
 }
 
-var xxx_messageInfo_AuthUserAddRequest proto.InternalMessageInfo
+func (m *StatusResponse) GetRaftAppliedIndex() uint64 {
+	if m != nil {
+		return m.RaftAppliedIndex
+	}
+	return 0
+}
 
-func (m *AuthUserAddRequest) GetName() string {
+func (m *StatusResponse) GetErrors() []string {
 	if m != nil {
-		return m.Name
+		return m.Errors
 	}
-	return ""
+	return nil
 }
 
-func (m *AuthUserAddRequest) GetPassword() string {
+func (m *StatusResponse) GetDbSizeInUse() int64 {
 	if m != nil {
-		return m.Password
+		return m.DbSizeInUse
 	}
-	return ""
+	return 0
 }
 
-func (m *AuthUserAddRequest) GetOptions() *authpb.UserAddOptions {
+func (m *StatusResponse) GetIsLearner() bool {
 	if m != nil {
-		return m.Options
+		return m.IsLearner
 	}
-	return nil
+	return false
 }
 
-func (m *AuthUserAddRequest) GetHashedPassword() string {
+func (m *StatusResponse) GetStorageVersion() string {
 	if m != nil {
-		return m.HashedPassword
+		return m.StorageVersion
 	}
 	return ""
 }
 
-type AuthUserGetRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (m *StatusResponse) GetDbSizeQuota() int64 {
+	if m != nil {
+		return m.DbSizeQuota
+	}
+	return 0
+}
+
+type AuthEnableRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthUserGetRequest) Reset()         { *m = AuthUserGetRequest{} }
-func (m *AuthUserGetRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthUserGetRequest) ProtoMessage()    {}
-func (*AuthUserGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{66}
+func (m *AuthEnableRequest) Reset()         { *m = AuthEnableRequest{} }
+func (m *AuthEnableRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthEnableRequest) ProtoMessage()    {}
+func (*AuthEnableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{74}
 }
-func (m *AuthUserGetRequest) XXX_Unmarshal(b []byte) error {
+func (m *AuthEnableRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthUserGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthEnableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthUserGetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthEnableRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4645,45 +5620,36 @@ func (m *AuthUserGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *AuthUserGetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthUserGetRequest.Merge(m, src)
+func (m *AuthEnableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthEnableRequest.Merge(m, src)
 }
-func (m *AuthUserGetRequest) XXX_Size() int {
+func (m *AuthEnableRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthUserGetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthUserGetRequest.DiscardUnknown(m)
+func (m *AuthEnableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthEnableRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthUserGetRequest proto.InternalMessageInfo
-
-func (m *AuthUserGetRequest) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_AuthEnableRequest proto.InternalMessageInfo
 
-type AuthUserDeleteRequest struct {
-	// name is the name of the user to delete.
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+type AuthDisableRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthUserDeleteRequest) Reset()         { *m = AuthUserDeleteRequest{} }
-func (m *AuthUserDeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthUserDeleteRequest) ProtoMessage()    {}
-func (*AuthUserDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{67}
+func (m *AuthDisableRequest) Reset()         { *m = AuthDisableRequest{} }
+func (m *AuthDisableRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthDisableRequest) ProtoMessage()    {}
+func (*AuthDisableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{75}
 }
-func (m *AuthUserDeleteRequest) XXX_Unmarshal(b []byte) error {
+func (m *AuthDisableRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthUserDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthDisableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthUserDeleteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthDisableRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4693,49 +5659,36 @@ func (m *AuthUserDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *AuthUserDeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthUserDeleteRequest.Merge(m, src)
+func (m *AuthDisableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthDisableRequest.Merge(m, src)
 }
-func (m *AuthUserDeleteRequest) XXX_Size() int {
+func (m *AuthDisableRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthUserDeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthUserDeleteRequest.DiscardUnknown(m)
+func (m *AuthDisableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthDisableRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthUserDeleteRequest proto.InternalMessageInfo
-
-func (m *AuthUserDeleteRequest) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_AuthDisableRequest proto.InternalMessageInfo
 
-type AuthUserChangePasswordRequest struct {
-	// name is the name of the user whose password is being changed.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// password is the new password for the user. Note that this field will be removed in the API layer.
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	// hashedPassword is the new password for the user. Note that this field will be initialized in the API layer.
-	HashedPassword       string   `protobuf:"bytes,3,opt,name=hashedPassword,proto3" json:"hashedPassword,omitempty"`
+type AuthStatusRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthUserChangePasswordRequest) Reset()         { *m = AuthUserChangePasswordRequest{} }
-func (m *AuthUserChangePasswordRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthUserChangePasswordRequest) ProtoMessage()    {}
-func (*AuthUserChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{68}
+func (m *AuthStatusRequest) Reset()         { *m = AuthStatusRequest{} }
+func (m *AuthStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthStatusRequest) ProtoMessage()    {}
+func (*AuthStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{76}
 }
-func (m *AuthUserChangePasswordRequest) XXX_Unmarshal(b []byte) error {
+func (m *AuthStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthUserChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthUserChangePasswordRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4745,61 +5698,38 @@ func (m *AuthUserChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *AuthUserChangePasswordRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthUserChangePasswordRequest.Merge(m, src)
+func (m *AuthStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthStatusRequest.Merge(m, src)
 }
-func (m *AuthUserChangePasswordRequest) XXX_Size() int {
+func (m *AuthStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthUserChangePasswordRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthUserChangePasswordRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AuthUserChangePasswordRequest proto.InternalMessageInfo
-
-func (m *AuthUserChangePasswordRequest) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-func (m *AuthUserChangePasswordRequest) GetPassword() string {
-	if m != nil {
-		return m.Password
-	}
-	return ""
+func (m *AuthStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthStatusRequest.DiscardUnknown(m)
 }
 
-func (m *AuthUserChangePasswordRequest) GetHashedPassword() string {
-	if m != nil {
-		return m.HashedPassword
-	}
-	return ""
-}
+var xxx_messageInfo_AuthStatusRequest proto.InternalMessageInfo
 
-type AuthUserGrantRoleRequest struct {
-	// user is the name of the user which should be granted a given role.
-	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	// role is the name of the role to grant to the user.
-	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+type AuthenticateRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthUserGrantRoleRequest) Reset()         { *m = AuthUserGrantRoleRequest{} }
-func (m *AuthUserGrantRoleRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthUserGrantRoleRequest) ProtoMessage()    {}
-func (*AuthUserGrantRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{69}
+func (m *AuthenticateRequest) Reset()         { *m = AuthenticateRequest{} }
+func (m *AuthenticateRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthenticateRequest) ProtoMessage()    {}
+func (*AuthenticateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{77}
 }
-func (m *AuthUserGrantRoleRequest) XXX_Unmarshal(b []byte) error {
+func (m *AuthenticateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthUserGrantRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthenticateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthUserGrantRoleRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthenticateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4809,52 +5739,54 @@ func (m *AuthUserGrantRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *AuthUserGrantRoleRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthUserGrantRoleRequest.Merge(m, src)
+func (m *AuthenticateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticateRequest.Merge(m, src)
 }
-func (m *AuthUserGrantRoleRequest) XXX_Size() int {
+func (m *AuthenticateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthUserGrantRoleRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthUserGrantRoleRequest.DiscardUnknown(m)
+func (m *AuthenticateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthUserGrantRoleRequest proto.InternalMessageInfo
+var xxx_messageInfo_AuthenticateRequest proto.InternalMessageInfo
 
-func (m *AuthUserGrantRoleRequest) GetUser() string {
+func (m *AuthenticateRequest) GetName() string {
 	if m != nil {
-		return m.User
+		return m.Name
 	}
 	return ""
 }
 
-func (m *AuthUserGrantRoleRequest) GetRole() string {
+func (m *AuthenticateRequest) GetPassword() string {
 	if m != nil {
-		return m.Role
+		return m.Password
 	}
 	return ""
 }
 
-type AuthUserRevokeRoleRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type AuthUserAddRequest struct {
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Password             string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Options              *authpb.UserAddOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	HashedPassword       string                 `protobuf:"bytes,4,opt,name=hashedPassword,proto3" json:"hashedPassword,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *AuthUserRevokeRoleRequest) Reset()         { *m = AuthUserRevokeRoleRequest{} }
-func (m *AuthUserRevokeRoleRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthUserRevokeRoleRequest) ProtoMessage()    {}
-func (*AuthUserRevokeRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{70}
+func (m *AuthUserAddRequest) Reset()         { *m = AuthUserAddRequest{} }
+func (m *AuthUserAddRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthUserAddRequest) ProtoMessage()    {}
+func (*AuthUserAddRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{78}
 }
-func (m *AuthUserRevokeRoleRequest) XXX_Unmarshal(b []byte) error {
+func (m *AuthUserAddRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthUserRevokeRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthUserAddRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthUserRevokeRoleRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthUserAddRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4864,52 +5796,65 @@ func (m *AuthUserRevokeRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *AuthUserRevokeRoleRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthUserRevokeRoleRequest.Merge(m, src)
+func (m *AuthUserAddRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthUserAddRequest.Merge(m, src)
 }
-func (m *AuthUserRevokeRoleRequest) XXX_Size() int {
+func (m *AuthUserAddRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthUserRevokeRoleRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthUserRevokeRoleRequest.DiscardUnknown(m)
+func (m *AuthUserAddRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthUserAddRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthUserRevokeRoleRequest proto.InternalMessageInfo
+var xxx_messageInfo_AuthUserAddRequest proto.InternalMessageInfo
 
-func (m *AuthUserRevokeRoleRequest) GetName() string {
+func (m *AuthUserAddRequest) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *AuthUserRevokeRoleRequest) GetRole() string {
+func (m *AuthUserAddRequest) GetPassword() string {
 	if m != nil {
-		return m.Role
+		return m.Password
 	}
 	return ""
 }
 
-type AuthRoleAddRequest struct {
-	// name is the name of the role to add to the authentication system.
+func (m *AuthUserAddRequest) GetOptions() *authpb.UserAddOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *AuthUserAddRequest) GetHashedPassword() string {
+	if m != nil {
+		return m.HashedPassword
+	}
+	return ""
+}
+
+type AuthUserGetRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthRoleAddRequest) Reset()         { *m = AuthRoleAddRequest{} }
-func (m *AuthRoleAddRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthRoleAddRequest) ProtoMessage()    {}
-func (*AuthRoleAddRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{71}
+func (m *AuthUserGetRequest) Reset()         { *m = AuthUserGetRequest{} }
+func (m *AuthUserGetRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthUserGetRequest) ProtoMessage()    {}
+func (*AuthUserGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{79}
 }
-func (m *AuthRoleAddRequest) XXX_Unmarshal(b []byte) error {
+func (m *AuthUserGetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthRoleAddRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthUserGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthRoleAddRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthUserGetRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4919,44 +5864,45 @@ func (m *AuthRoleAddRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *AuthRoleAddRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthRoleAddRequest.Merge(m, src)
+func (m *AuthUserGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthUserGetRequest.Merge(m, src)
 }
-func (m *AuthRoleAddRequest) XXX_Size() int {
+func (m *AuthUserGetRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthRoleAddRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthRoleAddRequest.DiscardUnknown(m)
+func (m *AuthUserGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthUserGetRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthRoleAddRequest proto.InternalMessageInfo
+var xxx_messageInfo_AuthUserGetRequest proto.InternalMessageInfo
 
-func (m *AuthRoleAddRequest) GetName() string {
+func (m *AuthUserGetRequest) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-type AuthRoleGetRequest struct {
-	Role                 string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+type AuthUserDeleteRequest struct {
+	// name is the name of the user to delete.
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthRoleGetRequest) Reset()         { *m = AuthRoleGetRequest{} }
-func (m *AuthRoleGetRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthRoleGetRequest) ProtoMessage()    {}
-func (*AuthRoleGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72}
+func (m *AuthUserDeleteRequest) Reset()         { *m = AuthUserDeleteRequest{} }
+func (m *AuthUserDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthUserDeleteRequest) ProtoMessage()    {}
+func (*AuthUserDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{80}
 }
-func (m *AuthRoleGetRequest) XXX_Unmarshal(b []byte) error {
+func (m *AuthUserDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthRoleGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthUserDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthRoleGetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthUserDeleteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4966,43 +5912,49 @@ func (m *AuthRoleGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *AuthRoleGetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthRoleGetRequest.Merge(m, src)
+func (m *AuthUserDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthUserDeleteRequest.Merge(m, src)
 }
-func (m *AuthRoleGetRequest) XXX_Size() int {
+func (m *AuthUserDeleteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthRoleGetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthRoleGetRequest.DiscardUnknown(m)
+func (m *AuthUserDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthUserDeleteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthRoleGetRequest proto.InternalMessageInfo
+var xxx_messageInfo_AuthUserDeleteRequest proto.InternalMessageInfo
 
-func (m *AuthRoleGetRequest) GetRole() string {
+func (m *AuthUserDeleteRequest) GetName() string {
 	if m != nil {
-		return m.Role
+		return m.Name
 	}
 	return ""
 }
 
-type AuthUserListRequest struct {
+type AuthUserChangePasswordRequest struct {
+	// name is the name of the user whose password is being changed.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// password is the new password for the user. Note that this field will be removed in the API layer.
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// hashedPassword is the new password for the user. Note that this field will be initialized in the API layer.
+	HashedPassword       string   `protobuf:"bytes,3,opt,name=hashedPassword,proto3" json:"hashedPassword,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthUserListRequest) Reset()         { *m = AuthUserListRequest{} }
-func (m *AuthUserListRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthUserListRequest) ProtoMessage()    {}
-func (*AuthUserListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{73}
+func (m *AuthUserChangePasswordRequest) Reset()         { *m = AuthUserChangePasswordRequest{} }
+func (m *AuthUserChangePasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthUserChangePasswordRequest) ProtoMessage()    {}
+func (*AuthUserChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{81}
 }
-func (m *AuthUserListRequest) XXX_Unmarshal(b []byte) error {
+func (m *AuthUserChangePasswordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthUserListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthUserChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthUserListRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthUserChangePasswordRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5012,36 +5964,61 @@ func (m *AuthUserListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *AuthUserListRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthUserListRequest.Merge(m, src)
+func (m *AuthUserChangePasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthUserChangePasswordRequest.Merge(m, src)
 }
-func (m *AuthUserListRequest) XXX_Size() int {
+func (m *AuthUserChangePasswordRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthUserListRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthUserListRequest.DiscardUnknown(m)
+func (m *AuthUserChangePasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthUserChangePasswordRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthUserListRequest proto.InternalMessageInfo
+var xxx_messageInfo_AuthUserChangePasswordRequest proto.InternalMessageInfo
 
-type AuthRoleListRequest struct {
+func (m *AuthUserChangePasswordRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AuthUserChangePasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *AuthUserChangePasswordRequest) GetHashedPassword() string {
+	if m != nil {
+		return m.HashedPassword
+	}
+	return ""
+}
+
+type AuthUserGrantRoleRequest struct {
+	// user is the name of the user which should be granted a given role.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// role is the name of the role to grant to the user.
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthRoleListRequest) Reset()         { *m = AuthRoleListRequest{} }
-func (m *AuthRoleListRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthRoleListRequest) ProtoMessage()    {}
-func (*AuthRoleListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{74}
+func (m *AuthUserGrantRoleRequest) Reset()         { *m = AuthUserGrantRoleRequest{} }
+func (m *AuthUserGrantRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthUserGrantRoleRequest) ProtoMessage()    {}
+func (*AuthUserGrantRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{82}
 }
-func (m *AuthRoleListRequest) XXX_Unmarshal(b []byte) error {
+func (m *AuthUserGrantRoleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthRoleListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthUserGrantRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthRoleListRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthUserGrantRoleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5051,37 +6028,52 @@ func (m *AuthRoleListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *AuthRoleListRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthRoleListRequest.Merge(m, src)
+func (m *AuthUserGrantRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthUserGrantRoleRequest.Merge(m, src)
 }
-func (m *AuthRoleListRequest) XXX_Size() int {
+func (m *AuthUserGrantRoleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthRoleListRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthRoleListRequest.DiscardUnknown(m)
+func (m *AuthUserGrantRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthUserGrantRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthRoleListRequest proto.InternalMessageInfo
+var xxx_messageInfo_AuthUserGrantRoleRequest proto.InternalMessageInfo
 
-type AuthRoleDeleteRequest struct {
-	Role                 string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+func (m *AuthUserGrantRoleRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *AuthUserGrantRoleRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type AuthUserRevokeRoleRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthRoleDeleteRequest) Reset()         { *m = AuthRoleDeleteRequest{} }
-func (m *AuthRoleDeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthRoleDeleteRequest) ProtoMessage()    {}
-func (*AuthRoleDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{75}
+func (m *AuthUserRevokeRoleRequest) Reset()         { *m = AuthUserRevokeRoleRequest{} }
+func (m *AuthUserRevokeRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthUserRevokeRoleRequest) ProtoMessage()    {}
+func (*AuthUserRevokeRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{83}
 }
-func (m *AuthRoleDeleteRequest) XXX_Unmarshal(b []byte) error {
+func (m *AuthUserRevokeRoleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthRoleDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthUserRevokeRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthRoleDeleteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthUserRevokeRoleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5091,47 +6083,52 @@ func (m *AuthRoleDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *AuthRoleDeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthRoleDeleteRequest.Merge(m, src)
+func (m *AuthUserRevokeRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthUserRevokeRoleRequest.Merge(m, src)
 }
-func (m *AuthRoleDeleteRequest) XXX_Size() int {
+func (m *AuthUserRevokeRoleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthRoleDeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthRoleDeleteRequest.DiscardUnknown(m)
+func (m *AuthUserRevokeRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthUserRevokeRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthRoleDeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_AuthUserRevokeRoleRequest proto.InternalMessageInfo
 
-func (m *AuthRoleDeleteRequest) GetRole() string {
+func (m *AuthUserRevokeRoleRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AuthUserRevokeRoleRequest) GetRole() string {
 	if m != nil {
 		return m.Role
 	}
 	return ""
 }
 
-type AuthRoleGrantPermissionRequest struct {
-	// name is the name of the role which will be granted the permission.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// perm is the permission to grant to the role.
-	Perm                 *authpb.Permission `protobuf:"bytes,2,opt,name=perm,proto3" json:"perm,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type AuthRoleAddRequest struct {
+	// name is the name of the role to add to the authentication system.
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthRoleGrantPermissionRequest) Reset()         { *m = AuthRoleGrantPermissionRequest{} }
-func (m *AuthRoleGrantPermissionRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthRoleGrantPermissionRequest) ProtoMessage()    {}
-func (*AuthRoleGrantPermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{76}
+func (m *AuthRoleAddRequest) Reset()         { *m = AuthRoleAddRequest{} }
+func (m *AuthRoleAddRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthRoleAddRequest) ProtoMessage()    {}
+func (*AuthRoleAddRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{84}
 }
-func (m *AuthRoleGrantPermissionRequest) XXX_Unmarshal(b []byte) error {
+func (m *AuthRoleAddRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthRoleGrantPermissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthRoleAddRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthRoleGrantPermissionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthRoleAddRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5141,53 +6138,44 @@ func (m *AuthRoleGrantPermissionRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *AuthRoleGrantPermissionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthRoleGrantPermissionRequest.Merge(m, src)
+func (m *AuthRoleAddRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthRoleAddRequest.Merge(m, src)
 }
-func (m *AuthRoleGrantPermissionRequest) XXX_Size() int {
+func (m *AuthRoleAddRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthRoleGrantPermissionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthRoleGrantPermissionRequest.DiscardUnknown(m)
+func (m *AuthRoleAddRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthRoleAddRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthRoleGrantPermissionRequest proto.InternalMessageInfo
+var xxx_messageInfo_AuthRoleAddRequest proto.InternalMessageInfo
 
-func (m *AuthRoleGrantPermissionRequest) GetName() string {
+func (m *AuthRoleAddRequest) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *AuthRoleGrantPermissionRequest) GetPerm() *authpb.Permission {
-	if m != nil {
-		return m.Perm
-	}
-	return nil
-}
-
-type AuthRoleRevokePermissionRequest struct {
+type AuthRoleGetRequest struct {
 	Role                 string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	RangeEnd             []byte   `protobuf:"bytes,3,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthRoleRevokePermissionRequest) Reset()         { *m = AuthRoleRevokePermissionRequest{} }
-func (m *AuthRoleRevokePermissionRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthRoleRevokePermissionRequest) ProtoMessage()    {}
-func (*AuthRoleRevokePermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{77}
+func (m *AuthRoleGetRequest) Reset()         { *m = AuthRoleGetRequest{} }
+func (m *AuthRoleGetRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthRoleGetRequest) ProtoMessage()    {}
+func (*AuthRoleGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{85}
 }
-func (m *AuthRoleRevokePermissionRequest) XXX_Unmarshal(b []byte) error {
+func (m *AuthRoleGetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthRoleRevokePermissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthRoleGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthRoleRevokePermissionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthRoleGetRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5197,38 +6185,269 @@ func (m *AuthRoleRevokePermissionRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *AuthRoleRevokePermissionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthRoleRevokePermissionRequest.Merge(m, src)
+func (m *AuthRoleGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthRoleGetRequest.Merge(m, src)
 }
-func (m *AuthRoleRevokePermissionRequest) XXX_Size() int {
+func (m *AuthRoleGetRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthRoleRevokePermissionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthRoleRevokePermissionRequest.DiscardUnknown(m)
+func (m *AuthRoleGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthRoleGetRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthRoleRevokePermissionRequest proto.InternalMessageInfo
+var xxx_messageInfo_AuthRoleGetRequest proto.InternalMessageInfo
 
-func (m *AuthRoleRevokePermissionRequest) GetRole() string {
+func (m *AuthRoleGetRequest) GetRole() string {
 	if m != nil {
 		return m.Role
 	}
 	return ""
 }
 
-func (m *AuthRoleRevokePermissionRequest) GetKey() []byte {
-	if m != nil {
-		return m.Key
-	}
-	return nil
+type AuthUserListRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthRoleRevokePermissionRequest) GetRangeEnd() []byte {
-	if m != nil {
-		return m.RangeEnd
-	}
-	return nil
-}
+func (m *AuthUserListRequest) Reset()         { *m = AuthUserListRequest{} }
+func (m *AuthUserListRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthUserListRequest) ProtoMessage()    {}
+func (*AuthUserListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{86}
+}
+func (m *AuthUserListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthUserListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthUserListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthUserListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthUserListRequest.Merge(m, src)
+}
+func (m *AuthUserListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthUserListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthUserListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthUserListRequest proto.InternalMessageInfo
+
+type AuthRoleListRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthRoleListRequest) Reset()         { *m = AuthRoleListRequest{} }
+func (m *AuthRoleListRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthRoleListRequest) ProtoMessage()    {}
+func (*AuthRoleListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{87}
+}
+func (m *AuthRoleListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthRoleListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthRoleListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthRoleListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthRoleListRequest.Merge(m, src)
+}
+func (m *AuthRoleListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthRoleListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthRoleListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthRoleListRequest proto.InternalMessageInfo
+
+type AuthRoleDeleteRequest struct {
+	Role                 string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthRoleDeleteRequest) Reset()         { *m = AuthRoleDeleteRequest{} }
+func (m *AuthRoleDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthRoleDeleteRequest) ProtoMessage()    {}
+func (*AuthRoleDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{88}
+}
+func (m *AuthRoleDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthRoleDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthRoleDeleteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthRoleDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthRoleDeleteRequest.Merge(m, src)
+}
+func (m *AuthRoleDeleteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthRoleDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthRoleDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthRoleDeleteRequest proto.InternalMessageInfo
+
+func (m *AuthRoleDeleteRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type AuthRoleGrantPermissionRequest struct {
+	// name is the name of the role which will be granted the permission.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// perm is the permission to grant to the role.
+	Perm                 *authpb.Permission `protobuf:"bytes,2,opt,name=perm,proto3" json:"perm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AuthRoleGrantPermissionRequest) Reset()         { *m = AuthRoleGrantPermissionRequest{} }
+func (m *AuthRoleGrantPermissionRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthRoleGrantPermissionRequest) ProtoMessage()    {}
+func (*AuthRoleGrantPermissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{89}
+}
+func (m *AuthRoleGrantPermissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthRoleGrantPermissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthRoleGrantPermissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthRoleGrantPermissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthRoleGrantPermissionRequest.Merge(m, src)
+}
+func (m *AuthRoleGrantPermissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthRoleGrantPermissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthRoleGrantPermissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthRoleGrantPermissionRequest proto.InternalMessageInfo
+
+func (m *AuthRoleGrantPermissionRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AuthRoleGrantPermissionRequest) GetPerm() *authpb.Permission {
+	if m != nil {
+		return m.Perm
+	}
+	return nil
+}
+
+type AuthRoleRevokePermissionRequest struct {
+	Role                 string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	RangeEnd             []byte   `protobuf:"bytes,3,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthRoleRevokePermissionRequest) Reset()         { *m = AuthRoleRevokePermissionRequest{} }
+func (m *AuthRoleRevokePermissionRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthRoleRevokePermissionRequest) ProtoMessage()    {}
+func (*AuthRoleRevokePermissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{90}
+}
+func (m *AuthRoleRevokePermissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthRoleRevokePermissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthRoleRevokePermissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthRoleRevokePermissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthRoleRevokePermissionRequest.Merge(m, src)
+}
+func (m *AuthRoleRevokePermissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthRoleRevokePermissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthRoleRevokePermissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthRoleRevokePermissionRequest proto.InternalMessageInfo
+
+func (m *AuthRoleRevokePermissionRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *AuthRoleRevokePermissionRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *AuthRoleRevokePermissionRequest) GetRangeEnd() []byte {
+	if m != nil {
+		return m.RangeEnd
+	}
+	return nil
+}
 
 type AuthEnableResponse struct {
 	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
@@ -5241,7 +6460,7 @@ func (m *AuthEnableResponse) Reset()         { *m = AuthEnableResponse{} }
 func (m *AuthEnableResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthEnableResponse) ProtoMessage()    {}
 func (*AuthEnableResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{78}
+	return fileDescriptor_77a6da22d6a3feb1, []int{91}
 }
 func (m *AuthEnableResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5288,7 +6507,7 @@ func (m *AuthDisableResponse) Reset()         { *m = AuthDisableResponse{} }
 func (m *AuthDisableResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthDisableResponse) ProtoMessage()    {}
 func (*AuthDisableResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{79}
+	return fileDescriptor_77a6da22d6a3feb1, []int{92}
 }
 func (m *AuthDisableResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5338,7 +6557,7 @@ func (m *AuthStatusResponse) Reset()         { *m = AuthStatusResponse{} }
 func (m *AuthStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthStatusResponse) ProtoMessage()    {}
 func (*AuthStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{80}
+	return fileDescriptor_77a6da22d6a3feb1, []int{93}
 }
 func (m *AuthStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5401,7 +6620,7 @@ func (m *AuthenticateResponse) Reset()         { *m = AuthenticateResponse{} }
 func (m *AuthenticateResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthenticateResponse) ProtoMessage()    {}
 func (*AuthenticateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{81}
+	return fileDescriptor_77a6da22d6a3feb1, []int{94}
 }
 func (m *AuthenticateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5455,7 +6674,7 @@ func (m *AuthUserAddResponse) Reset()         { *m = AuthUserAddResponse{} }
 func (m *AuthUserAddResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthUserAddResponse) ProtoMessage()    {}
 func (*AuthUserAddResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{82}
+	return fileDescriptor_77a6da22d6a3feb1, []int{95}
 }
 func (m *AuthUserAddResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5503,7 +6722,7 @@ func (m *AuthUserGetResponse) Reset()         { *m = AuthUserGetResponse{} }
 func (m *AuthUserGetResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthUserGetResponse) ProtoMessage()    {}
 func (*AuthUserGetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{83}
+	return fileDescriptor_77a6da22d6a3feb1, []int{96}
 }
 func (m *AuthUserGetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5557,7 +6776,7 @@ func (m *AuthUserDeleteResponse) Reset()         { *m = AuthUserDeleteResponse{}
 func (m *AuthUserDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthUserDeleteResponse) ProtoMessage()    {}
 func (*AuthUserDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{84}
+	return fileDescriptor_77a6da22d6a3feb1, []int{97}
 }
 func (m *AuthUserDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5604,7 +6823,7 @@ func (m *AuthUserChangePasswordResponse) Reset()         { *m = AuthUserChangePa
 func (m *AuthUserChangePasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthUserChangePasswordResponse) ProtoMessage()    {}
 func (*AuthUserChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{85}
+	return fileDescriptor_77a6da22d6a3feb1, []int{98}
 }
 func (m *AuthUserChangePasswordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5651,7 +6870,7 @@ func (m *AuthUserGrantRoleResponse) Reset()         { *m = AuthUserGrantRoleResp
 func (m *AuthUserGrantRoleResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthUserGrantRoleResponse) ProtoMessage()    {}
 func (*AuthUserGrantRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{86}
+	return fileDescriptor_77a6da22d6a3feb1, []int{99}
 }
 func (m *AuthUserGrantRoleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5698,7 +6917,7 @@ func (m *AuthUserRevokeRoleResponse) Reset()         { *m = AuthUserRevokeRoleRe
 func (m *AuthUserRevokeRoleResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthUserRevokeRoleResponse) ProtoMessage()    {}
 func (*AuthUserRevokeRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{87}
+	return fileDescriptor_77a6da22d6a3feb1, []int{100}
 }
 func (m *AuthUserRevokeRoleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5745,7 +6964,7 @@ func (m *AuthRoleAddResponse) Reset()         { *m = AuthRoleAddResponse{} }
 func (m *AuthRoleAddResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthRoleAddResponse) ProtoMessage()    {}
 func (*AuthRoleAddResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{88}
+	return fileDescriptor_77a6da22d6a3feb1, []int{101}
 }
 func (m *AuthRoleAddResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5793,7 +7012,7 @@ func (m *AuthRoleGetResponse) Reset()         { *m = AuthRoleGetResponse{} }
 func (m *AuthRoleGetResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthRoleGetResponse) ProtoMessage()    {}
 func (*AuthRoleGetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{89}
+	return fileDescriptor_77a6da22d6a3feb1, []int{102}
 }
 func (m *AuthRoleGetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5848,7 +7067,7 @@ func (m *AuthRoleListResponse) Reset()         { *m = AuthRoleListResponse{} }
 func (m *AuthRoleListResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthRoleListResponse) ProtoMessage()    {}
 func (*AuthRoleListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{90}
+	return fileDescriptor_77a6da22d6a3feb1, []int{103}
 }
 func (m *AuthRoleListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5903,7 +7122,7 @@ func (m *AuthUserListResponse) Reset()         { *m = AuthUserListResponse{} }
 func (m *AuthUserListResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthUserListResponse) ProtoMessage()    {}
 func (*AuthUserListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{91}
+	return fileDescriptor_77a6da22d6a3feb1, []int{104}
 }
 func (m *AuthUserListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5957,7 +7176,7 @@ func (m *AuthRoleDeleteResponse) Reset()         { *m = AuthRoleDeleteResponse{}
 func (m *AuthRoleDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthRoleDeleteResponse) ProtoMessage()    {}
 func (*AuthRoleDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{92}
+	return fileDescriptor_77a6da22d6a3feb1, []int{105}
 }
 func (m *AuthRoleDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6004,7 +7223,7 @@ func (m *AuthRoleGrantPermissionResponse) Reset()         { *m = AuthRoleGrantPe
 func (m *AuthRoleGrantPermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthRoleGrantPermissionResponse) ProtoMessage()    {}
 func (*AuthRoleGrantPermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{93}
+	return fileDescriptor_77a6da22d6a3feb1, []int{106}
 }
 func (m *AuthRoleGrantPermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6051,7 +7270,7 @@ func (m *AuthRoleRevokePermissionResponse) Reset()         { *m = AuthRoleRevoke
 func (m *AuthRoleRevokePermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthRoleRevokePermissionResponse) ProtoMessage()    {}
 func (*AuthRoleRevokePermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{94}
+	return fileDescriptor_77a6da22d6a3feb1, []int{107}
 }
 func (m *AuthRoleRevokePermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6091,14 +7310,20 @@ func init() {
 	proto.RegisterEnum("etcdserverpb.AlarmType", AlarmType_name, AlarmType_value)
 	proto.RegisterEnum("etcdserverpb.RangeRequest_SortOrder", RangeRequest_SortOrder_name, RangeRequest_SortOrder_value)
 	proto.RegisterEnum("etcdserverpb.RangeRequest_SortTarget", RangeRequest_SortTarget_name, RangeRequest_SortTarget_value)
+	proto.RegisterEnum("etcdserverpb.RangeFilter_FilterResult", RangeFilter_FilterResult_name, RangeFilter_FilterResult_value)
 	proto.RegisterEnum("etcdserverpb.Compare_CompareResult", Compare_CompareResult_name, Compare_CompareResult_value)
 	proto.RegisterEnum("etcdserverpb.Compare_CompareTarget", Compare_CompareTarget_name, Compare_CompareTarget_value)
 	proto.RegisterEnum("etcdserverpb.WatchCreateRequest_FilterType", WatchCreateRequest_FilterType_name, WatchCreateRequest_FilterType_value)
+	proto.RegisterEnum("etcdserverpb.DefragmentRequest_DefragmentMode", DefragmentRequest_DefragmentMode_name, DefragmentRequest_DefragmentMode_value)
 	proto.RegisterEnum("etcdserverpb.AlarmRequest_AlarmAction", AlarmRequest_AlarmAction_name, AlarmRequest_AlarmAction_value)
 	proto.RegisterEnum("etcdserverpb.DowngradeRequest_DowngradeAction", DowngradeRequest_DowngradeAction_name, DowngradeRequest_DowngradeAction_value)
 	proto.RegisterType((*ResponseHeader)(nil), "etcdserverpb.ResponseHeader")
 	proto.RegisterType((*RangeRequest)(nil), "etcdserverpb.RangeRequest")
+	proto.RegisterType((*Staleness)(nil), "etcdserverpb.Staleness")
+	proto.RegisterType((*RangeFilter)(nil), "etcdserverpb.RangeFilter")
 	proto.RegisterType((*RangeResponse)(nil), "etcdserverpb.RangeResponse")
+	proto.RegisterType((*MultiRangeRequest)(nil), "etcdserverpb.MultiRangeRequest")
+	proto.RegisterType((*MultiRangeResponse)(nil), "etcdserverpb.MultiRangeResponse")
 	proto.RegisterType((*PutRequest)(nil), "etcdserverpb.PutRequest")
 	proto.RegisterType((*PutResponse)(nil), "etcdserverpb.PutResponse")
 	proto.RegisterType((*DeleteRangeRequest)(nil), "etcdserverpb.DeleteRangeRequest")
@@ -6118,8 +7343,10 @@ func init() {
 	proto.RegisterType((*SnapshotResponse)(nil), "etcdserverpb.SnapshotResponse")
 	proto.RegisterType((*WatchRequest)(nil), "etcdserverpb.WatchRequest")
 	proto.RegisterType((*WatchCreateRequest)(nil), "etcdserverpb.WatchCreateRequest")
+	proto.RegisterType((*WatchFilter)(nil), "etcdserverpb.WatchFilter")
 	proto.RegisterType((*WatchCancelRequest)(nil), "etcdserverpb.WatchCancelRequest")
 	proto.RegisterType((*WatchProgressRequest)(nil), "etcdserverpb.WatchProgressRequest")
+	proto.RegisterType((*WatchCreditRequest)(nil), "etcdserverpb.WatchCreditRequest")
 	proto.RegisterType((*WatchResponse)(nil), "etcdserverpb.WatchResponse")
 	proto.RegisterType((*LeaseGrantRequest)(nil), "etcdserverpb.LeaseGrantRequest")
 	proto.RegisterType((*LeaseGrantResponse)(nil), "etcdserverpb.LeaseGrantResponse")
@@ -6146,6 +7373,8 @@ func init() {
 	proto.RegisterType((*MemberListResponse)(nil), "etcdserverpb.MemberListResponse")
 	proto.RegisterType((*MemberPromoteRequest)(nil), "etcdserverpb.MemberPromoteRequest")
 	proto.RegisterType((*MemberPromoteResponse)(nil), "etcdserverpb.MemberPromoteResponse")
+	proto.RegisterType((*MemberReconfigureRequest)(nil), "etcdserverpb.MemberReconfigureRequest")
+	proto.RegisterType((*MemberReconfigureResponse)(nil), "etcdserverpb.MemberReconfigureResponse")
 	proto.RegisterType((*DefragmentRequest)(nil), "etcdserverpb.DefragmentRequest")
 	proto.RegisterType((*DefragmentResponse)(nil), "etcdserverpb.DefragmentResponse")
 	proto.RegisterType((*MoveLeaderRequest)(nil), "etcdserverpb.MoveLeaderRequest")
@@ -6155,6 +7384,11 @@ func init() {
 	proto.RegisterType((*AlarmResponse)(nil), "etcdserverpb.AlarmResponse")
 	proto.RegisterType((*DowngradeRequest)(nil), "etcdserverpb.DowngradeRequest")
 	proto.RegisterType((*DowngradeResponse)(nil), "etcdserverpb.DowngradeResponse")
+	proto.RegisterType((*WatchLagRequest)(nil), "etcdserverpb.WatchLagRequest")
+	proto.RegisterType((*WatchStreamLag)(nil), "etcdserverpb.WatchStreamLag")
+	proto.RegisterType((*WatchLagResponse)(nil), "etcdserverpb.WatchLagResponse")
+	proto.RegisterType((*RevisionAtRequest)(nil), "etcdserverpb.RevisionAtRequest")
+	proto.RegisterType((*RevisionAtResponse)(nil), "etcdserverpb.RevisionAtResponse")
 	proto.RegisterType((*StatusRequest)(nil), "etcdserverpb.StatusRequest")
 	proto.RegisterType((*StatusResponse)(nil), "etcdserverpb.StatusResponse")
 	proto.RegisterType((*AuthEnableRequest)(nil), "etcdserverpb.AuthEnableRequest")
@@ -6196,289 +7430,352 @@ func init() {
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 4511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3c, 0x5d, 0x6f, 0x1c, 0x47,
-	0x72, 0x9c, 0x5d, 0x92, 0xcb, 0xad, 0x5d, 0x2e, 0x97, 0x2d, 0x4a, 0x5a, 0xad, 0x25, 0x8a, 0x1e,
-	0x59, 0xb6, 0x2c, 0x5b, 0x5c, 0x89, 0x94, 0xec, 0x44, 0x81, 0x9d, 0x5b, 0x91, 0x6b, 0x89, 0x11,
-	0x45, 0xd2, 0xc3, 0x95, 0x7c, 0x56, 0x80, 0x63, 0x86, 0xbb, 0xad, 0xe5, 0x1c, 0x77, 0x67, 0xf6,
-	0x66, 0x86, 0x2b, 0xd2, 0x79, 0x38, 0xe7, 0x92, 0xcb, 0xe1, 0x12, 0xe0, 0x80, 0x38, 0x40, 0x70,
-	0x08, 0x92, 0x97, 0x20, 0x40, 0xf2, 0x90, 0x04, 0xc9, 0x43, 0x1e, 0x82, 0x04, 0xc8, 0x43, 0xf2,
-	0x90, 0x3c, 0x04, 0x08, 0x90, 0x87, 0xbc, 0x26, 0xce, 0x3d, 0xe5, 0x57, 0x1c, 0xfa, 0x6b, 0xba,
-	0x67, 0xa6, 0x87, 0x94, 0x8f, 0x34, 0xfc, 0x62, 0xed, 0x74, 0x55, 0x57, 0x55, 0x57, 0x75, 0x55,
-	0x75, 0x57, 0xb5, 0x09, 0x45, 0x7f, 0xd8, 0x59, 0x1c, 0xfa, 0x5e, 0xe8, 0xa1, 0x32, 0x0e, 0x3b,
-	0xdd, 0x00, 0xfb, 0x23, 0xec, 0x0f, 0x77, 0xeb, 0x73, 0x3d, 0xaf, 0xe7, 0x51, 0x40, 0x83, 0xfc,
-	0x62, 0x38, 0xf5, 0x1a, 0xc1, 0x69, 0xd8, 0x43, 0xa7, 0x31, 0x18, 0x75, 0x3a, 0xc3, 0xdd, 0xc6,
-	0xfe, 0x88, 0x43, 0xea, 0x11, 0xc4, 0x3e, 0x08, 0xf7, 0x86, 0xbb, 0xf4, 0x1f, 0x0e, 0x5b, 0x88,
-	0x60, 0x23, 0xec, 0x07, 0x8e, 0xe7, 0x0e, 0x77, 0xc5, 0x2f, 0x8e, 0x71, 0xb9, 0xe7, 0x79, 0xbd,
-	0x3e, 0x66, 0xf3, 0x5d, 0xd7, 0x0b, 0xed, 0xd0, 0xf1, 0xdc, 0x80, 0x43, 0xd9, 0x3f, 0x9d, 0x5b,
-	0x3d, 0xec, 0xde, 0xf2, 0x86, 0xd8, 0xb5, 0x87, 0xce, 0x68, 0xa9, 0xe1, 0x0d, 0x29, 0x4e, 0x1a,
-	0xdf, 0xfc, 0x89, 0x01, 0x15, 0x0b, 0x07, 0x43, 0xcf, 0x0d, 0xf0, 0x23, 0x6c, 0x77, 0xb1, 0x8f,
-	0xae, 0x00, 0x74, 0xfa, 0x07, 0x41, 0x88, 0xfd, 0x1d, 0xa7, 0x5b, 0x33, 0x16, 0x8c, 0x1b, 0xe3,
-	0x56, 0x91, 0x8f, 0xac, 0x75, 0xd1, 0x6b, 0x50, 0x1c, 0xe0, 0xc1, 0x2e, 0x83, 0xe6, 0x28, 0x74,
-	0x8a, 0x0d, 0xac, 0x75, 0x51, 0x1d, 0xa6, 0x7c, 0x3c, 0x72, 0x88, 0xb8, 0xb5, 0xfc, 0x82, 0x71,
-	0x23, 0x6f, 0x45, 0xdf, 0x64, 0xa2, 0x6f, 0xbf, 0x08, 0x77, 0x42, 0xec, 0x0f, 0x6a, 0xe3, 0x6c,
-	0x22, 0x19, 0x68, 0x63, 0x7f, 0x70, 0xbf, 0xf0, 0x83, 0xbf, 0xaf, 0xe5, 0x97, 0x17, 0x6f, 0x9b,
-	0xff, 0x32, 0x01, 0x65, 0xcb, 0x76, 0x7b, 0xd8, 0xc2, 0xdf, 0x3b, 0xc0, 0x41, 0x88, 0xaa, 0x90,
-	0xdf, 0xc7, 0x47, 0x54, 0x8e, 0xb2, 0x45, 0x7e, 0x32, 0x42, 0x6e, 0x0f, 0xef, 0x60, 0x97, 0x49,
-	0x50, 0x26, 0x84, 0xdc, 0x1e, 0x6e, 0xb9, 0x5d, 0x34, 0x07, 0x13, 0x7d, 0x67, 0xe0, 0x84, 0x9c,
-	0x3d, 0xfb, 0x88, 0xc9, 0x35, 0x9e, 0x90, 0x6b, 0x05, 0x20, 0xf0, 0xfc, 0x70, 0xc7, 0xf3, 0xbb,
-	0xd8, 0xaf, 0x4d, 0x2c, 0x18, 0x37, 0x2a, 0x4b, 0x6f, 0x2c, 0xaa, 0x16, 0x5e, 0x54, 0x05, 0x5a,
-	0xdc, 0xf6, 0xfc, 0x70, 0x93, 0xe0, 0x5a, 0xc5, 0x40, 0xfc, 0x44, 0x1f, 0x41, 0x89, 0x12, 0x09,
-	0x6d, 0xbf, 0x87, 0xc3, 0xda, 0x24, 0xa5, 0x72, 0xfd, 0x04, 0x2a, 0x6d, 0x8a, 0x6c, 0x51, 0xf6,
-	0xec, 0x37, 0x32, 0xa1, 0x1c, 0x60, 0xdf, 0xb1, 0xfb, 0xce, 0x67, 0xf6, 0x6e, 0x1f, 0xd7, 0x0a,
-	0x0b, 0xc6, 0x8d, 0x29, 0x2b, 0x36, 0x46, 0xd6, 0xbf, 0x8f, 0x8f, 0x82, 0x1d, 0xcf, 0xed, 0x1f,
-	0xd5, 0xa6, 0x28, 0xc2, 0x14, 0x19, 0xd8, 0x74, 0xfb, 0x47, 0xd4, 0x7a, 0xde, 0x81, 0x1b, 0x32,
-	0x68, 0x91, 0x42, 0x8b, 0x74, 0x84, 0x82, 0xef, 0x40, 0x75, 0xe0, 0xb8, 0x3b, 0x03, 0xaf, 0xbb,
-	0x13, 0x29, 0x04, 0x88, 0x42, 0x1e, 0x14, 0x7e, 0x8f, 0x5a, 0xe0, 0x8e, 0x55, 0x19, 0x38, 0xee,
-	0x13, 0xaf, 0x6b, 0x09, 0xfd, 0x90, 0x29, 0xf6, 0x61, 0x7c, 0x4a, 0x29, 0x39, 0xc5, 0x3e, 0x54,
-	0xa7, 0xbc, 0x0f, 0xe7, 0x08, 0x97, 0x8e, 0x8f, 0xed, 0x10, 0xcb, 0x59, 0xe5, 0xf8, 0xac, 0xd9,
-	0x81, 0xe3, 0xae, 0x50, 0x94, 0xd8, 0x44, 0xfb, 0x30, 0x35, 0x71, 0x3a, 0x39, 0xd1, 0x3e, 0x8c,
-	0x4f, 0x34, 0xdf, 0x87, 0x62, 0x64, 0x17, 0x34, 0x05, 0xe3, 0x1b, 0x9b, 0x1b, 0xad, 0xea, 0x18,
-	0x02, 0x98, 0x6c, 0x6e, 0xaf, 0xb4, 0x36, 0x56, 0xab, 0x06, 0x2a, 0x41, 0x61, 0xb5, 0xc5, 0x3e,
-	0x72, 0xf5, 0xc2, 0x17, 0x7c, 0xbf, 0x3d, 0x06, 0x90, 0xa6, 0x40, 0x05, 0xc8, 0x3f, 0x6e, 0x7d,
-	0x5a, 0x1d, 0x23, 0xc8, 0xcf, 0x5a, 0xd6, 0xf6, 0xda, 0xe6, 0x46, 0xd5, 0x20, 0x54, 0x56, 0xac,
-	0x56, 0xb3, 0xdd, 0xaa, 0xe6, 0x08, 0xc6, 0x93, 0xcd, 0xd5, 0x6a, 0x1e, 0x15, 0x61, 0xe2, 0x59,
-	0x73, 0xfd, 0x69, 0xab, 0x3a, 0x1e, 0x11, 0x93, 0xbb, 0xf8, 0x4f, 0x0c, 0x98, 0xe6, 0xe6, 0x66,
-	0xbe, 0x85, 0xee, 0xc2, 0xe4, 0x1e, 0xf5, 0x2f, 0xba, 0x93, 0x4b, 0x4b, 0x97, 0x13, 0x7b, 0x23,
-	0xe6, 0x83, 0x16, 0xc7, 0x45, 0x26, 0xe4, 0xf7, 0x47, 0x41, 0x2d, 0xb7, 0x90, 0xbf, 0x51, 0x5a,
-	0xaa, 0x2e, 0xb2, 0x48, 0xb2, 0xf8, 0x18, 0x1f, 0x3d, 0xb3, 0xfb, 0x07, 0xd8, 0x22, 0x40, 0x84,
-	0x60, 0x7c, 0xe0, 0xf9, 0x98, 0x6e, 0xf8, 0x29, 0x8b, 0xfe, 0x26, 0x5e, 0x40, 0x6d, 0xce, 0x37,
-	0x3b, 0xfb, 0x90, 0xe2, 0xfd, 0x87, 0x01, 0xb0, 0x75, 0x10, 0x66, 0xbb, 0xd8, 0x1c, 0x4c, 0x8c,
-	0x08, 0x07, 0xee, 0x5e, 0xec, 0x83, 0xfa, 0x16, 0xb6, 0x03, 0x1c, 0xf9, 0x16, 0xf9, 0x40, 0x0b,
-	0x50, 0x18, 0xfa, 0x78, 0xb4, 0xb3, 0x3f, 0xa2, 0xdc, 0xa6, 0xa4, 0x9d, 0x26, 0xc9, 0xf8, 0xe3,
-	0x11, 0xba, 0x09, 0x65, 0xa7, 0xe7, 0x7a, 0x3e, 0xde, 0x61, 0x44, 0x27, 0x54, 0xb4, 0x25, 0xab,
-	0xc4, 0x80, 0x74, 0x49, 0x0a, 0x2e, 0x63, 0x35, 0xa9, 0xc5, 0x5d, 0x27, 0x30, 0xb9, 0x9e, 0xcf,
-	0x0d, 0x28, 0xd1, 0xf5, 0x9c, 0x4a, 0xd9, 0x4b, 0x72, 0x21, 0x39, 0x3a, 0x2d, 0xa5, 0xf0, 0xd4,
-	0xd2, 0xa4, 0x08, 0x2e, 0xa0, 0x55, 0xdc, 0xc7, 0x21, 0x3e, 0x4d, 0xf0, 0x52, 0x54, 0x99, 0xd7,
-	0xaa, 0x52, 0xf2, 0xfb, 0x73, 0x03, 0xce, 0xc5, 0x18, 0x9e, 0x6a, 0xe9, 0x35, 0x28, 0x74, 0x29,
-	0x31, 0x26, 0x53, 0xde, 0x12, 0x9f, 0xe8, 0x2e, 0x4c, 0x71, 0x91, 0x82, 0x5a, 0x5e, 0xbf, 0x0d,
-	0xa5, 0x94, 0x05, 0x26, 0x65, 0x20, 0xc5, 0xfc, 0xc7, 0x1c, 0x14, 0xb9, 0x32, 0x36, 0x87, 0xa8,
-	0x09, 0xd3, 0x3e, 0xfb, 0xd8, 0xa1, 0x6b, 0xe6, 0x32, 0xd6, 0xb3, 0xe3, 0xe4, 0xa3, 0x31, 0xab,
-	0xcc, 0xa7, 0xd0, 0x61, 0xf4, 0x2b, 0x50, 0x12, 0x24, 0x86, 0x07, 0x21, 0x37, 0x54, 0x2d, 0x4e,
-	0x40, 0x6e, 0xed, 0x47, 0x63, 0x16, 0x70, 0xf4, 0xad, 0x83, 0x10, 0xb5, 0x61, 0x4e, 0x4c, 0x66,
-	0xeb, 0xe3, 0x62, 0xe4, 0x29, 0x95, 0x85, 0x38, 0x95, 0xb4, 0x39, 0x1f, 0x8d, 0x59, 0x88, 0xcf,
-	0x57, 0x80, 0x68, 0x55, 0x8a, 0x14, 0x1e, 0xb2, 0xfc, 0x92, 0x12, 0xa9, 0x7d, 0xe8, 0x72, 0x22,
-	0x42, 0x5b, 0xcb, 0x8a, 0x6c, 0xed, 0x43, 0x37, 0x52, 0xd9, 0x83, 0x22, 0x14, 0xf8, 0xb0, 0xf9,
-	0xef, 0x39, 0x00, 0x61, 0xb1, 0xcd, 0x21, 0x5a, 0x85, 0x8a, 0xcf, 0xbf, 0x62, 0xfa, 0x7b, 0x4d,
-	0xab, 0x3f, 0x6e, 0xe8, 0x31, 0x6b, 0x5a, 0x4c, 0x62, 0xe2, 0x7e, 0x08, 0xe5, 0x88, 0x8a, 0x54,
-	0xe1, 0x25, 0x8d, 0x0a, 0x23, 0x0a, 0x25, 0x31, 0x81, 0x28, 0xf1, 0x13, 0x38, 0x1f, 0xcd, 0xd7,
-	0x68, 0xf1, 0xf5, 0x63, 0xb4, 0x18, 0x11, 0x3c, 0x27, 0x28, 0xa8, 0x7a, 0x7c, 0xa8, 0x08, 0x26,
-	0x15, 0x79, 0x49, 0xa3, 0x48, 0x86, 0xa4, 0x6a, 0x32, 0x92, 0x30, 0xa6, 0x4a, 0x20, 0x69, 0x9f,
-	0x8d, 0x9b, 0x7f, 0x39, 0x0e, 0x85, 0x15, 0x6f, 0x30, 0xb4, 0x7d, 0xb2, 0x89, 0x26, 0x7d, 0x1c,
-	0x1c, 0xf4, 0x43, 0xaa, 0xc0, 0xca, 0xd2, 0xb5, 0x38, 0x0f, 0x8e, 0x26, 0xfe, 0xb5, 0x28, 0xaa,
-	0xc5, 0xa7, 0x90, 0xc9, 0x3c, 0xcb, 0xe7, 0x5e, 0x61, 0x32, 0xcf, 0xf1, 0x7c, 0x8a, 0x08, 0x08,
-	0x79, 0x19, 0x10, 0xea, 0x50, 0xe0, 0x07, 0x3c, 0x16, 0xac, 0x1f, 0x8d, 0x59, 0x62, 0x00, 0xbd,
-	0x0d, 0x33, 0xc9, 0x54, 0x38, 0xc1, 0x71, 0x2a, 0x9d, 0x78, 0xe6, 0xbc, 0x06, 0xe5, 0x58, 0x86,
-	0x9e, 0xe4, 0x78, 0xa5, 0x81, 0x92, 0x97, 0x2f, 0x88, 0xb0, 0x4e, 0x8e, 0x15, 0xe5, 0x47, 0x63,
-	0x22, 0xb0, 0x5f, 0x15, 0x81, 0x7d, 0x4a, 0x4d, 0xb4, 0x44, 0xaf, 0x3c, 0xc6, 0xbf, 0xa1, 0x46,
-	0xad, 0x6f, 0x91, 0xc9, 0x11, 0x92, 0x0c, 0x5f, 0xa6, 0x05, 0xd3, 0x31, 0x95, 0x91, 0x1c, 0xd9,
-	0xfa, 0xf8, 0x69, 0x73, 0x9d, 0x25, 0xd4, 0x87, 0x34, 0x87, 0x5a, 0x55, 0x83, 0x24, 0xe8, 0xf5,
-	0xd6, 0xf6, 0x76, 0x35, 0x87, 0x2e, 0x40, 0x71, 0x63, 0xb3, 0xbd, 0xc3, 0xb0, 0xf2, 0xf5, 0xc2,
-	0x1f, 0xb3, 0x48, 0x22, 0xf3, 0xf3, 0xa7, 0x11, 0x4d, 0x9e, 0xa2, 0x95, 0xcc, 0x3c, 0xa6, 0x64,
-	0x66, 0x43, 0x64, 0xe6, 0x9c, 0xcc, 0xcc, 0x79, 0x84, 0x60, 0x62, 0xbd, 0xd5, 0xdc, 0xa6, 0x49,
-	0x9a, 0x91, 0x5e, 0x4e, 0x67, 0xeb, 0x07, 0x15, 0x28, 0x33, 0xf3, 0xec, 0x1c, 0xb8, 0xe4, 0x30,
-	0xf1, 0x57, 0x06, 0x80, 0x74, 0x58, 0xd4, 0x80, 0x42, 0x87, 0x89, 0x50, 0x33, 0x68, 0x04, 0x3c,
-	0xaf, 0xb5, 0xb8, 0x25, 0xb0, 0xd0, 0x1d, 0x28, 0x04, 0x07, 0x9d, 0x0e, 0x0e, 0x44, 0xe6, 0xbe,
-	0x98, 0x0c, 0xc2, 0x3c, 0x20, 0x5a, 0x02, 0x8f, 0x4c, 0x79, 0x61, 0x3b, 0xfd, 0x03, 0x9a, 0xc7,
-	0x8f, 0x9f, 0xc2, 0xf1, 0x64, 0x8c, 0xfd, 0x33, 0x03, 0x4a, 0x8a, 0x5b, 0xfc, 0x82, 0x29, 0xe0,
-	0x32, 0x14, 0xa9, 0x30, 0xb8, 0xcb, 0x93, 0xc0, 0x94, 0x25, 0x07, 0xd0, 0x7b, 0x50, 0x14, 0x9e,
-	0x24, 0xf2, 0x40, 0x4d, 0x4f, 0x76, 0x73, 0x68, 0x49, 0x54, 0x29, 0x64, 0x1b, 0x66, 0xa9, 0x9e,
-	0x3a, 0xe4, 0xf6, 0x21, 0x34, 0xab, 0x1e, 0xcb, 0x8d, 0xc4, 0xb1, 0xbc, 0x0e, 0x53, 0xc3, 0xbd,
-	0xa3, 0xc0, 0xe9, 0xd8, 0x7d, 0x2e, 0x4e, 0xf4, 0x2d, 0xa9, 0x6e, 0x03, 0x52, 0xa9, 0x9e, 0x46,
-	0x01, 0x92, 0xe8, 0x05, 0x28, 0x3d, 0xb2, 0x83, 0x3d, 0x2e, 0xa4, 0x1c, 0xbf, 0x0b, 0xd3, 0x64,
-	0xfc, 0xf1, 0xb3, 0x57, 0x10, 0x5f, 0xcc, 0x5a, 0x36, 0xff, 0xc9, 0x80, 0x8a, 0x98, 0x76, 0x2a,
-	0x03, 0x21, 0x18, 0xdf, 0xb3, 0x83, 0x3d, 0xaa, 0x8c, 0x69, 0x8b, 0xfe, 0x46, 0x6f, 0x43, 0xb5,
-	0xc3, 0xd6, 0xbf, 0x93, 0xb8, 0x77, 0xcd, 0xf0, 0xf1, 0xc8, 0xf7, 0xdf, 0x85, 0x69, 0x32, 0x65,
-	0x27, 0x7e, 0x0f, 0x12, 0x6e, 0xfc, 0x9e, 0x55, 0xde, 0xa3, 0x6b, 0x4e, 0x8a, 0x6f, 0x43, 0x99,
-	0x29, 0xe3, 0xac, 0x65, 0x97, 0x7a, 0xad, 0xc3, 0xcc, 0xb6, 0x6b, 0x0f, 0x83, 0x3d, 0x2f, 0x4c,
-	0xe8, 0x7c, 0xd9, 0xfc, 0x3b, 0x03, 0xaa, 0x12, 0x78, 0x2a, 0x19, 0xde, 0x82, 0x19, 0x1f, 0x0f,
-	0x6c, 0xc7, 0x75, 0xdc, 0xde, 0xce, 0xee, 0x51, 0x88, 0x03, 0x7e, 0x7d, 0xad, 0x44, 0xc3, 0x0f,
-	0xc8, 0x28, 0x11, 0x76, 0xb7, 0xef, 0xed, 0xf2, 0x20, 0x4d, 0x7f, 0xa3, 0xd7, 0xe3, 0x51, 0xba,
-	0x28, 0xf5, 0x26, 0xc6, 0xa5, 0xcc, 0x3f, 0xcd, 0x41, 0xf9, 0x13, 0x3b, 0xec, 0x88, 0x1d, 0x84,
-	0xd6, 0xa0, 0x12, 0x85, 0x71, 0x3a, 0xc2, 0xe5, 0x4e, 0x1c, 0x38, 0xe8, 0x1c, 0x71, 0xaf, 0x11,
-	0x07, 0x8e, 0xe9, 0x8e, 0x3a, 0x40, 0x49, 0xd9, 0x6e, 0x07, 0xf7, 0x23, 0x52, 0xb9, 0x6c, 0x52,
-	0x14, 0x51, 0x25, 0xa5, 0x0e, 0xa0, 0x6f, 0x43, 0x75, 0xe8, 0x7b, 0x3d, 0x1f, 0x07, 0x41, 0x44,
-	0x8c, 0xa5, 0x70, 0x53, 0x43, 0x6c, 0x8b, 0xa3, 0x26, 0x4e, 0x31, 0x77, 0x1f, 0x8d, 0x59, 0x33,
-	0xc3, 0x38, 0x4c, 0x06, 0xd6, 0x19, 0x79, 0xde, 0x63, 0x91, 0xf5, 0x47, 0x79, 0x40, 0xe9, 0x65,
-	0x7e, 0xd5, 0x63, 0xf2, 0x75, 0xa8, 0x04, 0xa1, 0xed, 0xa7, 0xf6, 0xfc, 0x34, 0x1d, 0x8d, 0x76,
-	0xfc, 0x5b, 0x10, 0x49, 0xb6, 0xe3, 0x7a, 0xa1, 0xf3, 0xe2, 0x88, 0x5d, 0x50, 0xac, 0x8a, 0x18,
-	0xde, 0xa0, 0xa3, 0x68, 0x03, 0x0a, 0x2f, 0x9c, 0x7e, 0x88, 0xfd, 0xa0, 0x36, 0xb1, 0x90, 0xbf,
-	0x51, 0x59, 0x7a, 0xe7, 0x24, 0xc3, 0x2c, 0x7e, 0x44, 0xf1, 0xdb, 0x47, 0x43, 0xf5, 0xf4, 0xcb,
-	0x89, 0xa8, 0xc7, 0xf8, 0x49, 0xfd, 0x8d, 0xc8, 0x84, 0xa9, 0x97, 0x84, 0xe8, 0x8e, 0xd3, 0xa5,
-	0xb9, 0x38, 0xf2, 0xc3, 0xbb, 0x56, 0x81, 0x02, 0xd6, 0xba, 0xe8, 0x1a, 0x4c, 0xbd, 0xf0, 0xed,
-	0xde, 0x00, 0xbb, 0x21, 0xbb, 0xe5, 0x4b, 0x9c, 0x08, 0x60, 0x2e, 0x02, 0x48, 0x51, 0x48, 0xe6,
-	0xdb, 0xd8, 0xdc, 0x7a, 0xda, 0xae, 0x8e, 0xa1, 0x32, 0x4c, 0x6d, 0x6c, 0xae, 0xb6, 0xd6, 0x5b,
-	0x24, 0x37, 0x8a, 0x9c, 0x77, 0x47, 0x3a, 0x5d, 0x53, 0x18, 0x22, 0xb6, 0x27, 0x54, 0xb9, 0x8c,
-	0xf8, 0xa5, 0x5b, 0xc8, 0x25, 0x48, 0xdc, 0x31, 0xaf, 0xc2, 0x9c, 0x6e, 0x6b, 0x08, 0x84, 0xbb,
-	0xe6, 0xbf, 0xe6, 0x60, 0x9a, 0x3b, 0xc2, 0xa9, 0x3c, 0xf7, 0x92, 0x22, 0x15, 0xbf, 0x9e, 0x08,
-	0x25, 0xd5, 0xa0, 0xc0, 0x1c, 0xa4, 0xcb, 0xef, 0xbf, 0xe2, 0x93, 0x04, 0x67, 0xb6, 0xdf, 0x71,
-	0x97, 0x9b, 0x3d, 0xfa, 0xd6, 0x86, 0xcd, 0x89, 0xcc, 0xb0, 0x19, 0x39, 0x9c, 0x1d, 0xf0, 0x83,
-	0x55, 0x51, 0x9a, 0xa2, 0x2c, 0x9c, 0x8a, 0x00, 0x63, 0x36, 0x2b, 0x64, 0xd8, 0x0c, 0x5d, 0x87,
-	0x49, 0x3c, 0xc2, 0x6e, 0x18, 0xd4, 0x4a, 0x34, 0x91, 0x4e, 0x8b, 0x0b, 0x55, 0x8b, 0x8c, 0x5a,
-	0x1c, 0x28, 0x4d, 0xf5, 0x21, 0xcc, 0xd2, 0xfb, 0xee, 0x43, 0xdf, 0x76, 0xd5, 0x3b, 0x7b, 0xbb,
-	0xbd, 0xce, 0xd3, 0x0e, 0xf9, 0x89, 0x2a, 0x90, 0x5b, 0x5b, 0xe5, 0xfa, 0xc9, 0xad, 0xad, 0xca,
-	0xf9, 0xbf, 0x6f, 0x00, 0x52, 0x09, 0x9c, 0xca, 0x16, 0x09, 0x2e, 0x42, 0x8e, 0xbc, 0x94, 0x63,
-	0x0e, 0x26, 0xb0, 0xef, 0x7b, 0x3e, 0x0b, 0x94, 0x16, 0xfb, 0x90, 0xd2, 0xdc, 0xe2, 0xc2, 0x58,
-	0x78, 0xe4, 0xed, 0x47, 0x11, 0x80, 0x91, 0x35, 0xd2, 0xc2, 0xb7, 0xe1, 0x5c, 0x0c, 0xfd, 0x6c,
-	0x52, 0xfc, 0x26, 0xcc, 0x50, 0xaa, 0x2b, 0x7b, 0xb8, 0xb3, 0x3f, 0xf4, 0x1c, 0x37, 0x25, 0x01,
-	0xba, 0x46, 0x62, 0x97, 0x48, 0x17, 0x64, 0x89, 0x6c, 0xcd, 0xe5, 0x68, 0xb0, 0xdd, 0x5e, 0x97,
-	0x5b, 0x7d, 0x17, 0x2e, 0x24, 0x08, 0x8a, 0x95, 0xfd, 0x2a, 0x94, 0x3a, 0xd1, 0x60, 0xc0, 0x4f,
-	0x90, 0x57, 0xe2, 0xe2, 0x26, 0xa7, 0xaa, 0x33, 0x24, 0x8f, 0x6f, 0xc3, 0xc5, 0x14, 0x8f, 0xb3,
-	0x50, 0xc7, 0x5d, 0xf3, 0x36, 0x9c, 0xa7, 0x94, 0x1f, 0x63, 0x3c, 0x6c, 0xf6, 0x9d, 0xd1, 0xc9,
-	0x66, 0x39, 0xe2, 0xeb, 0x55, 0x66, 0x7c, 0xbd, 0xdb, 0x4a, 0xb2, 0x6e, 0x71, 0xd6, 0x6d, 0x67,
-	0x80, 0xdb, 0xde, 0x7a, 0xb6, 0xb4, 0x24, 0x91, 0xef, 0xe3, 0xa3, 0x80, 0x1f, 0x1f, 0xe9, 0x6f,
-	0x19, 0xbd, 0xfe, 0xc6, 0xe0, 0xea, 0x54, 0xe9, 0x7c, 0xcd, 0xae, 0x31, 0x0f, 0xd0, 0x23, 0x3e,
-	0x88, 0xbb, 0x04, 0xc0, 0x6a, 0x73, 0xca, 0x48, 0x24, 0x30, 0xc9, 0x42, 0xe5, 0xa4, 0xc0, 0x57,
-	0xb8, 0xe3, 0xd0, 0xff, 0x04, 0xa9, 0x93, 0xd2, 0x9b, 0x50, 0xa2, 0x90, 0xed, 0xd0, 0x0e, 0x0f,
-	0x82, 0x2c, 0xcb, 0x2d, 0x9b, 0x3f, 0x32, 0xb8, 0x47, 0x09, 0x3a, 0xa7, 0x5a, 0xf3, 0x1d, 0x98,
-	0xa4, 0x37, 0x44, 0x71, 0xd3, 0xb9, 0xa4, 0xd9, 0xd8, 0x4c, 0x22, 0x8b, 0x23, 0x2a, 0xe7, 0x24,
-	0x03, 0x26, 0x9f, 0xd0, 0xce, 0x81, 0x22, 0xed, 0xb8, 0xb0, 0x9c, 0x6b, 0x0f, 0x58, 0xf9, 0xb1,
-	0x68, 0xd1, 0xdf, 0xf4, 0x42, 0x80, 0xb1, 0xff, 0xd4, 0x5a, 0x67, 0x37, 0x90, 0xa2, 0x15, 0x7d,
-	0x13, 0xc5, 0x76, 0xfa, 0x0e, 0x76, 0x43, 0x0a, 0x1d, 0xa7, 0x50, 0x65, 0x04, 0x5d, 0x87, 0xa2,
-	0x13, 0xac, 0x63, 0xdb, 0x77, 0x79, 0x89, 0x5f, 0x09, 0xcc, 0x12, 0x22, 0xf7, 0xd8, 0x77, 0xa0,
-	0xca, 0x24, 0x6b, 0x76, 0xbb, 0xca, 0x69, 0x3f, 0xe2, 0x6f, 0x24, 0xf8, 0xc7, 0xe8, 0xe7, 0x4e,
-	0xa6, 0xff, 0xb7, 0x06, 0xcc, 0x2a, 0x0c, 0x4e, 0x65, 0x82, 0x77, 0x61, 0x92, 0xf5, 0x5f, 0xf8,
-	0x51, 0x70, 0x2e, 0x3e, 0x8b, 0xb1, 0xb1, 0x38, 0x0e, 0x5a, 0x84, 0x02, 0xfb, 0x25, 0xae, 0x71,
-	0x7a, 0x74, 0x81, 0x24, 0x45, 0x5e, 0x84, 0x73, 0x1c, 0x86, 0x07, 0x9e, 0xce, 0xe7, 0xc6, 0xe3,
-	0x11, 0xe2, 0x87, 0x06, 0xcc, 0xc5, 0x27, 0x9c, 0x6a, 0x95, 0x8a, 0xdc, 0xb9, 0xaf, 0x24, 0xf7,
-	0xaf, 0x09, 0xb9, 0x9f, 0x0e, 0xbb, 0xca, 0x91, 0x33, 0xb9, 0xe3, 0x54, 0xeb, 0xe6, 0xe2, 0xd6,
-	0x95, 0xb4, 0x7e, 0x12, 0xad, 0x49, 0x10, 0x3b, 0xd5, 0x9a, 0xde, 0x7f, 0xa5, 0x35, 0x29, 0x47,
-	0xb0, 0xd4, 0xe2, 0xd6, 0xc4, 0x36, 0x5a, 0x77, 0x82, 0x28, 0xe3, 0xbc, 0x03, 0xe5, 0xbe, 0xe3,
-	0x62, 0xdb, 0xe7, 0x3d, 0x24, 0x43, 0xdd, 0x8f, 0xf7, 0xac, 0x18, 0x50, 0x92, 0xfa, 0x6d, 0x03,
-	0x90, 0x4a, 0xeb, 0x9b, 0xb1, 0x56, 0x43, 0x28, 0x78, 0xcb, 0xf7, 0x06, 0x5e, 0x78, 0xd2, 0x36,
-	0xbb, 0x6b, 0xfe, 0xae, 0x01, 0xe7, 0x13, 0x33, 0xbe, 0x09, 0xc9, 0xef, 0x9a, 0x97, 0x61, 0x76,
-	0x15, 0x8b, 0x33, 0x5e, 0xaa, 0x76, 0xb0, 0x0d, 0x48, 0x85, 0x9e, 0xcd, 0x29, 0xe6, 0x97, 0x60,
-	0xf6, 0x89, 0x37, 0x22, 0x81, 0x9c, 0x80, 0x65, 0x98, 0x62, 0xc5, 0xac, 0x48, 0x5f, 0xd1, 0xb7,
-	0x0c, 0xbd, 0xdb, 0x80, 0xd4, 0x99, 0x67, 0x21, 0xce, 0xb2, 0xf9, 0xbf, 0x06, 0x94, 0x9b, 0x7d,
-	0xdb, 0x1f, 0x08, 0x51, 0x3e, 0x84, 0x49, 0x56, 0x99, 0xe1, 0x65, 0xd6, 0x37, 0xe3, 0xf4, 0x54,
-	0x5c, 0xf6, 0xd1, 0x64, 0x75, 0x1c, 0x3e, 0x8b, 0x2c, 0x85, 0x77, 0x96, 0x57, 0x13, 0x9d, 0xe6,
-	0x55, 0x74, 0x0b, 0x26, 0x6c, 0x32, 0x85, 0xa6, 0xd7, 0x4a, 0xb2, 0x5c, 0x46, 0xa9, 0x91, 0x2b,
-	0x91, 0xc5, 0xb0, 0xcc, 0x0f, 0xa0, 0xa4, 0x70, 0x40, 0x05, 0xc8, 0x3f, 0x6c, 0xf1, 0x6b, 0x52,
-	0x73, 0xa5, 0xbd, 0xf6, 0x8c, 0x95, 0x10, 0x2b, 0x00, 0xab, 0xad, 0xe8, 0x3b, 0xa7, 0x69, 0xec,
-	0xd9, 0x9c, 0x0e, 0xcf, 0x5b, 0xaa, 0x84, 0x46, 0x96, 0x84, 0xb9, 0x57, 0x91, 0x50, 0xb2, 0xf8,
-	0x2d, 0x03, 0xa6, 0xb9, 0x6a, 0x4e, 0x9b, 0x9a, 0x29, 0xe5, 0x8c, 0xd4, 0xac, 0x2c, 0xc3, 0xe2,
-	0x88, 0x52, 0x86, 0x7f, 0x36, 0xa0, 0xba, 0xea, 0xbd, 0x74, 0x7b, 0xbe, 0xdd, 0x8d, 0x7c, 0xf0,
-	0xa3, 0x84, 0x39, 0x17, 0x13, 0x95, 0xfe, 0x04, 0xbe, 0x1c, 0x48, 0x98, 0xb5, 0x26, 0x6b, 0x29,
-	0x2c, 0xbf, 0x8b, 0x4f, 0xf3, 0x5b, 0x30, 0x93, 0x98, 0x44, 0x0c, 0xf4, 0xac, 0xb9, 0xbe, 0xb6,
-	0x4a, 0x0c, 0x42, 0xeb, 0xbd, 0xad, 0x8d, 0xe6, 0x83, 0xf5, 0x16, 0xef, 0xca, 0x36, 0x37, 0x56,
-	0x5a, 0xeb, 0xd2, 0x50, 0xf7, 0xc4, 0x0a, 0xee, 0x99, 0x7d, 0x98, 0x55, 0x04, 0x3a, 0x6d, 0x73,
-	0x4c, 0x2f, 0xaf, 0xe4, 0x56, 0x83, 0x69, 0x7e, 0xca, 0x49, 0x3a, 0xfe, 0x7f, 0xe7, 0xa1, 0x22,
-	0x40, 0x5f, 0x8f, 0x14, 0xe8, 0x02, 0x4c, 0x76, 0x77, 0xb7, 0x9d, 0xcf, 0x44, 0x5f, 0x96, 0x7f,
-	0x91, 0xf1, 0x3e, 0xe3, 0xc3, 0x5e, 0x5b, 0xf0, 0x2f, 0x74, 0x99, 0x3d, 0xc4, 0x58, 0x73, 0xbb,
-	0xf8, 0x90, 0x1e, 0x86, 0xc6, 0x2d, 0x39, 0x40, 0x8b, 0x9a, 0xfc, 0x55, 0x06, 0xbd, 0xeb, 0x2a,
-	0xaf, 0x34, 0xd0, 0x32, 0x54, 0xc9, 0xef, 0xe6, 0x70, 0xd8, 0x77, 0x70, 0x97, 0x11, 0x20, 0xd7,
-	0xdc, 0x71, 0x79, 0xda, 0x49, 0x21, 0xa0, 0xab, 0x30, 0x49, 0xaf, 0x80, 0x41, 0x6d, 0x8a, 0xe4,
-	0x55, 0x89, 0xca, 0x87, 0xd1, 0xdb, 0x50, 0x62, 0x12, 0xaf, 0xb9, 0x4f, 0x03, 0x4c, 0xdf, 0x2c,
-	0x28, 0xf5, 0x10, 0x15, 0x16, 0x3f, 0x67, 0x41, 0xd6, 0x39, 0x0b, 0x35, 0xa0, 0x12, 0x84, 0x9e,
-	0x6f, 0xf7, 0xf0, 0x33, 0xae, 0xb2, 0x52, 0xbc, 0x68, 0x97, 0x00, 0x4b, 0x11, 0x3e, 0x3e, 0xf0,
-	0x42, 0x3b, 0xfe, 0x50, 0xe1, 0x3d, 0x4b, 0x85, 0x49, 0xcb, 0x5e, 0x86, 0xd9, 0xe6, 0x41, 0xb8,
-	0xd7, 0x72, 0x49, 0x1e, 0x4d, 0xd9, 0xfd, 0x0a, 0x20, 0x02, 0x5d, 0x75, 0x02, 0x2d, 0x98, 0x4f,
-	0xd6, 0x6e, 0x9a, 0x7b, 0xe6, 0x06, 0x9c, 0x23, 0x50, 0xec, 0x86, 0x4e, 0x47, 0x39, 0xb3, 0x88,
-	0x53, 0xb1, 0x91, 0x38, 0x15, 0xdb, 0x41, 0xf0, 0xd2, 0xf3, 0xbb, 0x7c, 0x5f, 0x44, 0xdf, 0x92,
-	0xdb, 0x3f, 0x18, 0x4c, 0x9a, 0xa7, 0x41, 0xec, 0x44, 0xfb, 0x15, 0xe9, 0xa1, 0x5f, 0x86, 0x02,
-	0x7f, 0x49, 0xc4, 0x0b, 0x85, 0x17, 0x16, 0xd9, 0x0b, 0xa6, 0x45, 0x4e, 0x78, 0x93, 0x41, 0x95,
-	0x62, 0x16, 0xc7, 0x27, 0x16, 0xd9, 0xb3, 0x83, 0x3d, 0xdc, 0xdd, 0x12, 0xc4, 0x63, 0x65, 0xd4,
-	0x7b, 0x56, 0x02, 0x2c, 0x65, 0xbf, 0x23, 0x45, 0x7f, 0x88, 0xc3, 0x63, 0x44, 0x57, 0x0b, 0xf5,
-	0xe7, 0xc5, 0x14, 0xde, 0x5f, 0x7c, 0x95, 0x59, 0x3f, 0x36, 0xe0, 0x8a, 0x98, 0xb6, 0xb2, 0x67,
-	0xbb, 0x3d, 0x2c, 0x84, 0xf9, 0x45, 0xf5, 0x95, 0x5e, 0x74, 0xfe, 0x15, 0x17, 0xfd, 0x18, 0x6a,
-	0xd1, 0xa2, 0x69, 0xd1, 0xc6, 0xeb, 0xab, 0x8b, 0x38, 0x08, 0x78, 0xf0, 0x28, 0x5a, 0xf4, 0x37,
-	0x19, 0xf3, 0xbd, 0x7e, 0x74, 0x5f, 0x22, 0xbf, 0x25, 0xb1, 0x75, 0xb8, 0x24, 0x88, 0xf1, 0x2a,
-	0x4a, 0x9c, 0x5a, 0x6a, 0x4d, 0xc7, 0x52, 0xe3, 0xf6, 0x20, 0x34, 0x8e, 0xdf, 0x4a, 0xda, 0x29,
-	0x71, 0x13, 0x52, 0x2e, 0x86, 0x8e, 0xcb, 0x3c, 0xf3, 0x00, 0x22, 0xb3, 0x72, 0xb4, 0x4d, 0xc1,
-	0x09, 0x49, 0x2d, 0x9c, 0x6f, 0x01, 0x02, 0x4f, 0x6d, 0x81, 0x6c, 0xae, 0x18, 0xe6, 0x23, 0x41,
-	0x89, 0xda, 0xb7, 0xb0, 0x3f, 0x70, 0x82, 0x40, 0xe9, 0x58, 0xe9, 0xd4, 0xf5, 0x26, 0x8c, 0x0f,
-	0x31, 0xcf, 0xf3, 0xa5, 0x25, 0x24, 0x7c, 0x42, 0x99, 0x4c, 0xe1, 0x92, 0xcd, 0x00, 0xae, 0x0a,
-	0x36, 0xcc, 0x20, 0x5a, 0x3e, 0x49, 0x31, 0x45, 0x95, 0x3c, 0x97, 0x51, 0x25, 0xcf, 0xc7, 0xab,
-	0xe4, 0xb1, 0xb3, 0xa7, 0x1a, 0xa8, 0xce, 0xe6, 0xec, 0xd9, 0x66, 0x06, 0x88, 0xe2, 0xdb, 0xd9,
-	0x50, 0xfd, 0x03, 0x1e, 0xa8, 0xce, 0x2a, 0x63, 0x62, 0xba, 0x66, 0xd1, 0xcf, 0x14, 0x9f, 0xc8,
-	0x84, 0x32, 0x31, 0x92, 0xa5, 0xb6, 0x0f, 0xc6, 0xad, 0xd8, 0x98, 0x0c, 0xc6, 0xfb, 0x30, 0x17,
-	0x0f, 0xc6, 0xa7, 0x12, 0x6a, 0x0e, 0x26, 0x42, 0x6f, 0x1f, 0x8b, 0x24, 0xce, 0x3e, 0x52, 0x6a,
-	0x8d, 0x02, 0xf5, 0xd9, 0xa8, 0xf5, 0xbb, 0x92, 0x2a, 0x75, 0xc0, 0xd3, 0xae, 0x80, 0x6c, 0x47,
-	0x71, 0x4d, 0x66, 0x1f, 0x92, 0xd7, 0x27, 0x70, 0x21, 0x19, 0x7c, 0xcf, 0x66, 0x11, 0x3b, 0xcc,
-	0x39, 0x75, 0xe1, 0xf9, 0x6c, 0x18, 0x3c, 0x97, 0x71, 0x52, 0x09, 0xba, 0x67, 0x43, 0xfb, 0xd7,
-	0xa1, 0xae, 0x8b, 0xc1, 0x67, 0xea, 0x8b, 0x51, 0x48, 0x3e, 0x1b, 0xaa, 0x3f, 0x34, 0x24, 0x59,
-	0x75, 0xd7, 0x7c, 0xf0, 0x55, 0xc8, 0x8a, 0x5c, 0x77, 0x3b, 0xda, 0x3e, 0x8d, 0x28, 0x5a, 0xe6,
-	0xf5, 0xd1, 0x52, 0x4e, 0xa1, 0x88, 0xc2, 0xff, 0x64, 0xa8, 0xff, 0x3a, 0x77, 0x2f, 0x67, 0x26,
-	0xf3, 0xce, 0x69, 0x99, 0x91, 0xf4, 0x1c, 0x31, 0xa3, 0x1f, 0x29, 0x57, 0x51, 0x93, 0xd4, 0xd9,
-	0x98, 0xee, 0x37, 0x64, 0x82, 0x49, 0xe5, 0xb1, 0xb3, 0xe1, 0x60, 0xc3, 0x42, 0x76, 0x0a, 0x3b,
-	0x13, 0x16, 0x37, 0x9b, 0x50, 0x8c, 0x2e, 0xc9, 0xca, 0x93, 0xde, 0x12, 0x14, 0x36, 0x36, 0xb7,
-	0xb7, 0x9a, 0x2b, 0xe4, 0x0e, 0x38, 0x07, 0x85, 0x95, 0x4d, 0xcb, 0x7a, 0xba, 0xd5, 0x26, 0x97,
-	0xc0, 0xe4, 0x0b, 0x9f, 0xa5, 0x9f, 0xe5, 0x21, 0xf7, 0xf8, 0x19, 0xfa, 0x14, 0x26, 0xd8, 0x0b,
-	0xb3, 0x63, 0x1e, 0x1a, 0xd6, 0x8f, 0x7b, 0x44, 0x67, 0x5e, 0xfc, 0xc1, 0x7f, 0xfd, 0xec, 0x0f,
-	0x73, 0xb3, 0x66, 0xb9, 0x31, 0x5a, 0x6e, 0xec, 0x8f, 0x1a, 0x34, 0xc9, 0xde, 0x37, 0x6e, 0xa2,
-	0x8f, 0x21, 0xbf, 0x75, 0x10, 0xa2, 0xcc, 0x07, 0x88, 0xf5, 0xec, 0x77, 0x75, 0xe6, 0x79, 0x4a,
-	0x74, 0xc6, 0x04, 0x4e, 0x74, 0x78, 0x10, 0x12, 0x92, 0xdf, 0x83, 0x92, 0xfa, 0x2a, 0xee, 0xc4,
-	0x57, 0x89, 0xf5, 0x93, 0x5f, 0xdc, 0x99, 0x57, 0x28, 0xab, 0x8b, 0x26, 0xe2, 0xac, 0xd8, 0xbb,
-	0x3d, 0x75, 0x15, 0xed, 0x43, 0x17, 0x65, 0xbe, 0x59, 0xac, 0x67, 0x3f, 0xc2, 0x4b, 0xad, 0x22,
-	0x3c, 0x74, 0x09, 0xc9, 0xef, 0xf2, 0xd7, 0x76, 0x9d, 0x10, 0x5d, 0xd5, 0x3c, 0x97, 0x52, 0x9f,
-	0x01, 0xd5, 0x17, 0xb2, 0x11, 0x38, 0x93, 0xcb, 0x94, 0xc9, 0x05, 0x73, 0x96, 0x33, 0xe9, 0x44,
-	0x28, 0xf7, 0x8d, 0x9b, 0x4b, 0x1d, 0x98, 0xa0, 0x6d, 0x66, 0xf4, 0x5c, 0xfc, 0xa8, 0x6b, 0x1a,
-	0xf8, 0x19, 0x86, 0x8e, 0x35, 0xa8, 0xcd, 0x39, 0xca, 0xa8, 0x62, 0x16, 0x09, 0x23, 0xda, 0x64,
-	0xbe, 0x6f, 0xdc, 0xbc, 0x61, 0xdc, 0x36, 0x96, 0xfe, 0x7a, 0x02, 0x26, 0x68, 0x3b, 0x03, 0xed,
-	0x03, 0xc8, 0x76, 0x6a, 0x72, 0x75, 0xa9, 0x4e, 0x6d, 0x72, 0x75, 0xe9, 0x4e, 0xac, 0x59, 0xa7,
-	0x4c, 0xe7, 0xcc, 0x19, 0xc2, 0x94, 0x76, 0x49, 0x1a, 0xb4, 0x29, 0x44, 0xf4, 0xf8, 0x63, 0x83,
-	0xf7, 0x75, 0x98, 0x9b, 0x21, 0x1d, 0xb5, 0x58, 0x2b, 0x35, 0xb9, 0x1d, 0x34, 0xdd, 0x53, 0xf3,
-	0x1e, 0x65, 0xd8, 0x30, 0xab, 0x92, 0xa1, 0x4f, 0x31, 0xee, 0x1b, 0x37, 0x9f, 0xd7, 0xcc, 0x73,
-	0x5c, 0xcb, 0x09, 0x08, 0xfa, 0x3e, 0x54, 0xe2, 0x4d, 0x3f, 0x74, 0x4d, 0xc3, 0x2b, 0xd9, 0x44,
-	0xac, 0xbf, 0x71, 0x3c, 0x12, 0x97, 0x69, 0x9e, 0xca, 0xc4, 0x99, 0x33, 0xce, 0xfb, 0x18, 0x0f,
-	0x6d, 0x82, 0xc4, 0x6d, 0x80, 0xfe, 0xd4, 0xe0, 0x7d, 0x5b, 0xd9, 0xb3, 0x43, 0x3a, 0xea, 0xa9,
-	0xd6, 0x60, 0xfd, 0xfa, 0x09, 0x58, 0x5c, 0x88, 0x0f, 0xa8, 0x10, 0xef, 0x9b, 0x73, 0x52, 0x88,
-	0xd0, 0x19, 0xe0, 0xd0, 0xe3, 0x52, 0x3c, 0xbf, 0x6c, 0x5e, 0x8c, 0x29, 0x27, 0x06, 0x95, 0xc6,
-	0x62, 0xbd, 0x35, 0xad, 0xb1, 0x62, 0xed, 0x3b, 0xad, 0xb1, 0xe2, 0x8d, 0x39, 0x9d, 0xb1, 0x78,
-	0x27, 0x4d, 0x63, 0xac, 0x08, 0xb2, 0xf4, 0xff, 0xe3, 0x50, 0x58, 0x61, 0xff, 0xd7, 0x0e, 0xf2,
-	0xa0, 0x18, 0x75, 0x9b, 0xd0, 0xbc, 0xae, 0xa0, 0x2d, 0xaf, 0x72, 0xf5, 0xab, 0x99, 0x70, 0x2e,
-	0xd0, 0xeb, 0x54, 0xa0, 0xd7, 0xcc, 0x0b, 0x84, 0x33, 0xff, 0x1f, 0x83, 0x1a, 0xac, 0xec, 0xd9,
-	0xb0, 0xbb, 0x5d, 0xa2, 0x88, 0xdf, 0x84, 0xb2, 0xda, 0xfb, 0x41, 0xaf, 0x6b, 0x8b, 0xe8, 0x6a,
-	0x23, 0xa9, 0x6e, 0x1e, 0x87, 0xc2, 0x39, 0xbf, 0x41, 0x39, 0xcf, 0x9b, 0x97, 0x34, 0x9c, 0x7d,
-	0x8a, 0x1a, 0x63, 0xce, 0x9a, 0x34, 0x7a, 0xe6, 0xb1, 0x6e, 0x90, 0x9e, 0x79, 0xbc, 0xc7, 0x73,
-	0x2c, 0xf3, 0x03, 0x8a, 0x4a, 0x98, 0x07, 0x00, 0xb2, 0x8b, 0x82, 0xb4, 0xba, 0x54, 0x2e, 0xac,
-	0xc9, 0xe0, 0x90, 0x6e, 0xc0, 0x98, 0x26, 0x65, 0xcb, 0xf7, 0x5d, 0x82, 0x6d, 0xdf, 0x09, 0x42,
-	0xe6, 0x98, 0xd3, 0xb1, 0x1e, 0x08, 0xd2, 0xae, 0x27, 0xde, 0x52, 0xa9, 0x5f, 0x3b, 0x16, 0x87,
-	0x73, 0xbf, 0x4e, 0xb9, 0x5f, 0x35, 0xeb, 0x1a, 0xee, 0x43, 0x86, 0x4b, 0x36, 0xdb, 0xe7, 0x05,
-	0x28, 0x3d, 0xb1, 0x1d, 0x37, 0xc4, 0xae, 0xed, 0x76, 0x30, 0xda, 0x85, 0x09, 0x9a, 0xbb, 0x93,
-	0x81, 0x58, 0x2d, 0xf9, 0x27, 0x03, 0x71, 0xac, 0xe6, 0x6d, 0x2e, 0x50, 0xc6, 0x75, 0xf3, 0x3c,
-	0x61, 0x3c, 0x90, 0xa4, 0x1b, 0xac, 0x5a, 0x6e, 0xdc, 0x44, 0x2f, 0x60, 0x92, 0xf7, 0xba, 0x13,
-	0x84, 0x62, 0x45, 0xb5, 0xfa, 0x65, 0x3d, 0x50, 0xb7, 0x97, 0x55, 0x36, 0x01, 0xc5, 0x23, 0x7c,
-	0x46, 0x00, 0xb2, 0x75, 0x93, 0xb4, 0x68, 0xaa, 0xe5, 0x53, 0x5f, 0xc8, 0x46, 0xd0, 0xe9, 0x54,
-	0xe5, 0xd9, 0x8d, 0x70, 0x09, 0xdf, 0xef, 0xc0, 0xf8, 0x23, 0x3b, 0xd8, 0x43, 0x89, 0xdc, 0xab,
-	0x3c, 0x4d, 0xad, 0xd7, 0x75, 0x20, 0xce, 0xe5, 0x2a, 0xe5, 0x72, 0x89, 0x85, 0x32, 0x95, 0x0b,
-	0x7d, 0x7c, 0xc9, 0xf4, 0xc7, 0xde, 0xa5, 0x26, 0xf5, 0x17, 0x7b, 0xe4, 0x9a, 0xd4, 0x5f, 0xfc,
-	0x29, 0x6b, 0xb6, 0xfe, 0x08, 0x97, 0xfd, 0x11, 0xe1, 0x33, 0x84, 0x29, 0xf1, 0x82, 0x13, 0x25,
-	0xde, 0xbd, 0x24, 0x9e, 0x7d, 0xd6, 0xe7, 0xb3, 0xc0, 0x9c, 0xdb, 0x35, 0xca, 0xed, 0x8a, 0x59,
-	0x4b, 0x59, 0x8b, 0x63, 0xde, 0x37, 0x6e, 0xde, 0x36, 0xd0, 0xf7, 0x01, 0x64, 0x77, 0x2b, 0xe5,
-	0x83, 0xc9, 0x8e, 0x59, 0xca, 0x07, 0x53, 0x8d, 0x31, 0x73, 0x91, 0xf2, 0xbd, 0x61, 0x5e, 0x4b,
-	0xf2, 0x0d, 0x7d, 0xdb, 0x0d, 0x5e, 0x60, 0xff, 0x16, 0x2b, 0xad, 0x07, 0x7b, 0xce, 0x90, 0x2c,
-	0xd9, 0x87, 0x62, 0xd4, 0x7c, 0x48, 0xc6, 0xdb, 0x64, 0x9b, 0x24, 0x19, 0x6f, 0x53, 0x5d, 0x8b,
-	0x78, 0xe0, 0x89, 0xed, 0x17, 0x81, 0x4a, 0x5c, 0xf0, 0x2f, 0xaa, 0x30, 0x4e, 0x8e, 0xe4, 0xe4,
-	0x78, 0x22, 0xcb, 0x3d, 0xc9, 0xd5, 0xa7, 0x2a, 0xd6, 0xc9, 0xd5, 0xa7, 0x2b, 0x45, 0xf1, 0xe3,
-	0x09, 0xb9, 0xae, 0x35, 0x58, 0x1d, 0x85, 0xac, 0xd4, 0x83, 0x92, 0x52, 0x06, 0x42, 0x1a, 0x62,
-	0xf1, 0x0a, 0x78, 0x32, 0xe1, 0x69, 0x6a, 0x48, 0xe6, 0x6b, 0x94, 0xdf, 0x79, 0x96, 0xf0, 0x28,
-	0xbf, 0x2e, 0xc3, 0x20, 0x0c, 0xf9, 0xea, 0xb8, 0xe7, 0x6b, 0x56, 0x17, 0xf7, 0xfe, 0x85, 0x6c,
-	0x84, 0xcc, 0xd5, 0x49, 0xd7, 0x7f, 0x09, 0x65, 0xb5, 0xf4, 0x83, 0x34, 0xc2, 0x27, 0x6a, 0xf4,
-	0xc9, 0x4c, 0xa2, 0xab, 0x1c, 0xc5, 0x63, 0x1b, 0x65, 0x69, 0x2b, 0x68, 0x84, 0x71, 0x1f, 0x0a,
-	0xbc, 0x04, 0xa4, 0x53, 0x69, 0xbc, 0x8c, 0xaf, 0x53, 0x69, 0xa2, 0x7e, 0x14, 0x3f, 0x3f, 0x53,
-	0x8e, 0xe4, 0x2a, 0x2a, 0xb2, 0x35, 0xe7, 0xf6, 0x10, 0x87, 0x59, 0xdc, 0x64, 0xd9, 0x36, 0x8b,
-	0x9b, 0x52, 0x21, 0xc8, 0xe2, 0xd6, 0xc3, 0x21, 0x8f, 0x07, 0xe2, 0x7a, 0x8d, 0x32, 0x88, 0xa9,
-	0x19, 0xd2, 0x3c, 0x0e, 0x45, 0x77, 0xbd, 0x91, 0x0c, 0x45, 0x7a, 0x3c, 0x04, 0x90, 0xe5, 0xa8,
-	0xe4, 0x99, 0x55, 0xdb, 0x29, 0x48, 0x9e, 0x59, 0xf5, 0x15, 0xad, 0x78, 0x8c, 0x95, 0x7c, 0xd9,
-	0xed, 0x8a, 0x70, 0xfe, 0xc2, 0x00, 0x94, 0x2e, 0x58, 0xa1, 0x77, 0xf4, 0xd4, 0xb5, 0x5d, 0x87,
-	0xfa, 0xbb, 0xaf, 0x86, 0xac, 0x0b, 0xc8, 0x52, 0xa4, 0x0e, 0xc5, 0x1e, 0xbe, 0x24, 0x42, 0x7d,
-	0x6e, 0xc0, 0x74, 0xac, 0xc8, 0x85, 0xde, 0xcc, 0xb0, 0x69, 0xa2, 0xf5, 0x50, 0x7f, 0xeb, 0x44,
-	0x3c, 0xdd, 0x61, 0x5e, 0xd9, 0x01, 0xe2, 0x56, 0xf3, 0x3b, 0x06, 0x54, 0xe2, 0xb5, 0x30, 0x94,
-	0x41, 0x3b, 0xd5, 0xb1, 0xa8, 0xdf, 0x38, 0x19, 0xf1, 0x78, 0xf3, 0xc8, 0x0b, 0x4d, 0x1f, 0x0a,
-	0xbc, 0x68, 0xa6, 0xdb, 0xf8, 0xf1, 0x16, 0x87, 0x6e, 0xe3, 0x27, 0x2a, 0x6e, 0x9a, 0x8d, 0xef,
-	0x7b, 0x7d, 0xac, 0xb8, 0x19, 0xaf, 0xa5, 0x65, 0x71, 0x3b, 0xde, 0xcd, 0x12, 0x85, 0xb8, 0x2c,
-	0x6e, 0xd2, 0xcd, 0x44, 0xc9, 0x0c, 0x65, 0x10, 0x3b, 0xc1, 0xcd, 0x92, 0x15, 0x37, 0x8d, 0x9b,
-	0x51, 0x86, 0x8a, 0x9b, 0xc9, 0x52, 0x96, 0xce, 0xcd, 0x52, 0xdd, 0x18, 0x9d, 0x9b, 0xa5, 0xab,
-	0x61, 0x1a, 0x3b, 0x52, 0xbe, 0x31, 0x37, 0x3b, 0xa7, 0x29, 0x76, 0xa1, 0x77, 0x33, 0x94, 0xa8,
-	0xed, 0xed, 0xd4, 0x6f, 0xbd, 0x22, 0x76, 0xe6, 0x1e, 0x67, 0xea, 0x17, 0x7b, 0xfc, 0x8f, 0x0c,
-	0x98, 0xd3, 0xd5, 0xc7, 0x50, 0x06, 0x9f, 0x8c, 0x56, 0x50, 0x7d, 0xf1, 0x55, 0xd1, 0x8f, 0xd7,
-	0x56, 0xb4, 0xeb, 0x1f, 0xf4, 0xbe, 0x68, 0x36, 0x9e, 0x5f, 0x85, 0x2b, 0x30, 0xd9, 0x1c, 0x3a,
-	0x8f, 0xf1, 0x11, 0x3a, 0x37, 0x95, 0xab, 0x4f, 0x13, 0xba, 0x9e, 0xef, 0x7c, 0x46, 0xff, 0x3c,
-	0xc4, 0x42, 0x6e, 0xb7, 0x0c, 0x10, 0x21, 0x8c, 0xfd, 0xdb, 0x97, 0xf3, 0xc6, 0x7f, 0x7e, 0x39,
-	0x6f, 0xfc, 0xcf, 0x97, 0xf3, 0xc6, 0x4f, 0xff, 0x6f, 0x7e, 0xec, 0xf9, 0xb5, 0x9e, 0x47, 0xc5,
-	0x5a, 0x74, 0xbc, 0x86, 0xfc, 0x93, 0x15, 0xcb, 0x0d, 0x55, 0xd4, 0xdd, 0x49, 0xfa, 0x37, 0x26,
-	0x96, 0x7f, 0x1e, 0x00, 0x00, 0xff, 0xff, 0x52, 0x87, 0xa2, 0xad, 0x3a, 0x43, 0x00, 0x00,
+	// 5516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7c, 0xdd, 0x6f, 0x5c, 0x49,
+	0x56, 0xb8, 0x6f, 0x77, 0xdb, 0xdd, 0x7d, 0xfa, 0xc3, 0xed, 0x8a, 0x93, 0x74, 0x3a, 0x89, 0xe3,
+	0xdc, 0x4c, 0x66, 0x33, 0xd9, 0x89, 0x9d, 0xd8, 0x89, 0xe7, 0xb7, 0xd9, 0xdf, 0x0e, 0xdb, 0xb1,
+	0x3b, 0xb1, 0x37, 0x8e, 0xed, 0xb9, 0x76, 0x32, 0x33, 0x41, 0xa2, 0xb9, 0xee, 0x2e, 0xb7, 0xef,
+	0xba, 0xfb, 0xde, 0xde, 0x7b, 0x6f, 0x3b, 0xf6, 0xf0, 0xb0, 0xcb, 0xc2, 0x82, 0x06, 0xc4, 0x00,
+	0x83, 0x84, 0x00, 0x09, 0x21, 0x21, 0x24, 0x78, 0x00, 0x04, 0x0f, 0x80, 0x10, 0x48, 0xbc, 0x82,
+	0x04, 0x12, 0xd2, 0x0a, 0xf1, 0x0a, 0x03, 0x0f, 0x08, 0xf1, 0x07, 0x20, 0xc4, 0x03, 0xaa, 0xaf,
+	0x5b, 0x75, 0x3f, 0xba, 0xe3, 0x19, 0x7b, 0xb4, 0x2f, 0x49, 0x57, 0xd5, 0xa9, 0x73, 0x4e, 0x9d,
+	0x3a, 0xe7, 0xd4, 0xa9, 0x73, 0xea, 0x1a, 0xf2, 0x6e, 0xbf, 0x35, 0xd7, 0x77, 0x1d, 0xdf, 0x41,
+	0x45, 0xec, 0xb7, 0xda, 0x1e, 0x76, 0x0f, 0xb1, 0xdb, 0xdf, 0xad, 0x4d, 0x77, 0x9c, 0x8e, 0x43,
+	0x07, 0xe6, 0xc9, 0x2f, 0x06, 0x53, 0xab, 0x12, 0x98, 0x79, 0xb3, 0x6f, 0xcd, 0xf7, 0x0e, 0x5b,
+	0xad, 0xfe, 0xee, 0xfc, 0xc1, 0x21, 0x1f, 0xa9, 0x05, 0x23, 0xe6, 0xc0, 0xdf, 0xef, 0xef, 0xd2,
+	0xff, 0xf8, 0xd8, 0x6c, 0x30, 0x76, 0x88, 0x5d, 0xcf, 0x72, 0xec, 0xfe, 0xae, 0xf8, 0xc5, 0x21,
+	0xae, 0x74, 0x1c, 0xa7, 0xd3, 0xc5, 0x6c, 0xbe, 0x6d, 0x3b, 0xbe, 0xe9, 0x5b, 0x8e, 0xed, 0xf1,
+	0x51, 0xf6, 0x5f, 0xeb, 0x4e, 0x07, 0xdb, 0x77, 0x9c, 0x3e, 0xb6, 0xcd, 0xbe, 0x75, 0xb8, 0x30,
+	0xef, 0xf4, 0x29, 0x4c, 0x1c, 0x5e, 0xff, 0x44, 0x83, 0xb2, 0x81, 0xbd, 0xbe, 0x63, 0x7b, 0x78,
+	0x15, 0x9b, 0x6d, 0xec, 0xa2, 0xab, 0x00, 0xad, 0xee, 0xc0, 0xf3, 0xb1, 0xdb, 0xb4, 0xda, 0x55,
+	0x6d, 0x56, 0xbb, 0x95, 0x31, 0xf2, 0xbc, 0x67, 0xad, 0x8d, 0x2e, 0x43, 0xbe, 0x87, 0x7b, 0xbb,
+	0x6c, 0x34, 0x45, 0x47, 0x73, 0xac, 0x63, 0xad, 0x8d, 0x6a, 0x90, 0x73, 0xf1, 0xa1, 0x45, 0xd8,
+	0xad, 0xa6, 0x67, 0xb5, 0x5b, 0x69, 0x23, 0x68, 0x93, 0x89, 0xae, 0xb9, 0xe7, 0x37, 0x7d, 0xec,
+	0xf6, 0xaa, 0x19, 0x36, 0x91, 0x74, 0xec, 0x60, 0xb7, 0xf7, 0x30, 0xfb, 0xfd, 0x3f, 0xab, 0xa6,
+	0x17, 0xe7, 0xee, 0xea, 0xff, 0x3d, 0x01, 0x45, 0xc3, 0xb4, 0x3b, 0xd8, 0xc0, 0xdf, 0x19, 0x60,
+	0xcf, 0x47, 0x15, 0x48, 0x1f, 0xe0, 0x63, 0xca, 0x47, 0xd1, 0x20, 0x3f, 0x19, 0x22, 0xbb, 0x83,
+	0x9b, 0xd8, 0x66, 0x1c, 0x14, 0x09, 0x22, 0xbb, 0x83, 0x1b, 0x76, 0x1b, 0x4d, 0xc3, 0x78, 0xd7,
+	0xea, 0x59, 0x3e, 0x27, 0xcf, 0x1a, 0x21, 0xbe, 0x32, 0x11, 0xbe, 0x96, 0x01, 0x3c, 0xc7, 0xf5,
+	0x9b, 0x8e, 0xdb, 0xc6, 0x6e, 0x75, 0x7c, 0x56, 0xbb, 0x55, 0x5e, 0x78, 0x63, 0x4e, 0xdd, 0xe1,
+	0x39, 0x95, 0xa1, 0xb9, 0x6d, 0xc7, 0xf5, 0x37, 0x09, 0xac, 0x91, 0xf7, 0xc4, 0x4f, 0xf4, 0x18,
+	0x0a, 0x14, 0x89, 0x6f, 0xba, 0x1d, 0xec, 0x57, 0x27, 0x28, 0x96, 0x9b, 0xaf, 0xc1, 0xb2, 0x43,
+	0x81, 0x0d, 0x4a, 0x9e, 0xfd, 0x46, 0x3a, 0x14, 0x3d, 0xec, 0x5a, 0x66, 0xd7, 0xfa, 0xc8, 0xdc,
+	0xed, 0xe2, 0x6a, 0x76, 0x56, 0xbb, 0x95, 0x33, 0x42, 0x7d, 0x64, 0xfd, 0x07, 0xf8, 0xd8, 0x6b,
+	0x3a, 0x76, 0xf7, 0xb8, 0x9a, 0xa3, 0x00, 0x39, 0xd2, 0xb1, 0x69, 0x77, 0x8f, 0xe9, 0xee, 0x39,
+	0x03, 0xdb, 0x67, 0xa3, 0x79, 0x3a, 0x9a, 0xa7, 0x3d, 0x74, 0xf8, 0x1e, 0x54, 0x7a, 0x96, 0xdd,
+	0xec, 0x39, 0xed, 0x66, 0x20, 0x10, 0x20, 0x02, 0x79, 0x94, 0xfd, 0x05, 0xba, 0x03, 0xf7, 0x8c,
+	0x72, 0xcf, 0xb2, 0x9f, 0x39, 0x6d, 0x43, 0xc8, 0x87, 0x4c, 0x31, 0x8f, 0xc2, 0x53, 0x0a, 0xd1,
+	0x29, 0xe6, 0x91, 0x3a, 0xe5, 0x1d, 0x38, 0x47, 0xa8, 0xb4, 0x5c, 0x6c, 0xfa, 0x58, 0xce, 0x2a,
+	0x86, 0x67, 0x4d, 0xf5, 0x2c, 0x7b, 0x99, 0x82, 0x84, 0x26, 0x9a, 0x47, 0xb1, 0x89, 0xa5, 0xe8,
+	0x44, 0xf3, 0x28, 0x32, 0x71, 0x0e, 0xca, 0x2d, 0xc7, 0xf6, 0x2d, 0x7b, 0x80, 0x9b, 0xbe, 0x73,
+	0x80, 0xed, 0x6a, 0x99, 0x28, 0x86, 0x98, 0xb3, 0x64, 0x94, 0xc4, 0xf0, 0x0e, 0x19, 0x45, 0xff,
+	0x1f, 0xb2, 0x7b, 0x56, 0xd7, 0xc7, 0xae, 0x57, 0x9d, 0x9c, 0x4d, 0xdf, 0x2a, 0x2c, 0x5c, 0x4a,
+	0xd8, 0xab, 0xc7, 0x14, 0x42, 0xe2, 0x10, 0x53, 0x50, 0x03, 0x4a, 0x84, 0x4d, 0xcf, 0x37, 0xbb,
+	0xd8, 0xc6, 0x9e, 0x57, 0xad, 0xcc, 0x6a, 0xb7, 0x0a, 0x0b, 0x17, 0xc3, 0x38, 0xb6, 0xc5, 0xb0,
+	0xc4, 0x50, 0xec, 0x99, 0x47, 0x41, 0xb7, 0xfe, 0x0e, 0xe4, 0x03, 0x65, 0x42, 0x39, 0xc8, 0x6c,
+	0x6c, 0x6e, 0x34, 0x2a, 0x63, 0x08, 0x60, 0xa2, 0xbe, 0xbd, 0xdc, 0xd8, 0x58, 0xa9, 0x68, 0xa8,
+	0x00, 0xd9, 0x95, 0x06, 0x6b, 0xa4, 0x6a, 0xd9, 0x4f, 0xb9, 0x91, 0x3c, 0x05, 0x90, 0xfa, 0x83,
+	0xb2, 0x90, 0x7e, 0xda, 0xf8, 0xb0, 0x32, 0x46, 0x80, 0x5f, 0x34, 0x8c, 0xed, 0xb5, 0xcd, 0x8d,
+	0x8a, 0x46, 0xb0, 0x2c, 0x1b, 0x8d, 0xfa, 0x4e, 0xa3, 0x92, 0x22, 0x10, 0xcf, 0x36, 0x57, 0x2a,
+	0x69, 0x94, 0x87, 0xf1, 0x17, 0xf5, 0xf5, 0xe7, 0x8d, 0x4a, 0x26, 0x40, 0x26, 0x4d, 0xef, 0x05,
+	0xe4, 0x03, 0xde, 0x88, 0x22, 0xf6, 0xac, 0x6e, 0xd7, 0xf2, 0x70, 0xcb, 0xb1, 0xdb, 0x1e, 0xb5,
+	0xbf, 0xb4, 0x11, 0xea, 0x43, 0x57, 0x20, 0x2f, 0xb6, 0xc8, 0xa3, 0x86, 0x98, 0x36, 0x64, 0x87,
+	0xc0, 0xbb, 0xa4, 0xff, 0x66, 0x1a, 0x0a, 0x8a, 0x3c, 0xd1, 0xbb, 0x30, 0xe1, 0x62, 0x6f, 0xd0,
+	0xf5, 0x29, 0xd2, 0xf2, 0xc2, 0x9b, 0x43, 0x45, 0x3f, 0xc7, 0xfe, 0x33, 0x28, 0xb4, 0xc1, 0x67,
+	0xa1, 0xaf, 0xc3, 0x04, 0x37, 0xb3, 0x14, 0x9d, 0x7f, 0x23, 0x3c, 0x7f, 0xd9, 0xe9, 0xf5, 0x4d,
+	0x17, 0x8b, 0xff, 0xb9, 0x91, 0xf1, 0x29, 0xa8, 0x06, 0x59, 0xee, 0x4f, 0x99, 0x87, 0x58, 0x1d,
+	0x33, 0x44, 0x07, 0x7a, 0x0b, 0x26, 0xa3, 0x9a, 0x97, 0xe1, 0x30, 0xe5, 0x56, 0x58, 0xdf, 0x6e,
+	0x40, 0x31, 0x64, 0x10, 0xe3, 0x1c, 0xae, 0xd0, 0x53, 0xcc, 0xe0, 0x02, 0x8c, 0x1f, 0x9a, 0xdd,
+	0x01, 0xa6, 0xee, 0xa0, 0xb8, 0x3a, 0x66, 0xb0, 0x26, 0xe9, 0xef, 0x62, 0xd3, 0x63, 0xd6, 0x4d,
+	0x66, 0xb1, 0xa6, 0xfe, 0x01, 0x14, 0xd5, 0x05, 0x93, 0xdd, 0x6a, 0xbc, 0xf7, 0xbc, 0xbe, 0xce,
+	0xb6, 0xf6, 0x09, 0xdd, 0x4d, 0xa3, 0xa2, 0x11, 0x55, 0x59, 0x6f, 0x6c, 0x6f, 0x57, 0x52, 0xa8,
+	0x04, 0xf9, 0x8d, 0xcd, 0x9d, 0x26, 0x83, 0x4a, 0xa3, 0x32, 0xc0, 0x6a, 0x7d, 0xbb, 0xb9, 0x65,
+	0x34, 0x1e, 0xaf, 0x7d, 0x20, 0xf7, 0x78, 0x29, 0xd8, 0x8b, 0x47, 0x65, 0x28, 0x32, 0x41, 0x34,
+	0x07, 0xb6, 0xe5, 0xd8, 0xfa, 0xdf, 0x6b, 0x50, 0xe2, 0x7e, 0x89, 0x1d, 0x02, 0xe8, 0x3e, 0x4c,
+	0xec, 0xd3, 0x83, 0x80, 0xee, 0x4e, 0x61, 0xe1, 0x4a, 0x64, 0x77, 0x42, 0x87, 0x85, 0xc1, 0x61,
+	0x91, 0x0e, 0xe9, 0x83, 0x43, 0xa2, 0x04, 0xc4, 0x96, 0x2a, 0x73, 0xec, 0xc8, 0x9b, 0x7b, 0x8a,
+	0x8f, 0x5f, 0x90, 0x15, 0x1b, 0x64, 0x10, 0x21, 0xc8, 0xf4, 0x1c, 0x17, 0x53, 0xb9, 0xe7, 0x0c,
+	0xfa, 0x9b, 0xb8, 0x6b, 0xea, 0x9c, 0xb8, 0x57, 0x66, 0x8d, 0x04, 0x6b, 0x1e, 0x1f, 0x65, 0xcd,
+	0x52, 0x85, 0x7f, 0x45, 0x83, 0xa9, 0x67, 0x83, 0xae, 0x6f, 0x85, 0x8e, 0x90, 0x05, 0x98, 0xa0,
+	0xe7, 0x03, 0xd1, 0x62, 0xc2, 0x5f, 0x6d, 0xb8, 0x5f, 0x36, 0x38, 0x64, 0xe8, 0xc4, 0x48, 0x45,
+	0x4e, 0x8c, 0xa8, 0x93, 0x4e, 0xc7, 0x9d, 0xb4, 0xd4, 0xfe, 0x4f, 0x34, 0x40, 0x2a, 0x4b, 0xa7,
+	0x12, 0xf3, 0xd7, 0x88, 0xc5, 0xb1, 0x11, 0x21, 0xec, 0xcb, 0x89, 0x8b, 0x61, 0x30, 0x86, 0x84,
+	0x96, 0x0c, 0xfd, 0x83, 0x06, 0xb0, 0x35, 0xf0, 0x87, 0x9f, 0xaf, 0xd3, 0x42, 0x6d, 0xd9, 0xd9,
+	0xca, 0x95, 0x76, 0x5a, 0x28, 0xad, 0x38, 0x58, 0x49, 0x03, 0xcd, 0x42, 0xb6, 0xef, 0xe2, 0xc3,
+	0xe6, 0xc1, 0x21, 0xdd, 0xc1, 0x9c, 0x74, 0xd2, 0x13, 0xa4, 0xff, 0xe9, 0x21, 0xba, 0x0d, 0x45,
+	0xab, 0x63, 0x3b, 0x2e, 0x6e, 0x32, 0xa4, 0xe3, 0x2a, 0xd8, 0x82, 0x51, 0x60, 0x83, 0x54, 0x4d,
+	0x14, 0x58, 0x46, 0x6a, 0x22, 0x11, 0x76, 0x9d, 0x8c, 0xc9, 0x3d, 0xff, 0x9e, 0x06, 0x05, 0xba,
+	0x9e, 0x53, 0x49, 0x76, 0x41, 0x2e, 0x24, 0x45, 0xa7, 0xc5, 0x94, 0x38, 0xb6, 0x34, 0xc9, 0x82,
+	0x0d, 0x68, 0x05, 0x77, 0xb1, 0x8f, 0x4f, 0x13, 0xb9, 0x28, 0xa2, 0x4c, 0x27, 0x8a, 0x52, 0xd2,
+	0xfb, 0x3d, 0x0d, 0xce, 0x85, 0x08, 0x9e, 0x6a, 0xe9, 0x55, 0xc8, 0xb6, 0x29, 0xb2, 0x36, 0xd7,
+	0x74, 0xd1, 0x44, 0xf7, 0x21, 0xc7, 0x59, 0xf2, 0xaa, 0xe9, 0x64, 0xd3, 0x96, 0x5c, 0x66, 0x19,
+	0x97, 0x9e, 0x64, 0xf3, 0xaf, 0x52, 0x90, 0xe7, 0xc2, 0xd8, 0xec, 0xa3, 0x3a, 0x94, 0x5c, 0xd6,
+	0x68, 0xd2, 0x35, 0x73, 0x1e, 0x47, 0x18, 0xe3, 0xea, 0x98, 0x51, 0xe4, 0x53, 0x68, 0x37, 0xfa,
+	0x3a, 0x14, 0x04, 0x8a, 0xfe, 0xc0, 0xe7, 0x1b, 0x55, 0x0d, 0x23, 0x90, 0xaa, 0xbd, 0x3a, 0x66,
+	0x00, 0x07, 0xdf, 0x1a, 0xf8, 0x68, 0x07, 0xa6, 0xc5, 0x64, 0xb6, 0x3e, 0xce, 0x46, 0x9a, 0x62,
+	0x99, 0x0d, 0x63, 0x89, 0x6f, 0xe7, 0xea, 0x98, 0x81, 0xf8, 0x7c, 0x65, 0x10, 0xad, 0x48, 0x96,
+	0xfc, 0x23, 0x76, 0x5e, 0xc4, 0x58, 0xda, 0x39, 0xb2, 0x39, 0x12, 0x21, 0xad, 0x45, 0x85, 0xb7,
+	0x9d, 0x23, 0xe9, 0xc0, 0x1e, 0xe5, 0x21, 0xcb, 0xbb, 0xf5, 0xbf, 0x4b, 0x01, 0x88, 0x1d, 0xdb,
+	0xec, 0xa3, 0x15, 0x28, 0x0b, 0x63, 0x0e, 0xc9, 0x6f, 0x94, 0xfd, 0xaf, 0x8e, 0x19, 0x25, 0x31,
+	0x89, 0xb1, 0xfb, 0x2e, 0x14, 0x03, 0x2c, 0x52, 0x84, 0x97, 0x12, 0x44, 0x18, 0x60, 0x28, 0x88,
+	0x09, 0x44, 0x88, 0xef, 0xc3, 0xf9, 0x60, 0x7e, 0x82, 0x14, 0xaf, 0x8f, 0x90, 0x62, 0x80, 0xf0,
+	0x9c, 0xc0, 0xa0, 0xca, 0xf1, 0x89, 0xc2, 0x98, 0x14, 0xe4, 0xa5, 0x04, 0x41, 0x32, 0x20, 0x55,
+	0x92, 0x01, 0x87, 0x21, 0x51, 0x02, 0xf1, 0xe0, 0xac, 0x5f, 0xff, 0x83, 0x0c, 0x64, 0x79, 0x3c,
+	0x40, 0xc2, 0x87, 0x50, 0xf8, 0x31, 0x3a, 0x7c, 0x38, 0xcb, 0xd8, 0x83, 0x3b, 0x84, 0xb4, 0x74,
+	0x08, 0x4a, 0x34, 0x92, 0x39, 0x41, 0x34, 0x32, 0x7e, 0xc2, 0x68, 0x64, 0x62, 0x64, 0x34, 0x92,
+	0x0d, 0x47, 0x23, 0xd7, 0x84, 0x63, 0xcf, 0xa9, 0x51, 0xf6, 0x62, 0x10, 0x96, 0xa0, 0x37, 0x54,
+	0xaf, 0xf5, 0x4d, 0xf5, 0x20, 0x5e, 0x94, 0xee, 0x4b, 0x37, 0xa0, 0x14, 0x12, 0xd9, 0x09, 0xa2,
+	0x97, 0x0b, 0xa1, 0xe8, 0xa5, 0x96, 0xfd, 0x2d, 0xe6, 0x49, 0x64, 0x9c, 0xfb, 0x61, 0x80, 0x93,
+	0x87, 0xba, 0x4a, 0x84, 0x3b, 0xa6, 0x44, 0xb8, 0x9a, 0x88, 0x70, 0x53, 0x32, 0xc2, 0x4d, 0x23,
+	0x04, 0xe3, 0xeb, 0x8d, 0xfa, 0x36, 0x0d, 0x76, 0x19, 0xea, 0xc5, 0x78, 0xd4, 0x1b, 0x8b, 0x88,
+	0xfe, 0x50, 0x03, 0x90, 0x06, 0x8b, 0xe6, 0x21, 0xdb, 0x62, 0x2c, 0xf0, 0xe0, 0xe1, 0x7c, 0xe2,
+	0x8e, 0x1b, 0x02, 0x0a, 0xdd, 0x83, 0xac, 0x37, 0x68, 0xb5, 0xc8, 0xad, 0x80, 0x1d, 0xd0, 0x17,
+	0xa3, 0x4e, 0x98, 0x3b, 0x44, 0x43, 0xc0, 0x91, 0x29, 0x7b, 0xa6, 0xd5, 0x1d, 0xd0, 0xd8, 0x68,
+	0xf4, 0x14, 0x0e, 0x27, 0x7d, 0xec, 0xef, 0x6a, 0x50, 0x50, 0xcc, 0xe2, 0x0b, 0x1e, 0x01, 0x57,
+	0x20, 0x4f, 0x99, 0xc1, 0x6d, 0x7e, 0x08, 0xe4, 0x0c, 0xd9, 0x81, 0x96, 0xd4, 0xa8, 0x83, 0x71,
+	0x58, 0x4d, 0x46, 0xbb, 0xd9, 0x4f, 0x08, 0x39, 0xee, 0xea, 0x3b, 0x30, 0x45, 0xe5, 0xd4, 0xf2,
+	0x2d, 0x27, 0x90, 0xac, 0x1a, 0x61, 0x69, 0x91, 0x08, 0xab, 0x06, 0xb9, 0xfe, 0xfe, 0xb1, 0x67,
+	0xb5, 0xcc, 0x2e, 0x67, 0x27, 0x68, 0x4b, 0xac, 0xdb, 0x80, 0x54, 0xac, 0xa7, 0x11, 0x80, 0x44,
+	0x7a, 0x01, 0x0a, 0xab, 0xa6, 0xb7, 0xcf, 0x99, 0x94, 0xfd, 0xf7, 0xa1, 0x44, 0xfa, 0x9f, 0xbe,
+	0x38, 0x01, 0xfb, 0x62, 0xd6, 0xa2, 0xfe, 0xd7, 0x1a, 0x94, 0xc5, 0xb4, 0x53, 0x6d, 0x10, 0x82,
+	0xcc, 0xbe, 0xe9, 0xed, 0x53, 0x61, 0x94, 0x0c, 0xfa, 0x1b, 0xbd, 0x05, 0x95, 0x16, 0x5b, 0x7f,
+	0x33, 0x92, 0x74, 0x99, 0xe4, 0xfd, 0x81, 0xed, 0xbf, 0x0d, 0x25, 0x32, 0x25, 0x72, 0xaf, 0x51,
+	0xee, 0xa5, 0xfb, 0x74, 0xcd, 0x51, 0xf6, 0x4d, 0x28, 0x32, 0x61, 0x9c, 0x35, 0xef, 0x52, 0xae,
+	0xdf, 0x82, 0xc9, 0x6d, 0xdb, 0xec, 0x7b, 0xfb, 0x4e, 0x10, 0x91, 0xce, 0x41, 0xd9, 0xb3, 0xec,
+	0x96, 0xe2, 0xf7, 0xb4, 0x30, 0xb7, 0x25, 0x3a, 0x1c, 0x67, 0xf7, 0x4f, 0x35, 0xa8, 0x48, 0x64,
+	0xa7, 0xe2, 0xf9, 0x2b, 0x30, 0xe9, 0xe2, 0x9e, 0x69, 0xd9, 0x96, 0xdd, 0x69, 0xee, 0x1e, 0xfb,
+	0xd8, 0xe3, 0xb9, 0xae, 0x72, 0xd0, 0xfd, 0x88, 0xf4, 0x92, 0xc5, 0xed, 0x76, 0x9d, 0x5d, 0xee,
+	0xd4, 0xe9, 0x6f, 0x74, 0x3d, 0xec, 0xd5, 0xf3, 0x4a, 0x06, 0x81, 0xf7, 0x4b, 0x9e, 0xff, 0x2b,
+	0x05, 0xc5, 0xf7, 0x4d, 0xbf, 0x25, 0x34, 0x0e, 0xad, 0x41, 0x39, 0x70, 0xfb, 0xb4, 0x87, 0xf3,
+	0x1d, 0x09, 0x50, 0xe8, 0x1c, 0x91, 0x04, 0x11, 0x01, 0x4a, 0xa9, 0xa5, 0x76, 0x50, 0x54, 0xa6,
+	0xdd, 0xc2, 0xdd, 0x00, 0x55, 0x6a, 0x38, 0x2a, 0x0a, 0xa8, 0xa2, 0x52, 0x3b, 0xd0, 0x07, 0x50,
+	0xe9, 0xbb, 0x4e, 0xc7, 0xc5, 0x9e, 0x17, 0x20, 0x63, 0x47, 0xbe, 0x9e, 0x80, 0x6c, 0x8b, 0x83,
+	0x46, 0xa2, 0x9e, 0xfb, 0xab, 0x63, 0xc6, 0x64, 0x3f, 0x3c, 0x86, 0x0c, 0xba, 0xde, 0xb6, 0xe5,
+	0x07, 0x78, 0x33, 0xa3, 0xd6, 0xdb, 0xb6, 0xfc, 0x08, 0xd6, 0x25, 0xbe, 0x70, 0x39, 0x22, 0x9d,
+	0xfb, 0xa4, 0x8c, 0x39, 0x99, 0x77, 0xff, 0x8b, 0x71, 0x40, 0x71, 0xd1, 0x7d, 0xde, 0x50, 0xfd,
+	0x26, 0x94, 0x3d, 0xdf, 0x74, 0x63, 0x76, 0x57, 0xa2, 0xbd, 0x81, 0xd5, 0x7d, 0x05, 0x82, 0xd5,
+	0x36, 0x6d, 0xc7, 0xb7, 0xf6, 0x8e, 0xd9, 0x25, 0xc9, 0x28, 0x8b, 0xee, 0x0d, 0xda, 0x8b, 0x36,
+	0x64, 0x36, 0x6a, 0x7c, 0x36, 0x7d, 0xab, 0xbc, 0xf0, 0xd5, 0xd7, 0x6d, 0x36, 0xcf, 0x8c, 0xec,
+	0x1c, 0xf7, 0xd5, 0x08, 0x5c, 0xe4, 0xa7, 0x94, 0xab, 0xc4, 0x44, 0xf2, 0xad, 0x4c, 0x87, 0xdc,
+	0x2b, 0x82, 0xb4, 0x69, 0xb5, 0x59, 0x16, 0x22, 0xd8, 0x23, 0x23, 0x4b, 0x07, 0xd6, 0xda, 0xe8,
+	0x06, 0xe4, 0xf6, 0x5c, 0xb3, 0xd3, 0xc3, 0xb6, 0xcf, 0xd2, 0x8c, 0x12, 0x26, 0x18, 0x40, 0x4f,
+	0xa0, 0x84, 0x0f, 0xb1, 0xed, 0x37, 0xc5, 0x02, 0xf2, 0x49, 0xe9, 0x34, 0xba, 0x80, 0x68, 0x3a,
+	0xad, 0x48, 0x27, 0x3e, 0xe6, 0x3c, 0xbf, 0x0d, 0x25, 0xcb, 0xb6, 0x7c, 0xcb, 0xec, 0x36, 0x3d,
+	0xdf, 0xf4, 0x31, 0x4d, 0x4b, 0xe6, 0x14, 0x68, 0x3e, 0xba, 0x4d, 0x06, 0xc9, 0x4d, 0x91, 0x44,
+	0x64, 0x3d, 0x91, 0x1f, 0x28, 0x84, 0xf3, 0x03, 0x05, 0x36, 0xc8, 0x72, 0x7d, 0x37, 0x20, 0xd7,
+	0x72, 0xcc, 0x2e, 0xf6, 0x5a, 0x98, 0xa6, 0x20, 0x15, 0xa4, 0xc1, 0x00, 0x7a, 0x00, 0x48, 0xfc,
+	0x6e, 0xbe, 0xb2, 0xec, 0xb6, 0xf3, 0xaa, 0xd9, 0xf3, 0xc2, 0x89, 0xc7, 0x25, 0xa3, 0x22, 0x40,
+	0xde, 0xa7, 0x10, 0xcf, 0x3c, 0xbd, 0x09, 0x20, 0x77, 0x82, 0x04, 0x1f, 0x1b, 0x9b, 0x5b, 0xcf,
+	0x77, 0x2a, 0x63, 0xa8, 0x08, 0xb9, 0x8d, 0xcd, 0x95, 0xc6, 0x7a, 0x83, 0x86, 0x27, 0x55, 0x28,
+	0x6c, 0x6c, 0x3e, 0xdf, 0x58, 0x5e, 0xad, 0x6f, 0x3c, 0x69, 0xd0, 0x54, 0x1e, 0x0b, 0x48, 0x96,
+	0xd0, 0x79, 0x02, 0xf7, 0x7c, 0x6b, 0x85, 0x84, 0x31, 0x41, 0x08, 0xb4, 0x24, 0xe2, 0x94, 0x7b,
+	0xd2, 0x51, 0xfa, 0x50, 0x50, 0xa4, 0x88, 0x2e, 0x43, 0xee, 0x00, 0x1f, 0x37, 0x3b, 0xc4, 0xf7,
+	0x10, 0xb5, 0xcd, 0x93, 0xd0, 0xf1, 0x00, 0x1f, 0x3f, 0x21, 0x0e, 0xe8, 0x2a, 0xcd, 0x10, 0x37,
+	0x5d, 0xdc, 0xc1, 0x47, 0x54, 0x79, 0xc9, 0x28, 0x81, 0x37, 0x48, 0x8f, 0xcc, 0x3f, 0xa5, 0x43,
+	0xf9, 0x27, 0x99, 0x25, 0xca, 0xc1, 0x04, 0xdb, 0x4e, 0xbd, 0x2e, 0xcc, 0x25, 0xe4, 0x0d, 0x54,
+	0xed, 0xd1, 0xc2, 0xb9, 0x59, 0xa1, 0x3d, 0x02, 0xd9, 0x3d, 0xfd, 0x1a, 0x4c, 0x27, 0x39, 0x05,
+	0x01, 0x70, 0x5f, 0x7f, 0x47, 0x9a, 0xa4, 0xb4, 0x61, 0x72, 0x2b, 0x65, 0x46, 0x2d, 0x72, 0x8f,
+	0xa2, 0x29, 0x33, 0x19, 0xff, 0x94, 0x86, 0x12, 0xf7, 0x9d, 0xa7, 0x72, 0xf6, 0x97, 0x94, 0xe5,
+	0xf0, 0x1b, 0xb0, 0xb0, 0x01, 0xc6, 0x85, 0x49, 0xee, 0xc6, 0x2c, 0xcb, 0x23, 0x9a, 0xe4, 0xfc,
+	0x67, 0x2e, 0x12, 0xb7, 0xb9, 0x55, 0x07, 0xed, 0xc4, 0x93, 0x79, 0x7c, 0xe8, 0xc9, 0x1c, 0xf8,
+	0x68, 0xd3, 0xe3, 0xb1, 0x7b, 0x5e, 0x5a, 0x5a, 0x51, 0xf8, 0x61, 0x32, 0x18, 0x32, 0xc9, 0xec,
+	0x30, 0x93, 0x8c, 0x59, 0x52, 0x6e, 0x94, 0x25, 0x3d, 0x00, 0x14, 0x82, 0x6e, 0xb6, 0x1d, 0x1b,
+	0xb3, 0xc2, 0x81, 0xa2, 0xf8, 0xea, 0x94, 0x15, 0xc7, 0x8e, 0x1b, 0x20, 0x8c, 0x30, 0xc0, 0x9b,
+	0x30, 0x41, 0x4d, 0xdd, 0xab, 0x16, 0xa8, 0x73, 0x28, 0x89, 0x24, 0x42, 0x83, 0xf4, 0x1a, 0x7c,
+	0x50, 0xaa, 0xfa, 0xbb, 0x30, 0x45, 0x73, 0x3c, 0x4f, 0x5c, 0xd3, 0x56, 0xf3, 0x54, 0x3b, 0x3b,
+	0xeb, 0x5c, 0x17, 0xc8, 0x4f, 0x54, 0x86, 0xd4, 0xda, 0x0a, 0xdf, 0xb0, 0xd4, 0xda, 0x8a, 0x9c,
+	0xff, 0x8b, 0x1a, 0x20, 0x15, 0xc1, 0xa9, 0x94, 0x23, 0x42, 0x45, 0xf0, 0x91, 0x96, 0x7c, 0x4c,
+	0xc3, 0x38, 0x76, 0x5d, 0xc7, 0x65, 0x87, 0xbd, 0xc1, 0x1a, 0x92, 0x9b, 0x3b, 0x9c, 0x19, 0x03,
+	0x1f, 0x3a, 0x07, 0xc1, 0x89, 0xc3, 0xd0, 0x6a, 0x71, 0xe6, 0x77, 0xe0, 0x5c, 0x08, 0xfc, 0x6c,
+	0xc2, 0xda, 0x4d, 0x98, 0xa4, 0x58, 0x97, 0xf7, 0x71, 0xeb, 0xa0, 0xef, 0x58, 0x76, 0x8c, 0x03,
+	0x74, 0x83, 0x9c, 0x95, 0x22, 0xe4, 0x21, 0x4b, 0x64, 0x6b, 0x2e, 0x06, 0x9d, 0x3b, 0x3b, 0xeb,
+	0xd2, 0x68, 0x77, 0xe1, 0x42, 0x04, 0xa1, 0x58, 0xd9, 0x8f, 0x41, 0xa1, 0x15, 0x74, 0x8a, 0x94,
+	0xeb, 0xd5, 0x30, 0xbb, 0xd1, 0xa9, 0xea, 0x0c, 0x49, 0xe3, 0x03, 0xb8, 0x18, 0xa3, 0x71, 0x16,
+	0xe2, 0xb8, 0xaf, 0xdf, 0x85, 0xf3, 0x14, 0xf3, 0x53, 0x8c, 0xfb, 0xf5, 0xae, 0x75, 0xf8, 0xfa,
+	0x6d, 0x39, 0xe6, 0xeb, 0x55, 0x66, 0x7c, 0xb9, 0x6a, 0x25, 0x49, 0x37, 0x38, 0xe9, 0x1d, 0x8b,
+	0x18, 0xd4, 0xfa, 0x70, 0x6e, 0x49, 0x30, 0x7a, 0x80, 0x8f, 0x3d, 0x7e, 0x65, 0xa2, 0xbf, 0xa5,
+	0x1f, 0xfe, 0x63, 0x8d, 0x8b, 0x53, 0xc5, 0xf3, 0x25, 0x9b, 0xc6, 0x0c, 0x40, 0x87, 0xd8, 0x20,
+	0x6e, 0x93, 0x01, 0x96, 0xe3, 0x57, 0x7a, 0x02, 0x86, 0x49, 0xd4, 0x53, 0x8c, 0x32, 0x7c, 0x95,
+	0x1b, 0x0e, 0xfd, 0x27, 0x7a, 0x6c, 0x2c, 0xea, 0x6f, 0x42, 0x81, 0x8e, 0x10, 0x9f, 0x34, 0xf0,
+	0x86, 0xed, 0xdc, 0xa2, 0xfe, 0xf3, 0x1a, 0xb7, 0x28, 0x81, 0xe7, 0x54, 0x6b, 0xbe, 0x07, 0x13,
+	0xf4, 0xb0, 0x14, 0xb7, 0xfb, 0x4b, 0x09, 0x8a, 0xcd, 0x38, 0x32, 0x38, 0xa0, 0xe4, 0xe4, 0x3f,
+	0x35, 0x98, 0x78, 0x46, 0x4b, 0xe5, 0x0a, 0xb7, 0x19, 0xb1, 0x73, 0xb6, 0xd9, 0x63, 0x29, 0xf7,
+	0xbc, 0x41, 0x7f, 0xd3, 0x4b, 0x30, 0xc6, 0xee, 0x73, 0x63, 0x9d, 0xdd, 0xba, 0xf3, 0x46, 0xd0,
+	0x26, 0x82, 0x6d, 0x75, 0x2d, 0x6c, 0xfb, 0x74, 0x34, 0x43, 0x47, 0x95, 0x1e, 0x74, 0x13, 0xf2,
+	0x96, 0xb7, 0x8e, 0x4d, 0xd7, 0xe6, 0x35, 0x6d, 0xe5, 0xa4, 0x90, 0x23, 0x0c, 0xec, 0x7d, 0xcb,
+	0xa7, 0x45, 0xcc, 0x89, 0xb0, 0xcf, 0x97, 0x23, 0xe8, 0x2d, 0x28, 0x98, 0x03, 0xdf, 0xd9, 0x72,
+	0x9d, 0x9e, 0xe3, 0xe3, 0xf0, 0xc9, 0xb3, 0x64, 0xa8, 0x63, 0x52, 0x6b, 0xff, 0x44, 0x83, 0x0a,
+	0x5b, 0x6c, 0xbd, 0xdd, 0x56, 0x2e, 0xcd, 0xc1, 0x92, 0xb4, 0xc8, 0x92, 0x42, 0x2c, 0xa7, 0x4e,
+	0xc6, 0x72, 0xfa, 0xa4, 0x2c, 0x67, 0x4e, 0xc8, 0xf2, 0x94, 0xc2, 0xf2, 0xa9, 0xf4, 0xe4, 0x6d,
+	0x98, 0x60, 0xaf, 0x22, 0xf8, 0x9d, 0x6b, 0x3a, 0x3c, 0x8b, 0x91, 0x31, 0x38, 0x0c, 0x9a, 0x83,
+	0x2c, 0xfb, 0x25, 0xf2, 0x2b, 0xc9, 0xe0, 0x02, 0x48, 0xb2, 0x3c, 0x07, 0xe7, 0xf8, 0x18, 0xee,
+	0x39, 0x49, 0x8e, 0x21, 0x13, 0x76, 0x63, 0x3f, 0xd0, 0x60, 0x3a, 0x3c, 0xe1, 0x54, 0xab, 0x54,
+	0xf8, 0x4e, 0x7d, 0x2e, 0xbe, 0xbf, 0x25, 0xf8, 0x7e, 0xde, 0x6f, 0x2b, 0xf7, 0xb0, 0xa8, 0x59,
+	0xa8, 0xfa, 0x92, 0x0a, 0xeb, 0x8b, 0xc4, 0xf5, 0x49, 0xb0, 0x26, 0x81, 0xec, 0x54, 0x6b, 0x7a,
+	0xe7, 0x44, 0x6b, 0x52, 0x22, 0xde, 0xd8, 0xe2, 0xd6, 0x84, 0x1a, 0xad, 0x5b, 0x5e, 0x70, 0x2c,
+	0x7e, 0x15, 0x8a, 0x5d, 0xcb, 0xc6, 0xa6, 0xcb, 0x8b, 0x86, 0x9a, 0xaa, 0x91, 0x0f, 0x8c, 0xd0,
+	0xa0, 0x44, 0xf5, 0x33, 0x1a, 0x20, 0x15, 0xd7, 0x8f, 0x66, 0xb7, 0xe6, 0x85, 0x80, 0xb9, 0xc9,
+	0xbc, 0x46, 0xcd, 0xee, 0xeb, 0x3f, 0xa7, 0xc1, 0xf9, 0xc8, 0x8c, 0x1f, 0x05, 0xe7, 0xf7, 0x89,
+	0xf3, 0xaf, 0x0a, 0x7d, 0x6f, 0x39, 0xf6, 0x9e, 0xd5, 0x19, 0xb8, 0x01, 0xfb, 0x77, 0x21, 0x6d,
+	0xb6, 0xdb, 0x3c, 0x42, 0x99, 0x49, 0xc2, 0x28, 0x5d, 0x97, 0x41, 0x40, 0xd1, 0x05, 0x98, 0x70,
+	0xa9, 0xdd, 0x50, 0x36, 0x32, 0x06, 0x6f, 0x91, 0x6b, 0x42, 0x9f, 0x7b, 0x9a, 0x34, 0x1d, 0x10,
+	0x4d, 0x79, 0x59, 0xf9, 0x73, 0x0d, 0x2e, 0x25, 0x70, 0x72, 0x2a, 0xb1, 0xdc, 0x86, 0x71, 0xb3,
+	0xcd, 0x52, 0xb6, 0xc3, 0x85, 0xc2, 0x40, 0xbe, 0xa8, 0x8b, 0x59, 0xd2, 0x7f, 0x47, 0x83, 0xa9,
+	0x15, 0x2c, 0x6e, 0x17, 0x42, 0x76, 0x4f, 0x21, 0xd3, 0x73, 0xda, 0x98, 0xd7, 0x50, 0xe6, 0xa2,
+	0x75, 0x9f, 0x08, 0xb8, 0xd2, 0xf3, 0xcc, 0x69, 0x63, 0xe9, 0x88, 0x29, 0x12, 0xfd, 0x3e, 0x94,
+	0xc3, 0x00, 0x28, 0x07, 0x99, 0xc7, 0xcf, 0xd7, 0xd7, 0x2b, 0x63, 0x68, 0x12, 0x0a, 0x6b, 0x1b,
+	0xcb, 0x46, 0xe3, 0x59, 0x63, 0x63, 0xa7, 0xbe, 0x5e, 0xd1, 0x62, 0x8f, 0x1a, 0x68, 0x22, 0x58,
+	0xa5, 0x78, 0x36, 0x11, 0xf3, 0xff, 0x83, 0xa9, 0x67, 0xce, 0x21, 0x09, 0x1a, 0xc8, 0xb0, 0x3c,
+	0xbf, 0x58, 0xb1, 0x20, 0x50, 0xfb, 0xa0, 0x2d, 0x8f, 0xf9, 0x6d, 0x40, 0xea, 0xcc, 0xb3, 0x60,
+	0x67, 0x51, 0xff, 0x57, 0x0d, 0x8a, 0xf5, 0xae, 0xe9, 0xf6, 0x04, 0x2b, 0xef, 0xc2, 0x04, 0xcb,
+	0x7c, 0x27, 0xbf, 0xa2, 0x51, 0x61, 0x59, 0xa3, 0xce, 0xf2, 0xe4, 0x7c, 0x16, 0x59, 0x0a, 0x7f,
+	0xb6, 0xb7, 0x12, 0x79, 0xc6, 0xb7, 0x82, 0xee, 0xc0, 0xb8, 0x49, 0xa6, 0xd0, 0xf3, 0xb5, 0x1c,
+	0x2d, 0x47, 0x50, 0x6c, 0x3b, 0xc7, 0x7d, 0x6c, 0x30, 0x28, 0xfd, 0x1b, 0x50, 0x50, 0x28, 0xa0,
+	0x2c, 0xa4, 0x9f, 0x34, 0x78, 0x0e, 0xa4, 0xbe, 0xbc, 0xb3, 0xf6, 0x82, 0x95, 0x68, 0xca, 0x00,
+	0x2b, 0x8d, 0xa0, 0x9d, 0x4a, 0x78, 0x80, 0x64, 0x72, 0x3c, 0x3c, 0x46, 0x52, 0x39, 0xd4, 0x86,
+	0x71, 0x98, 0x3a, 0x09, 0x87, 0x92, 0xc4, 0x4f, 0x6b, 0x50, 0xe2, 0xa2, 0x39, 0x6d, 0x18, 0x48,
+	0x31, 0x0f, 0x09, 0x03, 0x95, 0x65, 0x18, 0x1c, 0x50, 0xf2, 0xf0, 0x37, 0x1a, 0x54, 0x56, 0x9c,
+	0x57, 0x76, 0xc7, 0x35, 0xdb, 0x81, 0x2f, 0x7a, 0x1c, 0xd9, 0xce, 0xa8, 0x45, 0x45, 0xe0, 0x65,
+	0x47, 0x64, 0x5b, 0xab, 0x32, 0xf7, 0xcc, 0x62, 0x49, 0xd1, 0xd4, 0xbf, 0x09, 0x93, 0x91, 0x49,
+	0x64, 0x83, 0x5e, 0xd4, 0xd7, 0xd7, 0x68, 0xf2, 0x89, 0xd6, 0xd3, 0x1a, 0x1b, 0xf5, 0x47, 0xeb,
+	0x0d, 0xfe, 0x7a, 0xac, 0xbe, 0xb1, 0xdc, 0x58, 0x97, 0x1b, 0xf5, 0x40, 0xac, 0xe0, 0x81, 0xde,
+	0x85, 0x29, 0x85, 0xa1, 0xd3, 0x3e, 0x3e, 0x48, 0xe6, 0x57, 0x52, 0xab, 0xc1, 0x24, 0xcd, 0xf2,
+	0xac, 0x9b, 0x9d, 0xc8, 0x25, 0x60, 0x49, 0xff, 0x1f, 0x0d, 0xca, 0x74, 0x70, 0xdb, 0x77, 0xb1,
+	0xd9, 0x5b, 0x37, 0x3b, 0xe8, 0x32, 0xe4, 0x3d, 0xda, 0x90, 0xcf, 0x57, 0x73, 0xac, 0x63, 0xad,
+	0x8d, 0xae, 0x41, 0x81, 0xb8, 0x6c, 0x1f, 0x37, 0xcd, 0x76, 0xdb, 0xe5, 0x24, 0x81, 0x75, 0xd5,
+	0xdb, 0x6d, 0xaa, 0x74, 0x34, 0xf7, 0xc3, 0xfc, 0x24, 0x2d, 0xeb, 0x88, 0x36, 0xba, 0x0e, 0x45,
+	0x91, 0xce, 0x69, 0x76, 0xcc, 0x3e, 0xbf, 0xcf, 0x14, 0x44, 0xdf, 0x13, 0xb3, 0x8f, 0x6e, 0x42,
+	0x79, 0x77, 0xb0, 0xb7, 0x87, 0x5d, 0xdc, 0xe6, 0x65, 0x03, 0x96, 0xf7, 0x29, 0x89, 0x5e, 0x56,
+	0x35, 0xb8, 0x0e, 0xc5, 0xbd, 0xae, 0xf3, 0xaa, 0xd9, 0x72, 0x6c, 0xdf, 0x75, 0xba, 0x2c, 0xf4,
+	0x36, 0x0a, 0xa4, 0x6f, 0x99, 0x75, 0xa9, 0xf9, 0xaf, 0xec, 0x90, 0xfc, 0xd7, 0xc7, 0x1a, 0x54,
+	0xa4, 0x64, 0x4e, 0xb5, 0x0d, 0x4b, 0x90, 0x65, 0x32, 0x12, 0x0a, 0x7d, 0x25, 0x21, 0x81, 0x1b,
+	0xc8, 0xd8, 0x10, 0xc0, 0x92, 0x97, 0x87, 0x30, 0x25, 0x72, 0x5a, 0xf5, 0xe0, 0x90, 0xb8, 0x02,
+	0x79, 0xdf, 0xea, 0x61, 0xcf, 0x37, 0x7b, 0x7d, 0x7e, 0x35, 0x93, 0x1d, 0x72, 0xee, 0x2f, 0x69,
+	0x80, 0xd4, 0xc9, 0xa7, 0x5a, 0xc9, 0xa8, 0x87, 0x5b, 0x21, 0x7e, 0xd2, 0x43, 0xf9, 0xa9, 0x42,
+	0x89, 0x5f, 0xe1, 0xa2, 0x55, 0xc0, 0x7f, 0x4e, 0x43, 0x59, 0x0c, 0x7d, 0x39, 0x6a, 0x4f, 0x42,
+	0x8c, 0xf6, 0xee, 0xb6, 0xf5, 0x91, 0x78, 0x68, 0xc5, 0x5b, 0xa4, 0xbf, 0xcb, 0xe8, 0xb0, 0xb7,
+	0xd3, 0xbc, 0x45, 0x1f, 0x61, 0x9a, 0x7b, 0xfe, 0x9a, 0xdd, 0xc6, 0x47, 0x54, 0xd9, 0x32, 0x86,
+	0xec, 0xa0, 0xd2, 0xe0, 0x6f, 0xac, 0xa9, 0x92, 0x29, 0x6f, 0xae, 0xd1, 0x22, 0x54, 0xc8, 0xef,
+	0x7a, 0xbf, 0xdf, 0xb5, 0x70, 0x9b, 0x21, 0x20, 0xaa, 0x96, 0x91, 0xf7, 0xae, 0x18, 0x00, 0xba,
+	0x06, 0x13, 0x34, 0xbf, 0xe5, 0x55, 0x73, 0x24, 0x1e, 0x97, 0xa0, 0xbc, 0x9b, 0x5c, 0xbc, 0x18,
+	0xc7, 0x6b, 0xf6, 0x73, 0x8f, 0x25, 0x12, 0x95, 0xe2, 0x82, 0x3a, 0x16, 0xbe, 0xf1, 0xc1, 0xd0,
+	0x1b, 0xdf, 0x3c, 0x94, 0x3d, 0xdf, 0x71, 0xcd, 0x0e, 0x7e, 0xc1, 0x45, 0x56, 0x08, 0x57, 0xd5,
+	0x22, 0xc3, 0x92, 0x85, 0xf7, 0x06, 0x8e, 0x6f, 0x86, 0x9f, 0x1d, 0x2f, 0x19, 0xea, 0x98, 0xdc,
+	0xd9, 0x2b, 0x30, 0x55, 0x1f, 0xf8, 0xfb, 0x0d, 0x9b, 0xc4, 0xdf, 0xb1, 0x7d, 0xbf, 0x0a, 0x88,
+	0x8c, 0xae, 0x58, 0x5e, 0xe2, 0x30, 0x9f, 0x9c, 0xa8, 0x34, 0x0f, 0xf4, 0x0d, 0x38, 0x47, 0x46,
+	0xb1, 0xed, 0x5b, 0x2d, 0xe5, 0xae, 0x23, 0xae, 0xfc, 0x5a, 0xe4, 0xca, 0x6f, 0x7a, 0xde, 0x2b,
+	0xc7, 0x6d, 0x73, 0xbd, 0x08, 0xda, 0x92, 0xda, 0x5f, 0x6a, 0x8c, 0x9b, 0xe7, 0x5e, 0xe8, 0x6e,
+	0xfd, 0x39, 0xf1, 0xa1, 0xaf, 0x41, 0x96, 0x7f, 0x17, 0xc0, 0x2b, 0x79, 0x17, 0xe6, 0xd8, 0xf7,
+	0x08, 0x73, 0x1c, 0xf1, 0x26, 0x1b, 0x55, 0x2a, 0x43, 0x1c, 0x9e, 0xec, 0xc8, 0xbe, 0xe9, 0xed,
+	0xe3, 0xf6, 0x96, 0x40, 0x1e, 0xaa, 0x73, 0x3e, 0x30, 0x22, 0xc3, 0x92, 0xf7, 0x7b, 0x92, 0xf5,
+	0x27, 0xd8, 0x1f, 0xc1, 0xba, 0x5a, 0x79, 0x3f, 0x2f, 0xa6, 0xf0, 0x07, 0x43, 0x27, 0x99, 0xf5,
+	0xb1, 0x06, 0x57, 0xc5, 0xb4, 0xe5, 0x7d, 0xd3, 0xee, 0x60, 0xc1, 0xcc, 0x17, 0x95, 0x57, 0x7c,
+	0xd1, 0xe9, 0x13, 0x2e, 0xfa, 0x29, 0x54, 0x83, 0x45, 0xd3, 0x8c, 0xb4, 0xd3, 0x55, 0x17, 0x31,
+	0xf0, 0xb8, 0xf3, 0xc8, 0x1b, 0xf4, 0x37, 0xe9, 0x73, 0x9d, 0x6e, 0x90, 0x0c, 0x22, 0xbf, 0x25,
+	0xb2, 0x75, 0xb8, 0x24, 0x90, 0xf1, 0x14, 0x71, 0x18, 0x5b, 0x6c, 0x4d, 0x23, 0xb1, 0xf1, 0xfd,
+	0x20, 0x38, 0x46, 0xab, 0x52, 0xe2, 0x94, 0xf0, 0x16, 0x52, 0x2a, 0x5a, 0x12, 0x95, 0x19, 0x66,
+	0x01, 0x84, 0x67, 0xe5, 0x4a, 0x1c, 0x1b, 0x27, 0x28, 0x13, 0xc7, 0xb9, 0x0a, 0x90, 0xf1, 0x98,
+	0x0a, 0x0c, 0xa7, 0x8a, 0x61, 0x26, 0x60, 0x94, 0x88, 0x7d, 0x0b, 0xbb, 0x3d, 0xcb, 0xf3, 0x94,
+	0x27, 0x28, 0x49, 0xe2, 0x7a, 0x13, 0x32, 0x7d, 0xcc, 0x03, 0xcb, 0xc2, 0x02, 0x12, 0x36, 0xa1,
+	0x4c, 0xa6, 0xe3, 0x92, 0x4c, 0x0f, 0xae, 0x09, 0x32, 0x6c, 0x43, 0x12, 0xe9, 0x44, 0xd9, 0x14,
+	0x25, 0xe7, 0xd4, 0x90, 0x92, 0x73, 0x3a, 0x5c, 0x72, 0x0e, 0x5d, 0x76, 0x54, 0x47, 0x75, 0x36,
+	0x97, 0x9d, 0x1d, 0xb6, 0x01, 0x81, 0x7f, 0x3b, 0x1b, 0xac, 0xbf, 0xca, 0x1d, 0xd5, 0x59, 0x9d,
+	0x98, 0x98, 0xae, 0x59, 0x3c, 0x50, 0x12, 0x4d, 0xa4, 0x43, 0x91, 0x6c, 0x92, 0xa1, 0xd6, 0xe2,
+	0x33, 0x46, 0xa8, 0x4f, 0x3a, 0xe3, 0x03, 0x98, 0x0e, 0x3b, 0xe3, 0x53, 0x31, 0x35, 0x0d, 0xe3,
+	0xac, 0xfc, 0xc5, 0x8c, 0x8b, 0x35, 0x62, 0x62, 0x0d, 0x1c, 0xf5, 0xd9, 0x88, 0xf5, 0xdb, 0x12,
+	0x2b, 0x35, 0xc0, 0xd3, 0xae, 0x80, 0xa8, 0xa3, 0x48, 0xaf, 0xb1, 0x86, 0xa4, 0xf5, 0x3e, 0x5c,
+	0x88, 0x3a, 0xdf, 0xb3, 0x59, 0x44, 0x93, 0x19, 0x67, 0x92, 0x7b, 0x3e, 0x1b, 0x02, 0x2f, 0xa5,
+	0x9f, 0x54, 0x9c, 0xee, 0xd9, 0xe0, 0xfe, 0x71, 0xa8, 0x25, 0xf9, 0xe0, 0x33, 0xb5, 0xc5, 0xc0,
+	0x25, 0x9f, 0x0d, 0xd6, 0x1f, 0x68, 0x12, 0xad, 0xaa, 0x35, 0xdf, 0xf8, 0x3c, 0x68, 0xc5, 0x59,
+	0x77, 0x37, 0x50, 0x9f, 0xf9, 0xc0, 0x5b, 0xa6, 0x93, 0xbd, 0xa5, 0x9c, 0x42, 0x01, 0x85, 0xfd,
+	0x49, 0x57, 0xff, 0x65, 0x6a, 0x2f, 0x27, 0x26, 0xcf, 0x9d, 0xd3, 0x12, 0x23, 0xc7, 0x73, 0x40,
+	0x8c, 0x36, 0x62, 0xa6, 0xa2, 0x1e, 0x52, 0x67, 0xb3, 0x75, 0x3f, 0x29, 0x0f, 0x98, 0xd8, 0x39,
+	0x76, 0x36, 0x14, 0x4c, 0x98, 0x1d, 0x7e, 0x84, 0x9d, 0x09, 0x89, 0xdb, 0x75, 0xc8, 0x07, 0x59,
+	0x19, 0xe5, 0x5b, 0xb7, 0x02, 0x64, 0x37, 0x36, 0xb7, 0xb7, 0xea, 0xcb, 0x8d, 0x8a, 0x86, 0xa6,
+	0x21, 0xbb, 0xbc, 0x69, 0x18, 0xcf, 0xb7, 0x76, 0xe4, 0x0b, 0x19, 0xf9, 0x64, 0x77, 0xe1, 0x87,
+	0x19, 0x48, 0x3d, 0x7d, 0x81, 0x3e, 0x84, 0x71, 0xf6, 0x64, 0x7c, 0xc4, 0x97, 0x03, 0xb5, 0x51,
+	0xaf, 0xe2, 0xf5, 0x8b, 0xdf, 0xff, 0xe1, 0xbf, 0xff, 0x5a, 0x6a, 0x4a, 0x2f, 0xce, 0x1f, 0x2e,
+	0xce, 0x1f, 0x1c, 0xce, 0xd3, 0x43, 0xf6, 0xa1, 0x76, 0x1b, 0xf5, 0x00, 0xe4, 0xa7, 0x3a, 0xe8,
+	0x5a, 0x24, 0x41, 0x1a, 0xfd, 0xae, 0xa8, 0x36, 0x3b, 0x1c, 0x80, 0x53, 0xba, 0x42, 0x29, 0x5d,
+	0xd0, 0xa7, 0x38, 0xa5, 0x1e, 0x01, 0x09, 0xc8, 0xbd, 0x07, 0xe9, 0xad, 0x81, 0x8f, 0x86, 0x7e,
+	0xc0, 0x50, 0x1b, 0xfe, 0x2e, 0x5f, 0x3f, 0x4f, 0x31, 0x4f, 0xea, 0xc0, 0x31, 0xf7, 0x07, 0x3e,
+	0x41, 0xf9, 0x1d, 0x28, 0xa8, 0xaf, 0xea, 0x5f, 0xfb, 0x55, 0x43, 0xed, 0xf5, 0x2f, 0xf6, 0xf5,
+	0xab, 0x94, 0xd4, 0x45, 0x1d, 0x71, 0x52, 0xec, 0xdd, 0xbf, 0xba, 0x8a, 0x9d, 0x23, 0x1b, 0x0d,
+	0xfd, 0xe6, 0xa1, 0x36, 0xfc, 0x11, 0x7f, 0x6c, 0x15, 0xfe, 0x91, 0x4d, 0x50, 0x7e, 0x9b, 0xbf,
+	0xd6, 0x6f, 0xf9, 0xd1, 0x4d, 0x88, 0x3d, 0x23, 0x8e, 0x6e, 0x42, 0xfc, 0x45, 0x70, 0x6c, 0x13,
+	0x5a, 0x01, 0xc8, 0x43, 0xed, 0xf6, 0x42, 0x0b, 0xc6, 0x69, 0x72, 0x03, 0xbd, 0x14, 0x3f, 0x6a,
+	0x09, 0xa9, 0x8f, 0x21, 0x7a, 0x15, 0x7a, 0x7d, 0xa4, 0x4f, 0x53, 0x42, 0x65, 0x3d, 0x4f, 0x08,
+	0xd1, 0xac, 0xd1, 0x43, 0xed, 0xf6, 0x2d, 0xed, 0xae, 0xb6, 0xf0, 0x47, 0xe3, 0x30, 0x4e, 0x4b,
+	0xc3, 0xe8, 0x00, 0x40, 0x3e, 0x4d, 0x89, 0xae, 0x2e, 0xf6, 0xea, 0x25, 0xba, 0xba, 0xf8, 0xab,
+	0x16, 0xbd, 0x46, 0x89, 0x4e, 0xeb, 0x93, 0x84, 0x28, 0xad, 0x38, 0xcf, 0xd3, 0x02, 0x3b, 0x91,
+	0xe3, 0xc7, 0x1a, 0xaf, 0x91, 0x33, 0xab, 0x46, 0x49, 0xd8, 0x42, 0xcf, 0x52, 0xa2, 0xea, 0x90,
+	0xf0, 0x12, 0x45, 0x7f, 0x40, 0x09, 0xce, 0xeb, 0x15, 0x49, 0xd0, 0xa5, 0x10, 0x0f, 0xb5, 0xdb,
+	0x2f, 0xab, 0xfa, 0x39, 0x2e, 0xe5, 0xc8, 0x08, 0xfa, 0x2e, 0x94, 0xc3, 0x0f, 0x28, 0xd0, 0x8d,
+	0x04, 0x5a, 0xd1, 0x07, 0x19, 0xb5, 0x37, 0x46, 0x03, 0x71, 0x9e, 0x66, 0x28, 0x4f, 0x9c, 0x38,
+	0xa3, 0x7c, 0x80, 0x71, 0xdf, 0x24, 0x40, 0x7c, 0x0f, 0xd0, 0x6f, 0x6b, 0xfc, 0x0d, 0x8c, 0x7c,
+	0xff, 0x80, 0x92, 0xb0, 0xc7, 0x9e, 0x59, 0xd4, 0x6e, 0xbe, 0x06, 0x8a, 0x33, 0xf1, 0x0d, 0xca,
+	0xc4, 0x3b, 0xfa, 0xb4, 0x64, 0xc2, 0xb7, 0x7a, 0xd8, 0x77, 0x38, 0x17, 0x2f, 0xaf, 0xe8, 0x17,
+	0x43, 0xc2, 0x09, 0x8d, 0xca, 0xcd, 0x62, 0xef, 0x14, 0x12, 0x37, 0x2b, 0xf4, 0x14, 0x22, 0x71,
+	0xb3, 0xc2, 0x8f, 0x1c, 0x92, 0x36, 0x8b, 0xbf, 0x4a, 0x48, 0xd8, 0xac, 0x60, 0x64, 0xe1, 0x7f,
+	0xc7, 0x21, 0xbb, 0xcc, 0x3e, 0xf9, 0x47, 0x0e, 0xe4, 0x83, 0x62, 0x18, 0x7a, 0x4d, 0x95, 0xac,
+	0x76, 0x6d, 0xe8, 0x38, 0x67, 0xe8, 0x3a, 0x65, 0xe8, 0xb2, 0x7e, 0x81, 0x50, 0xe6, 0x7f, 0x55,
+	0x60, 0x9e, 0xa5, 0xf5, 0xe7, 0xcd, 0x76, 0x9b, 0x08, 0xe2, 0xa7, 0xa0, 0xa8, 0x96, 0xa8, 0xd1,
+	0xf5, 0xc4, 0x42, 0x95, 0x5a, 0xef, 0xae, 0xe9, 0xa3, 0x40, 0x38, 0xe5, 0x37, 0x28, 0xe5, 0x19,
+	0xfd, 0x52, 0x02, 0x65, 0x56, 0xc6, 0x0b, 0x11, 0x67, 0xb5, 0xe4, 0x64, 0xe2, 0xa1, 0xa2, 0x75,
+	0x32, 0xf1, 0x70, 0x29, 0x7a, 0x24, 0xf1, 0x01, 0x05, 0x25, 0xc4, 0x3d, 0x00, 0x59, 0xec, 0x45,
+	0x89, 0xb2, 0x54, 0xee, 0xc7, 0xb1, 0xf3, 0x27, 0x56, 0x27, 0xd6, 0x75, 0x4a, 0x96, 0xeb, 0x5d,
+	0x84, 0x6c, 0xd7, 0xf2, 0x7c, 0x66, 0x98, 0xa5, 0x50, 0xa9, 0x16, 0x25, 0xae, 0x27, 0x5c, 0xf9,
+	0xad, 0xdd, 0x18, 0x09, 0xc3, 0xa9, 0xdf, 0xa4, 0xd4, 0xaf, 0xe9, 0xb5, 0x04, 0xea, 0xa2, 0x3e,
+	0xaa, 0xdd, 0x46, 0xbf, 0x1c, 0x3c, 0xbb, 0x50, 0x2a, 0xa3, 0xe8, 0xcd, 0xe4, 0x2d, 0x8d, 0x16,
+	0x71, 0x6b, 0x5f, 0x79, 0x2d, 0x1c, 0xe7, 0xe6, 0x2d, 0xca, 0xcd, 0x0d, 0x7d, 0x26, 0x71, 0xff,
+	0x03, 0x78, 0xa2, 0xfe, 0xff, 0x91, 0x83, 0xc2, 0x33, 0xd3, 0xb2, 0x7d, 0x6c, 0x9b, 0x76, 0x0b,
+	0xa3, 0x5d, 0x18, 0xa7, 0xc1, 0x4b, 0xf4, 0x68, 0x50, 0x8b, 0x6c, 0xd1, 0xa3, 0x21, 0x54, 0x65,
+	0xd2, 0x67, 0x29, 0xf1, 0x9a, 0x7e, 0x9e, 0x10, 0xef, 0x49, 0xd4, 0xf3, 0xac, 0x3e, 0xa5, 0xdd,
+	0x46, 0x7b, 0x30, 0xc1, 0x5f, 0x32, 0x5d, 0x8e, 0xfd, 0x19, 0x01, 0x99, 0x55, 0xac, 0x5d, 0x49,
+	0x1e, 0x4c, 0xb2, 0x2e, 0x95, 0x8c, 0x47, 0xe1, 0x08, 0x9d, 0x43, 0x00, 0x59, 0x2c, 0x8d, 0xea,
+	0x58, 0xac, 0x70, 0x5b, 0x9b, 0x1d, 0x0e, 0x90, 0xb4, 0xcb, 0x2a, 0xcd, 0x76, 0x00, 0x4b, 0xe8,
+	0xfe, 0x04, 0x64, 0x56, 0x4d, 0x6f, 0x1f, 0x45, 0xa2, 0x01, 0xe5, 0x63, 0x9b, 0x5a, 0x2d, 0x69,
+	0x88, 0x53, 0xb9, 0x46, 0xa9, 0x5c, 0x62, 0xce, 0x55, 0xa5, 0x42, 0x3f, 0x27, 0x61, 0xf2, 0x63,
+	0x5f, 0xda, 0x44, 0xe5, 0x17, 0xfa, 0x6c, 0x27, 0x2a, 0xbf, 0xf0, 0xc7, 0x39, 0xc3, 0xe5, 0x47,
+	0xa8, 0x1c, 0x1c, 0x12, 0x3a, 0x7d, 0xc8, 0x89, 0x6f, 0x4c, 0x50, 0xe4, 0x55, 0x63, 0xe4, 0x43,
+	0x96, 0xda, 0xcc, 0xb0, 0x61, 0x4e, 0xed, 0x06, 0xa5, 0x76, 0x55, 0xaf, 0xc6, 0x76, 0x8b, 0x43,
+	0x3e, 0xd4, 0x6e, 0xdf, 0xd5, 0xd0, 0x77, 0x01, 0x64, 0x3d, 0x39, 0xe6, 0x15, 0xa2, 0x35, 0xea,
+	0x98, 0x57, 0x88, 0x95, 0xa2, 0xf5, 0x39, 0x4a, 0xf7, 0x96, 0x7e, 0x23, 0x4a, 0xd7, 0x77, 0x4d,
+	0xdb, 0xdb, 0xc3, 0xee, 0x1d, 0x56, 0x5b, 0xf0, 0xf6, 0xad, 0x3e, 0x59, 0xb2, 0x0b, 0xf9, 0xa0,
+	0xdc, 0x17, 0x3d, 0x01, 0xa2, 0x85, 0xc9, 0xe8, 0x09, 0x10, 0xab, 0x13, 0x86, 0x5d, 0x61, 0x48,
+	0x5f, 0x04, 0x28, 0xa1, 0x69, 0x43, 0x4e, 0x94, 0xb6, 0xa2, 0x62, 0x8e, 0x14, 0x03, 0xa3, 0x62,
+	0x8e, 0x56, 0xc4, 0x86, 0x8b, 0x99, 0x86, 0x68, 0x5d, 0xb3, 0xc3, 0xcd, 0x42, 0x96, 0xa0, 0xa2,
+	0x42, 0x8e, 0x55, 0xb6, 0xa2, 0x42, 0x8e, 0x57, 0xaf, 0x86, 0x9b, 0x85, 0xa8, 0x46, 0x99, 0x64,
+	0x7b, 0x17, 0x7e, 0xbf, 0x02, 0x19, 0x72, 0xf7, 0x22, 0x81, 0xa1, 0xcc, 0xeb, 0x45, 0x19, 0x88,
+	0x95, 0x26, 0xa2, 0x0c, 0xc4, 0x53, 0x82, 0xe1, 0xc0, 0x90, 0xdc, 0xcb, 0xe7, 0x59, 0xc2, 0x8c,
+	0xac, 0xd6, 0x81, 0x82, 0x92, 0xef, 0x43, 0x09, 0xc8, 0xc2, 0xa5, 0x8e, 0x68, 0xa8, 0x91, 0x90,
+	0x2c, 0xd4, 0x2f, 0x53, 0x7a, 0xe7, 0x59, 0xa8, 0x41, 0xe9, 0xb5, 0x19, 0x04, 0x21, 0xc8, 0x57,
+	0xc7, 0x3d, 0x5c, 0xc2, 0xea, 0xc2, 0x5e, 0x6e, 0x76, 0x38, 0xc0, 0xd0, 0xd5, 0x49, 0x17, 0xf7,
+	0x0a, 0x8a, 0x6a, 0x8e, 0x0f, 0x25, 0x30, 0x1f, 0x29, 0xc6, 0x44, 0xcf, 0xf0, 0xa4, 0x14, 0x61,
+	0xd8, 0x87, 0x53, 0x92, 0xa6, 0x02, 0x46, 0x08, 0x77, 0x21, 0xcb, 0x73, 0x7d, 0x49, 0x22, 0x0d,
+	0xd7, 0x6b, 0x92, 0x44, 0x1a, 0x49, 0x14, 0x86, 0x6f, 0x2e, 0x94, 0xe2, 0xc0, 0x93, 0x71, 0x12,
+	0xa7, 0xf6, 0x04, 0xfb, 0xc3, 0xa8, 0xc9, 0xfc, 0xfc, 0x30, 0x6a, 0x4a, 0x2a, 0x68, 0x18, 0xb5,
+	0x0e, 0xf6, 0xb9, 0xdf, 0x13, 0x79, 0x14, 0x34, 0x04, 0x99, 0x1a, 0x9b, 0xe8, 0xa3, 0x40, 0x92,
+	0x2e, 0x96, 0x92, 0xa0, 0x08, 0x4c, 0x8e, 0x00, 0x64, 0xde, 0x31, 0x7a, 0x5b, 0x48, 0x2c, 0x09,
+	0x45, 0x6f, 0x0b, 0xc9, 0xa9, 0xcb, 0xf0, 0x59, 0x22, 0xe9, 0xb2, 0x7b, 0x2d, 0xa1, 0xfc, 0xa9,
+	0x06, 0x28, 0x9e, 0x99, 0x44, 0x5f, 0x4d, 0xc6, 0x9e, 0x58, 0x5e, 0xaa, 0xbd, 0x7d, 0x32, 0xe0,
+	0xa4, 0x83, 0x47, 0xb2, 0xd4, 0xa2, 0xd0, 0xfd, 0x57, 0x84, 0xa9, 0xef, 0x69, 0x50, 0x0a, 0x65,
+	0x33, 0xa3, 0x21, 0xd2, 0xb0, 0x1a, 0x53, 0x34, 0x44, 0x1a, 0x9a, 0x16, 0x0d, 0x5f, 0xa3, 0x14,
+	0x0d, 0x10, 0xf7, 0xc9, 0x9f, 0xd5, 0xa0, 0x1c, 0x4e, 0x7a, 0xa2, 0x21, 0xb8, 0x63, 0xa5, 0xa9,
+	0xda, 0xad, 0xd7, 0x03, 0x8e, 0xde, 0x1e, 0x79, 0x95, 0xec, 0x42, 0x96, 0x67, 0x47, 0x93, 0x14,
+	0x3f, 0x5c, 0xcb, 0x4a, 0x52, 0xfc, 0x48, 0x6a, 0x35, 0x41, 0xf1, 0x5d, 0xa7, 0x8b, 0x15, 0x33,
+	0xe3, 0x49, 0xd3, 0x61, 0xd4, 0x46, 0x9b, 0x59, 0x24, 0xe3, 0x3a, 0x8c, 0x9a, 0x34, 0x33, 0x91,
+	0x1b, 0x45, 0x43, 0x90, 0xbd, 0xc6, 0xcc, 0xa2, 0xa9, 0xd5, 0x04, 0x33, 0xa3, 0x04, 0x15, 0x33,
+	0x93, 0x39, 0xcb, 0x24, 0x33, 0x8b, 0x95, 0xdd, 0x92, 0xcc, 0x2c, 0x9e, 0xf6, 0x4c, 0xd8, 0x47,
+	0x4a, 0x37, 0x64, 0x66, 0xe7, 0x12, 0xb2, 0x9a, 0xe8, 0xed, 0x21, 0x42, 0x4c, 0x2c, 0xe2, 0xd5,
+	0xee, 0x9c, 0x10, 0x7a, 0xa8, 0x8e, 0x33, 0xf1, 0x0b, 0x1d, 0xff, 0x75, 0x0d, 0xa6, 0x93, 0x12,
+	0xa1, 0x68, 0x08, 0x9d, 0x21, 0x35, 0xbf, 0xda, 0xdc, 0x49, 0xc1, 0x47, 0x4b, 0x2b, 0xd0, 0xfa,
+	0x47, 0x9d, 0x4f, 0xeb, 0xf3, 0x2f, 0xaf, 0xc1, 0x55, 0x98, 0xa8, 0xf7, 0xad, 0xa7, 0xf8, 0x18,
+	0x9d, 0xcb, 0xa5, 0x6a, 0x25, 0x82, 0xd7, 0x71, 0xad, 0x8f, 0xe8, 0x5f, 0xf5, 0x9b, 0x4d, 0xed,
+	0x16, 0x01, 0x02, 0x80, 0xb1, 0xbf, 0xfd, 0x6c, 0x46, 0xfb, 0xc7, 0xcf, 0x66, 0xb4, 0x7f, 0xf9,
+	0x6c, 0x46, 0xfb, 0x8d, 0x7f, 0x9b, 0x19, 0x7b, 0x79, 0xa3, 0xe3, 0x50, 0xb6, 0xe6, 0x2c, 0x67,
+	0x5e, 0xfe, 0xa5, 0xc1, 0xc5, 0x79, 0x95, 0xd5, 0xdd, 0x09, 0xfa, 0xa7, 0x01, 0x17, 0xff, 0x2f,
+	0x00, 0x00, 0xff, 0xff, 0xcd, 0xeb, 0x59, 0x5e, 0xf1, 0x50, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6495,6 +7792,9 @@ const _ = grpc.SupportPackageIsVersion4
 type KVClient interface {
 	// Range gets the keys in the range from the key-value store.
 	Range(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (*RangeResponse, error)
+	// MultiRange gets the keys in several ranges from the key-value store.
+	// All ranges are read at the same revision.
+	MultiRange(ctx context.Context, in *MultiRangeRequest, opts ...grpc.CallOption) (*MultiRangeResponse, error)
 	// Put puts the given key into the key-value store.
 	// A put request increments the revision of the key-value store
 	// and generates one event in the event history.
@@ -6531,6 +7831,15 @@ func (c *kVClient) Range(ctx context.Context, in *RangeRequest, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *kVClient) MultiRange(ctx context.Context, in *MultiRangeRequest, opts ...grpc.CallOption) (*MultiRangeResponse, error) {
+	out := new(MultiRangeResponse)
+	err := c.cc.Invoke(ctx, "/etcdserverpb.KV/MultiRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kVClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
 	out := new(PutResponse)
 	err := c.cc.Invoke(ctx, "/etcdserverpb.KV/Put", in, out, opts...)
@@ -6571,6 +7880,9 @@ func (c *kVClient) Compact(ctx context.Context, in *CompactionRequest, opts ...g
 type KVServer interface {
 	// Range gets the keys in the range from the key-value store.
 	Range(context.Context, *RangeRequest) (*RangeResponse, error)
+	// MultiRange gets the keys in several ranges from the key-value store.
+	// All ranges are read at the same revision.
+	MultiRange(context.Context, *MultiRangeRequest) (*MultiRangeResponse, error)
 	// Put puts the given key into the key-value store.
 	// A put request increments the revision of the key-value store
 	// and generates one event in the event history.
@@ -6597,6 +7909,9 @@ type UnimplementedKVServer struct {
 func (*UnimplementedKVServer) Range(ctx context.Context, req *RangeRequest) (*RangeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Range not implemented")
 }
+func (*UnimplementedKVServer) MultiRange(ctx context.Context, req *MultiRangeRequest) (*MultiRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiRange not implemented")
+}
 func (*UnimplementedKVServer) Put(ctx context.Context, req *PutRequest) (*PutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
 }
@@ -6632,6 +7947,24 @@ func _KV_Range_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KV_MultiRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServer).MultiRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/etcdserverpb.KV/MultiRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServer).MultiRange(ctx, req.(*MultiRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KV_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PutRequest)
 	if err := dec(in); err != nil {
@@ -6712,6 +8045,10 @@ var _KV_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Range",
 			Handler:    _KV_Range_Handler,
 		},
+		{
+			MethodName: "MultiRange",
+			Handler:    _KV_MultiRange_Handler,
+		},
 		{
 			MethodName: "Put",
 			Handler:    _KV_Put_Handler,
@@ -7126,6 +8463,8 @@ type ClusterClient interface {
 	MemberList(ctx context.Context, in *MemberListRequest, opts ...grpc.CallOption) (*MemberListResponse, error)
 	// MemberPromote promotes a member from raft learner (non-voting) to raft voting member.
 	MemberPromote(ctx context.Context, in *MemberPromoteRequest, opts ...grpc.CallOption) (*MemberPromoteResponse, error)
+	// MemberReconfigure adds, removes and promotes members at once through raft joint consensus.
+	MemberReconfigure(ctx context.Context, in *MemberReconfigureRequest, opts ...grpc.CallOption) (*MemberReconfigureResponse, error)
 }
 
 type clusterClient struct {
@@ -7181,6 +8520,15 @@ func (c *clusterClient) MemberPromote(ctx context.Context, in *MemberPromoteRequ
 	return out, nil
 }
 
+func (c *clusterClient) MemberReconfigure(ctx context.Context, in *MemberReconfigureRequest, opts ...grpc.CallOption) (*MemberReconfigureResponse, error) {
+	out := new(MemberReconfigureResponse)
+	err := c.cc.Invoke(ctx, "/etcdserverpb.Cluster/MemberReconfigure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServer is the server API for Cluster service.
 type ClusterServer interface {
 	// MemberAdd adds a member into the cluster.
@@ -7193,6 +8541,8 @@ type ClusterServer interface {
 	MemberList(context.Context, *MemberListRequest) (*MemberListResponse, error)
 	// MemberPromote promotes a member from raft learner (non-voting) to raft voting member.
 	MemberPromote(context.Context, *MemberPromoteRequest) (*MemberPromoteResponse, error)
+	// MemberReconfigure adds, removes and promotes members at once through raft joint consensus.
+	MemberReconfigure(context.Context, *MemberReconfigureRequest) (*MemberReconfigureResponse, error)
 }
 
 // UnimplementedClusterServer can be embedded to have forward compatible implementations.
@@ -7214,6 +8564,9 @@ func (*UnimplementedClusterServer) MemberList(ctx context.Context, req *MemberLi
 func (*UnimplementedClusterServer) MemberPromote(ctx context.Context, req *MemberPromoteRequest) (*MemberPromoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MemberPromote not implemented")
 }
+func (*UnimplementedClusterServer) MemberReconfigure(ctx context.Context, req *MemberReconfigureRequest) (*MemberReconfigureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemberReconfigure not implemented")
+}
 
 func RegisterClusterServer(s *grpc.Server, srv ClusterServer) {
 	s.RegisterService(&_Cluster_serviceDesc, srv)
@@ -7309,6 +8662,24 @@ func _Cluster_MemberPromote_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_MemberReconfigure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemberReconfigureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).MemberReconfigure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/etcdserverpb.Cluster/MemberReconfigure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).MemberReconfigure(ctx, req.(*MemberReconfigureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cluster_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "etcdserverpb.Cluster",
 	HandlerType: (*ClusterServer)(nil),
@@ -7333,6 +8704,10 @@ var _Cluster_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MemberPromote",
 			Handler:    _Cluster_MemberPromote_Handler,
 		},
+		{
+			MethodName: "MemberReconfigure",
+			Handler:    _Cluster_MemberReconfigure_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
@@ -7358,7 +8733,8 @@ type MaintenanceClient interface {
 	// HashKV computes the hash of all MVCC keys up to a given revision.
 	// It only iterates "key" bucket in backend storage.
 	HashKV(ctx context.Context, in *HashKVRequest, opts ...grpc.CallOption) (*HashKVResponse, error)
-	// Snapshot sends a snapshot of the entire backend from a member over a stream to a client.
+	// Snapshot sends a snapshot of the entire backend from a member over a stream to a client,
+	// or a delta snapshot since a revision.
 	Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (Maintenance_SnapshotClient, error)
 	// MoveLeader requests current leader node to transfer its leadership to transferee.
 	MoveLeader(ctx context.Context, in *MoveLeaderRequest, opts ...grpc.CallOption) (*MoveLeaderResponse, error)
@@ -7366,6 +8742,12 @@ type MaintenanceClient interface {
 	// on the cluster version.
 	// Supported since etcd 3.5.
 	Downgrade(ctx context.Context, in *DowngradeRequest, opts ...grpc.CallOption) (*DowngradeResponse, error)
+	// WatchLag reports how far behind the watch streams of the member are.
+	// Supported since etcd 3.6.
+	WatchLag(ctx context.Context, in *WatchLagRequest, opts ...grpc.CallOption) (*WatchLagResponse, error)
+	// RevisionAt looks up the revision that was current at a given time, as recorded by the leader.
+	// Supported since etcd 3.6.
+	RevisionAt(ctx context.Context, in *RevisionAtRequest, opts ...grpc.CallOption) (*RevisionAtResponse, error)
 }
 
 type maintenanceClient struct {
@@ -7471,6 +8853,24 @@ func (c *maintenanceClient) Downgrade(ctx context.Context, in *DowngradeRequest,
 	return out, nil
 }
 
+func (c *maintenanceClient) WatchLag(ctx context.Context, in *WatchLagRequest, opts ...grpc.CallOption) (*WatchLagResponse, error) {
+	out := new(WatchLagResponse)
+	err := c.cc.Invoke(ctx, "/etcdserverpb.Maintenance/WatchLag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceClient) RevisionAt(ctx context.Context, in *RevisionAtRequest, opts ...grpc.CallOption) (*RevisionAtResponse, error) {
+	out := new(RevisionAtResponse)
+	err := c.cc.Invoke(ctx, "/etcdserverpb.Maintenance/RevisionAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MaintenanceServer is the server API for Maintenance service.
 type MaintenanceServer interface {
 	// Alarm activates, deactivates, and queries alarms regarding cluster health.
@@ -7489,7 +8889,8 @@ type MaintenanceServer interface {
 	// HashKV computes the hash of all MVCC keys up to a given revision.
 	// It only iterates "key" bucket in backend storage.
 	HashKV(context.Context, *HashKVRequest) (*HashKVResponse, error)
-	// Snapshot sends a snapshot of the entire backend from a member over a stream to a client.
+	// Snapshot sends a snapshot of the entire backend from a member over a stream to a client,
+	// or a delta snapshot since a revision.
 	Snapshot(*SnapshotRequest, Maintenance_SnapshotServer) error
 	// MoveLeader requests current leader node to transfer its leadership to transferee.
 	MoveLeader(context.Context, *MoveLeaderRequest) (*MoveLeaderResponse, error)
@@ -7497,6 +8898,12 @@ type MaintenanceServer interface {
 	// on the cluster version.
 	// Supported since etcd 3.5.
 	Downgrade(context.Context, *DowngradeRequest) (*DowngradeResponse, error)
+	// WatchLag reports how far behind the watch streams of the member are.
+	// Supported since etcd 3.6.
+	WatchLag(context.Context, *WatchLagRequest) (*WatchLagResponse, error)
+	// RevisionAt looks up the revision that was current at a given time, as recorded by the leader.
+	// Supported since etcd 3.6.
+	RevisionAt(context.Context, *RevisionAtRequest) (*RevisionAtResponse, error)
 }
 
 // UnimplementedMaintenanceServer can be embedded to have forward compatible implementations.
@@ -7527,6 +8934,12 @@ func (*UnimplementedMaintenanceServer) MoveLeader(ctx context.Context, req *Move
 func (*UnimplementedMaintenanceServer) Downgrade(ctx context.Context, req *DowngradeRequest) (*DowngradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Downgrade not implemented")
 }
+func (*UnimplementedMaintenanceServer) WatchLag(ctx context.Context, req *WatchLagRequest) (*WatchLagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchLag not implemented")
+}
+func (*UnimplementedMaintenanceServer) RevisionAt(ctx context.Context, req *RevisionAtRequest) (*RevisionAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevisionAt not implemented")
+}
 
 func RegisterMaintenanceServer(s *grpc.Server, srv MaintenanceServer) {
 	s.RegisterService(&_Maintenance_serviceDesc, srv)
@@ -7679,6 +9092,42 @@ func _Maintenance_Downgrade_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Maintenance_WatchLag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchLagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).WatchLag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/etcdserverpb.Maintenance/WatchLag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).WatchLag(ctx, req.(*WatchLagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Maintenance_RevisionAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).RevisionAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/etcdserverpb.Maintenance/RevisionAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).RevisionAt(ctx, req.(*RevisionAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Maintenance_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "etcdserverpb.Maintenance",
 	HandlerType: (*MaintenanceServer)(nil),
@@ -7711,6 +9160,14 @@ var _Maintenance_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Downgrade",
 			Handler:    _Maintenance_Downgrade_Handler,
 		},
+		{
+			MethodName: "WatchLag",
+			Handler:    _Maintenance_WatchLag_Handler,
+		},
+		{
+			MethodName: "RevisionAt",
+			Handler:    _Maintenance_RevisionAt_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -8475,6 +9932,41 @@ func (m *RangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MaxStaleness != nil {
+		{
+			size, err := m.MaxStaleness.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ContinueToken) > 0 {
+		i -= len(m.ContinueToken)
+		copy(dAtA[i:], m.ContinueToken)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.ContinueToken)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.MaxCreateRevision != 0 {
 		i = encodeVarintRpc(dAtA, i, uint64(m.MaxCreateRevision))
 		i--
@@ -8562,6 +10054,153 @@ func (m *RangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Staleness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Staleness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Staleness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Revisions != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Revisions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Milliseconds != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Milliseconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TargetUnion != nil {
+		{
+			size := m.TargetUnion.Size()
+			i -= size
+			if _, err := m.TargetUnion.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Target != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Target))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Result != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeFilter_Version) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeFilter_Version) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintRpc(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+func (m *RangeFilter_CreateRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeFilter_CreateRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintRpc(dAtA, i, uint64(m.CreateRevision))
+	i--
+	dAtA[i] = 0x20
+	return len(dAtA) - i, nil
+}
+func (m *RangeFilter_ModRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeFilter_ModRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintRpc(dAtA, i, uint64(m.ModRevision))
+	i--
+	dAtA[i] = 0x28
+	return len(dAtA) - i, nil
+}
+func (m *RangeFilter_Value) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeFilter_Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Value != nil {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *RangeFilter_Lease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeFilter_Lease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintRpc(dAtA, i, uint64(m.Lease))
+	i--
+	dAtA[i] = 0x38
+	return len(dAtA) - i, nil
+}
 func (m *RangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8586,6 +10225,13 @@ func (m *RangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ContinueToken) > 0 {
+		i -= len(m.ContinueToken)
+		copy(dAtA[i:], m.ContinueToken)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.ContinueToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Count != 0 {
 		i = encodeVarintRpc(dAtA, i, uint64(m.Count))
 		i--
@@ -8630,7 +10276,7 @@ func (m *RangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PutRequest) Marshal() (dAtA []byte, err error) {
+func (m *MultiRangeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8640,12 +10286,12 @@ func (m *PutRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PutRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiRangeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8654,19 +10300,128 @@ func (m *PutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IgnoreLease {
+	if m.Serializable {
 		i--
-		if m.IgnoreLease {
+		if m.Serializable {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x18
 	}
-	if m.IgnoreValue {
+	if m.Revision != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Revision))
 		i--
-		if m.IgnoreValue {
+		dAtA[i] = 0x10
+	}
+	if len(m.Ranges) > 0 {
+		for iNdEx := len(m.Ranges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ranges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IgnoreLease {
+		i--
+		if m.IgnoreLease {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IgnoreValue {
+		i--
+		if m.IgnoreValue {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -9626,6 +11381,11 @@ func (m *SnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SinceRevision != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.SinceRevision))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -9786,6 +11546,27 @@ func (m *WatchRequest_ProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	}
 	return len(dAtA) - i, nil
 }
+func (m *WatchRequest_CreditRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchRequest_CreditRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CreditRequest != nil {
+		{
+			size, err := m.CreditRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
 func (m *WatchCreateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9810,6 +11591,52 @@ func (m *WatchCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.CoalesceWindowMs != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.CoalesceWindowMs))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Coalesce {
+		i--
+		if m.Coalesce {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ResumeToken) > 0 {
+		i -= len(m.ResumeToken)
+		copy(dAtA[i:], m.ResumeToken)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.ResumeToken)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.InitialState {
+		i--
+		if m.InitialState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.EventFilters) > 0 {
+		for iNdEx := len(m.EventFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Fragment {
 		i--
 		if m.Fragment {
@@ -9836,20 +11663,20 @@ func (m *WatchCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x30
 	}
 	if len(m.Filters) > 0 {
-		dAtA22 := make([]byte, len(m.Filters)*10)
-		var j21 int
+		dAtA25 := make([]byte, len(m.Filters)*10)
+		var j24 int
 		for _, num := range m.Filters {
 			for num >= 1<<7 {
-				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j21++
+				j24++
 			}
-			dAtA22[j21] = uint8(num)
-			j21++
+			dAtA25[j24] = uint8(num)
+			j24++
 		}
-		i -= j21
-		copy(dAtA[i:], dAtA22[:j21])
-		i = encodeVarintRpc(dAtA, i, uint64(j21))
+		i -= j24
+		copy(dAtA[i:], dAtA25[:j24])
+		i = encodeVarintRpc(dAtA, i, uint64(j24))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -9885,6 +11712,82 @@ func (m *WatchCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WatchFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Filter != nil {
+		{
+			size := m.Filter.Size()
+			i -= size
+			if _, err := m.Filter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchFilter_KeyGlob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchFilter_KeyGlob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.KeyGlob)
+	copy(dAtA[i:], m.KeyGlob)
+	i = encodeVarintRpc(dAtA, i, uint64(len(m.KeyGlob)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *WatchFilter_KeyRegex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchFilter_KeyRegex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.KeyRegex)
+	copy(dAtA[i:], m.KeyRegex)
+	i = encodeVarintRpc(dAtA, i, uint64(len(m.KeyRegex)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *WatchFilter_Lease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchFilter_Lease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintRpc(dAtA, i, uint64(m.Lease))
+	i--
+	dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
 func (m *WatchCancelRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9944,6 +11847,38 @@ func (m *WatchProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WatchCreditRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchCreditRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchCreditRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Credits != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Credits))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WatchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9982,6 +11917,33 @@ func (m *WatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x5a
 		}
 	}
+	if len(m.ResumeToken) > 0 {
+		i -= len(m.ResumeToken)
+		copy(dAtA[i:], m.ResumeToken)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.ResumeToken)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.InitialStateDone {
+		i--
+		if m.InitialStateDone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.InitialState {
+		i--
+		if m.InitialState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Fragment {
 		i--
 		if m.Fragment {
@@ -10647,7 +12609,27 @@ func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IsLearner {
+	if m.AutoPromote {
+		i--
+		if m.AutoPromote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IsWitness {
+		i--
+		if m.IsWitness {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsLearner {
 		i--
 		if m.IsLearner {
 			dAtA[i] = 1
@@ -10714,6 +12696,26 @@ func (m *MemberAddRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AutoPromote {
+		i--
+		if m.AutoPromote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsWitness {
+		i--
+		if m.IsWitness {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.IsLearner {
 		i--
 		if m.IsLearner {
@@ -11155,6 +13157,150 @@ func (m *MemberPromoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MemberReconfigureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberReconfigureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberReconfigureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Promote) > 0 {
+		dAtA40 := make([]byte, len(m.Promote)*10)
+		var j39 int
+		for _, num := range m.Promote {
+			for num >= 1<<7 {
+				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j39++
+			}
+			dAtA40[j39] = uint8(num)
+			j39++
+		}
+		i -= j39
+		copy(dAtA[i:], dAtA40[:j39])
+		i = encodeVarintRpc(dAtA, i, uint64(j39))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Remove) > 0 {
+		dAtA42 := make([]byte, len(m.Remove)*10)
+		var j41 int
+		for _, num := range m.Remove {
+			for num >= 1<<7 {
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA42[j41] = uint8(num)
+			j41++
+		}
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintRpc(dAtA, i, uint64(j41))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Add[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemberReconfigureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberReconfigureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberReconfigureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Added[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DefragmentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11179,6 +13325,11 @@ func (m *DefragmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Mode != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -11509,7 +13660,7 @@ func (m *DowngradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
+func (m *WatchLagRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11519,12 +13670,12 @@ func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *WatchLagRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WatchLagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11536,7 +13687,7 @@ func (m *StatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *WatchStreamLag) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11546,12 +13697,12 @@ func (m *StatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *WatchStreamLag) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WatchStreamLag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11560,90 +13711,52 @@ func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.DbSizeQuota != 0 {
-		i = encodeVarintRpc(dAtA, i, uint64(m.DbSizeQuota))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.StorageVersion) > 0 {
-		i -= len(m.StorageVersion)
-		copy(dAtA[i:], m.StorageVersion)
-		i = encodeVarintRpc(dAtA, i, uint64(len(m.StorageVersion)))
+	if m.Credits != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Credits))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x38
 	}
-	if m.IsLearner {
+	if m.FlowControl {
 		i--
-		if m.IsLearner {
+		if m.FlowControl {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x50
-	}
-	if m.DbSizeInUse != 0 {
-		i = encodeVarintRpc(dAtA, i, uint64(m.DbSizeInUse))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.Errors) > 0 {
-		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Errors[iNdEx])
-			copy(dAtA[i:], m.Errors[iNdEx])
-			i = encodeVarintRpc(dAtA, i, uint64(len(m.Errors[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if m.RaftAppliedIndex != 0 {
-		i = encodeVarintRpc(dAtA, i, uint64(m.RaftAppliedIndex))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.RaftTerm != 0 {
-		i = encodeVarintRpc(dAtA, i, uint64(m.RaftTerm))
-		i--
 		dAtA[i] = 0x30
 	}
-	if m.RaftIndex != 0 {
-		i = encodeVarintRpc(dAtA, i, uint64(m.RaftIndex))
+	if m.BufferedBytes != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.BufferedBytes))
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.Leader != 0 {
-		i = encodeVarintRpc(dAtA, i, uint64(m.Leader))
+	if m.RevisionGap != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.RevisionGap))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.DbSize != 0 {
-		i = encodeVarintRpc(dAtA, i, uint64(m.DbSize))
+	if m.Watchers != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Watchers))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintRpc(dAtA, i, uint64(len(m.Version)))
+	if len(m.RemoteAddr) > 0 {
+		i -= len(m.RemoteAddr)
+		copy(dAtA[i:], m.RemoteAddr)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.RemoteAddr)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRpc(dAtA, i, uint64(size))
-		}
+	if m.StreamId != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.StreamId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AuthEnableRequest) Marshal() (dAtA []byte, err error) {
+func (m *WatchLagResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11653,12 +13766,12 @@ func (m *AuthEnableRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AuthEnableRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *WatchLagResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AuthEnableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WatchLagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11667,10 +13780,36 @@ func (m *AuthEnableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AuthDisableRequest) Marshal() (dAtA []byte, err error) {
+func (m *RevisionAtRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11680,12 +13819,12 @@ func (m *AuthDisableRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AuthDisableRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RevisionAtRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AuthDisableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RevisionAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11694,10 +13833,15 @@ func (m *AuthDisableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Timestamp != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AuthStatusRequest) Marshal() (dAtA []byte, err error) {
+func (m *RevisionAtResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11707,12 +13851,12 @@ func (m *AuthStatusRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AuthStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RevisionAtResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AuthStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RevisionAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11721,15 +13865,252 @@ func (m *AuthStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AuthenticateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Timestamp != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Revision != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DbSizeQuota != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.DbSizeQuota))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.StorageVersion) > 0 {
+		i -= len(m.StorageVersion)
+		copy(dAtA[i:], m.StorageVersion)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.StorageVersion)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.IsLearner {
+		i--
+		if m.IsLearner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DbSizeInUse != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.DbSizeInUse))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintRpc(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.RaftAppliedIndex != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.RaftAppliedIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RaftTerm != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.RaftTerm))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RaftIndex != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.RaftIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Leader != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Leader))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DbSize != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.DbSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthEnableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthEnableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthEnableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthDisableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthDisableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthDisableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -13081,20 +15462,121 @@ func (m *RangeRequest) Size() (n int) {
 	if m.MaxCreateRevision != 0 {
 		n += 1 + sovRpc(uint64(m.MaxCreateRevision))
 	}
+	l = len(m.ContinueToken)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	if m.MaxStaleness != nil {
+		l = m.MaxStaleness.Size()
+		n += 2 + l + sovRpc(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RangeResponse) Size() (n int) {
+func (m *Staleness) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
+	if m.Milliseconds != 0 {
+		n += 1 + sovRpc(uint64(m.Milliseconds))
+	}
+	if m.Revisions != 0 {
+		n += 1 + sovRpc(uint64(m.Revisions))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RangeFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovRpc(uint64(m.Result))
+	}
+	if m.Target != 0 {
+		n += 1 + sovRpc(uint64(m.Target))
+	}
+	if m.TargetUnion != nil {
+		n += m.TargetUnion.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RangeFilter_Version) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovRpc(uint64(m.Version))
+	return n
+}
+func (m *RangeFilter_CreateRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovRpc(uint64(m.CreateRevision))
+	return n
+}
+func (m *RangeFilter_ModRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovRpc(uint64(m.ModRevision))
+	return n
+}
+func (m *RangeFilter_Value) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = len(m.Value)
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	return n
+}
+func (m *RangeFilter_Lease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovRpc(uint64(m.Lease))
+	return n
+}
+func (m *RangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovRpc(uint64(l))
 	}
 	if len(m.Kvs) > 0 {
@@ -13109,6 +15591,56 @@ func (m *RangeResponse) Size() (n int) {
 	if m.Count != 0 {
 		n += 1 + sovRpc(uint64(m.Count))
 	}
+	l = len(m.ContinueToken)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MultiRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ranges) > 0 {
+		for _, e := range m.Ranges {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	if m.Revision != 0 {
+		n += 1 + sovRpc(uint64(m.Revision))
+	}
+	if m.Serializable {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MultiRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13584,6 +16116,9 @@ func (m *SnapshotRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.SinceRevision != 0 {
+		n += 1 + sovRpc(uint64(m.SinceRevision))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13668,6 +16203,18 @@ func (m *WatchRequest_ProgressRequest) Size() (n int) {
 	}
 	return n
 }
+func (m *WatchRequest_CreditRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreditRequest != nil {
+		l = m.CreditRequest.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	return n
+}
 func (m *WatchCreateRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13704,12 +16251,75 @@ func (m *WatchCreateRequest) Size() (n int) {
 	if m.Fragment {
 		n += 2
 	}
+	if len(m.EventFilters) > 0 {
+		for _, e := range m.EventFilters {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	if m.InitialState {
+		n += 2
+	}
+	l = len(m.ResumeToken)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.Coalesce {
+		n += 2
+	}
+	if m.CoalesceWindowMs != 0 {
+		n += 1 + sovRpc(uint64(m.CoalesceWindowMs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Filter != nil {
+		n += m.Filter.Size()
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
+func (m *WatchFilter_KeyGlob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyGlob)
+	n += 1 + l + sovRpc(uint64(l))
+	return n
+}
+func (m *WatchFilter_KeyRegex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyRegex)
+	n += 1 + l + sovRpc(uint64(l))
+	return n
+}
+func (m *WatchFilter_Lease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovRpc(uint64(m.Lease))
+	return n
+}
 func (m *WatchCancelRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13737,6 +16347,21 @@ func (m *WatchProgressRequest) Size() (n int) {
 	return n
 }
 
+func (m *WatchCreditRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credits != 0 {
+		n += 1 + sovRpc(uint64(m.Credits))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *WatchResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13766,6 +16391,16 @@ func (m *WatchResponse) Size() (n int) {
 	if m.Fragment {
 		n += 2
 	}
+	if m.InitialState {
+		n += 2
+	}
+	if m.InitialStateDone {
+		n += 2
+	}
+	l = len(m.ResumeToken)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
 	if len(m.Events) > 0 {
 		for _, e := range m.Events {
 			l = e.Size()
@@ -14068,6 +16703,12 @@ func (m *Member) Size() (n int) {
 	if m.IsLearner {
 		n += 2
 	}
+	if m.IsWitness {
+		n += 2
+	}
+	if m.AutoPromote {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14089,6 +16730,12 @@ func (m *MemberAddRequest) Size() (n int) {
 	if m.IsLearner {
 		n += 2
 	}
+	if m.IsWitness {
+		n += 2
+	}
+	if m.AutoPromote {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14275,19 +16922,39 @@ func (m *MemberPromoteResponse) Size() (n int) {
 	return n
 }
 
-func (m *DefragmentRequest) Size() (n int) {
+func (m *MemberReconfigureRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Add) > 0 {
+		for _, e := range m.Add {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		l = 0
+		for _, e := range m.Remove {
+			l += sovRpc(uint64(e))
+		}
+		n += 1 + sovRpc(uint64(l)) + l
+	}
+	if len(m.Promote) > 0 {
+		l = 0
+		for _, e := range m.Promote {
+			l += sovRpc(uint64(e))
+		}
+		n += 1 + sovRpc(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DefragmentResponse) Size() (n int) {
+func (m *MemberReconfigureResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -14297,20 +16964,32 @@ func (m *DefragmentResponse) Size() (n int) {
 		l = m.Header.Size()
 		n += 1 + l + sovRpc(uint64(l))
 	}
+	if len(m.Added) > 0 {
+		for _, e := range m.Added {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *MoveLeaderRequest) Size() (n int) {
+func (m *DefragmentRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.TargetID != 0 {
-		n += 1 + sovRpc(uint64(m.TargetID))
+	if m.Mode != 0 {
+		n += 1 + sovRpc(uint64(m.Mode))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -14318,7 +16997,7 @@ func (m *MoveLeaderRequest) Size() (n int) {
 	return n
 }
 
-func (m *MoveLeaderResponse) Size() (n int) {
+func (m *DefragmentResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -14334,20 +17013,14 @@ func (m *MoveLeaderResponse) Size() (n int) {
 	return n
 }
 
-func (m *AlarmRequest) Size() (n int) {
+func (m *MoveLeaderRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Action != 0 {
-		n += 1 + sovRpc(uint64(m.Action))
-	}
-	if m.MemberID != 0 {
-		n += 1 + sovRpc(uint64(m.MemberID))
-	}
-	if m.Alarm != 0 {
-		n += 1 + sovRpc(uint64(m.Alarm))
+	if m.TargetID != 0 {
+		n += 1 + sovRpc(uint64(m.TargetID))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -14355,15 +17028,52 @@ func (m *AlarmRequest) Size() (n int) {
 	return n
 }
 
-func (m *AlarmMember) Size() (n int) {
+func (m *MoveLeaderResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MemberID != 0 {
-		n += 1 + sovRpc(uint64(m.MemberID))
-	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlarmRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovRpc(uint64(m.Action))
+	}
+	if m.MemberID != 0 {
+		n += 1 + sovRpc(uint64(m.MemberID))
+	}
+	if m.Alarm != 0 {
+		n += 1 + sovRpc(uint64(m.Alarm))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlarmMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MemberID != 0 {
+		n += 1 + sovRpc(uint64(m.MemberID))
+	}
 	if m.Alarm != 0 {
 		n += 1 + sovRpc(uint64(m.Alarm))
 	}
@@ -14434,6 +17144,111 @@ func (m *DowngradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *WatchLagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchStreamLag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovRpc(uint64(m.StreamId))
+	}
+	l = len(m.RemoteAddr)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.Watchers != 0 {
+		n += 1 + sovRpc(uint64(m.Watchers))
+	}
+	if m.RevisionGap != 0 {
+		n += 1 + sovRpc(uint64(m.RevisionGap))
+	}
+	if m.BufferedBytes != 0 {
+		n += 1 + sovRpc(uint64(m.BufferedBytes))
+	}
+	if m.FlowControl {
+		n += 2
+	}
+	if m.Credits != 0 {
+		n += 1 + sovRpc(uint64(m.Credits))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchLagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevisionAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovRpc(uint64(m.Timestamp))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevisionAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovRpc(uint64(m.Revision))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRpc(uint64(m.Timestamp))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *StatusRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15544,62 +18359,11 @@ func (m *RangeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RangeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RangeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -15609,31 +18373,29 @@ func (m *RangeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ContinueToken = append(m.ContinueToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContinueToken == nil {
+				m.ContinueToken = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15660,16 +18422,16 @@ func (m *RangeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kvs = append(m.Kvs, &mvccpb.KeyValue{})
-			if err := m.Kvs[len(m.Kvs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Filters = append(m.Filters, &RangeFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field More", wireType)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -15679,31 +18441,28 @@ func (m *RangeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.More = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
 			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxStaleness == nil {
+				m.MaxStaleness = &Staleness{}
+			}
+			if err := m.MaxStaleness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -15726,7 +18485,7 @@ func (m *RangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutRequest) Unmarshal(dAtA []byte) error {
+func (m *Staleness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15749,17 +18508,17 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Staleness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Staleness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milliseconds", wireType)
 			}
-			var byteLen int
+			m.Milliseconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -15769,31 +18528,16 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Milliseconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
-			var byteLen int
+			m.Revisions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -15803,31 +18547,105 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Revisions |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpc
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRpc
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= RangeFilter_FilterResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			m.Target = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Target |= Compare_CompareTarget(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Lease = 0
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -15837,16 +18655,17 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Lease |= int64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.TargetUnion = &RangeFilter_Version{v}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevKv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateRevision", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -15856,17 +18675,17 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PrevKv = bool(v != 0)
+			m.TargetUnion = &RangeFilter_CreateRevision{v}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModRevision", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -15876,17 +18695,50 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IgnoreValue = bool(v != 0)
+			m.TargetUnion = &RangeFilter_ModRevision{v}
 		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.TargetUnion = &RangeFilter_Value{v}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreLease", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -15896,12 +18748,12 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IgnoreLease = bool(v != 0)
+			m.TargetUnion = &RangeFilter_Lease{v}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -15924,7 +18776,7 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutResponse) Unmarshal(dAtA []byte) error {
+func (m *RangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15947,10 +18799,10 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15991,7 +18843,7 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevKv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16018,13 +18870,84 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrevKv == nil {
-				m.PrevKv = &mvccpb.KeyValue{}
-			}
-			if err := m.PrevKv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Kvs = append(m.Kvs, &mvccpb.KeyValue{})
+			if err := m.Kvs[len(m.Kvs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field More", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.More = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinueToken = append(m.ContinueToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContinueToken == nil {
+				m.ContinueToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -16047,7 +18970,7 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
+func (m *MultiRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16070,17 +18993,17 @@ func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranges", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -16090,31 +19013,31 @@ func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			m.Ranges = append(m.Ranges, &RangeRequest{})
+			if err := m.Ranges[len(m.Ranges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var byteLen int
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -16124,29 +19047,14 @@ func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Revision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RangeEnd = append(m.RangeEnd[:0], dAtA[iNdEx:postIndex]...)
-			if m.RangeEnd == nil {
-				m.RangeEnd = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevKv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Serializable", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -16163,7 +19071,7 @@ func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.PrevKv = bool(v != 0)
+			m.Serializable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -16186,7 +19094,7 @@ func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRangeResponse) Unmarshal(dAtA []byte) error {
+func (m *MultiRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16209,10 +19117,10 @@ func (m *DeleteRangeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16252,27 +19160,8 @@ func (m *DeleteRangeResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
-			}
-			m.Deleted = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Deleted |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevKvs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16299,8 +19188,8 @@ func (m *DeleteRangeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrevKvs = append(m.PrevKvs, &mvccpb.KeyValue{})
-			if err := m.PrevKvs[len(m.PrevKvs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Responses = append(m.Responses, &RangeResponse{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16326,7 +19215,7 @@ func (m *DeleteRangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestOp) Unmarshal(dAtA []byte) error {
+func (m *PutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16349,17 +19238,17 @@ func (m *RequestOp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestOp: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestOp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -16369,32 +19258,31 @@ func (m *RequestOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RangeRequest{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
-			m.Request = &RequestOp_RequestRange{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestPut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -16404,30 +19292,159 @@ func (m *RequestOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PutRequest{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			m.Request = &RequestOp_RequestPut{v}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			m.Lease = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lease |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevKv", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PrevKv = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreValue = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreLease", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreLease = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestDeleteRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16454,15 +19471,16 @@ func (m *RequestOp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DeleteRangeRequest{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Request = &RequestOp_RequestDeleteRange{v}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTxn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevKv", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16489,11 +19507,12 @@ func (m *RequestOp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TxnRequest{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PrevKv == nil {
+				m.PrevKv = &mvccpb.KeyValue{}
+			}
+			if err := m.PrevKv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Request = &RequestOp_RequestTxn{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16517,7 +19536,7 @@ func (m *RequestOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseOp) Unmarshal(dAtA []byte) error {
+func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16540,17 +19559,17 @@ func (m *ResponseOp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseOp: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseOp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -16560,32 +19579,2492 @@ func (m *ResponseOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RangeResponse{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangeEnd = append(m.RangeEnd[:0], dAtA[iNdEx:postIndex]...)
+			if m.RangeEnd == nil {
+				m.RangeEnd = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevKv", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PrevKv = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			m.Deleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deleted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevKvs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevKvs = append(m.PrevKvs, &mvccpb.KeyValue{})
+			if err := m.PrevKvs[len(m.PrevKvs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RangeRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &RequestOp_RequestRange{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestPut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PutRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &RequestOp_RequestPut{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestDeleteRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DeleteRangeRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &RequestOp_RequestDeleteRange{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTxn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TxnRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &RequestOp_RequestTxn{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RangeResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Response = &ResponseOp_ResponseRange{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PutResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Response = &ResponseOp_ResponsePut{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseDeleteRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DeleteRangeResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Response = &ResponseOp_ResponseDeleteRange{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseTxn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TxnResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Response = &ResponseOp_ResponseTxn{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Compare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Compare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Compare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= Compare_CompareResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			m.Target = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Target |= Compare_CompareTarget(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TargetUnion = &Compare_Version{v}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateRevision", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TargetUnion = &Compare_CreateRevision{v}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModRevision", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TargetUnion = &Compare_ModRevision{v}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.TargetUnion = &Compare_Value{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TargetUnion = &Compare_Lease{v}
+		case 64:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangeEnd = append(m.RangeEnd[:0], dAtA[iNdEx:postIndex]...)
+			if m.RangeEnd == nil {
+				m.RangeEnd = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compare = append(m.Compare, &Compare{})
+			if err := m.Compare[len(m.Compare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Success = append(m.Success, &RequestOp{})
+			if err := m.Success[len(m.Success)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failure = append(m.Failure, &RequestOp{})
+			if err := m.Failure[len(m.Failure)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, &ResponseOp{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Physical", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Physical = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HashKVRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HashKVRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HashKVRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HashKVResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HashKVResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HashKVResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			m.Hash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hash |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactRevision", wireType)
+			}
+			m.CompactRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompactRevision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashRevision", wireType)
+			}
+			m.HashRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashRevision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			m.Hash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hash |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceRevision", wireType)
+			}
+			m.SinceRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceRevision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBytes", wireType)
+			}
+			m.RemainingBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blob = append(m.Blob[:0], dAtA[iNdEx:postIndex]...)
+			if m.Blob == nil {
+				m.Blob = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WatchCreateRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.RequestUnion = &WatchRequest_CreateRequest{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WatchCancelRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.RequestUnion = &WatchRequest_CancelRequest{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WatchProgressRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.RequestUnion = &WatchRequest_ProgressRequest{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WatchCreditRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.RequestUnion = &WatchRequest_CreditRequest{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangeEnd = append(m.RangeEnd[:0], dAtA[iNdEx:postIndex]...)
+			if m.RangeEnd == nil {
+				m.RangeEnd = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRevision", wireType)
+			}
+			m.StartRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartRevision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressNotify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressNotify = bool(v != 0)
+		case 5:
+			if wireType == 0 {
+				var v WatchCreateRequest_FilterType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= WatchCreateRequest_FilterType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Filters = append(m.Filters, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRpc
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRpc
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Filters) == 0 {
+					m.Filters = make([]WatchCreateRequest_FilterType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v WatchCreateRequest_FilterType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= WatchCreateRequest_FilterType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Filters = append(m.Filters, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevKv", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PrevKv = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchId", wireType)
+			}
+			m.WatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fragment", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fragment = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventFilters = append(m.EventFilters, &WatchFilter{})
+			if err := m.EventFilters[len(m.EventFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialState", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Response = &ResponseOp_ResponseRange{v}
-			iNdEx = postIndex
-		case 2:
+			m.InitialState = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -16595,32 +22074,31 @@ func (m *ResponseOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PutResponse{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ResumeToken = append(m.ResumeToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumeToken == nil {
+				m.ResumeToken = []byte{}
 			}
-			m.Response = &ResponseOp_ResponsePut{v}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseDeleteRange", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coalesce", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -16630,32 +22108,17 @@ func (m *ResponseOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &DeleteRangeResponse{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Response = &ResponseOp_ResponseDeleteRange{v}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseTxn", wireType)
+			m.Coalesce = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoalesceWindowMs", wireType)
 			}
-			var msglen int
+			m.CoalesceWindowMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -16665,27 +22128,11 @@ func (m *ResponseOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CoalesceWindowMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &TxnResponse{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Response = &ResponseOp_ResponseTxn{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -16708,7 +22155,7 @@ func (m *ResponseOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Compare) Unmarshal(dAtA []byte) error {
+func (m *WatchFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16731,55 +22178,17 @@ func (m *Compare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Compare: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Compare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= Compare_CompareResult(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
-			}
-			m.Target = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Target |= Compare_CompareTarget(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyGlob", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -16789,91 +22198,29 @@ func (m *Compare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.Filter = &WatchFilter_KeyGlob{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TargetUnion = &Compare_Version{v}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateRevision", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TargetUnion = &Compare_CreateRevision{v}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModRevision", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TargetUnion = &Compare_ModRevision{v}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRegex", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -16883,26 +22230,25 @@ func (m *Compare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.TargetUnion = &Compare_Value{v}
+			m.Filter = &WatchFilter_KeyRegex{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
 			}
@@ -16921,12 +22267,63 @@ func (m *Compare) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.TargetUnion = &Compare_Lease{v}
-		case 64:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			m.Filter = &WatchFilter_Lease{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchId", wireType)
 			}
-			var byteLen int
+			m.WatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -16936,26 +22333,11 @@ func (m *Compare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.WatchId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RangeEnd = append(m.RangeEnd[:0], dAtA[iNdEx:postIndex]...)
-			if m.RangeEnd == nil {
-				m.RangeEnd = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -16978,7 +22360,7 @@ func (m *Compare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxnRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchProgressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17001,85 +22383,68 @@ func (m *TxnRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxnRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchProgressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compare", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRpc
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Compare = append(m.Compare, &Compare{})
-			if err := m.Compare[len(m.Compare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchCreditRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Success = append(m.Success, &RequestOp{})
-			if err := m.Success[len(m.Success)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchCreditRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchCreditRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
 			}
-			var msglen int
+			m.Credits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -17089,26 +22454,11 @@ func (m *TxnRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Credits |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Failure = append(m.Failure, &RequestOp{})
-			if err := m.Failure[len(m.Failure)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -17131,7 +22481,7 @@ func (m *TxnRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxnResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17154,10 +22504,10 @@ func (m *TxnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17198,9 +22548,9 @@ func (m *TxnResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchId", wireType)
 			}
-			var v int
+			m.WatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -17210,17 +22560,16 @@ func (m *TxnResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.WatchId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Succeeded = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -17230,82 +22579,108 @@ func (m *TxnResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Created = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canceled", wireType)
 			}
-			m.Responses = append(m.Responses, &ResponseOp{})
-			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.Canceled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactRevision", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpc
+			m.CompactRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompactRevision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelReason", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CompactionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CancelReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fragment", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CompactionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CompactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fragment = bool(v != 0)
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialState", wireType)
 			}
-			m.Revision = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -17315,14 +22690,15 @@ func (m *CompactionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Revision |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.InitialState = bool(v != 0)
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Physical", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialStateDone", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -17339,61 +22715,44 @@ func (m *CompactionRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Physical = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.InitialStateDone = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeToken", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpc
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CompactionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ResumeToken = append(m.ResumeToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumeToken == nil {
+				m.ResumeToken = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CompactionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CompactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17420,10 +22779,8 @@ func (m *CompactionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, &mvccpb.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17449,7 +22806,7 @@ func (m *CompactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HashRequest) Unmarshal(dAtA []byte) error {
+func (m *LeaseGrantRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17472,68 +22829,36 @@ func (m *HashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaseGrantRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaseGrantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HashKVRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TTL |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HashKVRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HashKVRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Revision = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -17543,7 +22868,7 @@ func (m *HashKVRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Revision |= int64(b&0x7F) << shift
+				m.ID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -17570,7 +22895,7 @@ func (m *HashKVRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HashKVResponse) Unmarshal(dAtA []byte) error {
+func (m *LeaseGrantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17593,10 +22918,10 @@ func (m *HashKVResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HashKVResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaseGrantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HashKVResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaseGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17637,9 +22962,9 @@ func (m *HashKVResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Hash = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -17649,16 +22974,16 @@ func (m *HashKVResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Hash |= uint32(b&0x7F) << shift
+				m.ID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
-			m.CompactRevision = 0
+			m.TTL = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -17668,16 +22993,16 @@ func (m *HashKVResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompactRevision |= int64(b&0x7F) << shift
+				m.TTL |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HashRevision", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.HashRevision = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -17687,11 +23012,24 @@ func (m *HashKVResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HashRevision |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -17714,7 +23052,7 @@ func (m *HashKVResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HashResponse) Unmarshal(dAtA []byte) error {
+func (m *LeaseRevokeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17737,53 +23075,17 @@ func (m *HashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaseRevokeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaseRevokeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Hash = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -17793,7 +23095,7 @@ func (m *HashResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Hash |= uint32(b&0x7F) << shift
+				m.ID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -17820,7 +23122,7 @@ func (m *HashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SnapshotRequest) Unmarshal(dAtA []byte) error {
+func (m *LeaseRevokeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17843,12 +23145,48 @@ func (m *SnapshotRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SnapshotRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaseRevokeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaseRevokeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -17871,7 +23209,7 @@ func (m *SnapshotRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SnapshotResponse) Unmarshal(dAtA []byte) error {
+func (m *LeaseCheckpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17894,17 +23232,17 @@ func (m *SnapshotResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SnapshotResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaseCheckpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaseCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -17914,86 +23252,16 @@ func (m *SnapshotResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBytes", wireType)
-			}
-			m.RemainingBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RemainingBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Blob = append(m.Blob[:0], dAtA[iNdEx:postIndex]...)
-			if m.Blob == nil {
-				m.Blob = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining_TTL", wireType)
 			}
-			var stringLen uint64
+			m.Remaining_TTL = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -18003,24 +23271,11 @@ func (m *SnapshotResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Remaining_TTL |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -18043,7 +23298,7 @@ func (m *SnapshotResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchRequest) Unmarshal(dAtA []byte) error {
+func (m *LeaseCheckpointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18066,15 +23321,15 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaseCheckpointRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaseCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18101,50 +23356,65 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WatchCreateRequest{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Checkpoints = append(m.Checkpoints, &LeaseCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.RequestUnion = &WatchRequest_CreateRequest{v}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WatchCancelRequest{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.RequestUnion = &WatchRequest_CancelRequest{v}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18171,11 +23441,12 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WatchProgressRequest{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.RequestUnion = &WatchRequest_ProgressRequest{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18199,7 +23470,7 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *LeaseKeepAliveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18222,17 +23493,17 @@ func (m *WatchCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaseKeepAliveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaseKeepAliveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var byteLen int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -18242,31 +23513,67 @@ func (m *WatchCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpc
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRpc
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseKeepAliveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseKeepAliveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseKeepAliveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -18276,159 +23583,33 @@ func (m *WatchCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RangeEnd = append(m.RangeEnd[:0], dAtA[iNdEx:postIndex]...)
-			if m.RangeEnd == nil {
-				m.RangeEnd = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartRevision", wireType)
-			}
-			m.StartRevision = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartRevision |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressNotify", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ProgressNotify = bool(v != 0)
-		case 5:
-			if wireType == 0 {
-				var v WatchCreateRequest_FilterType
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpc
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= WatchCreateRequest_FilterType(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Filters = append(m.Filters, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpc
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRpc
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRpc
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Filters) == 0 {
-					m.Filters = make([]WatchCreateRequest_FilterType, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v WatchCreateRequest_FilterType
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRpc
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= WatchCreateRequest_FilterType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Filters = append(m.Filters, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevKv", wireType)
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.PrevKv = bool(v != 0)
-		case 7:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.WatchId = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -18438,16 +23619,16 @@ func (m *WatchCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WatchId |= int64(b&0x7F) << shift
+				m.ID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fragment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
-			var v int
+			m.TTL = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -18457,12 +23638,11 @@ func (m *WatchCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TTL |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Fragment = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -18485,7 +23665,7 @@ func (m *WatchCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchCancelRequest) Unmarshal(dAtA []byte) error {
+func (m *LeaseTimeToLiveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18508,17 +23688,17 @@ func (m *WatchCancelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchCancelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaseTimeToLiveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaseTimeToLiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.WatchId = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -18528,62 +23708,31 @@ func (m *WatchCancelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WatchId |= int64(b&0x7F) << shift
+				m.ID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WatchProgressRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WatchProgressRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Keys = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -18606,7 +23755,7 @@ func (m *WatchProgressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchResponse) Unmarshal(dAtA []byte) error {
+func (m *LeaseTimeToLiveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18629,10 +23778,10 @@ func (m *WatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaseTimeToLiveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaseTimeToLiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18673,9 +23822,9 @@ func (m *WatchResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.WatchId = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -18685,16 +23834,16 @@ func (m *WatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WatchId |= int64(b&0x7F) << shift
+				m.ID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
-			var v int
+			m.TTL = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -18704,17 +23853,169 @@ func (m *WatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TTL |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Created = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Canceled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedTTL", wireType)
+			}
+			m.GrantedTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantedTTL |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseLeasesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseLeasesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseLeasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var v int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -18724,36 +24025,67 @@ func (m *WatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Canceled = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactRevision", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.CompactRevision = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompactRevision |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
 			}
-		case 6:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseLeasesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseLeasesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseLeasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -18763,47 +24095,31 @@ func (m *WatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CancelReason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fragment", wireType)
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Fragment = bool(v != 0)
-		case 11:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18830,8 +24146,8 @@ func (m *WatchResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, &mvccpb.Event{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Leases = append(m.Leases, &LeaseStatus{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18857,7 +24173,7 @@ func (m *WatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeaseGrantRequest) Unmarshal(dAtA []byte) error {
+func (m *Member) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18880,17 +24196,17 @@ func (m *LeaseGrantRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseGrantRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseGrantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.TTL = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -18900,16 +24216,16 @@ func (m *LeaseGrantRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TTL |= int64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -18919,67 +24235,29 @@ func (m *LeaseGrantRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LeaseGrantResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseGrantResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerURLs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -18989,33 +24267,29 @@ func (m *LeaseGrantResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PeerURLs = append(m.PeerURLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientURLs", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -19025,16 +24299,29 @@ func (m *LeaseGrantResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientURLs = append(m.ClientURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
 			}
-			m.TTL = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -19044,16 +24331,17 @@ func (m *LeaseGrantResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TTL |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			m.IsLearner = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsWitness", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -19063,80 +24351,17 @@ func (m *LeaseGrantResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LeaseRevokeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseRevokeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseRevokeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.IsWitness = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromote", wireType)
 			}
-			m.ID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -19146,11 +24371,12 @@ func (m *LeaseRevokeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.AutoPromote = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -19173,7 +24399,7 @@ func (m *LeaseRevokeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeaseRevokeResponse) Unmarshal(dAtA []byte) error {
+func (m *MemberAddRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19196,17 +24422,17 @@ func (m *LeaseRevokeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseRevokeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberAddRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseRevokeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberAddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerURLs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -19216,84 +24442,49 @@ func (m *LeaseRevokeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PeerURLs = append(m.PeerURLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LeaseCheckpoint) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseCheckpoint: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.IsLearner = bool(v != 0)
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsWitness", wireType)
 			}
-			m.ID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -19303,16 +24494,17 @@ func (m *LeaseCheckpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.IsWitness = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remaining_TTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromote", wireType)
 			}
-			m.Remaining_TTL = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -19322,11 +24514,12 @@ func (m *LeaseCheckpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Remaining_TTL |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.AutoPromote = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -19349,7 +24542,7 @@ func (m *LeaseCheckpoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeaseCheckpointRequest) Unmarshal(dAtA []byte) error {
+func (m *MemberAddResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19372,15 +24565,15 @@ func (m *LeaseCheckpointRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseCheckpointRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberAddResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberAddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19407,65 +24600,52 @@ func (m *LeaseCheckpointRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checkpoints = append(m.Checkpoints, &LeaseCheckpoint{})
-			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpc
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LeaseCheckpointResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseCheckpointResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19492,10 +24672,8 @@ func (m *LeaseCheckpointResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19521,7 +24699,7 @@ func (m *LeaseCheckpointResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeaseKeepAliveRequest) Unmarshal(dAtA []byte) error {
+func (m *MemberRemoveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19544,10 +24722,10 @@ func (m *LeaseKeepAliveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseKeepAliveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberRemoveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseKeepAliveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19564,7 +24742,7 @@ func (m *LeaseKeepAliveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19591,7 +24769,7 @@ func (m *LeaseKeepAliveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeaseKeepAliveResponse) Unmarshal(dAtA []byte) error {
+func (m *MemberRemoveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19614,10 +24792,10 @@ func (m *LeaseKeepAliveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseKeepAliveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberRemoveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseKeepAliveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19657,10 +24835,10 @@ func (m *LeaseKeepAliveResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			m.ID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -19670,30 +24848,26 @@ func (m *LeaseKeepAliveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
 			}
-			m.TTL = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TTL |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -19716,7 +24890,7 @@ func (m *LeaseKeepAliveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeaseTimeToLiveRequest) Unmarshal(dAtA []byte) error {
+func (m *MemberUpdateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19739,10 +24913,10 @@ func (m *LeaseTimeToLiveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseTimeToLiveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberUpdateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseTimeToLiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19759,16 +24933,16 @@ func (m *LeaseTimeToLiveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerURLs", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -19778,12 +24952,24 @@ func (m *LeaseTimeToLiveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Keys = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerURLs = append(m.PeerURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -19806,7 +24992,7 @@ func (m *LeaseTimeToLiveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeaseTimeToLiveResponse) Unmarshal(dAtA []byte) error {
+func (m *MemberUpdateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19829,10 +25015,10 @@ func (m *LeaseTimeToLiveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseTimeToLiveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberUpdateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseTimeToLiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19872,67 +25058,10 @@ func (m *LeaseTimeToLiveResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
-			}
-			m.TTL = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TTL |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GrantedTTL", wireType)
-			}
-			m.GrantedTTL = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GrantedTTL |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -19942,75 +25071,26 @@ func (m *LeaseTimeToLiveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
-			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LeaseLeasesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseLeasesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseLeasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -20033,7 +25113,7 @@ func (m *LeaseLeasesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeaseStatus) Unmarshal(dAtA []byte) error {
+func (m *MemberListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20056,17 +25136,17 @@ func (m *LeaseStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Linearizable", wireType)
 			}
-			m.ID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -20076,11 +25156,12 @@ func (m *LeaseStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Linearizable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -20103,7 +25184,7 @@ func (m *LeaseStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeaseLeasesResponse) Unmarshal(dAtA []byte) error {
+func (m *MemberListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20126,10 +25207,10 @@ func (m *LeaseLeasesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseLeasesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseLeasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20170,7 +25251,7 @@ func (m *LeaseLeasesResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20197,8 +25278,8 @@ func (m *LeaseLeasesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leases = append(m.Leases, &LeaseStatus{})
-			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20224,7 +25305,7 @@ func (m *LeaseLeasesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *MemberPromoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20247,10 +25328,10 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberPromoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberPromoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20272,43 +25353,62 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberPromoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberPromoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberPromoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -20318,29 +25418,33 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerURLs = append(m.PeerURLs, string(dAtA[iNdEx:postIndex]))
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -20350,44 +25454,26 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientURLs = append(m.ClientURLs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IsLearner = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -20410,7 +25496,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberAddRequest) Unmarshal(dAtA []byte) error {
+func (m *MemberReconfigureRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20433,17 +25519,17 @@ func (m *MemberAddRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberAddRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberReconfigureRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberAddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberReconfigureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -20453,44 +25539,178 @@ func (m *MemberAddRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerURLs = append(m.PeerURLs, string(dAtA[iNdEx:postIndex]))
+			m.Add = append(m.Add, &MemberAddRequest{})
+			if err := m.Add[len(m.Add)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Remove = append(m.Remove, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRpc
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRpc
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Remove) == 0 {
+					m.Remove = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Remove = append(m.Remove, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Promote = append(m.Promote, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRpc
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRpc
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Promote) == 0 {
+					m.Promote = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Promote = append(m.Promote, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Promote", wireType)
 			}
-			m.IsLearner = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -20513,7 +25733,7 @@ func (m *MemberAddRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberAddResponse) Unmarshal(dAtA []byte) error {
+func (m *MemberReconfigureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20536,10 +25756,10 @@ func (m *MemberAddResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberAddResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberReconfigureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberAddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberReconfigureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20580,7 +25800,7 @@ func (m *MemberAddResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20607,10 +25827,8 @@ func (m *MemberAddResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Member == nil {
-				m.Member = &Member{}
-			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Added = append(m.Added, &Member{})
+			if err := m.Added[len(m.Added)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20670,7 +25888,7 @@ func (m *MemberAddResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberRemoveRequest) Unmarshal(dAtA []byte) error {
+func (m *DefragmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20693,17 +25911,17 @@ func (m *MemberRemoveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberRemoveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DefragmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DefragmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			m.ID = 0
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -20713,7 +25931,7 @@ func (m *MemberRemoveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.Mode |= DefragmentRequest_DefragmentMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -20740,7 +25958,7 @@ func (m *MemberRemoveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberRemoveResponse) Unmarshal(dAtA []byte) error {
+func (m *DefragmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20763,10 +25981,10 @@ func (m *MemberRemoveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberRemoveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DefragmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DefragmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20805,40 +26023,6 @@ func (m *MemberRemoveResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Members = append(m.Members, &Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -20861,7 +26045,7 @@ func (m *MemberRemoveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberUpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *MoveLeaderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20884,155 +26068,17 @@ func (m *MemberUpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberUpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MoveLeaderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MoveLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerURLs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PeerURLs = append(m.PeerURLs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MemberUpdateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MemberUpdateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetID", wireType)
 			}
-			var msglen int
+			m.TargetID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -21042,26 +26088,11 @@ func (m *MemberUpdateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TargetID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Members = append(m.Members, &Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -21084,7 +26115,7 @@ func (m *MemberUpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberListRequest) Unmarshal(dAtA []byte) error {
+func (m *MoveLeaderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21107,17 +26138,17 @@ func (m *MemberListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MoveLeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MoveLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Linearizable", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -21127,12 +26158,28 @@ func (m *MemberListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Linearizable = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -21155,7 +26202,7 @@ func (m *MemberListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberListResponse) Unmarshal(dAtA []byte) error {
+func (m *AlarmRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21178,17 +26225,17 @@ func (m *MemberListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlarmRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlarmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -21198,33 +26245,16 @@ func (m *MemberListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Action |= AlarmRequest_AlarmAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberID", wireType)
 			}
-			var msglen int
+			m.MemberID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -21234,26 +26264,30 @@ func (m *MemberListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MemberID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alarm", wireType)
 			}
-			m.Members = append(m.Members, &Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Alarm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Alarm |= AlarmType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -21276,7 +26310,7 @@ func (m *MemberListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberPromoteRequest) Unmarshal(dAtA []byte) error {
+func (m *AlarmMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21299,17 +26333,17 @@ func (m *MemberPromoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberPromoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlarmMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberPromoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlarmMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberID", wireType)
 			}
-			m.ID = 0
+			m.MemberID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -21319,7 +26353,26 @@ func (m *MemberPromoteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.MemberID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alarm", wireType)
+			}
+			m.Alarm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Alarm |= AlarmType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21346,7 +26399,7 @@ func (m *MemberPromoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberPromoteResponse) Unmarshal(dAtA []byte) error {
+func (m *AlarmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21369,10 +26422,10 @@ func (m *MemberPromoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberPromoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlarmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberPromoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21413,7 +26466,7 @@ func (m *MemberPromoteResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alarms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21440,8 +26493,8 @@ func (m *MemberPromoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Alarms = append(m.Alarms, &AlarmMember{})
+			if err := m.Alarms[len(m.Alarms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21467,7 +26520,7 @@ func (m *MemberPromoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DefragmentRequest) Unmarshal(dAtA []byte) error {
+func (m *DowngradeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21490,12 +26543,63 @@ func (m *DefragmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DefragmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DowngradeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DefragmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DowngradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= DowngradeRequest_DowngradeAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -21518,7 +26622,7 @@ func (m *DefragmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DefragmentResponse) Unmarshal(dAtA []byte) error {
+func (m *DowngradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21541,10 +26645,10 @@ func (m *DefragmentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DefragmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DowngradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DefragmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DowngradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21582,63 +26686,12 @@ func (m *DefragmentResponse) Unmarshal(dAtA []byte) error {
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MoveLeaderRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MoveLeaderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MoveLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetID", wireType)
-			}
-			m.TargetID = 0
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -21648,11 +26701,24 @@ func (m *MoveLeaderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -21675,7 +26741,7 @@ func (m *MoveLeaderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MoveLeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchLagRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21698,48 +26764,12 @@ func (m *MoveLeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MoveLeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchLagRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MoveLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchLagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -21762,7 +26792,7 @@ func (m *MoveLeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlarmRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchStreamLag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21785,17 +26815,17 @@ func (m *AlarmRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlarmRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchStreamLag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlarmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchStreamLag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			m.Action = 0
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -21805,16 +26835,16 @@ func (m *AlarmRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Action |= AlarmRequest_AlarmAction(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddr", wireType)
 			}
-			m.MemberID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -21824,16 +26854,29 @@ func (m *AlarmRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemberID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alarm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Watchers", wireType)
 			}
-			m.Alarm = 0
+			m.Watchers = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -21843,67 +26886,54 @@ func (m *AlarmRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Alarm |= AlarmType(b&0x7F) << shift
+				m.Watchers |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionGap", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AlarmMember) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
+			m.RevisionGap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionGap |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedBytes", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BufferedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BufferedBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AlarmMember: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlarmMember: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowControl", wireType)
 			}
-			m.MemberID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -21913,16 +26943,17 @@ func (m *AlarmMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemberID |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.FlowControl = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alarm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
 			}
-			m.Alarm = 0
+			m.Credits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -21932,7 +26963,7 @@ func (m *AlarmMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Alarm |= AlarmType(b&0x7F) << shift
+				m.Credits |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21959,7 +26990,7 @@ func (m *AlarmMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlarmResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchLagResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21982,10 +27013,10 @@ func (m *AlarmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlarmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchLagResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchLagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22026,7 +27057,7 @@ func (m *AlarmResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alarms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22053,8 +27084,8 @@ func (m *AlarmResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Alarms = append(m.Alarms, &AlarmMember{})
-			if err := m.Alarms[len(m.Alarms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Streams = append(m.Streams, &WatchStreamLag{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22080,7 +27111,7 @@ func (m *AlarmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DowngradeRequest) Unmarshal(dAtA []byte) error {
+func (m *RevisionAtRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22103,36 +27134,17 @@ func (m *DowngradeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DowngradeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionAtRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DowngradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
-			}
-			m.Action = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Action |= DowngradeRequest_DowngradeAction(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -22142,24 +27154,11 @@ func (m *DowngradeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -22182,7 +27181,7 @@ func (m *DowngradeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DowngradeResponse) Unmarshal(dAtA []byte) error {
+func (m *RevisionAtResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22205,10 +27204,10 @@ func (m *DowngradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DowngradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionAtResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DowngradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22248,10 +27247,10 @@ func (m *DowngradeResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var stringLen uint64
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -22261,24 +27260,30 @@ func (m *DowngradeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Revision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpc
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
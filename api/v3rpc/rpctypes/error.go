@@ -29,8 +29,15 @@ var (
 	ErrGRPCDuplicateKey            = status.Error(codes.InvalidArgument, "etcdserver: duplicate key given in txn request")
 	ErrGRPCInvalidClientAPIVersion = status.Error(codes.InvalidArgument, "etcdserver: invalid client api version")
 	ErrGRPCInvalidSortOption       = status.Error(codes.InvalidArgument, "etcdserver: invalid sort option")
+	ErrGRPCInvalidContinueToken    = status.Error(codes.InvalidArgument, "etcdserver: invalid continue token")
+	ErrGRPCInvalidRangeFilter      = status.Error(codes.InvalidArgument, "etcdserver: invalid range filter")
+	ErrGRPCInvalidMultiRange       = status.Error(codes.InvalidArgument, "etcdserver: range does not match multi-range revision or consistency")
+	ErrGRPCInvalidMaxStaleness     = status.Error(codes.InvalidArgument, "etcdserver: invalid max staleness")
+	ErrGRPCInvalidWatchFilter      = status.Error(codes.InvalidArgument, "etcdserver: invalid watch filter")
+	ErrGRPCInvalidResumeToken      = status.Error(codes.InvalidArgument, "etcdserver: invalid watch resume token")
 	ErrGRPCCompacted               = status.Error(codes.OutOfRange, "etcdserver: mvcc: required revision has been compacted")
 	ErrGRPCFutureRev               = status.Error(codes.OutOfRange, "etcdserver: mvcc: required revision is a future revision")
+	ErrGRPCRevisionTimeUnknown     = status.Error(codes.OutOfRange, "etcdserver: no revision is known at the given time")
 	ErrGRPCNoSpace                 = status.Error(codes.ResourceExhausted, "etcdserver: mvcc: database space exceeded")
 
 	ErrGRPCLeaseNotFound    = status.Error(codes.NotFound, "etcdserver: requested lease not found")
@@ -38,6 +45,7 @@ var (
 	ErrGRPCLeaseTTLTooLarge = status.Error(codes.OutOfRange, "etcdserver: too large lease TTL")
 
 	ErrGRPCWatchCanceled = status.Error(codes.Canceled, "etcdserver: watch canceled")
+	ErrGRPCWatchLagged   = status.Error(codes.ResourceExhausted, "etcdserver: watcher lagged too far behind")
 
 	ErrGRPCMemberExist            = status.Error(codes.FailedPrecondition, "etcdserver: member ID already exist")
 	ErrGRPCPeerURLExist           = status.Error(codes.FailedPrecondition, "etcdserver: Peer URLs already exists")
@@ -48,6 +56,12 @@ var (
 	ErrGRPCLearnerNotReady        = status.Error(codes.FailedPrecondition, "etcdserver: can only promote a learner member which is in sync with leader")
 	ErrGRPCTooManyLearners        = status.Error(codes.FailedPrecondition, "etcdserver: too many learner members in cluster")
 	ErrGRPCClusterIDMismatch      = status.Error(codes.FailedPrecondition, "etcdserver: cluster ID mismatch")
+	ErrGRPCMemberIDDuplicated     = status.Error(codes.InvalidArgument, "etcdserver: member ID changed more than once")
+	ErrGRPCNoVotingMember         = status.Error(codes.FailedPrecondition, "etcdserver: no voting member left")
+	ErrGRPCNoMemberChange         = status.Error(codes.InvalidArgument, "etcdserver: member reconfigure has no member change")
+	ErrGRPCReconfigureUnsupported = status.Error(codes.FailedPrecondition, "etcdserver: member reconfigure needs cluster version 3.6 or later")
+	ErrGRPCWitnessLearner         = status.Error(codes.InvalidArgument, "etcdserver: member cannot be both a learner and a witness")
+	ErrGRPCAutoPromoteNotLearner  = status.Error(codes.InvalidArgument, "etcdserver: can only auto-promote a learner member")
 	//revive:disable:var-naming
 	// Deprecated: Please use ErrGRPCClusterIDMismatch.
 	ErrGRPCClusterIdMismatch = ErrGRPCClusterIDMismatch
@@ -86,6 +100,7 @@ var (
 	ErrGRPCUnhealthy                  = status.Error(codes.Unavailable, "etcdserver: unhealthy cluster")
 	ErrGRPCCorrupt                    = status.Error(codes.DataLoss, "etcdserver: corrupt cluster")
 	ErrGRPCNotSupportedForLearner     = status.Error(codes.FailedPrecondition, "etcdserver: rpc not supported for learner")
+	ErrGRPCNotSupportedForWitness     = status.Error(codes.FailedPrecondition, "etcdserver: rpc not supported for witness")
 	ErrGRPCBadLeaderTransferee        = status.Error(codes.FailedPrecondition, "etcdserver: bad leader transferee")
 
 	ErrGRPCWrongDowngradeVersionFormat   = status.Error(codes.InvalidArgument, "etcdserver: wrong downgrade target version format")
@@ -103,12 +118,20 @@ var (
 		ErrorDesc(ErrGRPCValueProvided): ErrGRPCValueProvided,
 		ErrorDesc(ErrGRPCLeaseProvided): ErrGRPCLeaseProvided,
 
-		ErrorDesc(ErrGRPCTooManyOps):        ErrGRPCTooManyOps,
-		ErrorDesc(ErrGRPCDuplicateKey):      ErrGRPCDuplicateKey,
-		ErrorDesc(ErrGRPCInvalidSortOption): ErrGRPCInvalidSortOption,
-		ErrorDesc(ErrGRPCCompacted):         ErrGRPCCompacted,
-		ErrorDesc(ErrGRPCFutureRev):         ErrGRPCFutureRev,
-		ErrorDesc(ErrGRPCNoSpace):           ErrGRPCNoSpace,
+		ErrorDesc(ErrGRPCTooManyOps):           ErrGRPCTooManyOps,
+		ErrorDesc(ErrGRPCDuplicateKey):         ErrGRPCDuplicateKey,
+		ErrorDesc(ErrGRPCInvalidSortOption):    ErrGRPCInvalidSortOption,
+		ErrorDesc(ErrGRPCInvalidContinueToken): ErrGRPCInvalidContinueToken,
+		ErrorDesc(ErrGRPCInvalidRangeFilter):   ErrGRPCInvalidRangeFilter,
+		ErrorDesc(ErrGRPCInvalidMultiRange):    ErrGRPCInvalidMultiRange,
+		ErrorDesc(ErrGRPCInvalidMaxStaleness):  ErrGRPCInvalidMaxStaleness,
+		ErrorDesc(ErrGRPCInvalidWatchFilter):   ErrGRPCInvalidWatchFilter,
+		ErrorDesc(ErrGRPCInvalidResumeToken):   ErrGRPCInvalidResumeToken,
+		ErrorDesc(ErrGRPCWatchLagged):          ErrGRPCWatchLagged,
+		ErrorDesc(ErrGRPCCompacted):            ErrGRPCCompacted,
+		ErrorDesc(ErrGRPCFutureRev):            ErrGRPCFutureRev,
+		ErrorDesc(ErrGRPCRevisionTimeUnknown):  ErrGRPCRevisionTimeUnknown,
+		ErrorDesc(ErrGRPCNoSpace):              ErrGRPCNoSpace,
 
 		ErrorDesc(ErrGRPCLeaseNotFound):    ErrGRPCLeaseNotFound,
 		ErrorDesc(ErrGRPCLeaseExist):       ErrGRPCLeaseExist,
@@ -123,6 +146,12 @@ var (
 		ErrorDesc(ErrGRPCLearnerNotReady):        ErrGRPCLearnerNotReady,
 		ErrorDesc(ErrGRPCTooManyLearners):        ErrGRPCTooManyLearners,
 		ErrorDesc(ErrGRPCClusterIDMismatch):      ErrGRPCClusterIDMismatch,
+		ErrorDesc(ErrGRPCMemberIDDuplicated):     ErrGRPCMemberIDDuplicated,
+		ErrorDesc(ErrGRPCNoVotingMember):         ErrGRPCNoVotingMember,
+		ErrorDesc(ErrGRPCNoMemberChange):         ErrGRPCNoMemberChange,
+		ErrorDesc(ErrGRPCReconfigureUnsupported): ErrGRPCReconfigureUnsupported,
+		ErrorDesc(ErrGRPCWitnessLearner):         ErrGRPCWitnessLearner,
+		ErrorDesc(ErrGRPCAutoPromoteNotLearner):  ErrGRPCAutoPromoteNotLearner,
 
 		ErrorDesc(ErrGRPCRequestTooLarge):        ErrGRPCRequestTooLarge,
 		ErrorDesc(ErrGRPCRequestTooManyRequests): ErrGRPCRequestTooManyRequests,
@@ -155,6 +184,7 @@ var (
 		ErrorDesc(ErrGRPCUnhealthy):                  ErrGRPCUnhealthy,
 		ErrorDesc(ErrGRPCCorrupt):                    ErrGRPCCorrupt,
 		ErrorDesc(ErrGRPCNotSupportedForLearner):     ErrGRPCNotSupportedForLearner,
+		ErrorDesc(ErrGRPCNotSupportedForWitness):     ErrGRPCNotSupportedForWitness,
 		ErrorDesc(ErrGRPCBadLeaderTransferee):        ErrGRPCBadLeaderTransferee,
 
 		ErrorDesc(ErrGRPCClusterVersionUnavailable):     ErrGRPCClusterVersionUnavailable,
@@ -167,16 +197,24 @@ var (
 
 // client-side error
 var (
-	ErrEmptyKey          = Error(ErrGRPCEmptyKey)
-	ErrKeyNotFound       = Error(ErrGRPCKeyNotFound)
-	ErrValueProvided     = Error(ErrGRPCValueProvided)
-	ErrLeaseProvided     = Error(ErrGRPCLeaseProvided)
-	ErrTooManyOps        = Error(ErrGRPCTooManyOps)
-	ErrDuplicateKey      = Error(ErrGRPCDuplicateKey)
-	ErrInvalidSortOption = Error(ErrGRPCInvalidSortOption)
-	ErrCompacted         = Error(ErrGRPCCompacted)
-	ErrFutureRev         = Error(ErrGRPCFutureRev)
-	ErrNoSpace           = Error(ErrGRPCNoSpace)
+	ErrEmptyKey             = Error(ErrGRPCEmptyKey)
+	ErrKeyNotFound          = Error(ErrGRPCKeyNotFound)
+	ErrValueProvided        = Error(ErrGRPCValueProvided)
+	ErrLeaseProvided        = Error(ErrGRPCLeaseProvided)
+	ErrTooManyOps           = Error(ErrGRPCTooManyOps)
+	ErrDuplicateKey         = Error(ErrGRPCDuplicateKey)
+	ErrInvalidSortOption    = Error(ErrGRPCInvalidSortOption)
+	ErrInvalidContinueToken = Error(ErrGRPCInvalidContinueToken)
+	ErrInvalidRangeFilter   = Error(ErrGRPCInvalidRangeFilter)
+	ErrInvalidMultiRange    = Error(ErrGRPCInvalidMultiRange)
+	ErrInvalidMaxStaleness  = Error(ErrGRPCInvalidMaxStaleness)
+	ErrInvalidWatchFilter   = Error(ErrGRPCInvalidWatchFilter)
+	ErrInvalidResumeToken   = Error(ErrGRPCInvalidResumeToken)
+	ErrWatchLagged          = Error(ErrGRPCWatchLagged)
+	ErrCompacted            = Error(ErrGRPCCompacted)
+	ErrFutureRev            = Error(ErrGRPCFutureRev)
+	ErrRevisionTimeUnknown  = Error(ErrGRPCRevisionTimeUnknown)
+	ErrNoSpace              = Error(ErrGRPCNoSpace)
 
 	ErrLeaseNotFound    = Error(ErrGRPCLeaseNotFound)
 	ErrLeaseExist       = Error(ErrGRPCLeaseExist)
@@ -190,6 +228,12 @@ var (
 	ErrMemberNotLearner       = Error(ErrGRPCMemberNotLearner)
 	ErrMemberLearnerNotReady  = Error(ErrGRPCLearnerNotReady)
 	ErrTooManyLearners        = Error(ErrGRPCTooManyLearners)
+	ErrMemberIDDuplicated     = Error(ErrGRPCMemberIDDuplicated)
+	ErrNoVotingMember         = Error(ErrGRPCNoVotingMember)
+	ErrNoMemberChange         = Error(ErrGRPCNoMemberChange)
+	ErrReconfigureUnsupported = Error(ErrGRPCReconfigureUnsupported)
+	ErrWitnessLearner         = Error(ErrGRPCWitnessLearner)
+	ErrAutoPromoteNotLearner  = Error(ErrGRPCAutoPromoteNotLearner)
 
 	ErrRequestTooLarge = Error(ErrGRPCRequestTooLarge)
 	ErrTooManyRequests = Error(ErrGRPCRequestTooManyRequests)
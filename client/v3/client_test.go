@@ -457,6 +457,10 @@ func (mm mockMaintenance) Defragment(ctx context.Context, endpoint string) (*Def
 	return nil, nil
 }
 
+func (mm mockMaintenance) DefragmentIncremental(ctx context.Context, endpoint string) (*DefragmentResponse, error) {
+	return nil, nil
+}
+
 func (mm mockMaintenance) HashKV(ctx context.Context, endpoint string, rev int64) (*HashKVResponse, error) {
 	return nil, nil
 }
@@ -465,6 +469,10 @@ func (mm mockMaintenance) SnapshotWithVersion(ctx context.Context) (*SnapshotRes
 	return nil, nil
 }
 
+func (mm mockMaintenance) SnapshotSince(ctx context.Context, rev int64) (*SnapshotResponse, error) {
+	return nil, nil
+}
+
 func (mm mockMaintenance) Snapshot(ctx context.Context) (io.ReadCloser, error) {
 	return nil, nil
 }
@@ -477,6 +485,14 @@ func (mm mockMaintenance) Downgrade(ctx context.Context, action DowngradeAction,
 	return nil, nil
 }
 
+func (mm mockMaintenance) WatchLag(ctx context.Context, endpoint string) (*WatchLagResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) RevisionAt(ctx context.Context, endpoint string, t time.Time) (*RevisionAtResponse, error) {
+	return nil, nil
+}
+
 type mockAuthServer struct {
 	*etcdserverpb.UnimplementedAuthServer
 }
@@ -501,6 +517,14 @@ func (mc *mockCluster) MemberAddAsLearner(ctx context.Context, peerAddrs []strin
 	return nil, nil
 }
 
+func (mc *mockCluster) MemberAddAsAutoPromoteLearner(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error) {
+	return nil, nil
+}
+
+func (mc *mockCluster) MemberAddAsWitness(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error) {
+	return nil, nil
+}
+
 func (mc *mockCluster) MemberRemove(ctx context.Context, id uint64) (*MemberRemoveResponse, error) {
 	return nil, nil
 }
@@ -512,3 +536,7 @@ func (mc *mockCluster) MemberUpdate(ctx context.Context, id uint64, peerAddrs []
 func (mc *mockCluster) MemberPromote(ctx context.Context, id uint64) (*MemberPromoteResponse, error) {
 	return nil, nil
 }
+
+func (mc *mockCluster) MemberReconfigure(ctx context.Context, add []*etcdserverpb.MemberAddRequest, remove []uint64, promote []uint64) (*MemberReconfigureResponse, error) {
+	return nil, nil
+}
@@ -30,6 +30,8 @@ type (
 	MemberRemoveResponse  pb.MemberRemoveResponse
 	MemberUpdateResponse  pb.MemberUpdateResponse
 	MemberPromoteResponse pb.MemberPromoteResponse
+
+	MemberReconfigureResponse pb.MemberReconfigureResponse
 )
 
 type Cluster interface {
@@ -42,6 +44,14 @@ type Cluster interface {
 	// MemberAddAsLearner adds a new learner member into the cluster.
 	MemberAddAsLearner(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error)
 
+	// MemberAddAsAutoPromoteLearner adds a new learner member into the cluster, which the
+	// leader promotes to voting member once it caught up with the leader.
+	MemberAddAsAutoPromoteLearner(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error)
+
+	// MemberAddAsWitness adds a new witness member into the cluster. A witness votes and
+	// acknowledges the raft log, but does not store the key-value data.
+	MemberAddAsWitness(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error)
+
 	// MemberRemove removes an existing member from the cluster.
 	MemberRemove(ctx context.Context, id uint64) (*MemberRemoveResponse, error)
 
@@ -50,6 +60,9 @@ type Cluster interface {
 
 	// MemberPromote promotes a member from raft learner (non-voting) to raft voting member.
 	MemberPromote(ctx context.Context, id uint64) (*MemberPromoteResponse, error)
+
+	// MemberReconfigure adds, removes and promotes members at once through raft joint consensus.
+	MemberReconfigure(ctx context.Context, add []*pb.MemberAddRequest, remove []uint64, promote []uint64) (*MemberReconfigureResponse, error)
 }
 
 type cluster struct {
@@ -74,23 +87,27 @@ func NewClusterFromClusterClient(remote pb.ClusterClient, c *Client) Cluster {
 }
 
 func (c *cluster) MemberAdd(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error) {
-	return c.memberAdd(ctx, peerAddrs, false)
+	return c.memberAdd(ctx, &pb.MemberAddRequest{PeerURLs: peerAddrs})
 }
 
 func (c *cluster) MemberAddAsLearner(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error) {
-	return c.memberAdd(ctx, peerAddrs, true)
+	return c.memberAdd(ctx, &pb.MemberAddRequest{PeerURLs: peerAddrs, IsLearner: true})
+}
+
+func (c *cluster) MemberAddAsAutoPromoteLearner(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error) {
+	return c.memberAdd(ctx, &pb.MemberAddRequest{PeerURLs: peerAddrs, IsLearner: true, AutoPromote: true})
+}
+
+func (c *cluster) MemberAddAsWitness(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error) {
+	return c.memberAdd(ctx, &pb.MemberAddRequest{PeerURLs: peerAddrs, IsWitness: true})
 }
 
-func (c *cluster) memberAdd(ctx context.Context, peerAddrs []string, isLearner bool) (*MemberAddResponse, error) {
+func (c *cluster) memberAdd(ctx context.Context, r *pb.MemberAddRequest) (*MemberAddResponse, error) {
 	// fail-fast before panic in rafthttp
-	if _, err := types.NewURLs(peerAddrs); err != nil {
+	if _, err := types.NewURLs(r.PeerURLs); err != nil {
 		return nil, err
 	}
 
-	r := &pb.MemberAddRequest{
-		PeerURLs:  peerAddrs,
-		IsLearner: isLearner,
-	}
 	resp, err := c.remote.MemberAdd(ctx, r, c.callOpts...)
 	if err != nil {
 		return nil, ContextError(ctx, err)
@@ -139,3 +156,18 @@ func (c *cluster) MemberPromote(ctx context.Context, id uint64) (*MemberPromoteR
 	}
 	return (*MemberPromoteResponse)(resp), nil
 }
+
+func (c *cluster) MemberReconfigure(ctx context.Context, add []*pb.MemberAddRequest, remove []uint64, promote []uint64) (*MemberReconfigureResponse, error) {
+	for _, ar := range add {
+		// fail-fast before panic in rafthttp
+		if _, err := types.NewURLs(ar.PeerURLs); err != nil {
+			return nil, err
+		}
+	}
+	r := &pb.MemberReconfigureRequest{Add: add, Remove: remove, Promote: promote}
+	resp, err := c.remote.MemberReconfigure(ctx, r, c.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*MemberReconfigureResponse)(resp), nil
+}
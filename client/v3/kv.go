@@ -29,6 +29,8 @@ type (
 	GetResponse     pb.RangeResponse
 	DeleteResponse  pb.DeleteRangeResponse
 	TxnResponse     pb.TxnResponse
+	// MultiGetResponse holds one range response per Get op, in request order.
+	MultiGetResponse pb.MultiRangeResponse
 )
 
 type KV interface {
@@ -48,6 +50,12 @@ type KV interface {
 	// When passed WithSort(), the keys will be sorted.
 	Get(ctx context.Context, key string, opts ...OpOption) (*GetResponse, error)
 
+	// MultiGet retrieves several key ranges at a single revision, waiting for
+	// one linearizable read for all of them. Every op must be created by OpGet.
+	// The ops must agree on WithRev, and may only use WithSerializable if all
+	// of them do; otherwise the request fails with ErrInvalidMultiRange.
+	MultiGet(ctx context.Context, ops ...Op) (*MultiGetResponse, error)
+
 	// Delete deletes a key, or optionally using WithRange(end), [key, end).
 	Delete(ctx context.Context, key string, opts ...OpOption) (*DeleteResponse, error)
 
@@ -77,6 +85,15 @@ func (op OpResponse) Get() *GetResponse    { return op.get }
 func (op OpResponse) Del() *DeleteResponse { return op.del }
 func (op OpResponse) Txn() *TxnResponse    { return op.txn }
 
+// Gets returns the range responses as GetResponses, in request order.
+func (resp *MultiGetResponse) Gets() []*GetResponse {
+	gets := make([]*GetResponse, len(resp.Responses))
+	for i, rr := range resp.Responses {
+		gets[i] = (*GetResponse)(rr)
+	}
+	return gets
+}
+
 func (resp *PutResponse) OpResponse() OpResponse {
 	return OpResponse{put: resp}
 }
@@ -121,6 +138,31 @@ func (kv *kv) Get(ctx context.Context, key string, opts ...OpOption) (*GetRespon
 	return r.get, ContextError(ctx, err)
 }
 
+func (kv *kv) MultiGet(ctx context.Context, ops ...Op) (*MultiGetResponse, error) {
+	r := &pb.MultiRangeRequest{
+		Ranges:       make([]*pb.RangeRequest, 0, len(ops)),
+		Serializable: len(ops) > 0,
+	}
+	for i, op := range ops {
+		if !op.IsGet() {
+			panic("unexpected non-get op in multi-get")
+		}
+		if !op.IsSortOptionValid() {
+			return nil, rpctypes.ErrInvalidSortOption
+		}
+		if i == 0 {
+			r.Revision = op.rev
+		}
+		r.Serializable = r.Serializable && op.serializable
+		r.Ranges = append(r.Ranges, op.toRangeRequest())
+	}
+	resp, err := kv.remote.MultiRange(ctx, r, kv.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*MultiGetResponse)(resp), nil
+}
+
 func (kv *kv) Delete(ctx context.Context, key string, opts ...OpOption) (*DeleteResponse, error) {
 	r, err := kv.Do(ctx, OpDelete(key, opts...))
 	return r.del, ContextError(ctx, err)
@@ -86,6 +86,12 @@ func (lkv *leasingKV) Get(ctx context.Context, key string, opts ...v3.OpOption)
 	return lkv.get(ctx, v3.OpGet(key, opts...))
 }
 
+// MultiGet is served by the cluster rather than the leasing cache, so that
+// all ranges are read at the same revision.
+func (lkv *leasingKV) MultiGet(ctx context.Context, ops ...v3.Op) (*v3.MultiGetResponse, error) {
+	return lkv.kv.MultiGet(ctx, ops...)
+}
+
 func (lkv *leasingKV) Put(ctx context.Context, key, val string, opts ...v3.OpOption) (*v3.PutResponse, error) {
 	return lkv.put(ctx, v3.OpPut(key, val, opts...))
 }
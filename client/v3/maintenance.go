@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -34,6 +35,8 @@ type (
 	HashKVResponse     pb.HashKVResponse
 	MoveLeaderResponse pb.MoveLeaderResponse
 	DowngradeResponse  pb.DowngradeResponse
+	WatchLagResponse   pb.WatchLagResponse
+	RevisionAtResponse pb.RevisionAtResponse
 
 	DowngradeAction pb.DowngradeRequest_DowngradeAction
 )
@@ -60,6 +63,13 @@ type Maintenance interface {
 	// times with different endpoints.
 	Defragment(ctx context.Context, endpoint string) (*DefragmentResponse, error)
 
+	// DefragmentIncremental defragments the storage of a given etcd member like
+	// Defragment, but copies it in bounded time slices while the member keeps
+	// serving requests. Writes are only blocked at the end, to copy again the
+	// keys written during the copy.
+	// Supported since etcd 3.6.
+	DefragmentIncremental(ctx context.Context, endpoint string) (*DefragmentResponse, error)
+
 	// Status gets the status of the endpoint.
 	Status(ctx context.Context, endpoint string) (*StatusResponse, error)
 
@@ -73,6 +83,13 @@ type Maintenance interface {
 	// "io.ReadCloser" would error out (e.g. context.Canceled, context.DeadlineExceeded).
 	SnapshotWithVersion(ctx context.Context) (*SnapshotResponse, error)
 
+	// SnapshotSince is like SnapshotWithVersion, but returns a delta snapshot holding
+	// only the revisions after rev, to be layered on a full snapshot taken at rev or
+	// later. Compactions after rev are applied to that snapshot when the delta is
+	// layered on it.
+	// Supported since etcd 3.6.
+	SnapshotSince(ctx context.Context, rev int64) (*SnapshotResponse, error)
+
 	// Snapshot provides a reader for a point-in-time snapshot of etcd.
 	// If the context "ctx" is canceled or timed out, reading from returned
 	// "io.ReadCloser" would error out (e.g. context.Canceled, context.DeadlineExceeded).
@@ -87,6 +104,15 @@ type Maintenance interface {
 	// on the cluster version.
 	// Supported since etcd 3.5.
 	Downgrade(ctx context.Context, action DowngradeAction, version string) (*DowngradeResponse, error)
+
+	// WatchLag reports how far behind the watch streams of the endpoint are.
+	// Supported since etcd 3.6.
+	WatchLag(ctx context.Context, endpoint string) (*WatchLagResponse, error)
+
+	// RevisionAt looks up the revision that was current at the given time. The leader records
+	// the current revision at most once per second in an index replicated to every member.
+	// Supported since etcd 3.6.
+	RevisionAt(ctx context.Context, endpoint string, t time.Time) (*RevisionAtResponse, error)
 }
 
 // SnapshotResponse is aggregated response from the snapshot stream.
@@ -189,12 +215,20 @@ func (m *maintenance) AlarmDisarm(ctx context.Context, am *AlarmMember) (*AlarmR
 }
 
 func (m *maintenance) Defragment(ctx context.Context, endpoint string) (*DefragmentResponse, error) {
+	return m.defragment(ctx, endpoint, pb.DefragmentRequest_FULL)
+}
+
+func (m *maintenance) DefragmentIncremental(ctx context.Context, endpoint string) (*DefragmentResponse, error) {
+	return m.defragment(ctx, endpoint, pb.DefragmentRequest_INCREMENTAL)
+}
+
+func (m *maintenance) defragment(ctx context.Context, endpoint string, mode pb.DefragmentRequest_DefragmentMode) (*DefragmentResponse, error) {
 	remote, cancel, err := m.dial(endpoint)
 	if err != nil {
 		return nil, ContextError(ctx, err)
 	}
 	defer cancel()
-	resp, err := remote.Defragment(ctx, &pb.DefragmentRequest{}, m.callOpts...)
+	resp, err := remote.Defragment(ctx, &pb.DefragmentRequest{Mode: mode}, m.callOpts...)
 	if err != nil {
 		return nil, ContextError(ctx, err)
 	}
@@ -228,7 +262,15 @@ func (m *maintenance) HashKV(ctx context.Context, endpoint string, rev int64) (*
 }
 
 func (m *maintenance) SnapshotWithVersion(ctx context.Context) (*SnapshotResponse, error) {
-	ss, err := m.remote.Snapshot(ctx, &pb.SnapshotRequest{}, append(m.callOpts, withMax(defaultStreamMaxRetries))...)
+	return m.snapshotWithVersion(ctx, &pb.SnapshotRequest{})
+}
+
+func (m *maintenance) SnapshotSince(ctx context.Context, rev int64) (*SnapshotResponse, error) {
+	return m.snapshotWithVersion(ctx, &pb.SnapshotRequest{SinceRevision: rev})
+}
+
+func (m *maintenance) snapshotWithVersion(ctx context.Context, req *pb.SnapshotRequest) (*SnapshotResponse, error) {
+	ss, err := m.remote.Snapshot(ctx, req, append(m.callOpts, withMax(defaultStreamMaxRetries))...)
 	if err != nil {
 		return nil, ContextError(ctx, err)
 	}
@@ -348,3 +390,29 @@ func (m *maintenance) Downgrade(ctx context.Context, action DowngradeAction, ver
 	resp, err := m.remote.Downgrade(ctx, &pb.DowngradeRequest{Action: actionType, Version: version}, m.callOpts...)
 	return (*DowngradeResponse)(resp), ContextError(ctx, err)
 }
+
+func (m *maintenance) WatchLag(ctx context.Context, endpoint string) (*WatchLagResponse, error) {
+	remote, cancel, err := m.dial(endpoint)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	defer cancel()
+	resp, err := remote.WatchLag(ctx, &pb.WatchLagRequest{}, m.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*WatchLagResponse)(resp), nil
+}
+
+func (m *maintenance) RevisionAt(ctx context.Context, endpoint string, t time.Time) (*RevisionAtResponse, error) {
+	remote, cancel, err := m.dial(endpoint)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	defer cancel()
+	resp, err := remote.RevisionAt(ctx, &pb.RevisionAtRequest{Timestamp: t.UnixNano()}, m.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*RevisionAtResponse)(resp), nil
+}
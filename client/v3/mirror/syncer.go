@@ -90,6 +90,7 @@ func (s *syncer) SyncBase(ctx context.Context) (<-chan clientv3.GetResponse, cha
 			key = s.prefix
 		}
 
+		baseOpts := len(opts)
 		for {
 			resp, err := s.c.Get(ctx, key, opts...)
 			if err != nil {
@@ -102,7 +103,12 @@ func (s *syncer) SyncBase(ctx context.Context) (<-chan clientv3.GetResponse, cha
 			if !resp.More {
 				return
 			}
-			// move to next key
+			if len(resp.ContinueToken) != 0 {
+				// let the server resume the range at the pinned revision
+				opts = append(opts[:baseOpts], clientv3.WithContinueToken(resp.ContinueToken))
+				continue
+			}
+			// server does not issue continue tokens; move to next key
 			key = string(append(resp.Kvs[len(resp.Kvs)-1].Key, 0))
 		}
 	}()
@@ -171,6 +171,10 @@ func (m *mockKVServer) Range(context.Context, *pb.RangeRequest) (*pb.RangeRespon
 	return &pb.RangeResponse{}, nil
 }
 
+func (m *mockKVServer) MultiRange(context.Context, *pb.MultiRangeRequest) (*pb.MultiRangeResponse, error) {
+	return &pb.MultiRangeResponse{}, nil
+}
+
 func (m *mockKVServer) Put(context.Context, *pb.PutRequest) (*pb.PutResponse, error) {
 	return &pb.PutResponse{}, nil
 }
@@ -64,6 +64,24 @@ func (kv *kvPrefix) Get(ctx context.Context, key string, opts ...clientv3.OpOpti
 	return get, nil
 }
 
+func (kv *kvPrefix) MultiGet(ctx context.Context, ops ...clientv3.Op) (*clientv3.MultiGetResponse, error) {
+	pfxOps := make([]clientv3.Op, len(ops))
+	for i, op := range ops {
+		if len(op.KeyBytes()) == 0 && !(op.IsOptsWithFromKey() || op.IsOptsWithPrefix()) {
+			return nil, rpctypes.ErrEmptyKey
+		}
+		pfxOps[i] = kv.prefixOp(op)
+	}
+	resp, err := kv.KV.MultiGet(ctx, pfxOps...)
+	if err != nil {
+		return nil, err
+	}
+	for _, get := range resp.Gets() {
+		kv.unprefixGetResponse(get)
+	}
+	return resp, nil
+}
+
 func (kv *kvPrefix) Delete(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
 	if len(key) == 0 && !(clientv3.IsOptsWithFromKey(opts) || clientv3.IsOptsWithPrefix(opts)) {
 		return nil, rpctypes.ErrEmptyKey
@@ -14,7 +14,11 @@
 
 package clientv3
 
-import pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+import (
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
 
 type opType int
 
@@ -44,6 +48,9 @@ type Op struct {
 	maxModRev    int64
 	minCreateRev int64
 	maxCreateRev int64
+	continueTok  []byte
+	filters      []*pb.RangeFilter
+	maxStaleness *pb.Staleness
 
 	// for range, watch
 	rev int64
@@ -65,9 +72,19 @@ type Op struct {
 	progressNotify bool
 	// createdNotify is for created event
 	createdNotify bool
+	// initialState is for sending the watched range before the events
+	initialState bool
+	// resumeToken is for resuming a watcher
+	resumeToken []byte
+	// coalesce is for sending only the latest event of each key
+	coalesce       bool
+	coalesceWindow time.Duration
 	// filters for watchers
-	filterPut    bool
-	filterDelete bool
+	filterPut       bool
+	filterDelete    bool
+	filterUnchanged bool
+	filterUpdate    bool
+	eventFilters    []*pb.WatchFilter
 
 	// for put
 	val     []byte
@@ -140,6 +157,9 @@ func (op Op) MinCreateRev() int64 { return op.minCreateRev }
 // MaxCreateRev returns the operation's maximum create revision.
 func (op Op) MaxCreateRev() int64 { return op.maxCreateRev }
 
+// ContinueToken returns the operation's continue token, if any.
+func (op Op) ContinueToken() []byte { return op.continueTok }
+
 // WithRangeBytes sets the byte slice for the Op's range end.
 func (op *Op) WithRangeBytes(end []byte) { op.end = end }
 
@@ -165,6 +185,9 @@ func (op Op) toRangeRequest() *pb.RangeRequest {
 		MaxModRevision:    op.maxModRev,
 		MinCreateRevision: op.minCreateRev,
 		MaxCreateRevision: op.maxCreateRev,
+		ContinueToken:     op.continueTok,
+		Filters:           op.filters,
+		MaxStaleness:      op.maxStaleness,
 	}
 	if op.sort != nil {
 		r.SortOrder = pb.RangeRequest_SortOrder(op.sort.Order)
@@ -263,10 +286,22 @@ func OpDelete(key string, opts ...OpOption) Op {
 		panic("unexpected mod revision filter in delete")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in delete")
-	case ret.filterDelete, ret.filterPut:
+	case ret.continueTok != nil:
+		panic("unexpected continue token in delete")
+	case ret.filters != nil:
+		panic("unexpected range filter in delete")
+	case ret.maxStaleness != nil:
+		panic("unexpected max staleness in delete")
+	case ret.filterDelete, ret.filterPut, ret.filterUnchanged, ret.filterUpdate, ret.eventFilters != nil:
 		panic("unexpected filter in delete")
 	case ret.createdNotify:
 		panic("unexpected createdNotify in delete")
+	case ret.initialState:
+		panic("unexpected initialState in delete")
+	case ret.resumeToken != nil:
+		panic("unexpected resumeToken in delete")
+	case ret.coalesce:
+		panic("unexpected coalesce in delete")
 	}
 	return ret
 }
@@ -292,10 +327,22 @@ func OpPut(key, val string, opts ...OpOption) Op {
 		panic("unexpected mod revision filter in put")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in put")
-	case ret.filterDelete, ret.filterPut:
+	case ret.continueTok != nil:
+		panic("unexpected continue token in put")
+	case ret.filters != nil:
+		panic("unexpected range filter in put")
+	case ret.maxStaleness != nil:
+		panic("unexpected max staleness in put")
+	case ret.filterDelete, ret.filterPut, ret.filterUnchanged, ret.filterUpdate, ret.eventFilters != nil:
 		panic("unexpected filter in put")
 	case ret.createdNotify:
 		panic("unexpected createdNotify in put")
+	case ret.initialState:
+		panic("unexpected initialState in put")
+	case ret.resumeToken != nil:
+		panic("unexpected resumeToken in put")
+	case ret.coalesce:
+		panic("unexpected coalesce in put")
 	}
 	return ret
 }
@@ -323,6 +370,12 @@ func opWatch(key string, opts ...OpOption) Op {
 		panic("unexpected mod revision filter in watch")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in watch")
+	case ret.continueTok != nil:
+		panic("unexpected continue token in watch")
+	case ret.filters != nil:
+		panic("unexpected range filter in watch")
+	case ret.maxStaleness != nil:
+		panic("unexpected max staleness in watch")
 	}
 	return ret
 }
@@ -458,6 +511,75 @@ func WithMinCreateRev(rev int64) OpOption { return func(op *Op) { op.minCreateRe
 // WithMaxCreateRev filters out keys for Get with creation revisions greater than the given revision.
 func WithMaxCreateRev(rev int64) OpOption { return func(op *Op) { op.maxCreateRev = rev } }
 
+// WithContinueToken resumes a paginated 'Get' request from the ContinueToken of
+// a previous response for the same range. The request is served at the revision
+// of the first page; ErrCompacted is returned if that revision has been compacted.
+func WithContinueToken(token []byte) OpOption {
+	return func(op *Op) { op.continueTok = token }
+}
+
+// WithFilter makes 'Get' return only the key-value pairs matching all given
+// comparisons, which are evaluated on the server for every key in the range.
+// The comparisons are built the same way as for a transaction; their keys are
+// ignored, e.g. 'WithFilter(Compare(Version(""), ">", 1))'. Limit and count
+// apply to the filtered result.
+func WithFilter(cmps ...Cmp) OpOption {
+	return func(op *Op) {
+		for _, cmp := range cmps {
+			f := &pb.RangeFilter{Result: pb.RangeFilter_FilterResult(cmp.Result), Target: cmp.Target}
+			switch tv := cmp.TargetUnion.(type) {
+			case *pb.Compare_Version:
+				f.TargetUnion = &pb.RangeFilter_Version{Version: tv.Version}
+			case *pb.Compare_CreateRevision:
+				f.TargetUnion = &pb.RangeFilter_CreateRevision{CreateRevision: tv.CreateRevision}
+			case *pb.Compare_ModRevision:
+				f.TargetUnion = &pb.RangeFilter_ModRevision{ModRevision: tv.ModRevision}
+			case *pb.Compare_Value:
+				f.TargetUnion = &pb.RangeFilter_Value{Value: tv.Value}
+			case *pb.Compare_Lease:
+				f.TargetUnion = &pb.RangeFilter_Lease{Lease: tv.Lease}
+			}
+			op.filters = append(op.filters, f)
+		}
+	}
+}
+
+// WithValuePrefix makes 'Get' return only the key-value pairs whose value
+// starts with the given prefix.
+func WithValuePrefix(prefix string) OpOption {
+	return func(op *Op) {
+		op.filters = append(op.filters, &pb.RangeFilter{
+			Result:      pb.RangeFilter_HAS_PREFIX,
+			Target:      pb.Compare_VALUE,
+			TargetUnion: &pb.RangeFilter_Value{Value: []byte(prefix)},
+		})
+	}
+}
+
+// WithMaxStaleness lets a follower serve a linearizable 'Get' request
+// locally if it has applied the leader's commit index learned within the
+// given duration, instead of confirming the read with the leader.
+func WithMaxStaleness(d time.Duration) OpOption {
+	return func(op *Op) {
+		if op.maxStaleness == nil {
+			op.maxStaleness = &pb.Staleness{}
+		}
+		op.maxStaleness.Milliseconds = d.Milliseconds()
+	}
+}
+
+// WithMaxStalenessRevisions lets a follower serve a linearizable 'Get'
+// request locally if it is at most the given number of revisions behind
+// the leader, instead of confirming the read with the leader.
+func WithMaxStalenessRevisions(revs int64) OpOption {
+	return func(op *Op) {
+		if op.maxStaleness == nil {
+			op.maxStaleness = &pb.Staleness{}
+		}
+		op.maxStaleness.Revisions = revs
+	}
+}
+
 // WithFirstCreate gets the key with the oldest creation revision in the request range.
 func WithFirstCreate() []OpOption { return withTop(SortByCreateRevision, SortAscend) }
 
@@ -507,6 +629,71 @@ func WithFilterDelete() OpOption {
 	return func(op *Op) { op.filterDelete = true }
 }
 
+// WithFilterUnchanged discards PUT events that keep the previous value of the key.
+func WithFilterUnchanged() OpOption {
+	return func(op *Op) { op.filterUnchanged = true }
+}
+
+// WithFilterUpdate discards PUT events that update an existing key, so that
+// only PUT events creating a key are sent to the watcher.
+func WithFilterUpdate() OpOption {
+	return func(op *Op) { op.filterUpdate = true }
+}
+
+// WithMatchKeyGlob only sends the watcher events whose key matches the
+// glob pattern, using the syntax of path.Match.
+func WithMatchKeyGlob(pattern string) OpOption {
+	return func(op *Op) {
+		op.eventFilters = append(op.eventFilters, &pb.WatchFilter{Filter: &pb.WatchFilter_KeyGlob{KeyGlob: pattern}})
+	}
+}
+
+// WithMatchKeyRegex only sends the watcher events whose key matches the
+// RE2 regular expression. The expression is not anchored.
+func WithMatchKeyRegex(expr string) OpOption {
+	return func(op *Op) {
+		op.eventFilters = append(op.eventFilters, &pb.WatchFilter{Filter: &pb.WatchFilter_KeyRegex{KeyRegex: expr}})
+	}
+}
+
+// WithMatchLease only sends the watcher PUT events of keys attached to the
+// given lease. DELETE events carry no lease and are always sent.
+func WithMatchLease(id LeaseID) OpOption {
+	return func(op *Op) {
+		op.eventFilters = append(op.eventFilters, &pb.WatchFilter{Filter: &pb.WatchFilter_Lease{Lease: int64(id)}})
+	}
+}
+
+// WithInitialState makes the watcher first receive the key-value pairs in the
+// watched range, as of the revision before the watch starts, as PUT events in
+// responses with InitialState set. A response with InitialStateDone set ends
+// the initial state; the events after it start at the next revision. If the
+// watch is resumed before the end of the initial state, it is sent again.
+func WithInitialState() OpOption {
+	return func(op *Op) { op.initialState = true }
+}
+
+// WithResumeToken resumes a watcher with the ResumeToken of a response it
+// received, for example from a watcher of a previous client. Members that
+// issue resume tokens hold back auto compaction of the token revision for
+// a while. A manual Compact is not held back and may still compact the token
+// revision, failing the watcher with ErrCompacted. The watcher starts at the
+// token revision unless WithRev is later.
+func WithResumeToken(tok []byte) OpOption {
+	return func(op *Op) { op.resumeToken = tok }
+}
+
+// WithCoalesce makes the watcher receive only the latest event of each key,
+// out of the events collected for up to d, or out of the events of a single
+// server response if d is zero. The SkippedRevisions of a received event
+// counts the earlier events of its key that were dropped.
+func WithCoalesce(d time.Duration) OpOption {
+	return func(op *Op) {
+		op.coalesce = true
+		op.coalesceWindow = d
+	}
+}
+
 // WithPrevKV gets the previous key-value pair before the event happens. If the previous KV is already compacted,
 // nothing will be returned.
 func WithPrevKV() OpOption {
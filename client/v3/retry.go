@@ -105,6 +105,10 @@ func (rkv *retryKVClient) Range(ctx context.Context, in *pb.RangeRequest, opts .
 	return rkv.kc.Range(ctx, in, append(opts, withRepeatablePolicy())...)
 }
 
+func (rkv *retryKVClient) MultiRange(ctx context.Context, in *pb.MultiRangeRequest, opts ...grpc.CallOption) (resp *pb.MultiRangeResponse, err error) {
+	return rkv.kc.MultiRange(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
 func (rkv *retryKVClient) Put(ctx context.Context, in *pb.PutRequest, opts ...grpc.CallOption) (resp *pb.PutResponse, err error) {
 	return rkv.kc.Put(ctx, in, opts...)
 }
@@ -183,6 +187,10 @@ func (rcc *retryClusterClient) MemberPromote(ctx context.Context, in *pb.MemberP
 	return rcc.cc.MemberPromote(ctx, in, opts...)
 }
 
+func (rcc *retryClusterClient) MemberReconfigure(ctx context.Context, in *pb.MemberReconfigureRequest, opts ...grpc.CallOption) (resp *pb.MemberReconfigureResponse, err error) {
+	return rcc.cc.MemberReconfigure(ctx, in, opts...)
+}
+
 type retryMaintenanceClient struct {
 	mc pb.MaintenanceClient
 }
@@ -226,6 +234,14 @@ func (rmc *retryMaintenanceClient) Downgrade(ctx context.Context, in *pb.Downgra
 	return rmc.mc.Downgrade(ctx, in, opts...)
 }
 
+func (rmc *retryMaintenanceClient) WatchLag(ctx context.Context, in *pb.WatchLagRequest, opts ...grpc.CallOption) (resp *pb.WatchLagResponse, err error) {
+	return rmc.mc.WatchLag(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
+func (rmc *retryMaintenanceClient) RevisionAt(ctx context.Context, in *pb.RevisionAtRequest, opts ...grpc.CallOption) (resp *pb.RevisionAtResponse, err error) {
+	return rmc.mc.RevisionAt(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
 type retryAuthClient struct {
 	ac pb.AuthClient
 }
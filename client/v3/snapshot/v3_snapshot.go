@@ -46,6 +46,17 @@ func hasChecksum(n int64) bool {
 // the selected node.
 // Etcd <v3.6 will return "" as version.
 func SaveWithVersion(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, dbPath string) (string, error) {
+	return save(ctx, lg, cfg, dbPath, 0)
+}
+
+// SaveSinceWithVersion is like SaveWithVersion, but saves a delta snapshot
+// holding only the revisions after rev, to be layered on a full snapshot
+// taken at rev or later when restoring.
+func SaveSinceWithVersion(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, dbPath string, rev int64) (string, error) {
+	return save(ctx, lg, cfg, dbPath, rev)
+}
+
+func save(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, dbPath string, since int64) (string, error) {
 	cfg.Logger = lg.Named("client")
 	if len(cfg.Endpoints) != 1 {
 		return "", fmt.Errorf("snapshot must be requested to one selected node, not multiple %v", cfg.Endpoints)
@@ -67,7 +78,12 @@ func SaveWithVersion(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, d
 	lg.Info("created temporary db file", zap.String("path", partpath))
 
 	start := time.Now()
-	resp, err := cli.SnapshotWithVersion(ctx)
+	var resp *clientv3.SnapshotResponse
+	if since > 0 {
+		resp, err = cli.SnapshotSince(ctx, since)
+	} else {
+		resp, err = cli.SnapshotWithVersion(ctx)
+	}
 	if err != nil {
 		return "", err
 	}
@@ -84,6 +84,12 @@ type Watcher interface {
 	// RequestProgress requests a progress notify response be sent in all watch channels.
 	RequestProgress(ctx context.Context) error
 
+	// GrantCredits grants the server credits to send the given number of
+	// additional events over the watch stream of ctx. Once credits are
+	// granted, the server holds back the events of the stream while it has
+	// no credits left. A reconnected stream starts without flow control.
+	GrantCredits(ctx context.Context, credits int64) error
+
 	// Close closes the watcher and cancels all watch requests.
 	Close() error
 }
@@ -103,6 +109,18 @@ type WatchResponse struct {
 	// Created is used to indicate the creation of the watcher.
 	Created bool
 
+	// InitialState is set if the events are key-value pairs of the initial state
+	// requested by WithInitialState.
+	InitialState bool
+
+	// InitialStateDone is set if the response ends the initial state. Its header
+	// revision is the revision of the initial state.
+	InitialStateDone bool
+
+	// ResumeToken resumes the watcher after the response with WithResumeToken.
+	// It is only set by members running with watch resume retention.
+	ResumeToken []byte
+
 	closeErr error
 
 	// cancelReason is a reason of canceling watch
@@ -137,7 +155,7 @@ func (wr *WatchResponse) Err() error {
 
 // IsProgressNotify returns true if the WatchResponse is progress notification.
 func (wr *WatchResponse) IsProgressNotify() bool {
-	return len(wr.Events) == 0 && !wr.Canceled && !wr.Created && wr.CompactRevision == 0 && wr.Header.Revision != 0
+	return len(wr.Events) == 0 && !wr.Canceled && !wr.Created && !wr.InitialStateDone && wr.CompactRevision == 0 && wr.Header.Revision != 0
 }
 
 // watcher implements the Watcher interface
@@ -214,6 +232,16 @@ type watchRequest struct {
 
 	// filters is the list of events to filter out
 	filters []pb.WatchCreateRequest_FilterType
+	// eventFilters is the list of filters events must match
+	eventFilters []*pb.WatchFilter
+	// initialState is true until the initial state of the range is received
+	initialState bool
+	// resumeToken is the latest resume token received for the watcher
+	resumeToken []byte
+	// coalesce sends only the latest event of each key, collecting the
+	// events for coalesceWindow if it is set
+	coalesce       bool
+	coalesceWindow time.Duration
 	// get the previous key-value pair before the event happens
 	prevKV bool
 	// retc receives a chan WatchResponse once the watcher is established
@@ -224,6 +252,11 @@ type watchRequest struct {
 type progressRequest struct {
 }
 
+// creditRequest is issued by the subscriber to grant the server credits
+type creditRequest struct {
+	credits int64
+}
+
 // watcherStream represents a registered watcher
 type watcherStream struct {
 	// initReq is the request that initiated this request
@@ -304,6 +337,12 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 	if ow.filterDelete {
 		filters = append(filters, pb.WatchCreateRequest_NODELETE)
 	}
+	if ow.filterUnchanged {
+		filters = append(filters, pb.WatchCreateRequest_NOUNCHANGED)
+	}
+	if ow.filterUpdate {
+		filters = append(filters, pb.WatchCreateRequest_NOUPDATE)
+	}
 
 	wr := &watchRequest{
 		ctx:            ctx,
@@ -314,6 +353,11 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 		progressNotify: ow.progressNotify,
 		fragment:       ow.fragment,
 		filters:        filters,
+		eventFilters:   ow.eventFilters,
+		initialState:   ow.initialState,
+		resumeToken:    ow.resumeToken,
+		coalesce:       ow.coalesce,
+		coalesceWindow: ow.coalesceWindow,
 		prevKV:         ow.prevKV,
 		retc:           make(chan chan WatchResponse, 1),
 	}
@@ -403,6 +447,19 @@ func (w *watcher) Close() (err error) {
 
 // RequestProgress requests a progress notify response be sent in all watch channels.
 func (w *watcher) RequestProgress(ctx context.Context) (err error) {
+	return w.sendStreamRequest(ctx, &progressRequest{})
+}
+
+// GrantCredits grants the server credits to send more events over the watch stream.
+func (w *watcher) GrantCredits(ctx context.Context, credits int64) error {
+	if credits <= 0 {
+		return errors.New("credits must be positive")
+	}
+	return w.sendStreamRequest(ctx, &creditRequest{credits: credits})
+}
+
+// sendStreamRequest sends a request of the stream itself over the watch stream of ctx.
+func (w *watcher) sendStreamRequest(ctx context.Context, req watchStreamRequest) error {
 	ctxKey := streamKeyFromCtx(ctx)
 
 	w.mu.Lock()
@@ -419,10 +476,8 @@ func (w *watcher) RequestProgress(ctx context.Context) (err error) {
 	reqc := wgs.reqc
 	w.mu.Unlock()
 
-	pr := &progressRequest{}
-
 	select {
-	case reqc <- pr:
+	case reqc <- req:
 		return nil
 	case <-ctx.Done():
 		return ctx.Err()
@@ -431,7 +486,7 @@ func (w *watcher) RequestProgress(ctx context.Context) (err error) {
 			return wgs.closeErr
 		}
 		// retry; may have dropped stream from no ctxs
-		return w.RequestProgress(ctx)
+		return w.sendStreamRequest(ctx, req)
 	}
 }
 
@@ -569,7 +624,7 @@ func (w *watchGRPCStream) run() {
 						w.lg.Debug("error when sending request", zap.Error(err))
 					}
 				}
-			case *progressRequest:
+			case *progressRequest, *creditRequest:
 				if err := wc.Send(wreq.toPB()); err != nil {
 					w.lg.Debug("error when sending request", zap.Error(err))
 				}
@@ -717,12 +772,15 @@ func (w *watchGRPCStream) dispatchEvent(pbresp *pb.WatchResponse) bool {
 	}
 	// TODO: return watch ID?
 	wr := &WatchResponse{
-		Header:          *pbresp.Header,
-		Events:          events,
-		CompactRevision: pbresp.CompactRevision,
-		Created:         pbresp.Created,
-		Canceled:        pbresp.Canceled,
-		cancelReason:    pbresp.CancelReason,
+		Header:           *pbresp.Header,
+		Events:           events,
+		CompactRevision:  pbresp.CompactRevision,
+		Created:          pbresp.Created,
+		Canceled:         pbresp.Canceled,
+		InitialState:     pbresp.InitialState,
+		InitialStateDone: pbresp.InitialStateDone,
+		ResumeToken:      pbresp.ResumeToken,
+		cancelReason:     pbresp.CancelReason,
 	}
 
 	// watch IDs are zero indexed, so request notify watch responses are assigned a watch ID of InvalidWatchID to
@@ -850,11 +908,20 @@ func (w *watchGRPCStream) serveSubstream(ws *watcherStream, resumec chan struct{
 				nextRev = wr.Header.Revision + 1
 			}
 
-			if len(wr.Events) > 0 {
+			// the initial state events are older than the revision
+			// the watch resumes at
+			if len(wr.Events) > 0 && !wr.InitialState {
 				nextRev = wr.Events[len(wr.Events)-1].Kv.ModRevision + 1
 			}
 
 			ws.initReq.rev = nextRev
+			if len(wr.ResumeToken) != 0 {
+				ws.initReq.resumeToken = wr.ResumeToken
+			}
+			if wr.InitialStateDone {
+				// do not request the initial state again on resume
+				ws.initReq.initialState = false
+			}
 
 			// created event is already sent above,
 			// watcher should not post duplicate events
@@ -1015,13 +1082,18 @@ func (w *watchGRPCStream) openWatchClient() (ws pb.Watch_WatchClient, err error)
 // toPB converts an internal watch request structure to its protobuf WatchRequest structure.
 func (wr *watchRequest) toPB() *pb.WatchRequest {
 	req := &pb.WatchCreateRequest{
-		StartRevision:  wr.rev,
-		Key:            []byte(wr.key),
-		RangeEnd:       []byte(wr.end),
-		ProgressNotify: wr.progressNotify,
-		Filters:        wr.filters,
-		PrevKv:         wr.prevKV,
-		Fragment:       wr.fragment,
+		StartRevision:    wr.rev,
+		Key:              []byte(wr.key),
+		RangeEnd:         []byte(wr.end),
+		ProgressNotify:   wr.progressNotify,
+		Filters:          wr.filters,
+		EventFilters:     wr.eventFilters,
+		InitialState:     wr.initialState,
+		ResumeToken:      wr.resumeToken,
+		Coalesce:         wr.coalesce,
+		CoalesceWindowMs: wr.coalesceWindow.Milliseconds(),
+		PrevKv:           wr.prevKV,
+		Fragment:         wr.fragment,
 	}
 	cr := &pb.WatchRequest_CreateRequest{CreateRequest: req}
 	return &pb.WatchRequest{RequestUnion: cr}
@@ -1034,6 +1106,12 @@ func (pr *progressRequest) toPB() *pb.WatchRequest {
 	return &pb.WatchRequest{RequestUnion: cr}
 }
 
+// toPB converts an internal credit request structure to its protobuf WatchRequest structure.
+func (cr *creditRequest) toPB() *pb.WatchRequest {
+	req := &pb.WatchCreditRequest{Credits: cr.credits}
+	return &pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreditRequest{CreditRequest: req}}
+}
+
 func streamKeyFromCtx(ctx context.Context) string {
 	if md, ok := metadata.FromOutgoingContext(ctx); ok {
 		return fmt.Sprintf("%+v", map[string][]string(md))
@@ -24,6 +24,8 @@ import (
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
 )
 
+var defragIncremental bool
+
 // NewDefragCommand returns the cobra command for "Defrag".
 func NewDefragCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -32,6 +34,7 @@ func NewDefragCommand() *cobra.Command {
 		Run:   defragCommandFunc,
 	}
 	cmd.PersistentFlags().BoolVar(&epClusterEndpoints, "cluster", false, "use all endpoints from the cluster member list")
+	cmd.Flags().BoolVar(&defragIncremental, "incremental", false, "copy the storage in short slices while serving requests, and only block writes at the end to catch up with the writes made meanwhile")
 	return cmd
 }
 
@@ -43,7 +46,12 @@ func defragCommandFunc(cmd *cobra.Command, args []string) {
 		c := mustClient(cfg)
 		ctx, cancel := commandCtx(cmd)
 		start := time.Now()
-		_, err := c.Defragment(ctx, ep)
+		var err error
+		if defragIncremental {
+			_, err = c.DefragmentIncremental(ctx, ep)
+		} else {
+			_, err = c.Defragment(ctx, ep)
+		}
 		d := time.Now().Sub(start)
 		cancel()
 		if err != nil {
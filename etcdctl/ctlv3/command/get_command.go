@@ -15,11 +15,15 @@
 package command
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
 )
@@ -39,6 +43,11 @@ var (
 	getMaxCreateRev int64
 	getMinModRev    int64
 	getMaxModRev    int64
+	getMulti        bool
+	getAtTime       string
+
+	getMaxStaleness          time.Duration
+	getMaxStalenessRevisions int64
 )
 
 // NewGetCommand returns the cobra command for "get".
@@ -63,6 +72,10 @@ func NewGetCommand() *cobra.Command {
 	cmd.Flags().Int64Var(&getMaxCreateRev, "max-create-rev", 0, "Maximum create revision")
 	cmd.Flags().Int64Var(&getMinModRev, "min-mod-rev", 0, "Minimum modification revision")
 	cmd.Flags().Int64Var(&getMaxModRev, "max-mod-rev", 0, "Maximum modification revision")
+	cmd.Flags().BoolVar(&getMulti, "multi", false, "Get several keys (or prefixes with --prefix) at the same revision")
+	cmd.Flags().DurationVar(&getMaxStaleness, "max-staleness", 0, "Let a follower serve the linearizable read locally if it applied the leader's commit learned within this duration")
+	cmd.Flags().Int64Var(&getMaxStalenessRevisions, "max-staleness-revisions", 0, "Let a follower serve the linearizable read locally if it is at most this many revisions behind the leader")
+	cmd.Flags().StringVar(&getAtTime, "at-time", "", "Get the keys at the revision current at the given RFC3339 time, as recorded by the leader")
 
 	cmd.RegisterFlagCompletionFunc("consistency", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return []string{"l", "s"}, cobra.ShellCompDirectiveDefault
@@ -79,9 +92,22 @@ func NewGetCommand() *cobra.Command {
 
 // getCommandFunc executes the "get" command.
 func getCommandFunc(cmd *cobra.Command, args []string) {
-	key, opts := getGetOp(args)
+	var resp *clientv3.GetResponse
+	var err error
 	ctx, cancel := commandCtx(cmd)
-	resp, err := mustClientFromCmd(cmd).Get(ctx, key, opts...)
+	c := mustClientFromCmd(cmd)
+	if getAtTime != "" {
+		getRev = revisionAtTime(ctx, c)
+	}
+	if getMulti {
+		if getMaxStaleness != 0 || getMaxStalenessRevisions != 0 {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--max-staleness` and `--max-staleness-revisions` cannot be set with `--multi`"))
+		}
+		resp, err = multiGet(ctx, c, args)
+	} else {
+		key, opts := getGetOp(args)
+		resp, err = c.Get(ctx, key, opts...)
+	}
 	cancel()
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
@@ -103,6 +129,56 @@ func getCommandFunc(cmd *cobra.Command, args []string) {
 	display.Get(*resp)
 }
 
+// revisionAtTime looks up the revision current at the time given by
+// --at-time on the first endpoint that answers. The revision time index is
+// replicated, so the other endpoints are only tried if one is unavailable.
+func revisionAtTime(ctx context.Context, c *clientv3.Client) int64 {
+	if getRev > 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--rev` and `--at-time` cannot be set at the same time, choose one"))
+	}
+	t, err := time.Parse(time.RFC3339Nano, getAtTime)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("invalid `--at-time` %q: %v", getAtTime, err))
+	}
+	var lastErr error
+	for _, ep := range c.Endpoints() {
+		resp, err := c.RevisionAt(ctx, ep, t)
+		if err == nil {
+			return resp.Revision
+		}
+		if ctx.Err() != nil || errors.Is(err, rpctypes.ErrCompacted) || errors.Is(err, rpctypes.ErrRevisionTimeUnknown) {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		lastErr = err
+	}
+	cobrautl.ExitWithError(cobrautl.ExitError, lastErr)
+	return 0
+}
+
+// multiGet reads every key of args at the same revision and merges
+// the results into a single response.
+func multiGet(ctx context.Context, c *clientv3.Client, args []string) (*clientv3.GetResponse, error) {
+	if len(args) == 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("get command needs at least one key with `--multi`"))
+	}
+	ops := make([]clientv3.Op, 0, len(args))
+	for _, arg := range args {
+		key, opts := getGetOp([]string{arg})
+		ops = append(ops, clientv3.OpGet(key, opts...))
+	}
+	mresp, err := c.MultiGet(ctx, ops...)
+	if err != nil {
+		return nil, err
+	}
+	resp := &clientv3.GetResponse{Header: mresp.Header}
+	for _, rr := range mresp.Responses {
+		resp.Kvs = append(resp.Kvs, rr.Kvs...)
+		resp.Count += rr.Count
+		resp.More = resp.More || rr.More
+	}
+	return resp, nil
+}
+
 func getGetOp(args []string) (string, []clientv3.OpOption) {
 	if len(args) == 0 {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("get command needs one argument as key and an optional argument as range_end"))
@@ -120,6 +196,12 @@ func getGetOp(args []string) (string, []clientv3.OpOption) {
 	if IsSerializable(getConsistency) {
 		opts = append(opts, clientv3.WithSerializable())
 	}
+	if getMaxStaleness > 0 {
+		opts = append(opts, clientv3.WithMaxStaleness(getMaxStaleness))
+	}
+	if getMaxStalenessRevisions > 0 {
+		opts = append(opts, clientv3.WithMaxStalenessRevisions(getMaxStalenessRevisions))
+	}
 
 	key := args[0]
 	if len(args) > 1 {
@@ -22,6 +22,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
 )
@@ -29,7 +30,14 @@ import (
 var (
 	memberPeerURLs    string
 	isLearner         bool
+	isWitness         bool
+	autoPromote       bool
 	memberConsistency string
+
+	reconfigureAdd        []string
+	reconfigureAddLearner []string
+	reconfigureRemove     []string
+	reconfigurePromote    []string
 )
 
 // NewMemberCommand returns the cobra command for "member".
@@ -44,6 +52,7 @@ func NewMemberCommand() *cobra.Command {
 	mc.AddCommand(NewMemberUpdateCommand())
 	mc.AddCommand(NewMemberListCommand())
 	mc.AddCommand(NewMemberPromoteCommand())
+	mc.AddCommand(NewMemberReconfigureCommand())
 
 	return mc
 }
@@ -59,6 +68,8 @@ func NewMemberAddCommand() *cobra.Command {
 
 	cc.Flags().StringVar(&memberPeerURLs, "peer-urls", "", "comma separated peer URLs for the new member.")
 	cc.Flags().BoolVar(&isLearner, "learner", false, "indicates if the new member is raft learner")
+	cc.Flags().BoolVar(&autoPromote, "auto-promote", false, "indicates if the leader promotes the new learner member once it caught up with the leader")
+	cc.Flags().BoolVar(&isWitness, "witness", false, "indicates if the new member is a witness, which votes but does not store the key-value data")
 
 	return cc
 }
@@ -95,7 +106,7 @@ func NewMemberListCommand() *cobra.Command {
 		Use:   "list",
 		Short: "Lists all members in the cluster",
 		Long: `When --write-out is set to simple, this command prints out comma-separated member lists for each endpoint.
-The items in the lists are ID, Status, Name, Peer Addrs, Client Addrs, Is Learner.
+The items in the lists are ID, Status, Name, Peer Addrs, Client Addrs, Is Learner, Is Witness, Auto Promote.
 `,
 
 		Run: memberListCommandFunc,
@@ -120,6 +131,26 @@ func NewMemberPromoteCommand() *cobra.Command {
 	return cc
 }
 
+// NewMemberReconfigureCommand returns the cobra command for "member reconfigure".
+func NewMemberReconfigureCommand() *cobra.Command {
+	cc := &cobra.Command{
+		Use:   "reconfigure [options]",
+		Short: "Adds, removes and promotes members in the cluster at once",
+		Long: `Adds, removes and promotes members in the cluster at once, through raft joint consensus.
+The member IDs of the added members are printed in the order of the --add and then the --add-learner flags.
+`,
+
+		Run: memberReconfigureCommandFunc,
+	}
+
+	cc.Flags().StringArrayVar(&reconfigureAdd, "add", nil, "comma separated peer URLs for a new voting member. Can be repeated.")
+	cc.Flags().StringArrayVar(&reconfigureAddLearner, "add-learner", nil, "comma separated peer URLs for a new learner member. Can be repeated.")
+	cc.Flags().StringSliceVar(&reconfigureRemove, "remove", nil, "comma separated member IDs (in Hex) of the members to remove.")
+	cc.Flags().StringSliceVar(&reconfigurePromote, "promote", nil, "comma separated member IDs (in Hex) of the learner members to promote.")
+
+	return cc
+}
+
 // memberAddCommandFunc executes the "member add" command.
 func memberAddCommandFunc(cmd *cobra.Command, args []string) {
 	if len(args) < 1 {
@@ -140,6 +171,13 @@ func memberAddCommandFunc(cmd *cobra.Command, args []string) {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("member peer urls not provided"))
 	}
 
+	if isLearner && isWitness {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("--learner and --witness cannot be set together"))
+	}
+	if autoPromote && !isLearner {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("--auto-promote requires --learner"))
+	}
+
 	urls := strings.Split(memberPeerURLs, ",")
 	ctx, cancel := commandCtx(cmd)
 	cli := mustClientFromCmd(cmd)
@@ -147,9 +185,14 @@ func memberAddCommandFunc(cmd *cobra.Command, args []string) {
 		resp *clientv3.MemberAddResponse
 		err  error
 	)
-	if isLearner {
+	switch {
+	case isLearner && autoPromote:
+		resp, err = cli.MemberAddAsAutoPromoteLearner(ctx, urls)
+	case isLearner:
 		resp, err = cli.MemberAddAsLearner(ctx, urls)
-	} else {
+	case isWitness:
+		resp, err = cli.MemberAddAsWitness(ctx, urls)
+	default:
 		resp, err = cli.MemberAdd(ctx, urls)
 	}
 	cancel()
@@ -177,6 +220,9 @@ func memberAddCommandFunc(cmd *cobra.Command, args []string) {
 		fmt.Printf("ETCD_INITIAL_CLUSTER=%q\n", strings.Join(conf, ","))
 		fmt.Printf("ETCD_INITIAL_ADVERTISE_PEER_URLS=%q\n", memberPeerURLs)
 		fmt.Print("ETCD_INITIAL_CLUSTER_STATE=\"existing\"\n")
+		if isWitness {
+			fmt.Print("ETCD_EXPERIMENTAL_WITNESS=\"true\"\n")
+		}
 	}
 }
 
@@ -262,3 +308,43 @@ func memberPromoteCommandFunc(cmd *cobra.Command, args []string) {
 	}
 	display.MemberPromote(id, *resp)
 }
+
+// memberReconfigureCommandFunc executes the "member reconfigure" command.
+func memberReconfigureCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("too many arguments"))
+	}
+
+	var add []*pb.MemberAddRequest
+	for _, urls := range reconfigureAdd {
+		add = append(add, &pb.MemberAddRequest{PeerURLs: strings.Split(urls, ",")})
+	}
+	for _, urls := range reconfigureAddLearner {
+		add = append(add, &pb.MemberAddRequest{PeerURLs: strings.Split(urls, ","), IsLearner: true})
+	}
+	remove := mustParseMemberIDs(reconfigureRemove)
+	promote := mustParseMemberIDs(reconfigurePromote)
+	if len(add)+len(remove)+len(promote) == 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("no member to add, remove or promote"))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).MemberReconfigure(ctx, add, remove, promote)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	display.MemberReconfigure(remove, promote, *resp)
+}
+
+func mustParseMemberIDs(args []string) []uint64 {
+	var ids []uint64
+	for _, arg := range args {
+		id, err := strconv.ParseUint(arg, 16, 64)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad member ID arg (%v), expecting ID in Hex", err))
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
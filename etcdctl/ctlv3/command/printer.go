@@ -43,6 +43,7 @@ type printer interface {
 	MemberRemove(id uint64, r v3.MemberRemoveResponse)
 	MemberUpdate(id uint64, r v3.MemberUpdateResponse)
 	MemberPromote(id uint64, r v3.MemberPromoteResponse)
+	MemberReconfigure(remove, promote []uint64, r v3.MemberReconfigureResponse)
 	MemberList(v3.MemberListResponse)
 
 	EndpointHealth([]epHealth)
@@ -117,6 +118,9 @@ func (p *printerRPC) MemberUpdate(id uint64, r v3.MemberUpdateResponse) {
 func (p *printerRPC) MemberPromote(id uint64, r v3.MemberPromoteResponse) {
 	p.p((*pb.MemberPromoteResponse)(&r))
 }
+func (p *printerRPC) MemberReconfigure(remove, promote []uint64, r v3.MemberReconfigureResponse) {
+	p.p((*pb.MemberReconfigureResponse)(&r))
+}
 func (p *printerRPC) MemberList(r v3.MemberListResponse) { p.p((*pb.MemberListResponse)(&r)) }
 func (p *printerRPC) Alarm(r v3.AlarmResponse)           { p.p((*pb.AlarmResponse)(&r)) }
 func (p *printerRPC) MoveLeader(leader, target uint64, r v3.MoveLeaderResponse) {
@@ -175,7 +179,7 @@ func (p *printerUnsupported) DowngradeEnable(r v3.DowngradeResponse)
 func (p *printerUnsupported) DowngradeCancel(r v3.DowngradeResponse)                    { p.p(nil) }
 
 func makeMemberListTable(r v3.MemberListResponse) (hdr []string, rows [][]string) {
-	hdr = []string{"ID", "Status", "Name", "Peer Addrs", "Client Addrs", "Is Learner"}
+	hdr = []string{"ID", "Status", "Name", "Peer Addrs", "Client Addrs", "Is Learner", "Is Witness", "Auto Promote"}
 	for _, m := range r.Members {
 		status := "started"
 		if len(m.Name) == 0 {
@@ -192,6 +196,8 @@ func makeMemberListTable(r v3.MemberListResponse) (hdr []string, rows [][]string
 			strings.Join(m.PeerURLs, ","),
 			strings.Join(m.ClientURLs, ","),
 			isLearner,
+			fmt.Sprint(m.IsWitness),
+			fmt.Sprint(m.AutoPromote),
 		})
 	}
 	return hdr, rows
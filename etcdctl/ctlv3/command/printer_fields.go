@@ -175,6 +175,8 @@ func (p *fieldsPrinter) MemberList(r v3.MemberListResponse) {
 			fmt.Printf("\"ClientURL\" : %q\n", u)
 		}
 		fmt.Println(`"IsLearner" :`, m.IsLearner)
+		fmt.Println(`"IsWitness" :`, m.IsWitness)
+		fmt.Println(`"AutoPromote" :`, m.AutoPromote)
 		fmt.Println()
 	}
 }
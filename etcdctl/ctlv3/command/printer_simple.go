@@ -127,8 +127,13 @@ func (s *simplePrinter) Alarm(resp v3.AlarmResponse) {
 
 func (s *simplePrinter) MemberAdd(r v3.MemberAddResponse) {
 	asLearner := " "
-	if r.Member.IsLearner {
+	switch {
+	case r.Member.IsLearner && r.Member.AutoPromote:
+		asLearner = " as auto-promote learner "
+	case r.Member.IsLearner:
 		asLearner = " as learner "
+	case r.Member.IsWitness:
+		asLearner = " as witness "
 	}
 	fmt.Printf("Member %16x added%sto cluster %16x\n", r.Member.ID, asLearner, r.Header.ClusterId)
 }
@@ -145,6 +150,27 @@ func (s *simplePrinter) MemberPromote(id uint64, r v3.MemberPromoteResponse) {
 	fmt.Printf("Member %16x promoted in cluster %16x\n", id, r.Header.ClusterId)
 }
 
+func (s *simplePrinter) MemberReconfigure(remove, promote []uint64, r v3.MemberReconfigureResponse) {
+	for _, m := range r.Added {
+		asLearner := " "
+		switch {
+		case m.IsLearner && m.AutoPromote:
+			asLearner = " as auto-promote learner "
+		case m.IsLearner:
+			asLearner = " as learner "
+		case m.IsWitness:
+			asLearner = " as witness "
+		}
+		fmt.Printf("Member %16x added%sto cluster %16x\n", m.ID, asLearner, r.Header.ClusterId)
+	}
+	for _, id := range remove {
+		fmt.Printf("Member %16x removed from cluster %16x\n", id, r.Header.ClusterId)
+	}
+	for _, id := range promote {
+		fmt.Printf("Member %16x promoted in cluster %16x\n", id, r.Header.ClusterId)
+	}
+}
+
 func (s *simplePrinter) MemberList(resp v3.MemberListResponse) {
 	_, rows := makeMemberListTable(resp)
 	for _, row := range rows {
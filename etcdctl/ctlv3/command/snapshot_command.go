@@ -45,7 +45,12 @@ var (
 	etcdctl --endpoints=https://127.0.0.1:2379 --dial-timeout=20s snapshot save /backup/etcd-snapshot.db
 
 	# Save snapshot with desirable time format
-	etcdctl snapshot save /mnt/backup/etcd/backup_$(date +%Y%m%d_%H%M%S).db`)
+	etcdctl snapshot save /mnt/backup/etcd/backup_$(date +%Y%m%d_%H%M%S).db
+
+	# Save a delta snapshot of the revisions after the revision of a previous snapshot
+	etcdctl snapshot save --since-revision=$(etcdutl snapshot status /backup/etcd-snapshot.db -w json | jq .revision) /backup/etcd-delta.db`)
+
+	snapshotSinceRevision int64
 )
 
 // NewSnapshotCommand returns the cobra command for "snapshot".
@@ -60,12 +65,14 @@ func NewSnapshotCommand() *cobra.Command {
 }
 
 func NewSnapshotSaveCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:     "save <filename>",
 		Short:   "Stores an etcd node backend snapshot to a given file",
 		Run:     snapshotSaveCommandFunc,
 		Example: snapshotExample,
 	}
+	cmd.Flags().Int64Var(&snapshotSinceRevision, "since-revision", 0, "Store a delta snapshot of the revisions after the given revision, to restore on top of a snapshot taken at that revision or later")
+	return cmd
 }
 
 func snapshotSaveCommandFunc(cmd *cobra.Command, args []string) {
@@ -88,7 +95,12 @@ func snapshotSaveCommandFunc(cmd *cobra.Command, args []string) {
 	defer cancel()
 
 	path := args[0]
-	version, err := snapshot.SaveWithVersion(ctx, lg, *cfg, path)
+	var version string
+	if snapshotSinceRevision > 0 {
+		version, err = snapshot.SaveSinceWithVersion(ctx, lg, *cfg, path, snapshotSinceRevision)
+	} else {
+		version, err = snapshot.SaveWithVersion(ctx, lg, *cfg, path)
+	}
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitInterrupted, err)
 	}
@@ -42,6 +42,10 @@ var (
 	initialMmapSize     = backend.InitialMmapSize
 	markCompacted       bool
 	revisionBump        uint64
+	restoreDeltas       []string
+	replayWALDir        string
+	replayToIndex       uint64
+	replayToRevision    int64
 )
 
 // NewSnapshotCommand returns the cobra command for "snapshot".
@@ -52,6 +56,8 @@ func NewSnapshotCommand() *cobra.Command {
 	}
 	cmd.AddCommand(NewSnapshotRestoreCommand())
 	cmd.AddCommand(newSnapshotStatusCommand())
+	cmd.AddCommand(newSnapshotExportCommand())
+	cmd.AddCommand(newSnapshotImportCommand())
 	return cmd
 }
 
@@ -81,7 +87,44 @@ func NewSnapshotRestoreCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&skipHashCheck, "skip-hash-check", false, "Ignore snapshot integrity hash value (required if copied from data directory)")
 	cmd.Flags().Uint64Var(&initialMmapSize, "initial-memory-map-size", initialMmapSize, "Initial memory map size of the database in bytes. It uses the default value if not defined or defined to 0")
 	cmd.Flags().Uint64Var(&revisionBump, "bump-revision", 0, "How much to increase the latest revision after restore")
+	cmd.Flags().StringArrayVar(&restoreDeltas, "delta", nil, "Path to a delta snapshot to layer on the snapshot, taken since its revision or earlier. Can be repeated to apply a chain of deltas in order")
 	cmd.Flags().BoolVar(&markCompacted, "mark-compacted", false, "Mark the latest revision after restore as the point of scheduled compaction (required if --bump-revision > 0, disallowed otherwise)")
+	cmd.Flags().StringVar(&replayWALDir, "replay-wal-dir", "", "Path to WAL files archived from a member, whose committed entries after the snapshot are replayed on it")
+	cmd.Flags().Uint64Var(&replayToIndex, "to-index", 0, "Replay the WAL entries before the given raft index (requires --replay-wal-dir)")
+	cmd.Flags().Int64Var(&replayToRevision, "to-revision", 0, "Replay the WAL entries before the one reaching the given revision (requires --replay-wal-dir)")
+
+	cmd.MarkFlagDirname("data-dir")
+	cmd.MarkFlagDirname("wal-dir")
+
+	return cmd
+}
+
+func newSnapshotExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <filename> <output filename> [options]",
+		Short: "Exports an etcd member snapshot to a logical snapshot file",
+		Long: `Writes the keys, leases, users, roles and members of a snapshot, at its
+revision, as JSON lines independent of the storage schema.
+`,
+		Run: snapshotExportCommandFunc,
+	}
+	cmd.Flags().BoolVar(&skipHashCheck, "skip-hash-check", false, "Ignore snapshot integrity hash value (required if copied from data directory)")
+	return cmd
+}
+
+func newSnapshotImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <filename> --data-dir {output dir} [options]",
+		Short: "Imports a logical snapshot file to an etcd directory",
+		Run:   snapshotImportCommandFunc,
+	}
+	cmd.Flags().StringVar(&restoreDataDir, "data-dir", "", "Path to the output data directory")
+	cmd.Flags().StringVar(&restoreWALDir, "wal-dir", "", "Path to the WAL directory (use --data-dir if none given)")
+	cmd.Flags().StringVar(&restoreCluster, "initial-cluster", initialClusterFromName(defaultName), "Initial cluster configuration for import bootstrap")
+	cmd.Flags().StringVar(&restoreClusterToken, "initial-cluster-token", "etcd-cluster", "Initial cluster token for the etcd cluster during import bootstrap")
+	cmd.Flags().StringVar(&restorePeerURLs, "initial-advertise-peer-urls", defaultInitialAdvertisePeerURLs, "List of this member's peer URLs to advertise to the rest of the cluster")
+	cmd.Flags().StringVar(&restoreName, "name", defaultName, "Human-readable name for this member")
+	cmd.Flags().Uint64Var(&initialMmapSize, "initial-memory-map-size", initialMmapSize, "Initial memory map size of the database in bytes. It uses the default value if not defined or defined to 0")
 
 	cmd.MarkFlagDirname("data-dir")
 	cmd.MarkFlagDirname("wal-dir")
@@ -107,7 +150,8 @@ func SnapshotStatusCommandFunc(cmd *cobra.Command, args []string) {
 
 func snapshotRestoreCommandFunc(_ *cobra.Command, args []string) {
 	SnapshotRestoreCommandFunc(restoreCluster, restoreClusterToken, restoreDataDir, restoreWALDir,
-		restorePeerURLs, restoreName, skipHashCheck, initialMmapSize, revisionBump, markCompacted, args)
+		restorePeerURLs, restoreName, skipHashCheck, initialMmapSize, revisionBump, markCompacted, restoreDeltas,
+		replayWALDir, replayToIndex, replayToRevision, args)
 }
 
 func SnapshotRestoreCommandFunc(restoreCluster string,
@@ -120,6 +164,10 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 	initialMmapSize uint64,
 	revisionBump uint64,
 	markCompacted bool,
+	deltas []string,
+	replayWALDir string,
+	toIndex uint64,
+	toRevision int64,
 	args []string) {
 	if len(args) != 1 {
 		err := fmt.Errorf("snapshot restore requires exactly one argument")
@@ -131,6 +179,16 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
 	}
 
+	if (toIndex != 0 || toRevision != 0) && replayWALDir == "" {
+		err := fmt.Errorf("--replay-wal-dir required if --to-index or --to-revision is set")
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
+	if toIndex != 0 && toRevision != 0 {
+		err := fmt.Errorf("--to-index and --to-revision cannot be set together")
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
 	dataDir := restoreDataDir
 	if dataDir == "" {
 		dataDir = restoreName + ".etcd"
@@ -146,6 +204,10 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 
 	if err := sp.Restore(snapshot.RestoreConfig{
 		SnapshotPath:        args[0],
+		DeltaPaths:          deltas,
+		ReplayWALDir:        replayWALDir,
+		ToIndex:             toIndex,
+		ToRevision:          toRevision,
 		Name:                restoreName,
 		OutputDataDir:       dataDir,
 		OutputWALDir:        walDir,
@@ -161,6 +223,55 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 	}
 }
 
+func snapshotExportCommandFunc(_ *cobra.Command, args []string) {
+	if len(args) != 2 {
+		err := fmt.Errorf("snapshot export requires exactly two arguments")
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
+	lg := GetLogger()
+	sp := snapshot.NewV3(lg)
+	if err := sp.Export(snapshot.ExportConfig{
+		SnapshotPath:  args[0],
+		OutputPath:    args[1],
+		SkipHashCheck: skipHashCheck,
+	}); err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+}
+
+func snapshotImportCommandFunc(_ *cobra.Command, args []string) {
+	if len(args) != 1 {
+		err := fmt.Errorf("snapshot import requires exactly one argument")
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
+	dataDir := restoreDataDir
+	if dataDir == "" {
+		dataDir = restoreName + ".etcd"
+	}
+
+	walDir := restoreWALDir
+	if walDir == "" {
+		walDir = datadir.ToWALDir(dataDir)
+	}
+
+	lg := GetLogger()
+	sp := snapshot.NewV3(lg)
+	if err := sp.Import(snapshot.RestoreConfig{
+		SnapshotPath:        args[0],
+		Name:                restoreName,
+		OutputDataDir:       dataDir,
+		OutputWALDir:        walDir,
+		PeerURLs:            strings.Split(restorePeerURLs, ","),
+		InitialCluster:      restoreCluster,
+		InitialClusterToken: restoreClusterToken,
+		InitialMmapSize:     initialMmapSize,
+	}); err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+}
+
 func initialClusterFromName(name string) string {
 	n := name
 	if name == "" {
@@ -0,0 +1,185 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// applyDelta verifies the delta snapshot file at deltaPath and layers it on
+// the restored database.
+func (s *v3Manager) applyDelta(deltaPath string) error {
+	tmpPath := s.outDbPath() + ".delta"
+	defer os.Remove(tmpPath)
+	if err := s.copyAndVerify(deltaPath, tmpPath); err != nil {
+		return fmt.Errorf("delta snapshot %s: %w", deltaPath, err)
+	}
+
+	delta, err := bolt.Open(tmpPath, 0400, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer delta.Close()
+	db, err := bolt.Open(s.outDbPath(), 0600, nil)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return delta.View(func(dtx *bolt.Tx) error {
+		return db.Update(func(tx *bolt.Tx) error {
+			base, rev, err := layerDelta(tx, dtx)
+			if err != nil {
+				return fmt.Errorf("delta snapshot %s: %w", deltaPath, err)
+			}
+			s.lg.Info(
+				"applied delta snapshot",
+				zap.String("path", deltaPath),
+				zap.Int64("base-revision", base),
+				zap.Int64("snapshot-revision", rev),
+			)
+			return nil
+		})
+	})
+}
+
+// layerDelta layers the delta snapshot read by dtx on the snapshot written
+// by tx. The key bucket gets the revisions of the delta, all the other buckets
+// are replaced with the ones of the delta. If the delta is compacted after the
+// revision it is taken since, the revisions of the snapshot up to the
+// compaction the delta neither holds nor lists as kept are dropped. It returns
+// the revision the delta is taken since, and the revision of the snapshot it
+// is layered on.
+func layerDelta(tx, dtx *bolt.Tx) (base, rev int64, err error) {
+	dmeta := dtx.Bucket(schema.Meta.Name())
+	if dmeta == nil {
+		return 0, 0, errors.New("missing meta bucket")
+	}
+	v := dmeta.Get(schema.SnapshotBaseRevisionKeyName)
+	if v == nil {
+		return 0, 0, errors.New("not a delta snapshot")
+	}
+	base = mvcc.BytesToRev(v).Main
+
+	rev = latestRevision(tx)
+	if base > rev {
+		return base, rev, fmt.Errorf("taken since revision %d, after the snapshot revision %d", base, rev)
+	}
+	// Keep the finished compaction of the snapshot, so that a member started
+	// on the layered database redoes the compactions of the delta on the older
+	// revisions of the snapshot.
+	var finishedCompact []byte
+	if meta := tx.Bucket(schema.Meta.Name()); meta != nil {
+		finishedCompact = bytes.Clone(meta.Get(schema.FinishedCompactKeyName))
+	}
+
+	if kept := dtx.Bucket(schema.SnapshotKept.Name()); kept != nil {
+		if err = dropCompacted(tx, dtx, kept); err != nil {
+			return base, rev, err
+		}
+	}
+
+	err = dtx.ForEach(func(name []byte, db *bolt.Bucket) error {
+		if bytes.Equal(name, schema.SnapshotKept.Name()) {
+			return nil
+		}
+		if !bytes.Equal(name, schema.Key.Name()) {
+			if berr := tx.DeleteBucket(name); berr != nil && !errors.Is(berr, bolt.ErrBucketNotFound) {
+				return berr
+			}
+		}
+		b, berr := tx.CreateBucketIfNotExists(name)
+		if berr != nil {
+			return berr
+		}
+		b.FillPercent = 0.9
+		return db.ForEach(b.Put)
+	})
+	if err != nil {
+		return base, rev, err
+	}
+
+	meta := tx.Bucket(schema.Meta.Name())
+	if err = meta.Delete(schema.SnapshotBaseRevisionKeyName); err != nil {
+		return base, rev, err
+	}
+	if finishedCompact == nil {
+		err = meta.Delete(schema.FinishedCompactKeyName)
+	} else {
+		err = meta.Put(schema.FinishedCompactKeyName, finishedCompact)
+	}
+	return base, rev, err
+}
+
+// dropCompacted deletes the revisions of the snapshot written by tx up to the
+// compaction of the delta read by dtx that are neither in the delta nor kept.
+func dropCompacted(tx, dtx *bolt.Tx, kept *bolt.Bucket) error {
+	var compactRev int64
+	if v := dtx.Bucket(schema.Meta.Name()).Get(schema.ScheduledCompactKeyName); v != nil {
+		compactRev = mvcc.BytesToRev(v).Main
+	}
+	b := tx.Bucket(schema.Key.Name())
+	if b == nil {
+		return nil
+	}
+	db := dtx.Bucket(schema.Key.Name())
+	var compacted [][]byte
+	c := b.Cursor()
+	for k, _ := c.First(); k != nil && mvcc.BytesToRev(k).Main <= compactRev; k, _ = c.Next() {
+		if hasKey(kept, k) || (db != nil && hasKey(db, k)) {
+			continue
+		}
+		compacted = append(compacted, bytes.Clone(k))
+	}
+	for _, k := range compacted {
+		if err := b.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasKey reports whether b holds key, whatever its value.
+func hasKey(b *bolt.Bucket, key []byte) bool {
+	k, _ := b.Cursor().Seek(key)
+	return bytes.Equal(k, key)
+}
+
+// latestRevision returns the revision of the snapshot written by tx.
+func latestRevision(tx *bolt.Tx) int64 {
+	var rev int64
+	if b := tx.Bucket(schema.Key.Name()); b != nil {
+		if k, _ := b.Cursor().Last(); k != nil {
+			rev = mvcc.BytesToRev(k).Main
+		}
+	}
+	if meta := tx.Bucket(schema.Meta.Name()); meta != nil {
+		if v := meta.Get(schema.ScheduledCompactKeyName); v != nil {
+			if compactRev := mvcc.BytesToRev(v).Main; compactRev > rev {
+				rev = compactRev
+			}
+		}
+	}
+	return rev
+}
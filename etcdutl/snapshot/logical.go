@@ -0,0 +1,374 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/coreos/go-semver/semver"
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/lease/leasepb"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+const (
+	// LogicalFormat identifies the files written by Export.
+	LogicalFormat = "etcd-logical-snapshot"
+
+	// LogicalFormatVersion is the version of the logical snapshot format
+	// written by Export. Import reads all the versions up to it.
+	LogicalFormatVersion = 1
+)
+
+// ExportConfig configures snapshot export operation.
+type ExportConfig struct {
+	// SnapshotPath is the path of snapshot file to export.
+	SnapshotPath string
+
+	// OutputPath is the path of the logical snapshot file to write.
+	OutputPath string
+
+	// SkipHashCheck is "true" to ignore snapshot integrity hash value
+	// (required if copied from data directory).
+	SkipHashCheck bool
+}
+
+// logicalHeader is the first line of a logical snapshot.
+type logicalHeader struct {
+	Format  string `json:"format"`
+	Version int    `json:"version"`
+	// StorageVersion is the storage version of the exported snapshot,
+	// empty if it was taken before v3.6.
+	StorageVersion string `json:"storageVersion,omitempty"`
+	// Revision is the revision of the exported snapshot.
+	Revision int64 `json:"revision"`
+	// CompactRevision is the last revision the exported snapshot was
+	// compacted at.
+	CompactRevision int64  `json:"compactRevision,omitempty"`
+	AuthEnabled     bool   `json:"authEnabled,omitempty"`
+	AuthRevision    uint64 `json:"authRevision,omitempty"`
+}
+
+// logicalRecord is one of the lines following the header of a logical
+// snapshot. Exactly one of its fields is set.
+type logicalRecord struct {
+	Member *membership.Member `json:"member,omitempty"`
+	User   *authpb.User       `json:"user,omitempty"`
+	Role   *authpb.Role       `json:"role,omitempty"`
+	Lease  *leasepb.Lease     `json:"lease,omitempty"`
+	KV     *mvccpb.KeyValue   `json:"kv,omitempty"`
+}
+
+// Export writes the content of the snapshot file as JSON lines: a header
+// followed by the members, the users, the roles, the leases, and the keys at
+// the revision of the snapshot, in order of revision. The history of the keys
+// is not exported.
+func (s *v3Manager) Export(cfg ExportConfig) error {
+	s.skipHashCheck = cfg.SkipHashCheck
+
+	dir, err := os.MkdirTemp(filepath.Dir(cfg.OutputPath), "etcd-export.*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	dbPath := filepath.Join(dir, "db")
+	if err = s.copyAndVerify(cfg.SnapshotPath, dbPath); err != nil {
+		return err
+	}
+	be := backend.NewDefaultBackend(s.lg, dbPath)
+	defer be.Close()
+
+	partPath := cfg.OutputPath + ".part"
+	defer os.RemoveAll(partPath)
+	f, err := os.OpenFile(partPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileutil.PrivateFileMode)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	hdr, err := exportLogical(s.lg, be, json.NewEncoder(w))
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = fileutil.Fsync(f)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if err = os.Rename(partPath, cfg.OutputPath); err != nil {
+		return fmt.Errorf("could not rename %s to %s (%w)", partPath, cfg.OutputPath, err)
+	}
+
+	s.lg.Info(
+		"exported snapshot",
+		zap.String("path", cfg.SnapshotPath),
+		zap.String("output-path", cfg.OutputPath),
+		zap.Int64("revision", hdr.Revision),
+		zap.String("storage-version", hdr.StorageVersion),
+	)
+	return nil
+}
+
+func exportLogical(lg *zap.Logger, be backend.Backend, enc *json.Encoder) (hdr logicalHeader, err error) {
+	hdr = logicalHeader{Format: LogicalFormat, Version: LogicalFormatVersion}
+
+	tx := be.ReadTx()
+	tx.RLock()
+	if v := schema.UnsafeReadStorageVersion(tx); v != nil {
+		hdr.StorageVersion = v.String()
+	}
+	hdr.CompactRevision, _ = mvcc.UnsafeReadScheduledCompact(tx)
+	leases := schema.MustUnsafeGetAllLeases(tx)
+	latest, rev, err := unsafeLatestRevisions(tx)
+	tx.RUnlock()
+	if err != nil {
+		return hdr, err
+	}
+	hdr.Revision = max(hdr.CompactRevision, rev)
+
+	// the roles are not in a bucket that can be ranged by a read transaction
+	atx := schema.NewAuthBackend(lg, be).BatchTx()
+	atx.Lock()
+	hdr.AuthEnabled = atx.UnsafeReadAuthEnabled()
+	hdr.AuthRevision = atx.UnsafeReadAuthRevision()
+	users := atx.UnsafeGetAllUsers()
+	roles := atx.UnsafeGetAllRoles()
+	atx.Unlock()
+
+	members, _ := schema.NewMembershipBackend(lg, be).MustReadMembersFromBackend()
+	ms := make([]*membership.Member, 0, len(members))
+	for _, m := range members {
+		ms = append(ms, m)
+	}
+	sort.Slice(ms, func(i, j int) bool { return ms[i].ID < ms[j].ID })
+
+	if err = enc.Encode(&hdr); err != nil {
+		return hdr, err
+	}
+	for _, m := range ms {
+		if err = enc.Encode(&logicalRecord{Member: m}); err != nil {
+			return hdr, err
+		}
+	}
+	for _, u := range users {
+		if err = enc.Encode(&logicalRecord{User: u}); err != nil {
+			return hdr, err
+		}
+	}
+	for _, r := range roles {
+		if err = enc.Encode(&logicalRecord{Role: r}); err != nil {
+			return hdr, err
+		}
+	}
+	for _, l := range leases {
+		if err = enc.Encode(&logicalRecord{Lease: l}); err != nil {
+			return hdr, err
+		}
+	}
+
+	// the key bucket is streamed again in order of revision, only the values
+	// of the latest revisions are held one at a time
+	tx.RLock()
+	defer tx.RUnlock()
+	err = unsafeForEachKeyValue(tx, func(rev mvcc.BucketKey, kv *mvccpb.KeyValue) error {
+		if latestRev, ok := latest[string(kv.Key)]; !ok || latestRev != rev.Revision {
+			return nil
+		}
+		delete(latest, string(kv.Key))
+		return enc.Encode(&logicalRecord{KV: kv})
+	})
+	return hdr, err
+}
+
+// unsafeLatestRevisions returns the latest revision of each key of a snapshot
+// that is not deleted, and the revision of the snapshot.
+func unsafeLatestRevisions(tx backend.UnsafeReader) (map[string]mvcc.Revision, int64, error) {
+	var rev int64
+	latest := make(map[string]mvcc.Revision)
+	err := unsafeForEachKeyValue(tx, func(bk mvcc.BucketKey, kv *mvccpb.KeyValue) error {
+		rev = bk.Main
+		switch {
+		case len(kv.Key) == 0:
+			// marker of a bumped revision
+		case bk.IsTombstone():
+			delete(latest, string(kv.Key))
+		default:
+			latest[string(kv.Key)] = bk.Revision
+		}
+		return nil
+	})
+	return latest, rev, err
+}
+
+// unsafeForEachKeyValue calls f on the key-values of the key bucket in order
+// of revision.
+func unsafeForEachKeyValue(tx backend.UnsafeReader, f func(bk mvcc.BucketKey, kv *mvccpb.KeyValue) error) error {
+	return tx.UnsafeForEach(schema.Key, func(k, v []byte) error {
+		bk := mvcc.BytesToBucketKey(k)
+		kv := &mvccpb.KeyValue{}
+		if err := mvcc.DecodeKeyValue(v, kv); err != nil {
+			return fmt.Errorf("cannot unmarshal value, key: %q value: %q err: %w", k, v, err)
+		}
+		return f(bk, kv)
+	})
+}
+
+// Import restores a new etcd data directory from a logical snapshot file
+// written by Export. The members of the logical snapshot are ignored, the
+// cluster is configured as for Restore. The revision of the logical snapshot
+// is marked compacted.
+func (s *v3Manager) Import(cfg RestoreConfig) error {
+	if len(cfg.DeltaPaths) > 0 {
+		return errors.New("delta snapshots cannot be layered on a logical snapshot")
+	}
+	if cfg.ReplayWALDir != "" {
+		return errors.New("WAL entries cannot be replayed on a logical snapshot")
+	}
+	return s.restore(cfg, s.importDB)
+}
+
+// importDB writes the backend database of the logical snapshot to the
+// snapshot directory.
+func (s *v3Manager) importDB() error {
+	f, err := os.Open(s.srcDbPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err = fileutil.CreateDirAll(s.lg, s.snapDir); err != nil {
+		return err
+	}
+	be := backend.NewDefaultBackend(s.lg, s.outDbPath(), backend.WithMmapSize(s.initialMmapSize))
+	defer be.Close()
+
+	hdr, err := importLogical(s.lg, be, json.NewDecoder(bufio.NewReader(f)))
+	if err != nil {
+		return fmt.Errorf("logical snapshot %s: %w", s.srcDbPath, err)
+	}
+	s.lg.Info(
+		"imported logical snapshot",
+		zap.String("path", s.srcDbPath),
+		zap.Int("format-version", hdr.Version),
+		zap.Int64("revision", hdr.Revision),
+		zap.String("storage-version", hdr.StorageVersion),
+	)
+
+	return schema.NewMembershipBackend(s.lg, be).TrimMembershipFromBackend()
+}
+
+func importLogical(lg *zap.Logger, be backend.Backend, dec *json.Decoder) (hdr logicalHeader, err error) {
+	if err = dec.Decode(&hdr); err != nil {
+		return hdr, fmt.Errorf("cannot read header: %w", err)
+	}
+	if hdr.Format != LogicalFormat {
+		return hdr, fmt.Errorf("unknown format %q", hdr.Format)
+	}
+	if hdr.Version < 1 || hdr.Version > LogicalFormatVersion {
+		return hdr, fmt.Errorf("unsupported format version %d, want at most %d", hdr.Version, LogicalFormatVersion)
+	}
+
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	tx.UnsafeCreateBucket(schema.Meta)
+	tx.UnsafeCreateBucket(schema.Key)
+	schema.UnsafeCreateLeaseBucket(tx)
+	tx.Unlock()
+	abe := schema.NewAuthBackend(lg, be)
+	abe.CreateAuthBuckets()
+	atx := abe.BatchTx()
+
+	// keys of the same revision get consecutive sub revisions
+	var last mvcc.Revision
+	for {
+		var r logicalRecord
+		if err = dec.Decode(&r); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return hdr, err
+		}
+
+		switch {
+		case r.KV != nil:
+			rev := mvcc.Revision{Main: r.KV.ModRevision}
+			if rev.Main <= 0 || rev.Main < last.Main || rev.Main > hdr.Revision {
+				return hdr, fmt.Errorf("key %q has unexpected revision %d", r.KV.Key, rev.Main)
+			}
+			if rev.Main == last.Main {
+				rev.Sub = last.Sub + 1
+			}
+			v, merr := r.KV.Marshal()
+			if merr != nil {
+				return hdr, merr
+			}
+			tx.LockOutsideApply()
+			tx.UnsafeSeqPut(schema.Key, mvcc.RevToBytes(rev, mvcc.NewRevBytes()), v)
+			tx.Unlock()
+			last = rev
+		case r.Lease != nil:
+			tx.LockOutsideApply()
+			schema.MustUnsafePutLease(tx, r.Lease)
+			tx.Unlock()
+		case r.User != nil:
+			atx.Lock()
+			atx.UnsafePutUser(r.User)
+			atx.Unlock()
+		case r.Role != nil:
+			atx.Lock()
+			atx.UnsafePutRole(r.Role)
+			atx.Unlock()
+		case r.Member != nil:
+			// members are configured as for a restore
+		default:
+			return hdr, errors.New("empty record")
+		}
+	}
+
+	atx.Lock()
+	atx.UnsafeSaveAuthEnabled(hdr.AuthEnabled)
+	atx.UnsafeSaveAuthRevision(hdr.AuthRevision)
+	atx.Unlock()
+
+	tx.LockOutsideApply()
+	defer tx.Unlock()
+	if hdr.Revision > last.Main {
+		// keep the revision of the logical snapshot, as for a bumped one
+		tx.UnsafePut(schema.Key, mvcc.RevToBytes(mvcc.Revision{Main: hdr.Revision}, mvcc.NewRevBytes()), []byte{})
+	}
+	if hdr.Revision > 0 {
+		// the history before the revision is not in the logical snapshot
+		mvcc.UnsafeSetScheduledCompact(tx, hdr.Revision)
+	}
+	schema.UnsafeSetStorageVersion(tx, semver.New(version.Version))
+	return hdr, nil
+}
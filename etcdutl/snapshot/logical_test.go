@@ -0,0 +1,93 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+)
+
+// TestSnapshotExportImport exports a snapshot, imports it, and exports the
+// imported snapshot again, expecting the same content but for the members.
+func TestSnapshotExportImport(t *testing.T) {
+	dbpath := createDB(t, func(srv *etcdserver.EtcdServer) {
+		ctx := context.TODO()
+		insertKeys(t, 10, 10)(srv)
+		_, err := srv.DeleteRange(ctx, &etcdserverpb.DeleteRangeRequest{Key: []byte("3")})
+		require.NoError(t, err)
+		lresp, err := srv.LeaseGrant(ctx, &etcdserverpb.LeaseGrantRequest{TTL: 3600})
+		require.NoError(t, err)
+		_, err = srv.Put(ctx, &etcdserverpb.PutRequest{Key: []byte("leased"), Value: []byte("bar"), Lease: lresp.ID})
+		require.NoError(t, err)
+		_, err = srv.RoleAdd(ctx, &etcdserverpb.AuthRoleAddRequest{Name: "role"})
+		require.NoError(t, err)
+		_, err = srv.UserAdd(ctx, &etcdserverpb.AuthUserAddRequest{Name: "user", Password: "pass"})
+		require.NoError(t, err)
+	})
+
+	sp := NewV3(zaptest.NewLogger(t))
+	dir := t.TempDir()
+	exported := filepath.Join(dir, "exported.jsonl")
+	require.NoError(t, sp.Export(ExportConfig{SnapshotPath: dbpath, OutputPath: exported, SkipHashCheck: true}))
+
+	dataDir := filepath.Join(dir, "imported")
+	require.NoError(t, sp.Import(RestoreConfig{
+		SnapshotPath:        exported,
+		Name:                "default",
+		OutputDataDir:       dataDir,
+		PeerURLs:            []string{"http://localhost:2380"},
+		InitialCluster:      "default=http://localhost:2380",
+		InitialClusterToken: "etcd-cluster",
+	}))
+
+	reexported := filepath.Join(dir, "reexported.jsonl")
+	require.NoError(t, sp.Export(ExportConfig{
+		SnapshotPath:  filepath.Join(dataDir, "member", "snap", "db"),
+		OutputPath:    reexported,
+		SkipHashCheck: true,
+	}))
+
+	want, got := readLogicalRecords(t, exported), readLogicalRecords(t, reexported)
+	assert.Equal(t, want[1:], got[1:])
+	// a user, a role, a lease and the keys
+	assert.Len(t, want, 1+1+1+1+10)
+	assert.Contains(t, want[0], `"revision":13`)
+	assert.Contains(t, got[0], `"revision":13,"compactRevision":13`)
+}
+
+// readLogicalRecords returns the lines of a logical snapshot, but for the
+// members.
+func readLogicalRecords(t *testing.T, path string) []string {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var lines []string
+	for _, l := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+		if !strings.HasPrefix(l, `{"member":`) {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,262 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/auth"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3alarm"
+	"go.etcd.io/etcd/server/v3/etcdserver/apply"
+	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// replayedEntry is a WAL entry applied to a backend database.
+type replayedEntry struct {
+	entry raftpb.Entry
+	// request is the request of the entry, nil if it does not apply to the
+	// backend, like membership changes.
+	request *pb.InternalRaftRequest
+	result  *apply.Result
+}
+
+// replayWAL applies the committed entries of the WAL files in s.replayWALDir
+// to the restored database, up to the entry before s.toIndex, or before the
+// one reaching s.toRevision, and logs what that next entry did.
+func (s *v3Manager) replayWAL() error {
+	be := backend.NewDefaultBackend(s.lg, s.outDbPath(), backend.WithMmapSize(s.initialMmapSize))
+	index, term := schema.ReadConsistentIndex(be.ReadTx())
+	be.Close()
+	if s.toIndex != 0 && s.toIndex <= index {
+		return fmt.Errorf("snapshot already holds index %d, after --to-index %d", index, s.toIndex)
+	}
+
+	ents, err := readCommittedEntries(s.lg, s.replayWALDir, index, term)
+	if err != nil {
+		return err
+	}
+
+	// The entry reaching the target is only found by applying it, so the
+	// entries are first replayed on a copy of the database.
+	scratchPath := s.outDbPath() + ".replay"
+	defer os.Remove(scratchPath)
+	if err = copyFile(s.outDbPath(), scratchPath); err != nil {
+		return err
+	}
+	next, err := replayEntries(s.lg, scratchPath, ents, func(e raftpb.Entry, rev int64) bool {
+		return (s.toIndex != 0 && e.Index >= s.toIndex) || (s.toRevision != 0 && rev >= s.toRevision)
+	}, s.toRevision)
+	if err != nil {
+		return err
+	}
+	if next == nil {
+		s.lg.Warn(
+			"replayed all the committed WAL entries before reaching the target",
+			zap.Uint64("to-index", s.toIndex),
+			zap.Int64("to-revision", s.toRevision),
+		)
+		return os.Rename(scratchPath, s.outDbPath())
+	}
+
+	n := 0
+	for n < len(ents) && ents[n].Index < next.entry.Index {
+		n++
+	}
+	if _, err = replayEntries(s.lg, s.outDbPath(), ents[:n], nil, 0); err != nil {
+		return err
+	}
+
+	fields := []zap.Field{
+		zap.Uint64("index", next.entry.Index),
+		zap.Uint64("term", next.entry.Term),
+		zap.Stringer("type", next.entry.Type),
+	}
+	if next.request != nil {
+		fields = append(fields, zap.Stringer("request", &pb.InternalRaftStringer{Request: next.request}))
+	}
+	if next.result != nil {
+		if next.result.Resp != nil {
+			fields = append(fields, zap.String("response", fmt.Sprint(next.result.Resp)))
+		}
+		if next.result.Err != nil {
+			fields = append(fields, zap.NamedError("response-error", next.result.Err))
+		}
+	}
+	s.lg.Info("replayed WAL entries up to the next entry, not replayed", fields...)
+	return nil
+}
+
+// readCommittedEntries returns the committed entries after index in the WAL
+// files in walDir, checking that the entry at index has the given term.
+func readCommittedEntries(lg *zap.Logger, walDir string, index, term uint64) ([]raftpb.Entry, error) {
+	snaps, err := wal.ValidSnapshotEntries(lg, walDir)
+	if err != nil {
+		return nil, err
+	}
+	var snap *walpb.Snapshot
+	for i := range snaps {
+		if snaps[i].Index <= index && (snap == nil || snaps[i].Index >= snap.Index) {
+			snap = &snaps[i]
+		}
+	}
+	if snap == nil {
+		return nil, fmt.Errorf("WAL in %s does not start at or before the snapshot index %d", walDir, index)
+	}
+
+	w, err := wal.OpenForRead(lg, walDir, *snap)
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+	_, st, ents, err := w.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var committed []raftpb.Entry
+	for _, e := range ents {
+		if e.Index == index && e.Term != term {
+			return nil, fmt.Errorf("WAL in %s has entry %d at term %d, not at the snapshot term %d", walDir, e.Index, e.Term, term)
+		}
+		if e.Index > index && e.Index <= st.Commit {
+			committed = append(committed, e)
+		}
+	}
+	lg.Info(
+		"read committed WAL entries to replay",
+		zap.String("wal-dir", walDir),
+		zap.Uint64("snapshot-index", index),
+		zap.Uint64("commit-index", st.Commit),
+		zap.Int("entries", len(committed)),
+	)
+	return committed, nil
+}
+
+// replayEntries applies ents to the backend database at dbPath, until stop
+// returns true after applying one, which is returned. It returns an error if
+// the database is already at toRevision.
+func replayEntries(lg *zap.Logger, dbPath string, ents []raftpb.Entry, stop func(e raftpb.Entry, rev int64) bool, toRevision int64) (*replayedEntry, error) {
+	be := backend.NewDefaultBackend(lg, dbPath)
+	defer be.Close()
+
+	cluster := membership.NewCluster(lg)
+	lessor := lease.NewLessor(lg, be, cluster, lease.LessorConfig{})
+	defer lessor.Stop()
+	kv := mvcc.NewStore(lg, be, lessor, mvcc.StoreConfig{})
+	defer kv.Close()
+	if toRevision != 0 && kv.Rev() >= toRevision {
+		return nil, fmt.Errorf("snapshot already holds revision %d, at or after --to-revision %d", kv.Rev(), toRevision)
+	}
+	alarmStore, err := v3alarm.NewAlarmStore(lg, schema.NewAlarmBackend(lg, be))
+	if err != nil {
+		return nil, err
+	}
+	// tokens are not needed to apply the entries
+	tp, err := auth.NewTokenProvider(lg, "", nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	authStore := auth.NewAuthStore(lg, schema.NewAuthBackend(lg, be), tp, bcrypt.DefaultCost)
+	defer authStore.Close()
+	ci := cindex.NewConsistentIndex(be)
+	rs := &replayStatus{}
+	// The quota is disabled, the entries rejected by the quota of the
+	// cluster are followed by the alarm it raised.
+	ua := apply.NewUberApplier(lg, be, kv, alarmStore, authStore, lessor, cluster, rs, rs, ci, time.Minute, true, -1, mvcc.ValueCodecNone)
+
+	defer func() {
+		if rs.entry.Index != 0 {
+			cindex.UpdateConsistentIndexForce(be.BatchTx(), rs.entry.Index, rs.entry.Term)
+		}
+	}()
+	for _, e := range ents {
+		rs.entry = e
+		ci.SetConsistentApplyingIndex(e.Index, e.Term)
+		re := replayEntry(ua, e)
+		ci.SetConsistentIndex(e.Index, e.Term)
+		if stop != nil && stop(e, kv.Rev()) {
+			return re, nil
+		}
+	}
+	return nil, nil
+}
+
+// replayEntry applies e as applyEntryNormal of the server does, leaving out
+// membership changes.
+func replayEntry(ua apply.UberApplier, e raftpb.Entry) *replayedEntry {
+	re := &replayedEntry{entry: e}
+	if e.Type != raftpb.EntryNormal || len(e.Data) == 0 {
+		return re
+	}
+	var r pb.InternalRaftRequest
+	if !pbutil.MaybeUnmarshal(&r, e.Data) || r.V2 != nil {
+		return re
+	}
+	re.request = &r
+	if r.ClusterVersionSet != nil || r.ClusterMemberAttrSet != nil || r.DowngradeInfoSet != nil {
+		return re
+	}
+	re.result = ua.Apply(&r)
+	if re.result.Physc != nil {
+		<-re.result.Physc
+	}
+	return re
+}
+
+// replayStatus is the raft status of replayed entries.
+type replayStatus struct {
+	entry raftpb.Entry
+}
+
+func (rs *replayStatus) MemberID() types.ID     { return 0 }
+func (rs *replayStatus) Leader() types.ID       { return 0 }
+func (rs *replayStatus) CommittedIndex() uint64 { return rs.entry.Index }
+func (rs *replayStatus) AppliedIndex() uint64   { return rs.entry.Index }
+func (rs *replayStatus) Term() uint64           { return rs.entry.Term }
+func (rs *replayStatus) ForceSnapshot()         {}
+
+func copyFile(srcPath, dstPath string) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(dstPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	return dst.Close()
+}
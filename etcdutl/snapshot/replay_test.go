@@ -0,0 +1,95 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+// TestSnapshotRestoreReplayWAL restores a snapshot replaying the WAL of the
+// member up to a delete made after it.
+func TestSnapshotRestoreReplayWAL(t *testing.T) {
+	snapPath := filepath.Join(t.TempDir(), "snapshot.db")
+	var deleteRev int64
+	dbpath := createDB(t, func(srv *etcdserver.EtcdServer) {
+		ctx := context.TODO()
+		insertKeys(t, 5, 10)(srv)
+
+		f, err := os.Create(snapPath)
+		require.NoError(t, err)
+		snap := srv.Backend().Snapshot()
+		_, err = snap.WriteTo(f)
+		require.NoError(t, err)
+		require.NoError(t, snap.Close())
+		require.NoError(t, f.Close())
+
+		_, err = srv.Put(ctx, &etcdserverpb.PutRequest{Key: []byte("foo"), Value: []byte("bar")})
+		require.NoError(t, err)
+		resp, err := srv.DeleteRange(ctx, &etcdserverpb.DeleteRangeRequest{Key: []byte("0"), RangeEnd: []byte("9")})
+		require.NoError(t, err)
+		deleteRev = resp.Header.Revision
+		_, err = srv.Put(ctx, &etcdserverpb.PutRequest{Key: []byte("baz"), Value: []byte("qux")})
+		require.NoError(t, err)
+	})
+	walDir := filepath.Join(filepath.Dir(filepath.Dir(dbpath)), "wal")
+
+	dataDir := filepath.Join(t.TempDir(), "restored")
+	sp := NewV3(zaptest.NewLogger(t))
+	require.NoError(t, sp.Restore(RestoreConfig{
+		SnapshotPath:        snapPath,
+		Name:                "default",
+		OutputDataDir:       dataDir,
+		PeerURLs:            []string{"http://localhost:2380"},
+		InitialCluster:      "default=http://localhost:2380",
+		InitialClusterToken: "etcd-cluster",
+		SkipHashCheck:       true,
+		ReplayWALDir:        walDir,
+		ToRevision:          deleteRev,
+	}))
+
+	be := backend.NewDefaultBackend(zaptest.NewLogger(t), filepath.Join(dataDir, "member", "snap", "db"))
+	defer be.Close()
+	kv := mvcc.NewStore(zaptest.NewLogger(t), be, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	defer kv.Close()
+	assert.Equal(t, deleteRev-1, kv.Rev())
+	r, err := kv.Range(context.TODO(), []byte("0"), []byte("zzz"), mvcc.RangeOptions{})
+	require.NoError(t, err)
+	var keys []string
+	for _, k := range r.KVs {
+		keys = append(keys, string(k.Key))
+	}
+	assert.Equal(t, []string{"0", "1", "2", "3", "4", "foo"}, keys)
+}
+
+func TestSnapshotRestoreReplayWALBadArgs(t *testing.T) {
+	sp := NewV3(zaptest.NewLogger(t))
+	err := sp.Restore(RestoreConfig{SnapshotPath: "snapshot.db", ToRevision: 10})
+	require.Error(t, err)
+	err = sp.Restore(RestoreConfig{SnapshotPath: "snapshot.db", ReplayWALDir: "wal", ToIndex: 10, ToRevision: 10})
+	require.Error(t, err)
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"hash/crc32"
 	"io"
@@ -69,6 +70,14 @@ type Manager interface {
 	// file. It returns an error if specified data directory already
 	// exists, to prevent unintended data directory overwrites.
 	Restore(cfg RestoreConfig) error
+
+	// Export writes the content of the snapshot file in the logical
+	// snapshot format, which does not depend on the storage schema.
+	Export(cfg ExportConfig) error
+
+	// Import restores a new etcd data directory from a file written by
+	// Export, the same way Restore does from a snapshot file.
+	Import(cfg RestoreConfig) error
 }
 
 // NewV3 returns a new snapshot Manager for v3.x snapshot.
@@ -79,11 +88,16 @@ func NewV3(lg *zap.Logger) Manager {
 type v3Manager struct {
 	lg *zap.Logger
 
-	name      string
-	srcDbPath string
-	walDir    string
-	snapDir   string
-	cl        *membership.RaftCluster
+	name       string
+	srcDbPath  string
+	deltaPaths []string
+	walDir     string
+	snapDir    string
+	cl         *membership.RaftCluster
+
+	replayWALDir string
+	toIndex      uint64
+	toRevision   int64
 
 	skipHashCheck   bool
 	initialMmapSize uint64
@@ -147,6 +161,11 @@ func (s *v3Manager) Status(dbPath string) (ds Status, err error) {
 			if b == nil {
 				return fmt.Errorf("nil bucket: %q", string(next))
 			}
+			// the revision time index is local to the member and
+			// depends on when the revisions were applied
+			if bytes.Equal(next, schema.RevisionTime.Name()) {
+				continue
+			}
 			_, err = h.Write(next)
 			if err != nil {
 				return fmt.Errorf("cannot hash bucket name: %q err: %w", string(next), err)
@@ -171,7 +190,7 @@ func (s *v3Manager) Status(dbPath string) (ds Status, err error) {
 					ds.Revision = rev.Main
 
 					var kv mvccpb.KeyValue
-					err = kv.Unmarshal(v)
+					err = mvcc.DecodeKeyValue(v, &kv)
 					if err != nil {
 						return fmt.Errorf("cannot unmarshal value, key: %q value: %q err: %w", k, v, err)
 					}
@@ -205,6 +224,22 @@ type RestoreConfig struct {
 	// SnapshotPath is the path of snapshot file to restore from.
 	SnapshotPath string
 
+	// DeltaPaths are the paths of delta snapshot files to layer, in order,
+	// on the snapshot file. Each delta must be taken since a revision at or
+	// before the revision of the snapshot it is layered on.
+	DeltaPaths []string
+
+	// ReplayWALDir is the path of WAL files, archived from a member of the
+	// cluster the snapshot was taken from, whose committed entries after
+	// the snapshot are replayed on it.
+	ReplayWALDir string
+	// ToIndex is the raft index of the first WAL entry not to replay.
+	// If 0, the entries are replayed up to ToRevision.
+	ToIndex uint64
+	// ToRevision is the revision that the first WAL entry not to replay
+	// reaches. If 0, the entries are replayed up to ToIndex, or all of them.
+	ToRevision int64
+
 	// Name is the human-readable name of this member.
 	Name string
 
@@ -246,6 +281,19 @@ type RestoreConfig struct {
 
 // Restore restores a new etcd data directory from given snapshot file.
 func (s *v3Manager) Restore(cfg RestoreConfig) error {
+	return s.restore(cfg, s.saveDB)
+}
+
+// restore restores a new etcd data directory from the backend database
+// written to the snapshot directory by saveDB.
+func (s *v3Manager) restore(cfg RestoreConfig, saveDB func() error) error {
+	if (cfg.ToIndex != 0 || cfg.ToRevision != 0) && cfg.ReplayWALDir == "" {
+		return errors.New("replaying up to an index or a revision requires a WAL directory")
+	}
+	if cfg.ToIndex != 0 && cfg.ToRevision != 0 {
+		return errors.New("cannot replay up to both an index and a revision")
+	}
+
 	pURLs, err := types.NewURLs(cfg.PeerURLs)
 	if err != nil {
 		return err
@@ -289,6 +337,10 @@ func (s *v3Manager) Restore(cfg RestoreConfig) error {
 
 	s.name = cfg.Name
 	s.srcDbPath = cfg.SnapshotPath
+	s.deltaPaths = cfg.DeltaPaths
+	s.replayWALDir = cfg.ReplayWALDir
+	s.toIndex = cfg.ToIndex
+	s.toRevision = cfg.ToRevision
 	s.walDir = walDir
 	s.snapDir = filepath.Join(dataDir, "member", "snap")
 	s.skipHashCheck = cfg.SkipHashCheck
@@ -297,13 +349,15 @@ func (s *v3Manager) Restore(cfg RestoreConfig) error {
 	s.lg.Info(
 		"restoring snapshot",
 		zap.String("path", s.srcDbPath),
+		zap.Strings("delta-paths", s.deltaPaths),
+		zap.String("replay-wal-dir", s.replayWALDir),
 		zap.String("wal-dir", s.walDir),
 		zap.String("data-dir", dataDir),
 		zap.String("snap-dir", s.snapDir),
 		zap.Uint64("initial-memory-map-size", s.initialMmapSize),
 	)
 
-	if err = s.saveDB(); err != nil {
+	if err = saveDB(); err != nil {
 		return err
 	}
 
@@ -348,6 +402,16 @@ func (s *v3Manager) saveDB() error {
 	if err != nil {
 		return err
 	}
+	for _, deltaPath := range s.deltaPaths {
+		if err = s.applyDelta(deltaPath); err != nil {
+			return err
+		}
+	}
+	if s.replayWALDir != "" {
+		if err = s.replayWAL(); err != nil {
+			return err
+		}
+	}
 
 	be := backend.NewDefaultBackend(s.lg, s.outDbPath(), backend.WithMmapSize(s.initialMmapSize))
 	defer be.Close()
@@ -425,7 +489,16 @@ func (s *v3Manager) unsafeGetLatestRevision(tx backend.UnsafeReader) (mvcc.Revis
 }
 
 func (s *v3Manager) copyAndVerifyDB() error {
-	srcf, ferr := os.Open(s.srcDbPath)
+	if err := fileutil.CreateDirAll(s.lg, s.snapDir); err != nil {
+		return err
+	}
+	return s.copyAndVerify(s.srcDbPath, s.outDbPath())
+}
+
+// copyAndVerify copies the snapshot file at srcPath to dbPath, checking and
+// truncating away its integrity hash.
+func (s *v3Manager) copyAndVerify(srcPath, dbPath string) error {
+	srcf, ferr := os.Open(srcPath)
 	if ferr != nil {
 		return ferr
 	}
@@ -443,13 +516,7 @@ func (s *v3Manager) copyAndVerifyDB() error {
 		return err
 	}
 
-	if err := fileutil.CreateDirAll(s.lg, s.snapDir); err != nil {
-		return err
-	}
-
-	outDbPath := s.outDbPath()
-
-	db, dberr := os.OpenFile(outDbPath, os.O_RDWR|os.O_CREATE, 0600)
+	db, dberr := os.OpenFile(dbPath, os.O_RDWR|os.O_CREATE, 0600)
 	if dberr != nil {
 		return dberr
 	}
@@ -34,6 +34,14 @@ import (
 	"go.etcd.io/etcd/server/v3/storage/datadir"
 )
 
+// PrefixRetention is the minimum history compactions keep for the keys with
+// the prefix, either as a number of revisions or as a duration.
+type PrefixRetention struct {
+	Prefix    string
+	Revisions int64
+	Duration  time.Duration
+}
+
 // ServerConfig holds the configuration of etcd as taken from the command line or discovery.
 type ServerConfig struct {
 	Name string
@@ -122,6 +130,10 @@ type ServerConfig struct {
 	QuotaBackendBytes       int64
 	MaxTxnOps               uint
 
+	// CompactionPrefixRetentions keep the history of key prefixes past
+	// compactions. The ones of the leader apply to the whole cluster.
+	CompactionPrefixRetentions []PrefixRetention
+
 	// MaxRequestBytes is the maximum request size to send over raft.
 	MaxRequestBytes uint
 
@@ -175,6 +187,22 @@ type ServerConfig struct {
 
 	WatchProgressNotifyInterval time.Duration
 
+	// WatchResumeRetention is how long auto compaction keeps the revision
+	// of a watch resume token after it is issued. Zero disables resume tokens.
+	// Only auto compaction run by a leader with it set is held back; a manual
+	// compaction still invalidates the tokens of the revisions it compacts.
+	WatchResumeRetention time.Duration
+
+	// WatchLagCancelRevisions cancels a watcher whose responses are sent
+	// this many revisions behind the current revision. Zero disables it.
+	WatchLagCancelRevisions int64
+	// WatchLagCancelBytes cancels a watcher whose responses held back by
+	// watch flow control exceed this size. Zero disables it.
+	WatchLagCancelBytes int64
+	// WatchMaxQueuedBytes bounds the size of the responses a watch stream
+	// holds back. Past it, the watchers with the most held back are canceled.
+	WatchMaxQueuedBytes int64
+
 	// UnsafeNoFsync disables all uses of fsync.
 	// Setting this is unsafe and will cause data loss.
 	UnsafeNoFsync bool `json:"unsafe-no-fsync"`
@@ -197,6 +225,54 @@ type ServerConfig struct {
 	// consider running defrag during bootstrap. Needs to be set to non-zero value to take effect.
 	ExperimentalBootstrapDefragThresholdMegabytes uint `json:"experimental-bootstrap-defrag-threshold-megabytes"`
 
+	// AutoDefragRatio is the ratio of free space in the backend, from 0 to 1,
+	// above which the member defragments automatically. 0 disables it.
+	AutoDefragRatio float64
+	// AutoDefragThresholdMegabytes is the minimum free space in the backend
+	// needed for the member to defragment automatically.
+	AutoDefragThresholdMegabytes uint
+	// AutoDefragCheckTime is the time between two checks of the free space.
+	AutoDefragCheckTime time.Duration
+	// AutoDefragSkipLeader is true to not defragment automatically while the
+	// member is the leader.
+	AutoDefragSkipLeader bool
+
+	// WALArchiveTarget is the directory or the http(s) URL the sealed WAL
+	// segments are archived to before they can be purged. Empty disables it.
+	WALArchiveTarget string
+	// WALCompression is the codec the data of the WAL entries is compressed
+	// with, "none", "snappy" or "zstd".
+	WALCompression string
+
+	// ValueCompression is the codec the values of the keys are compressed
+	// with in the backend, "none", "snappy" or "zstd".
+	ValueCompression string
+
+	// BackendEngine is the name of a registered engine of the backend, the
+	// bbolt one if empty. The memory engine is not crash safe, it is meant
+	// for tests.
+	BackendEngine string
+
+	// LeaderLeaseReads serves the linearizable reads locally on the leader
+	// while its lease is valid, instead of confirming its leadership with
+	// a ReadIndex for each of them.
+	LeaderLeaseReads bool
+	// LeaderLeaseMaxClockDrift is the bound on the clock drift between the
+	// members the leader lease is shortened by.
+	LeaderLeaseMaxClockDrift time.Duration
+
+	// Witness starts the member as a witness. Witnesses vote and acknowledge
+	// the raft log, but do not apply the entries to the key-value store and
+	// keep their backend in memory, only holding the membership.
+	Witness bool
+
+	// LearnerAutoPromoteMaxLag is the number of entries the match index of a
+	// learner added with auto-promote may lag behind the one of the leader.
+	LearnerAutoPromoteMaxLag uint64
+	// LearnerAutoPromoteStableWindow is how long the learner must stay within
+	// LearnerAutoPromoteMaxLag before the leader promotes it.
+	LearnerAutoPromoteStableWindow time.Duration
+
 	// ExperimentalMaxLearners sets a limit to the number of learner members that can exist in the cluster membership.
 	ExperimentalMaxLearners int `json:"experimental-max-learners"`
 
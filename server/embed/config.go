@@ -52,6 +52,8 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3discovery"
 	"go.etcd.io/etcd/server/v3/features"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/wal"
 )
 
 const (
@@ -73,6 +75,11 @@ const (
 	DefaultAutoCompactionMode               = "periodic"
 	DefaultAuthToken                        = "simple"
 	DefaultExperimentalCompactHashCheckTime = time.Minute
+	DefaultExperimentalAutoDefragCheckTime  = 5 * time.Minute
+	DefaultLeaderLeaseMaxClockDrift         = 100 * time.Millisecond
+	DefaultLearnerAutoPromoteMaxLag         = 1000
+	DefaultLearnerAutoPromoteStableWindow   = 30 * time.Second
+	DefaultWatchMaxQueuedBytes              = 64 * 1024 * 1024
 
 	DefaultDiscoveryDialTimeout      = 2 * time.Second
 	DefaultDiscoveryRequestTimeOut   = 5 * time.Second
@@ -366,14 +373,66 @@ type Config struct {
 	ExperimentalEnableLeaseCheckpointPersist bool `json:"experimental-enable-lease-checkpoint-persist"`
 	ExperimentalCompactionBatchLimit         int  `json:"experimental-compaction-batch-limit"`
 	// ExperimentalCompactionSleepInterval is the sleep interval between every etcd compaction loop.
-	ExperimentalCompactionSleepInterval     time.Duration `json:"experimental-compaction-sleep-interval"`
+	ExperimentalCompactionSleepInterval time.Duration `json:"experimental-compaction-sleep-interval"`
+	// ExperimentalCompactionPrefixRetention is a comma separated list of 'prefix=retention'
+	// entries, where retention is the minimum history compactions keep for the keys with
+	// the prefix, either as a duration with time unit (e.g. '168h') or a number of revisions.
+	// Prefixes must not overlap. The retentions of the leader apply to the compactions of
+	// the whole cluster, so they should be the same on every member.
+	ExperimentalCompactionPrefixRetention   string        `json:"experimental-compaction-prefix-retention"`
 	ExperimentalWatchProgressNotifyInterval time.Duration `json:"experimental-watch-progress-notify-interval"`
+	// ExperimentalWatchResumeRetention is how long auto compaction keeps the revision of an issued watch resume token.
+	// It should be set on every member, as only the leader compacts. Manual compactions are not held back.
+	ExperimentalWatchResumeRetention time.Duration `json:"experimental-watch-resume-retention"`
+	// ExperimentalWatchLagCancelRevisions cancels watchers sent responses more than this many revisions behind.
+	ExperimentalWatchLagCancelRevisions int64 `json:"experimental-watch-lag-cancel-revisions"`
+	// ExperimentalWatchLagCancelBytes cancels watchers with more than this many bytes held back by flow control.
+	ExperimentalWatchLagCancelBytes int64 `json:"experimental-watch-lag-cancel-bytes"`
+	// ExperimentalWatchMaxQueuedBytes bounds the responses a watch stream holds back, past which the watchers with
+	// the most held back are canceled.
+	ExperimentalWatchMaxQueuedBytes int64 `json:"experimental-watch-max-queued-bytes"`
 	// ExperimentalWarningApplyDuration is the time duration after which a warning is generated if applying request
 	// takes more time than this value.
 	ExperimentalWarningApplyDuration time.Duration `json:"experimental-warning-apply-duration"`
 	// ExperimentalBootstrapDefragThresholdMegabytes is the minimum number of megabytes needed to be freed for etcd server to
 	// consider running defrag during bootstrap. Needs to be set to non-zero value to take effect.
 	ExperimentalBootstrapDefragThresholdMegabytes uint `json:"experimental-bootstrap-defrag-threshold-megabytes"`
+	// ExperimentalAutoDefragRatio is the ratio of free space in the backend, from 0 to 1, above which
+	// the member defragments automatically, one member of the cluster at a time. 0 disables it.
+	ExperimentalAutoDefragRatio float64 `json:"experimental-auto-defrag-ratio"`
+	// ExperimentalAutoDefragThresholdMegabytes is the minimum number of megabytes needed to be freed
+	// for the member to defragment automatically.
+	ExperimentalAutoDefragThresholdMegabytes uint `json:"experimental-auto-defrag-threshold-megabytes"`
+	// ExperimentalAutoDefragCheckTime is the duration between two checks of the backend free space.
+	ExperimentalAutoDefragCheckTime time.Duration `json:"experimental-auto-defrag-check-time"`
+	// ExperimentalAutoDefragSkipLeader skips automatic defragmentation while the member is the leader.
+	ExperimentalAutoDefragSkipLeader bool `json:"experimental-auto-defrag-skip-leader"`
+	// ExperimentalWALArchiveTarget is the directory, or the http(s) URL to PUT to, the sealed WAL segments are
+	// archived to, with a manifest each, before they can be purged. Empty disables it. The segments whose manifest
+	// is found there, read with GET, are not archived again on restart.
+	ExperimentalWALArchiveTarget string `json:"experimental-wal-archive-target"`
+	// ExperimentalWALCompression is the codec the data of the WAL entries is compressed with, "none", "snappy"
+	// or "zstd". WALs with compressed entries cannot be read by etcd versions before compression was added.
+	ExperimentalWALCompression string `json:"experimental-wal-compression"`
+	// ExperimentalValueCompression is the codec the values of the keys are compressed with in the backend, "none",
+	// "snappy" or "zstd". Backends with compressed values cannot be read by etcd versions before compression was added.
+	ExperimentalValueCompression string `json:"experimental-value-compression"`
+	// ExperimentalLeaderLeaseReads serves the linearizable reads locally on the leader while its lease is valid,
+	// falling back to ReadIndex otherwise. The lease lasts the election timeout minus one heartbeat interval and
+	// the max clock drift.
+	ExperimentalLeaderLeaseReads bool `json:"experimental-leader-lease-reads"`
+	// ExperimentalLeaderLeaseMaxClockDrift is the bound on the clock drift between the members the leader lease
+	// is shortened by.
+	ExperimentalLeaderLeaseMaxClockDrift time.Duration `json:"experimental-leader-lease-max-clock-drift"`
+	// ExperimentalWitness starts the member as a witness, which votes and acknowledges the raft log but does not
+	// store the key-value data nor serve the client requests. The member must be added with "member add --witness".
+	ExperimentalWitness bool `json:"experimental-witness"`
+	// ExperimentalLearnerAutoPromoteMaxLag is the number of entries the match index of a learner added with
+	// "member add --learner --auto-promote" may lag behind the one of the leader.
+	ExperimentalLearnerAutoPromoteMaxLag uint64 `json:"experimental-learner-auto-promote-max-lag"`
+	// ExperimentalLearnerAutoPromoteStableWindow is how long the learner must stay within the max lag before the
+	// leader promotes it.
+	ExperimentalLearnerAutoPromoteStableWindow time.Duration `json:"experimental-learner-auto-promote-stable-window"`
 	// WarningUnaryRequestDuration is the time duration after which a warning is generated if applying
 	// unary request takes more time than this value.
 	WarningUnaryRequestDuration time.Duration `json:"warning-unary-request-duration"`
@@ -570,6 +629,15 @@ func NewConfig() *Config {
 		ExperimentalCompactHashCheckEnabled: false,
 		ExperimentalCompactHashCheckTime:    DefaultExperimentalCompactHashCheckTime,
 
+		ExperimentalAutoDefragCheckTime: DefaultExperimentalAutoDefragCheckTime,
+
+		ExperimentalLeaderLeaseMaxClockDrift: DefaultLeaderLeaseMaxClockDrift,
+
+		ExperimentalLearnerAutoPromoteMaxLag:       DefaultLearnerAutoPromoteMaxLag,
+		ExperimentalLearnerAutoPromoteStableWindow: DefaultLearnerAutoPromoteStableWindow,
+
+		ExperimentalWatchMaxQueuedBytes: DefaultWatchMaxQueuedBytes,
+
 		V2Deprecation: config.V2DeprDefault,
 
 		DiscoveryCfg: v3discovery.DiscoveryConfig{
@@ -757,7 +825,12 @@ func (cfg *Config) AddFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&cfg.ExperimentalEnableLeaseCheckpointPersist, "experimental-enable-lease-checkpoint-persist", false, "Enable persisting remainingTTL to prevent indefinite auto-renewal of long lived leases. Always enabled in v3.6. Should be used to ensure smooth upgrade from v3.5 clusters with this feature enabled. Requires experimental-enable-lease-checkpoint to be enabled.")
 	fs.IntVar(&cfg.ExperimentalCompactionBatchLimit, "experimental-compaction-batch-limit", cfg.ExperimentalCompactionBatchLimit, "Sets the maximum revisions deleted in each compaction batch.")
 	fs.DurationVar(&cfg.ExperimentalCompactionSleepInterval, "experimental-compaction-sleep-interval", cfg.ExperimentalCompactionSleepInterval, "Sets the sleep interval between each compaction batch.")
+	fs.StringVar(&cfg.ExperimentalCompactionPrefixRetention, "experimental-compaction-prefix-retention", cfg.ExperimentalCompactionPrefixRetention, "Comma separated 'prefix=retention' list of the minimum history compactions keep for key prefixes, either as a duration with time unit (e.g. '168h') or a number of revisions (e.g. '10000'). The list of the leader applies to the whole cluster.")
 	fs.DurationVar(&cfg.ExperimentalWatchProgressNotifyInterval, "experimental-watch-progress-notify-interval", cfg.ExperimentalWatchProgressNotifyInterval, "Duration of periodic watch progress notifications.")
+	fs.DurationVar(&cfg.ExperimentalWatchResumeRetention, "experimental-watch-resume-retention", cfg.ExperimentalWatchResumeRetention, "Duration auto compaction keeps the revision of an issued watch resume token. Manual compactions are not held back. 0 disables watch resume tokens.")
+	fs.Int64Var(&cfg.ExperimentalWatchLagCancelRevisions, "experimental-watch-lag-cancel-revisions", cfg.ExperimentalWatchLagCancelRevisions, "Cancel watchers whose responses are sent more than this many revisions behind the current revision. 0 disables it.")
+	fs.Int64Var(&cfg.ExperimentalWatchLagCancelBytes, "experimental-watch-lag-cancel-bytes", cfg.ExperimentalWatchLagCancelBytes, "Cancel watchers with more than this many bytes of responses held back by watch flow control. 0 disables it.")
+	fs.Int64Var(&cfg.ExperimentalWatchMaxQueuedBytes, "experimental-watch-max-queued-bytes", cfg.ExperimentalWatchMaxQueuedBytes, "Maximum bytes of responses a watch stream holds back, past which the watchers with the most held back are canceled.")
 	fs.DurationVar(&cfg.ExperimentalDowngradeCheckTime, "experimental-downgrade-check-time", cfg.ExperimentalDowngradeCheckTime, "Duration of time between two downgrade status checks.")
 	fs.DurationVar(&cfg.ExperimentalWarningApplyDuration, "experimental-warning-apply-duration", cfg.ExperimentalWarningApplyDuration, "Time duration after which a warning is generated if request takes more time.")
 	fs.DurationVar(&cfg.WarningUnaryRequestDuration, "warning-unary-request-duration", cfg.WarningUnaryRequestDuration, "Time duration after which a warning is generated if a unary request takes more time.")
@@ -766,6 +839,18 @@ func (cfg *Config) AddFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&cfg.ExperimentalTxnModeWriteWithSharedBuffer, "experimental-txn-mode-write-with-shared-buffer", true, "Enable the write transaction to use a shared buffer in its readonly check operations.")
 	fs.BoolVar(&cfg.ExperimentalStopGRPCServiceOnDefrag, "experimental-stop-grpc-service-on-defrag", cfg.ExperimentalStopGRPCServiceOnDefrag, "Enable etcd gRPC service to stop serving client requests on defragmentation.")
 	fs.UintVar(&cfg.ExperimentalBootstrapDefragThresholdMegabytes, "experimental-bootstrap-defrag-threshold-megabytes", 0, "Enable the defrag during etcd server bootstrap on condition that it will free at least the provided threshold of disk space. Needs to be set to non-zero value to take effect.")
+	fs.Float64Var(&cfg.ExperimentalAutoDefragRatio, "experimental-auto-defrag-ratio", 0, "Defragment the member automatically once this ratio of the backend, from 0 to 1, is free space. Members defragment one at a time, holding a lock taken through raft with a lease. Needs to be set to non-zero value to take effect.")
+	fs.UintVar(&cfg.ExperimentalAutoDefragThresholdMegabytes, "experimental-auto-defrag-threshold-megabytes", 0, "Minimum number of megabytes automatic defragmentation must free.")
+	fs.DurationVar(&cfg.ExperimentalAutoDefragCheckTime, "experimental-auto-defrag-check-time", cfg.ExperimentalAutoDefragCheckTime, "Duration of time between two checks of the backend free space for automatic defragmentation.")
+	fs.BoolVar(&cfg.ExperimentalAutoDefragSkipLeader, "experimental-auto-defrag-skip-leader", false, "Do not defragment automatically while the member is the leader.")
+	fs.StringVar(&cfg.ExperimentalWALArchiveTarget, "experimental-wal-archive-target", "", "Directory, or http(s) URL to PUT to, the sealed WAL segments are archived to before they can be purged. The segments whose manifest is found there, read with GET, are not archived again.")
+	fs.StringVar(&cfg.ExperimentalWALCompression, "experimental-wal-compression", "none", "Codec the data of the WAL entries is compressed with, 'none', 'snappy' or 'zstd'. Compressed WALs cannot be read by etcd versions before v3.6.")
+	fs.StringVar(&cfg.ExperimentalValueCompression, "experimental-value-compression", "none", "Codec the values of the keys are compressed with in the backend, 'none', 'snappy' or 'zstd'. Backends with compressed values cannot be read by etcd versions before v3.6.")
+	fs.BoolVar(&cfg.ExperimentalLeaderLeaseReads, "experimental-leader-lease-reads", false, "Serve the linearizable reads locally on the leader while its lease is valid, falling back to ReadIndex otherwise.")
+	fs.DurationVar(&cfg.ExperimentalLeaderLeaseMaxClockDrift, "experimental-leader-lease-max-clock-drift", cfg.ExperimentalLeaderLeaseMaxClockDrift, "Bound on the clock drift between the members the leader lease is shortened by. Must be >0 and lower than the election timeout minus the heartbeat interval.")
+	fs.BoolVar(&cfg.ExperimentalWitness, "experimental-witness", false, "Start the member as a witness, which votes and acknowledges the raft log but does not store the key-value data. The member must be added as a witness.")
+	fs.Uint64Var(&cfg.ExperimentalLearnerAutoPromoteMaxLag, "experimental-learner-auto-promote-max-lag", cfg.ExperimentalLearnerAutoPromoteMaxLag, "Number of entries the match index of a learner added with auto-promote may lag behind the one of the leader.")
+	fs.DurationVar(&cfg.ExperimentalLearnerAutoPromoteStableWindow, "experimental-learner-auto-promote-stable-window", cfg.ExperimentalLearnerAutoPromoteStableWindow, "Duration a learner added with auto-promote must stay within the max lag before the leader promotes it.")
 	fs.IntVar(&cfg.ExperimentalMaxLearners, "experimental-max-learners", membership.DefaultMaxLearners, "Sets the maximum number of learners that can be available in the cluster membership.")
 	fs.Uint64Var(&cfg.SnapshotCatchUpEntries, "experimental-snapshot-catchup-entries", cfg.SnapshotCatchUpEntries, "Number of entries for a slow follower to catch up after compacting the raft storage entries.")
 
@@ -1082,6 +1167,39 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("--experimental-compact-hash-check-time must be >0 (set to %v)", cfg.ExperimentalCompactHashCheckTime)
 	}
 
+	if cfg.ExperimentalAutoDefragRatio < 0 || cfg.ExperimentalAutoDefragRatio >= 1 {
+		return fmt.Errorf("--experimental-auto-defrag-ratio must be >=0 and <1 (set to %v)", cfg.ExperimentalAutoDefragRatio)
+	}
+	if cfg.ExperimentalAutoDefragRatio > 0 && cfg.ExperimentalAutoDefragCheckTime <= 0 {
+		return fmt.Errorf("--experimental-auto-defrag-check-time must be >0 (set to %v)", cfg.ExperimentalAutoDefragCheckTime)
+	}
+
+	if cfg.ExperimentalWALCompression != "" {
+		if _, err := wal.ParseCodec(cfg.ExperimentalWALCompression); err != nil {
+			return fmt.Errorf("--experimental-wal-compression must be 'none', 'snappy' or 'zstd' (set to %q)", cfg.ExperimentalWALCompression)
+		}
+	}
+	if _, err := mvcc.ParseValueCodec(cfg.ExperimentalValueCompression); err != nil {
+		return fmt.Errorf("--experimental-value-compression must be 'none', 'snappy' or 'zstd' (set to %q)", cfg.ExperimentalValueCompression)
+	}
+	// the leader lease lasts one heartbeat less than the election timeout,
+	// minus the drift.
+	leaseMs := uint(cfg.ElectionTicks()-1) * cfg.TickMs
+	if cfg.ExperimentalLeaderLeaseMaxClockDrift <= 0 || cfg.ExperimentalLeaderLeaseMaxClockDrift >= time.Duration(leaseMs)*time.Millisecond {
+		return fmt.Errorf("--experimental-leader-lease-max-clock-drift must be >0 and lower than --election-timeout minus --heartbeat-interval[%vms] (set to %v)", leaseMs, cfg.ExperimentalLeaderLeaseMaxClockDrift)
+	}
+	// witnesses only win elections to hand the leadership over, without
+	// pre-vote they would disrupt a healthy leader by bumping their term.
+	if cfg.ExperimentalWitness && !cfg.PreVote {
+		return fmt.Errorf("--experimental-witness requires --pre-vote")
+	}
+	if cfg.ExperimentalLearnerAutoPromoteStableWindow < 0 {
+		return fmt.Errorf("--experimental-learner-auto-promote-stable-window must be >=0 (set to %v)", cfg.ExperimentalLearnerAutoPromoteStableWindow)
+	}
+	if cfg.ExperimentalWatchMaxQueuedBytes <= 0 {
+		return fmt.Errorf("--experimental-watch-max-queued-bytes must be >0 (set to %v)", cfg.ExperimentalWatchMaxQueuedBytes)
+	}
+
 	// If `--name` isn't configured, then multiple members may have the same "default" name.
 	// When adding a new member with the "default" name as well, etcd may regards its peerURL
 	// as one additional peerURL of the existing member which has the same "default" name,
@@ -33,6 +33,7 @@ import (
 	"go.etcd.io/etcd/client/pkg/v3/transport"
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/pkg/v3/featuregate"
+	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/features"
 )
 
@@ -532,6 +533,35 @@ func TestAutoCompactionModeParse(t *testing.T) {
 	}
 }
 
+func TestCompactionPrefixRetentionParse(t *testing.T) {
+	tests := []struct {
+		retention string
+		werr      bool
+		wprs      []config.PrefixRetention
+	}{
+		{"", false, nil},
+		{"/audit/=168h", false, []config.PrefixRetention{{Prefix: "/audit/", Duration: 168 * time.Hour}}},
+		{"/audit/=168h,/events/=1000", false, []config.PrefixRetention{
+			{Prefix: "/audit/", Duration: 168 * time.Hour},
+			{Prefix: "/events/", Revisions: 1000},
+		}},
+		{"/a=b/=10", false, []config.PrefixRetention{{Prefix: "/a=b/", Revisions: 10}}},
+		{"/audit/", true, nil},
+		{"=1h", true, nil},
+		{"/audit/=a", true, nil},
+		{"/audit/=-1", true, nil},
+		{"/audit/=1h,/audit/log/=10", true, nil},
+	}
+
+	for i, tt := range tests {
+		prs, err := parseCompactionPrefixRetention(tt.retention)
+		if (err != nil) != tt.werr {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
+		}
+		assert.Equalf(t, tt.wprs, prs, "#%d", i)
+	}
+}
+
 func TestPeerURLsMapAndTokenFromSRV(t *testing.T) {
 	defer func() { getCluster = srv.GetCluster }()
 
@@ -662,6 +692,98 @@ func TestLeaseCheckpointValidate(t *testing.T) {
 	}
 }
 
+func TestLeaderLeaseMaxClockDriftValidate(t *testing.T) {
+	tcs := []struct {
+		name        string
+		drift       time.Duration
+		expectError bool
+	}{
+		{name: "Default drift should pass", drift: DefaultLeaderLeaseMaxClockDrift},
+		{name: "No drift should fail", drift: 0, expectError: true},
+		{name: "Negative drift should fail", drift: -time.Millisecond, expectError: true},
+		{name: "Drift below the election timeout minus a heartbeat should pass", drift: 899 * time.Millisecond},
+		{name: "Drift of the election timeout minus a heartbeat should fail", drift: 900 * time.Millisecond, expectError: true},
+		{name: "Drift of the election timeout should fail", drift: time.Second, expectError: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfig()
+			cfg.ExperimentalLeaderLeaseReads = true
+			cfg.ExperimentalLeaderLeaseMaxClockDrift = tc.drift
+			err := cfg.Validate()
+			if (err != nil) != tc.expectError {
+				t.Errorf("config.Validate() = %q, expected error: %v", err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestWitnessValidate(t *testing.T) {
+	tcs := []struct {
+		name        string
+		preVote     bool
+		expectError bool
+	}{
+		{name: "Witness with pre-vote should pass", preVote: true},
+		{name: "Witness without pre-vote should fail", preVote: false, expectError: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfig()
+			cfg.ExperimentalWitness = true
+			cfg.PreVote = tc.preVote
+			err := cfg.Validate()
+			if (err != nil) != tc.expectError {
+				t.Errorf("config.Validate() = %q, expected error: %v", err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestLearnerAutoPromoteStableWindowValidate(t *testing.T) {
+	tcs := []struct {
+		name        string
+		window      time.Duration
+		expectError bool
+	}{
+		{name: "Default window should pass", window: DefaultLearnerAutoPromoteStableWindow},
+		{name: "No window should pass", window: 0},
+		{name: "Negative window should fail", window: -time.Second, expectError: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfig()
+			cfg.ExperimentalLearnerAutoPromoteStableWindow = tc.window
+			err := cfg.Validate()
+			if (err != nil) != tc.expectError {
+				t.Errorf("config.Validate() = %q, expected error: %v", err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestWatchMaxQueuedBytesValidate(t *testing.T) {
+	tcs := []struct {
+		name        string
+		bytes       int64
+		expectError bool
+	}{
+		{name: "Default bound should pass", bytes: DefaultWatchMaxQueuedBytes},
+		{name: "No bound should fail", bytes: 0, expectError: true},
+		{name: "Negative bound should fail", bytes: -1, expectError: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfig()
+			cfg.ExperimentalWatchMaxQueuedBytes = tc.bytes
+			err := cfg.Validate()
+			if (err != nil) != tc.expectError {
+				t.Errorf("config.Validate() = %q, expected error: %v", err, tc.expectError)
+			}
+		})
+	}
+}
+
 func TestLogRotation(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -165,6 +165,11 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		return e, err
 	}
 
+	compactionPrefixRetentions, err := parseCompactionPrefixRetention(cfg.ExperimentalCompactionPrefixRetention)
+	if err != nil {
+		return e, err
+	}
+
 	backendFreelistType := parseBackendFreelistType(cfg.BackendFreelistType)
 
 	srvcfg := config.ServerConfig{
@@ -217,8 +222,25 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		LeaseCheckpointPersist:                   cfg.ExperimentalEnableLeaseCheckpointPersist,
 		CompactionBatchLimit:                     cfg.ExperimentalCompactionBatchLimit,
 		CompactionSleepInterval:                  cfg.ExperimentalCompactionSleepInterval,
+		CompactionPrefixRetentions:               compactionPrefixRetentions,
 		WatchProgressNotifyInterval:              cfg.ExperimentalWatchProgressNotifyInterval,
+		WatchResumeRetention:                     cfg.ExperimentalWatchResumeRetention,
+		WatchLagCancelRevisions:                  cfg.ExperimentalWatchLagCancelRevisions,
+		WatchLagCancelBytes:                      cfg.ExperimentalWatchLagCancelBytes,
+		WatchMaxQueuedBytes:                      cfg.ExperimentalWatchMaxQueuedBytes,
 		DowngradeCheckTime:                       cfg.ExperimentalDowngradeCheckTime,
+		AutoDefragRatio:                          cfg.ExperimentalAutoDefragRatio,
+		AutoDefragThresholdMegabytes:             cfg.ExperimentalAutoDefragThresholdMegabytes,
+		AutoDefragCheckTime:                      cfg.ExperimentalAutoDefragCheckTime,
+		AutoDefragSkipLeader:                     cfg.ExperimentalAutoDefragSkipLeader,
+		WALArchiveTarget:                         cfg.ExperimentalWALArchiveTarget,
+		WALCompression:                           cfg.ExperimentalWALCompression,
+		ValueCompression:                         cfg.ExperimentalValueCompression,
+		LeaderLeaseReads:                         cfg.ExperimentalLeaderLeaseReads,
+		LeaderLeaseMaxClockDrift:                 cfg.ExperimentalLeaderLeaseMaxClockDrift,
+		Witness:                                  cfg.ExperimentalWitness,
+		LearnerAutoPromoteMaxLag:                 cfg.ExperimentalLearnerAutoPromoteMaxLag,
+		LearnerAutoPromoteStableWindow:           cfg.ExperimentalLearnerAutoPromoteStableWindow,
 		WarningApplyDuration:                     cfg.ExperimentalWarningApplyDuration,
 		WarningUnaryRequestDuration:              cfg.WarningUnaryRequestDuration,
 		ExperimentalMemoryMlock:                  cfg.ExperimentalMemoryMlock,
@@ -258,8 +280,9 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 	e.errc = make(chan error, len(e.Peers)+len(e.Clients)+2*len(e.sctxs))
 
 	// newly started member ("memberInitialized==false")
-	// does not need corruption check
-	if memberInitialized && srvcfg.ServerFeatureGate.Enabled(features.InitialCorruptCheck) {
+	// does not need corruption check, nor do witnesses,
+	// which do not keep the key-value data
+	if memberInitialized && !srvcfg.Witness && srvcfg.ServerFeatureGate.Enabled(features.InitialCorruptCheck) {
 		if err = e.Server.CorruptionChecker().InitialCheck(); err != nil {
 			// set "EtcdServer" to nil, so that it does not block on "EtcdServer.Close()"
 			// (nothing to close since rafthttp transports have not been started)
@@ -912,3 +935,33 @@ func parseCompactionRetention(mode, retention string) (ret time.Duration, err er
 	}
 	return ret, nil
 }
+
+func parseCompactionPrefixRetention(s string) ([]config.PrefixRetention, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	var prs []config.PrefixRetention
+	for _, entry := range strings.Split(s, ",") {
+		i := strings.LastIndex(entry, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid compaction prefix retention %q, expected 'prefix=retention'", entry)
+		}
+		pr := config.PrefixRetention{Prefix: entry[:i]}
+		retention := entry[i+1:]
+		if n, err := strconv.ParseInt(retention, 10, 64); err == nil {
+			pr.Revisions = n
+		} else if pr.Duration, err = time.ParseDuration(retention); err != nil {
+			return nil, fmt.Errorf("error parsing compaction prefix retention %q: %v", entry, err)
+		}
+		if pr.Revisions < 0 || pr.Duration < 0 {
+			return nil, fmt.Errorf("invalid compaction prefix retention %q, retention must not be negative", entry)
+		}
+		for _, p := range prs {
+			if strings.HasPrefix(p.Prefix, pr.Prefix) || strings.HasPrefix(pr.Prefix, p.Prefix) {
+				return nil, fmt.Errorf("compaction prefix retentions of %q and %q overlap", p.Prefix, pr.Prefix)
+			}
+		}
+		prs = append(prs, pr)
+	}
+	return prs, nil
+}
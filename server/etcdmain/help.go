@@ -285,16 +285,50 @@ Experimental feature:
     ExperimentalEnableLeaseCheckpoint enables primary lessor to persist lease remainingTTL to prevent indefinite auto-renewal of long lived leases.
   --experimental-compaction-batch-limit 1000
     ExperimentalCompactionBatchLimit sets the maximum revisions deleted in each compaction batch.
+  --experimental-compaction-prefix-retention ''
+    Comma separated 'prefix=retention' list of the minimum history compactions keep for key prefixes, either as a duration with time unit (e.g. '168h') or a number of revisions (e.g. '10000'). The list of the leader applies to the whole cluster.
   --experimental-peer-skip-client-san-verification 'false'
     Skip verification of SAN field in client certificate for peer connections.
   --experimental-watch-progress-notify-interval '10m'
     Duration of periodical watch progress notification.
+  --experimental-watch-resume-retention '0s'
+    Duration auto compaction keeps the revision of an issued watch resume token. Manual compactions are not held back. 0 disables watch resume tokens.
+  --experimental-watch-lag-cancel-revisions '0'
+    Cancel watchers whose responses are sent more than this many revisions behind the current revision. 0 disables it.
+  --experimental-watch-lag-cancel-bytes '0'
+    Cancel watchers with more than this many bytes of responses held back by watch flow control. 0 disables it.
+  --experimental-watch-max-queued-bytes '67108864'
+    Maximum bytes of responses a watch stream holds back, past which the watchers with the most held back are canceled.
   --experimental-warning-apply-duration '100ms'
     Warning is generated if requests take more than this duration.
   --experimental-txn-mode-write-with-shared-buffer 'true'
     Enable the write transaction to use a shared buffer in its readonly check operations.
   --experimental-bootstrap-defrag-threshold-megabytes
     Enable the defrag during etcd server bootstrap on condition that it will free at least the provided threshold of disk space. Needs to be set to non-zero value to take effect.
+  --experimental-auto-defrag-ratio '0'
+    Defragment the member automatically once this ratio of the backend, from 0 to 1, is free space. Members defragment one at a time, holding a lock taken through raft with a lease. Needs to be set to non-zero value to take effect.
+  --experimental-auto-defrag-threshold-megabytes '0'
+    Minimum number of megabytes automatic defragmentation must free.
+  --experimental-auto-defrag-check-time '5m'
+    Duration of time between two checks of the backend free space for automatic defragmentation.
+  --experimental-auto-defrag-skip-leader 'false'
+    Do not defragment automatically while the member is the leader.
+  --experimental-wal-archive-target ''
+    Directory, or http(s) URL to PUT to, the sealed WAL segments are archived to before they can be purged. The segments whose manifest is found there, read with GET, are not archived again.
+  --experimental-wal-compression 'none'
+    Codec the data of the WAL entries is compressed with, 'none', 'snappy' or 'zstd'. Compressed WALs cannot be read by etcd versions before v3.6.
+  --experimental-value-compression 'none'
+    Codec the values of the keys are compressed with in the backend, 'none', 'snappy' or 'zstd'. Backends with compressed values cannot be read by etcd versions before v3.6.
+  --experimental-leader-lease-reads 'false'
+    Serve the linearizable reads locally on the leader while its lease is valid, falling back to ReadIndex otherwise.
+  --experimental-leader-lease-max-clock-drift '100ms'
+    Bound on the clock drift between the members the leader lease is shortened by. Must be >0 and lower than the election timeout minus the heartbeat interval.
+  --experimental-witness 'false'
+    Start the member as a witness, which votes and acknowledges the raft log but does not store the key-value data. The member must be added as a witness.
+  --experimental-learner-auto-promote-max-lag '1000'
+    Number of entries the match index of a learner added with auto-promote may lag behind the one of the leader.
+  --experimental-learner-auto-promote-stable-window '30s'
+    Duration a learner added with auto-promote must stay within the max lag before the leader promotes it.
   --experimental-warning-unary-request-duration '300ms'
     Set time duration after which a warning is generated if a unary request takes more than this duration. It's deprecated, and will be decommissioned in v3.7. Use --warning-unary-request-duration instead.
   --experimental-max-learners '1'
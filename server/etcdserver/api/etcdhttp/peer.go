@@ -39,7 +39,7 @@ const (
 
 // NewPeerHandler generates an http.Handler to handle etcd peer requests.
 func NewPeerHandler(lg *zap.Logger, s etcdserver.ServerPeerV2) http.Handler {
-	return newPeerHandler(lg, s, s.RaftHandler(), s.LeaseHandler(), s.HashKVHandler(), s.DowngradeEnabledHandler())
+	return newPeerHandler(lg, s, s.RaftHandler(), s.LeaseHandler(), s.HashKVHandler(), s.DowngradeEnabledHandler(), s.WatchResumeHoldsHandler())
 }
 
 func newPeerHandler(
@@ -49,6 +49,7 @@ func newPeerHandler(
 	leaseHandler http.Handler,
 	hashKVHandler http.Handler,
 	downgradeEnabledHandler http.Handler,
+	watchResumeHoldsHandler http.Handler,
 ) http.Handler {
 	if lg == nil {
 		lg = zap.NewNop()
@@ -72,6 +73,9 @@ func newPeerHandler(
 	if hashKVHandler != nil {
 		mux.Handle(etcdserver.PeerHashKVPath, hashKVHandler)
 	}
+	if watchResumeHoldsHandler != nil {
+		mux.Handle(etcdserver.PeerWatchResumeHoldsPath, watchResumeHoldsHandler)
+	}
 	mux.HandleFunc(versionPath, versionHandler(s, serveVersion))
 	return mux
 }
@@ -70,6 +70,15 @@ type ConfigChangeContext struct {
 	IsPromote bool `json:"isPromote"`
 }
 
+// ReconfigureContext represents a context for confChangeV2, with the
+// ConfigChangeContext of each of its changes in order.
+type ReconfigureContext struct {
+	// ID is the ID of the request proposing the confChangeV2, which has
+	// none of its own.
+	ID      uint64                `json:"id"`
+	Changes []ConfigChangeContext `json:"changes"`
+}
+
 type ShouldApplyV3 bool
 
 const (
@@ -256,6 +265,27 @@ func (c *RaftCluster) SetVersionChangedNotifier(n *notify.Notifier) {
 	c.versionChanged = n
 }
 
+// RecoverBackendFromStore replaces the members, the removed members and the
+// cluster version in the backend with the ones of the v2 store. Witnesses keep
+// their backend in memory, so they rebuild it from the v2 store recovered from
+// the raft snapshot.
+func RecoverBackendFromStore(lg *zap.Logger, st v2store.Store, be MembershipBackend) error {
+	if err := be.TrimMembershipFromBackend(); err != nil {
+		return err
+	}
+	members, removed := membersFromStore(lg, st)
+	for _, m := range members {
+		be.MustSaveMemberToBackend(m)
+	}
+	for id := range removed {
+		be.MustDeleteMemberFromBackend(id)
+	}
+	if ver := clusterVersionFromStore(lg, st); ver != nil {
+		be.MustSaveClusterVersionToBackend(ver)
+	}
+	return nil
+}
+
 func (c *RaftCluster) Recover(onSet func(*zap.Logger, *semver.Version)) {
 	c.Lock()
 	defer c.Unlock()
@@ -332,6 +362,12 @@ func (c *RaftCluster) ValidateConfigurationChange(cc raftpb.ConfChange) error {
 			if membersMap[id] != nil {
 				return ErrIDExists
 			}
+			if confChangeContext.Member.IsWitness && (confChangeContext.Member.IsLearner || cc.Type == raftpb.ConfChangeAddLearnerNode) {
+				return ErrWitnessLearner
+			}
+			if confChangeContext.Member.AutoPromote && (!confChangeContext.Member.IsLearner || cc.Type != raftpb.ConfChangeAddLearnerNode) {
+				return ErrAutoPromoteNotLearner
+			}
 
 			var members []*Member
 			urls := make(map[string]bool)
@@ -388,6 +424,111 @@ func (c *RaftCluster) ValidateConfigurationChange(cc raftpb.ConfChange) error {
 	return nil
 }
 
+// ValidateConfigurationChangeV2 takes a proposed ConfChangeV2 entering a
+// joint configuration and ensures that its changes are still valid when
+// applied together.
+func (c *RaftCluster) ValidateConfigurationChangeV2(cc raftpb.ConfChangeV2) error {
+	rc := new(ReconfigureContext)
+	if err := json.Unmarshal(cc.Context, rc); err != nil {
+		c.lg.Panic("failed to unmarshal reconfigureContext", zap.Error(err))
+	}
+	if len(rc.Changes) != len(cc.Changes) {
+		c.lg.Panic(
+			"got different number of changes",
+			zap.Int("changes-from-config-change-entry", len(cc.Changes)),
+			zap.Int("changes-from-context", len(rc.Changes)),
+		)
+	}
+
+	// TODO: this must be switched to backend as well.
+	membersMap, removedMap := membersFromStore(c.lg, c.v2store)
+	urls := make(map[string]bool)
+	for _, m := range membersMap {
+		for _, u := range m.PeerURLs {
+			urls[u] = true
+		}
+	}
+	changed := make(map[types.ID]bool)
+	addedLearners := false
+	for i, change := range cc.Changes {
+		id := types.ID(change.NodeID)
+		if removedMap[id] {
+			return ErrIDRemoved
+		}
+		if changed[id] {
+			return ErrIDDuplicated
+		}
+		changed[id] = true
+
+		confChangeContext := rc.Changes[i]
+		switch change.Type {
+		case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode:
+			if confChangeContext.IsPromote {
+				if membersMap[id] == nil {
+					return ErrIDNotFound
+				}
+				if !membersMap[id].IsLearner {
+					return ErrMemberNotLearner
+				}
+				m := membersMap[id].Clone()
+				m.IsLearner = false
+				m.AutoPromote = false
+				membersMap[id] = m
+			} else {
+				if membersMap[id] != nil {
+					return ErrIDExists
+				}
+				if confChangeContext.Member.IsWitness && (confChangeContext.Member.IsLearner || change.Type == raftpb.ConfChangeAddLearnerNode) {
+					return ErrWitnessLearner
+				}
+				if confChangeContext.Member.AutoPromote && (!confChangeContext.Member.IsLearner || change.Type != raftpb.ConfChangeAddLearnerNode) {
+					return ErrAutoPromoteNotLearner
+				}
+				for _, u := range confChangeContext.Member.PeerURLs {
+					if urls[u] {
+						return ErrPeerURLexists
+					}
+					urls[u] = true
+				}
+				m := confChangeContext.Member
+				membersMap[id] = &m
+				addedLearners = addedLearners || m.IsLearner
+			}
+		case raftpb.ConfChangeRemoveNode:
+			if membersMap[id] == nil {
+				return ErrIDNotFound
+			}
+			delete(membersMap, id)
+
+		default:
+			c.lg.Panic("unknown ConfChange type", zap.String("type", change.Type.String()))
+		}
+	}
+
+	var members []*Member
+	for _, m := range membersMap {
+		members = append(members, m)
+	}
+	if !hasStoringVotingMember(membersMap) {
+		return ErrNoVotingMember
+	}
+	if addedLearners {
+		return ValidateMaxLearnerConfig(c.maxLearners, members, false)
+	}
+	return nil
+}
+
+// hasStoringVotingMember returns true if a voting member that is not a witness
+// is left, as witnesses cannot become leader.
+func hasStoringVotingMember(membersMap map[types.ID]*Member) bool {
+	for _, m := range membersMap {
+		if !m.IsLearner && !m.IsWitness {
+			return true
+		}
+	}
+	return false
+}
+
 // AddMember adds a new Member into the cluster, and saves the given member's
 // raftAttributes into the store. The given member should have empty attributes.
 // A Member with a matching id must not exist.
@@ -511,6 +652,7 @@ func (c *RaftCluster) PromoteMember(id types.ID, shouldApplyV3 ShouldApplyV3) {
 	if c.v2store != nil {
 		m := *(c.members[id])
 		m.RaftAttributes.IsLearner = false
+		m.RaftAttributes.AutoPromote = false
 		mustUpdateMemberInStore(c.lg, c.v2store, &m)
 	}
 
@@ -520,6 +662,7 @@ func (c *RaftCluster) PromoteMember(id types.ID, shouldApplyV3 ShouldApplyV3) {
 
 	if c.be != nil && shouldApplyV3 {
 		c.members[id].RaftAttributes.IsLearner = false
+		c.members[id].RaftAttributes.AutoPromote = false
 		c.updateMembershipMetric(id, true)
 		c.be.MustSaveMemberToBackend(c.members[id])
 
@@ -707,6 +850,61 @@ func (c *RaftCluster) IsReadyToPromoteMember(id uint64) bool {
 	return true
 }
 
+// IsReadyToReconfigure returns true if both the current voting members and
+// the voting members after adding addVoting new ones, removing and
+// promoting the given ones have a quorum of started members, as the joint
+// configuration in between needs both.
+func (c *RaftCluster) IsReadyToReconfigure(addVoting int, remove, promote []types.ID) bool {
+	removed := make(map[types.ID]bool)
+	for _, id := range remove {
+		removed[id] = true
+	}
+	nmembers, nstarted := 0, 0
+	nnewMembers, nnewStarted := addVoting, 0
+	for _, member := range c.VotingMembers() {
+		nmembers++
+		if member.IsStarted() {
+			nstarted++
+		}
+		if removed[member.ID] {
+			continue
+		}
+		nnewMembers++
+		if member.IsStarted() {
+			nnewStarted++
+		}
+	}
+	// We count the learners to be promoted as started, as IsReadyToPromoteMember does.
+	for _, id := range promote {
+		if !removed[id] {
+			nnewMembers++
+			nnewStarted++
+		}
+	}
+
+	if nstarted == 1 && nmembers == 1 && nnewMembers == 2 && nnewStarted == 1 {
+		// a case of adding a new node to 1-member cluster for restoring cluster data,
+		// as IsReadyToAddVotingMember does.
+		c.lg.Debug("number of started member is 1; can accept member reconfigure request")
+		return true
+	}
+
+	if nstarted < nmembers/2+1 || nnewStarted < nnewMembers/2+1 {
+		c.lg.Warn(
+			"rejecting member reconfigure; started member will be less than quorum",
+			zap.Int("number-of-started-member", nstarted),
+			zap.Int("quorum", nmembers/2+1),
+			zap.Int("number-of-started-member-after-reconfigure", nnewStarted),
+			zap.Int("quorum-after-reconfigure", nnewMembers/2+1),
+			zap.String("cluster-id", c.cid.String()),
+			zap.String("local-member-id", c.localID.String()),
+		)
+		return false
+	}
+
+	return true
+}
+
 func membersFromStore(lg *zap.Logger, st v2store.Store) (map[types.ID]*Member, map[types.ID]bool) {
 	members := make(map[types.ID]*Member)
 	removed := make(map[types.ID]bool)
@@ -465,6 +465,88 @@ func TestClusterValidateConfigurationChangeV2(t *testing.T) {
 	}
 }
 
+func TestClusterValidateJointConfigurationChange(t *testing.T) {
+	cl := NewCluster(zaptest.NewLogger(t), WithMaxLearners(1))
+	cl.SetBackend(newMembershipBackend())
+	cl.SetStore(v2store.New())
+	for i := 1; i <= 4; i++ {
+		attr := RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", i)}, IsLearner: i == 3}
+		cl.AddMember(&Member{ID: types.ID(i), RaftAttributes: attr}, true)
+	}
+	cl.RemoveMember(4, true)
+
+	add := func(id uint64, port int, isLearner bool) ConfigChangeContext {
+		attr := RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", port)}, IsLearner: isLearner}
+		return ConfigChangeContext{Member: Member{ID: types.ID(id), RaftAttributes: attr}}
+	}
+	witness := func(id uint64, port int, isLearner bool) ConfigChangeContext {
+		c := add(id, port, isLearner)
+		c.IsWitness = true
+		return c
+	}
+	autoPromote := func(id uint64, port int, isLearner bool) ConfigChangeContext {
+		c := add(id, port, isLearner)
+		c.AutoPromote = true
+		return c
+	}
+	remove := func(id uint64) ConfigChangeContext {
+		return ConfigChangeContext{Member: Member{ID: types.ID(id)}}
+	}
+	promote := func(id uint64) ConfigChangeContext {
+		return ConfigChangeContext{Member: Member{ID: types.ID(id)}, IsPromote: true}
+	}
+
+	tests := []struct {
+		name    string
+		changes []ConfigChangeContext
+		werr    error
+	}{
+		{"replace member", []ConfigChangeContext{add(5, 5, false), remove(2)}, nil},
+		{"move to new hosts", []ConfigChangeContext{add(5, 5, false), add(6, 6, false), remove(1), remove(2), remove(3)}, nil},
+		{"promote and remove", []ConfigChangeContext{promote(3), remove(1)}, nil},
+		{"removed ID", []ConfigChangeContext{add(4, 5, false)}, ErrIDRemoved},
+		{"existing ID", []ConfigChangeContext{add(2, 5, false)}, ErrIDExists},
+		{"existing peer URL", []ConfigChangeContext{add(5, 1, false)}, ErrPeerURLexists},
+		{"peer URL added twice", []ConfigChangeContext{add(5, 5, false), add(6, 5, false)}, ErrPeerURLexists},
+		{"unknown ID", []ConfigChangeContext{remove(6)}, ErrIDNotFound},
+		{"promote voting member", []ConfigChangeContext{promote(1)}, ErrMemberNotLearner},
+		{"ID changed twice", []ConfigChangeContext{promote(3), remove(3)}, ErrIDDuplicated},
+		{"too many learners", []ConfigChangeContext{add(5, 5, true)}, ErrTooManyLearners},
+		{"learner replaced", []ConfigChangeContext{add(5, 5, true), remove(3)}, nil},
+		{"no voting member", []ConfigChangeContext{remove(1), remove(2)}, ErrNoVotingMember},
+		{"add witness", []ConfigChangeContext{witness(5, 5, false)}, nil},
+		{"witness learner", []ConfigChangeContext{witness(5, 5, true)}, ErrWitnessLearner},
+		{"auto-promote learner", []ConfigChangeContext{autoPromote(5, 5, true), remove(3)}, nil},
+		{"auto-promote voting member", []ConfigChangeContext{autoPromote(5, 5, false)}, ErrAutoPromoteNotLearner},
+		{"too many auto-promote learners", []ConfigChangeContext{autoPromote(5, 5, true)}, ErrTooManyLearners},
+		{"only witness voting", []ConfigChangeContext{witness(5, 5, false), remove(1), remove(2)}, ErrNoVotingMember},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cc := raftpb.ConfChangeV2{Transition: raftpb.ConfChangeTransitionJointImplicit}
+			for _, c := range tt.changes {
+				typ := raftpb.ConfChangeAddNode
+				switch {
+				case c.IsPromote:
+				case c.PeerURLs == nil:
+					typ = raftpb.ConfChangeRemoveNode
+				case c.IsLearner:
+					typ = raftpb.ConfChangeAddLearnerNode
+				}
+				cc.Changes = append(cc.Changes, raftpb.ConfChangeSingle{Type: typ, NodeID: uint64(c.ID)})
+			}
+			ctx, err := json.Marshal(&ReconfigureContext{ID: 1, Changes: tt.changes})
+			if err != nil {
+				t.Fatal(err)
+			}
+			cc.Context = ctx
+			if err := cl.ValidateConfigurationChangeV2(cc); !errors.Is(err, tt.werr) {
+				t.Errorf("validateConfigurationChangeV2 error = %v, want %v", err, tt.werr)
+			}
+		})
+	}
+}
+
 func TestClusterGenID(t *testing.T) {
 	cs := newTestCluster(t, []*Member{
 		newTestMember(1, nil, "", nil),
@@ -983,6 +1065,92 @@ func TestIsReadyToPromoteMember(t *testing.T) {
 	}
 }
 
+func TestIsReadyToReconfigure(t *testing.T) {
+	tests := []struct {
+		name      string
+		members   []*Member
+		addVoting int
+		remove    []types.ID
+		promote   []types.ID
+		want      bool
+	}{
+		{
+			// 3/3 members ready, 2/3 after replacing one (quorum = 2)
+			"replace member",
+			[]*Member{
+				newTestMember(1, nil, "1", nil),
+				newTestMember(2, nil, "2", nil),
+				newTestMember(3, nil, "3", nil),
+			},
+			1, []types.ID{3}, nil,
+			true,
+		},
+		{
+			// 2/3 members ready, 2/3 after replacing the stopped one (quorum = 2)
+			"replace stopped member",
+			[]*Member{
+				newTestMember(1, nil, "1", nil),
+				newTestMember(2, nil, "2", nil),
+				newTestMember(3, nil, "", nil),
+			},
+			1, []types.ID{3}, nil,
+			true,
+		},
+		{
+			// 3/3 members ready, 0/3 after moving to new hosts (quorum = 2)
+			"move to new hosts",
+			[]*Member{
+				newTestMember(1, nil, "1", nil),
+				newTestMember(2, nil, "2", nil),
+				newTestMember(3, nil, "3", nil),
+			},
+			3, []types.ID{1, 2, 3}, nil,
+			false,
+		},
+		{
+			// 3/3 members ready, 3/3 after moving to promoted learners (quorum = 2)
+			"move to promoted learners",
+			[]*Member{
+				newTestMember(1, nil, "1", nil),
+				newTestMember(2, nil, "2", nil),
+				newTestMember(3, nil, "3", nil),
+				newTestMemberAsLearner(4, nil, "4", nil),
+				newTestMemberAsLearner(5, nil, "5", nil),
+				newTestMemberAsLearner(6, nil, "6", nil),
+			},
+			0, []types.ID{1, 2, 3}, []types.ID{4, 5, 6},
+			true,
+		},
+		{
+			// 1/3 members ready (quorum = 2)
+			"not enough ready members",
+			[]*Member{
+				newTestMember(1, nil, "1", nil),
+				newTestMember(2, nil, "", nil),
+				newTestMember(3, nil, "", nil),
+				newTestMemberAsLearner(4, nil, "4", nil),
+			},
+			0, nil, []types.ID{4},
+			false,
+		},
+		{
+			// 1/1 members ready, adding a member to 1-member cluster
+			"add to 1-member cluster",
+			[]*Member{
+				newTestMember(1, nil, "1", nil),
+			},
+			1, nil, nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestCluster(t, tt.members)
+			assert.Equal(t, tt.want, c.IsReadyToReconfigure(tt.addVoting, tt.remove, tt.promote))
+		})
+	}
+}
+
 func TestClusterStore(t *testing.T) {
 	name := "etcd"
 	clientURLs := []string{"http://127.0.0.1:4001"}
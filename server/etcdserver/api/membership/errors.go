@@ -21,12 +21,16 @@ import (
 )
 
 var (
-	ErrIDRemoved        = errors.New("membership: ID removed")
-	ErrIDExists         = errors.New("membership: ID exists")
-	ErrIDNotFound       = errors.New("membership: ID not found")
-	ErrPeerURLexists    = errors.New("membership: peerURL exists")
-	ErrMemberNotLearner = errors.New("membership: can only promote a learner member")
-	ErrTooManyLearners  = errors.New("membership: too many learner members in cluster")
+	ErrIDRemoved             = errors.New("membership: ID removed")
+	ErrIDExists              = errors.New("membership: ID exists")
+	ErrIDNotFound            = errors.New("membership: ID not found")
+	ErrPeerURLexists         = errors.New("membership: peerURL exists")
+	ErrMemberNotLearner      = errors.New("membership: can only promote a learner member")
+	ErrTooManyLearners       = errors.New("membership: too many learner members in cluster")
+	ErrWitnessLearner        = errors.New("membership: a member cannot be both a learner and a witness")
+	ErrAutoPromoteNotLearner = errors.New("membership: can only auto-promote a learner member")
+	ErrIDDuplicated          = errors.New("membership: ID changed more than once")
+	ErrNoVotingMember        = errors.New("membership: no voting member left")
 )
 
 func isKeyNotFound(err error) bool {
@@ -32,6 +32,12 @@ type RaftAttributes struct {
 	PeerURLs []string `json:"peerURLs"`
 	// IsLearner indicates if the member is raft learner.
 	IsLearner bool `json:"isLearner,omitempty"`
+	// IsWitness indicates if the member is a witness, which votes and
+	// acknowledges the raft log but does not store the key-value data.
+	IsWitness bool `json:"isWitness,omitempty"`
+	// AutoPromote indicates if the member is a learner the leader promotes
+	// once it caught up with the leader.
+	AutoPromote bool `json:"autoPromote,omitempty"`
 }
 
 // Attributes represents all the non-raft related attributes of an etcd member.
@@ -53,6 +59,15 @@ func NewMember(name string, peerURLs types.URLs, clusterName string, now *time.T
 	return newMember(name, peerURLs, memberID, false)
 }
 
+// NewMemberAsWitness creates a witness Member without an ID and generates one based on the
+// cluster name, peer URLs, and time. This is used for adding new witness member.
+func NewMemberAsWitness(name string, peerURLs types.URLs, clusterName string, now *time.Time) *Member {
+	memberID := computeMemberID(peerURLs, clusterName, now)
+	m := newMember(name, peerURLs, memberID, false)
+	m.IsWitness = true
+	return m
+}
+
 // NewMemberAsLearner creates a learner Member without an ID and generates one based on the
 // cluster name, peer URLs, and time. This is used for adding new learner member.
 func NewMemberAsLearner(name string, peerURLs types.URLs, clusterName string, now *time.Time) *Member {
@@ -94,7 +109,9 @@ func (m *Member) Clone() *Member {
 	mm := &Member{
 		ID: m.ID,
 		RaftAttributes: RaftAttributes{
-			IsLearner: m.IsLearner,
+			IsLearner:   m.IsLearner,
+			IsWitness:   m.IsWitness,
+			AutoPromote: m.AutoPromote,
 		},
 		Attributes: Attributes{
 			Name: m.Name,
@@ -0,0 +1,212 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+)
+
+// ErrCollectingHolds is returned when compacting before the peers
+// reported the revisions they hold.
+var ErrCollectingHolds = errors.New("v3compactor: collecting the watch resume holds of the peers")
+
+// Holds keeps revisions from being compacted by the auto compactor
+// for a bounded time after they are held. It lets watchers resume
+// after short disconnects without their revision being compacted.
+//
+// Holds are taken on the member serving the watch, while only the leader
+// compacts, so every member reports the lowest revision it holds to the
+// leader each ReportInterval through HoldPeer.
+//
+// Holds only apply to the auto compactor. A manual compaction through the
+// Compact RPC may compact held revisions, after which resuming at them
+// fails with ErrCompacted.
+type Holds struct {
+	clock clockwork.Clock
+	ttl   time.Duration
+
+	mu    sync.Mutex
+	holds map[uint64]hold
+	// peers maps member ids to the lowest revision held on them
+	peers map[types.ID]hold
+	// peersSince is when the holds of the peers started to be collected
+	peersSince time.Time
+}
+
+type hold struct {
+	rev    int64
+	expire time.Time
+}
+
+// NewHolds returns Holds that keep each revision for ttl after it is held.
+func NewHolds(ttl time.Duration) *Holds {
+	return newHolds(clockwork.NewRealClock(), ttl)
+}
+
+func newHolds(clock clockwork.Clock, ttl time.Duration) *Holds {
+	return &Holds{
+		clock:      clock,
+		ttl:        ttl,
+		holds:      make(map[uint64]hold),
+		peers:      make(map[types.ID]hold),
+		peersSince: clock.Now(),
+	}
+}
+
+// ReportInterval is how often members report their lowest held
+// revision to the leader. A report outlives two missed ones.
+func (h *Holds) ReportInterval() time.Duration {
+	return h.ttl / 3
+}
+
+// Hold keeps rev from being compacted by the hold with the given id,
+// replacing the revision it held before.
+func (h *Holds) Hold(id uint64, rev int64) {
+	h.mu.Lock()
+	h.holds[id] = hold{rev: rev, expire: h.clock.Now().Add(h.ttl)}
+	h.mu.Unlock()
+}
+
+// Refresh restarts the time to live of the hold with the given id,
+// if it has not expired yet.
+func (h *Holds) Refresh(id uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if hd, ok := h.holds[id]; ok && h.clock.Now().Before(hd.expire) {
+		hd.expire = h.clock.Now().Add(h.ttl)
+		h.holds[id] = hd
+	}
+}
+
+// Release removes the hold with the given id.
+func (h *Holds) Release(id uint64) {
+	h.mu.Lock()
+	delete(h.holds, id)
+	h.mu.Unlock()
+}
+
+// HoldPeer keeps rev, the lowest revision held on the peer member with
+// the given id, from being compacted until the next report of the peer.
+// A zero rev releases the hold of the peer.
+func (h *Holds) HoldPeer(id types.ID, rev int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if rev == 0 {
+		delete(h.peers, id)
+		return
+	}
+	h.peers[id] = hold{rev: rev, expire: h.clock.Now().Add(h.ttl)}
+}
+
+// ResetPeers drops the holds of the peers and starts collecting them
+// again. It is called when the member becomes the leader, as the peers
+// may have reported to the previous leader until then.
+func (h *Holds) ResetPeers() {
+	h.mu.Lock()
+	clear(h.peers)
+	h.peersSince = h.clock.Now()
+	h.mu.Unlock()
+}
+
+// Prune removes the expired holds.
+func (h *Holds) Prune() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	now := h.clock.Now()
+	for id, hd := range h.holds {
+		if !now.Before(hd.expire) {
+			delete(h.holds, id)
+		}
+	}
+	for id, hd := range h.peers {
+		if !now.Before(hd.expire) {
+			delete(h.peers, id)
+		}
+	}
+}
+
+// LocalMinRev returns the lowest revision held on this member,
+// or 0 if none is held.
+func (h *Holds) LocalMinRev() int64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return minRev(h.holds, h.clock.Now())
+}
+
+// MinRev returns the lowest revision held on this member or reported
+// by its peers, or 0 if none is held.
+func (h *Holds) MinRev() int64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	now := h.clock.Now()
+	local, peers := minRev(h.holds, now), minRev(h.peers, now)
+	if local == 0 || (peers != 0 && peers < local) {
+		return peers
+	}
+	return local
+}
+
+func minRev[K comparable](holds map[K]hold, now time.Time) int64 {
+	rev := int64(0)
+	for _, hd := range holds {
+		if !now.Before(hd.expire) {
+			continue
+		}
+		if rev == 0 || hd.rev < rev {
+			rev = hd.rev
+		}
+	}
+	return rev
+}
+
+// collectingPeers reports whether the peers may not have reported their
+// holds yet since they started to be collected.
+func (h *Holds) collectingPeers() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.clock.Since(h.peersSince) < h.ReportInterval()
+}
+
+// Compactable returns a Compactable that compacts at most up to the
+// lowest held revision through c. Compaction fails with
+// ErrCollectingHolds until the peers had the time to report their holds.
+func (h *Holds) Compactable(c Compactable) Compactable {
+	return &holdCompactable{h: h, c: c}
+}
+
+type holdCompactable struct {
+	h *Holds
+	c Compactable
+}
+
+func (hc *holdCompactable) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error) {
+	if hc.h.collectingPeers() {
+		return nil, ErrCollectingHolds
+	}
+	if rev := hc.h.MinRev(); rev != 0 && rev < r.Revision {
+		req := *r
+		req.Revision = rev
+		r = &req
+	}
+	return hc.c.Compact(ctx, r)
+}
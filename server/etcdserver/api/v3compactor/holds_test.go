@@ -0,0 +1,157 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/testutil"
+)
+
+func TestHolds(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	h := newHolds(fc, time.Minute)
+
+	if rev := h.MinRev(); rev != 0 {
+		t.Fatalf("min revision = %d, want 0", rev)
+	}
+
+	h.Hold(1, 10)
+	h.Hold(2, 20)
+	if rev := h.MinRev(); rev != 10 {
+		t.Fatalf("min revision = %d, want 10", rev)
+	}
+
+	// moving a hold replaces its revision
+	h.Hold(1, 30)
+	if rev := h.MinRev(); rev != 20 {
+		t.Fatalf("min revision = %d, want 20", rev)
+	}
+
+	fc.Advance(40 * time.Second)
+	h.Refresh(2)
+	fc.Advance(40 * time.Second)
+	// hold 1 expired, hold 2 was refreshed
+	if rev := h.MinRev(); rev != 20 {
+		t.Fatalf("min revision = %d, want 20", rev)
+	}
+	h.Refresh(1)
+	h.Release(2)
+	if rev := h.MinRev(); rev != 0 {
+		t.Fatalf("min revision = %d, want 0", rev)
+	}
+}
+
+func TestHoldsPeers(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	h := newHolds(fc, time.Minute)
+
+	h.Hold(1, 30)
+	h.HoldPeer(2, 20)
+	h.HoldPeer(3, 40)
+	if rev := h.LocalMinRev(); rev != 30 {
+		t.Fatalf("local min revision = %d, want 30", rev)
+	}
+	if rev := h.MinRev(); rev != 20 {
+		t.Fatalf("min revision = %d, want 20", rev)
+	}
+
+	// a zero revision releases the hold of the peer
+	h.HoldPeer(2, 0)
+	if rev := h.MinRev(); rev != 30 {
+		t.Fatalf("min revision = %d, want 30", rev)
+	}
+
+	fc.Advance(40 * time.Second)
+	h.HoldPeer(3, 10)
+	fc.Advance(40 * time.Second)
+	// hold 1 expired, the peer reported again
+	if rev := h.LocalMinRev(); rev != 0 {
+		t.Fatalf("local min revision = %d, want 0", rev)
+	}
+	if rev := h.MinRev(); rev != 10 {
+		t.Fatalf("min revision = %d, want 10", rev)
+	}
+
+	h.ResetPeers()
+	if rev := h.MinRev(); rev != 0 {
+		t.Fatalf("min revision = %d, want 0", rev)
+	}
+}
+
+func TestHoldsPrune(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	h := newHolds(fc, time.Minute)
+
+	h.Hold(1, 10)
+	h.HoldPeer(2, 20)
+	fc.Advance(40 * time.Second)
+	h.Hold(3, 30)
+	h.HoldPeer(4, 40)
+	fc.Advance(40 * time.Second)
+	h.Prune()
+	if len(h.holds) != 1 || len(h.peers) != 1 {
+		t.Fatalf("holds after prune = %v, peers = %v, want only the unexpired ones", h.holds, h.peers)
+	}
+	if _, ok := h.holds[3]; !ok {
+		t.Fatalf("hold 3 was pruned before it expired")
+	}
+	if _, ok := h.peers[4]; !ok {
+		t.Fatalf("hold of peer 4 was pruned before it expired")
+	}
+}
+
+func TestHoldsCompactable(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	h := newHolds(fc, time.Minute)
+	compactable := &fakeCompactable{&testutil.RecorderBuffered{}}
+	c := h.Compactable(compactable)
+
+	// the peers have not reported their holds yet
+	if _, err := c.Compact(context.Background(), &pb.CompactionRequest{Revision: 100}); !errors.Is(err, ErrCollectingHolds) {
+		t.Fatalf("compact error = %v, want %v", err, ErrCollectingHolds)
+	}
+	fc.Advance(h.ReportInterval())
+
+	tests := []struct {
+		hold int64
+		rev  int64
+		want int64
+	}{
+		{0, 100, 100},
+		{50, 100, 50},
+		{150, 100, 100},
+	}
+	for i, tt := range tests {
+		h.Release(1)
+		if tt.hold != 0 {
+			h.Hold(1, tt.hold)
+		}
+		if _, err := c.Compact(context.Background(), &pb.CompactionRequest{Revision: tt.rev}); err != nil {
+			t.Fatal(err)
+		}
+		a := compactable.Action()
+		if !reflect.DeepEqual(a[i].Params[0], &pb.CompactionRequest{Revision: tt.want}) {
+			t.Errorf("#%d: compact request = %v, want %v", i, a[i].Params[0], &pb.CompactionRequest{Revision: tt.want})
+		}
+	}
+}
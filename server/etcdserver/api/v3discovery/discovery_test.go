@@ -748,6 +748,10 @@ func (fkv *fakeBaseKV) Get(ctx context.Context, key string, opts ...clientv3.OpO
 	return nil, nil
 }
 
+func (fkv *fakeBaseKV) MultiGet(ctx context.Context, ops ...clientv3.Op) (*clientv3.MultiGetResponse, error) {
+	return nil, nil
+}
+
 func (fkv *fakeBaseKV) Delete(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
 	return nil, nil
 }
@@ -775,6 +779,10 @@ func (fw *fakeBaseWatcher) RequestProgress(ctx context.Context) error {
 	return nil
 }
 
+func (fw *fakeBaseWatcher) GrantCredits(ctx context.Context, credits int64) error {
+	return nil
+}
+
 func (fw *fakeBaseWatcher) Close() error {
 	return nil
 }
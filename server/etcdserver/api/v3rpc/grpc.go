@@ -75,9 +75,9 @@ func Server(s *etcdserver.EtcdServer, tls *tls.Config, interceptor grpc.UnarySer
 	pb.RegisterAuthServer(grpcServer, NewAuthServer(s))
 
 	hsrv := health.NewServer()
-	healthNotifier := newHealthNotifier(hsrv, s)
+	s.AddDefragNotifier(newHealthNotifier(hsrv, s))
 	healthpb.RegisterHealthServer(grpcServer, hsrv)
-	pb.RegisterMaintenanceServer(grpcServer, NewMaintenanceServer(s, healthNotifier))
+	pb.RegisterMaintenanceServer(grpcServer, NewMaintenanceServer(s))
 
 	// set zero values for metrics registered for this grpc server
 	grpc_prometheus.Register(grpcServer)
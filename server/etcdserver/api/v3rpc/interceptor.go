@@ -53,6 +53,10 @@ func newUnaryInterceptor(s *etcdserver.EtcdServer) grpc.UnaryServerInterceptor {
 			return nil, rpctypes.ErrGRPCNotSupportedForLearner
 		}
 
+		if s.IsWitness() && !isRPCSupportedForWitness(req) {
+			return nil, rpctypes.ErrGRPCNotSupportedForWitness
+		}
+
 		md, ok := metadata.FromIncomingContext(ctx)
 		if ok {
 			ver, vs := "unknown", md.Get(rpctypes.MetadataClientAPIVersionKey)
@@ -222,6 +226,10 @@ func newStreamInterceptor(s *etcdserver.EtcdServer) grpc.StreamServerInterceptor
 			return rpctypes.ErrGRPCNotSupportedForLearner
 		}
 
+		if s.IsWitness() { // witness does not support stream RPC
+			return rpctypes.ErrGRPCNotSupportedForWitness
+		}
+
 		md, ok := metadata.FromIncomingContext(ss.Context())
 		if ok {
 			ver, vs := "unknown", md.Get(rpctypes.MetadataClientAPIVersionKey)
@@ -52,6 +52,23 @@ func (s *kvServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResp
 	return resp, nil
 }
 
+func (s *kvServer) MultiRange(ctx context.Context, r *pb.MultiRangeRequest) (*pb.MultiRangeResponse, error) {
+	if err := checkMultiRangeRequest(r, int(s.maxTxnOps)); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.kv.MultiRange(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+
+	s.hdr.fill(resp.Header)
+	for _, rr := range resp.Responses {
+		s.hdr.fill(rr.Header)
+	}
+	return resp, nil
+}
+
 func (s *kvServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
 	if err := checkPutRequest(r); err != nil {
 		return nil, err
@@ -124,6 +141,55 @@ func checkRangeRequest(r *pb.RangeRequest) error {
 		return rpctypes.ErrGRPCInvalidSortOption
 	}
 
+	for _, f := range r.Filters {
+		if err := checkRangeFilter(f); err != nil {
+			return err
+		}
+	}
+
+	if st := r.MaxStaleness; st != nil && (st.Milliseconds < 0 || st.Revisions < 0) {
+		return rpctypes.ErrGRPCInvalidMaxStaleness
+	}
+
+	return nil
+}
+
+// checkMultiRangeRequest checks the ranges of a MultiRange request, which
+// reads them in a single read txn, so their number is capped by maxTxnOps.
+func checkMultiRangeRequest(r *pb.MultiRangeRequest, maxTxnOps int) error {
+	if len(r.Ranges) > maxTxnOps {
+		return rpctypes.ErrGRPCTooManyOps
+	}
+	for _, rr := range r.Ranges {
+		if rr == nil {
+			return rpctypes.ErrGRPCEmptyKey
+		}
+		if err := checkRangeRequest(rr); err != nil {
+			return err
+		}
+		if rr.Revision != 0 && rr.Revision != r.Revision {
+			return rpctypes.ErrGRPCInvalidMultiRange
+		}
+		if rr.Serializable && !r.Serializable {
+			return rpctypes.ErrGRPCInvalidMultiRange
+		}
+	}
+	return nil
+}
+
+func checkRangeFilter(f *pb.RangeFilter) error {
+	if f == nil {
+		return rpctypes.ErrGRPCInvalidRangeFilter
+	}
+	if _, ok := pb.RangeFilter_FilterResult_name[int32(f.Result)]; !ok {
+		return rpctypes.ErrGRPCInvalidRangeFilter
+	}
+	if _, ok := pb.Compare_CompareTarget_name[int32(f.Target)]; !ok {
+		return rpctypes.ErrGRPCInvalidRangeFilter
+	}
+	if f.Result == pb.RangeFilter_HAS_PREFIX && f.Target != pb.Compare_VALUE {
+		return rpctypes.ErrGRPCInvalidRangeFilter
+	}
 	return nil
 }
 
@@ -66,3 +66,135 @@ func getError(err error) string {
 
 	return err.Error()
 }
+
+func TestCheckMultiRangeRequest(t *testing.T) {
+	tests := []struct {
+		multiRangeReq *pb.MultiRangeRequest
+		expectedError error
+	}{
+		{
+			multiRangeReq: &pb.MultiRangeRequest{
+				Ranges:   []*pb.RangeRequest{{Key: []byte("a")}, {Key: []byte("b"), Revision: 3}},
+				Revision: 3,
+			},
+		},
+		{
+			multiRangeReq: &pb.MultiRangeRequest{
+				Ranges:       []*pb.RangeRequest{{Key: []byte("a"), Serializable: true}, {Key: []byte("b")}},
+				Serializable: true,
+			},
+		},
+		{
+			multiRangeReq: &pb.MultiRangeRequest{
+				Ranges: []*pb.RangeRequest{{Key: []byte("a")}, {Key: nil}},
+			},
+			expectedError: rpctypes.ErrGRPCEmptyKey,
+		},
+		{
+			multiRangeReq: &pb.MultiRangeRequest{
+				Ranges: []*pb.RangeRequest{nil},
+			},
+			expectedError: rpctypes.ErrGRPCEmptyKey,
+		},
+		{
+			multiRangeReq: &pb.MultiRangeRequest{
+				Ranges:   []*pb.RangeRequest{{Key: []byte("a"), Revision: 2}},
+				Revision: 3,
+			},
+			expectedError: rpctypes.ErrGRPCInvalidMultiRange,
+		},
+		{
+			multiRangeReq: &pb.MultiRangeRequest{
+				Ranges: []*pb.RangeRequest{{Key: []byte("a"), Serializable: true}},
+			},
+			expectedError: rpctypes.ErrGRPCInvalidMultiRange,
+		},
+		{
+			multiRangeReq: &pb.MultiRangeRequest{
+				Ranges: []*pb.RangeRequest{{Key: []byte("a")}, {Key: []byte("b")}, {Key: []byte("c")}},
+			},
+			expectedError: rpctypes.ErrGRPCTooManyOps,
+		},
+	}
+
+	for i, tt := range tests {
+		actualRet := checkMultiRangeRequest(tt.multiRangeReq, 2)
+		if getError(actualRet) != getError(tt.expectedError) {
+			t.Errorf("#%d: expected %q, but got %q", i, getError(tt.expectedError), getError(actualRet))
+		}
+	}
+}
+
+func TestCheckRangeRequestFilters(t *testing.T) {
+	tests := []struct {
+		filter        *pb.RangeFilter
+		expectedError error
+	}{
+		{
+			filter: &pb.RangeFilter{Result: pb.RangeFilter_GREATER, Target: pb.Compare_VERSION, TargetUnion: &pb.RangeFilter_Version{Version: 1}},
+		},
+		{
+			filter: &pb.RangeFilter{Result: pb.RangeFilter_HAS_PREFIX, Target: pb.Compare_VALUE, TargetUnion: &pb.RangeFilter_Value{Value: []byte("v")}},
+		},
+		{
+			filter:        &pb.RangeFilter{Result: pb.RangeFilter_HAS_PREFIX, Target: pb.Compare_LEASE},
+			expectedError: rpctypes.ErrGRPCInvalidRangeFilter,
+		},
+		{
+			filter:        &pb.RangeFilter{Result: 100, Target: pb.Compare_MOD},
+			expectedError: rpctypes.ErrGRPCInvalidRangeFilter,
+		},
+		{
+			filter:        &pb.RangeFilter{Result: pb.RangeFilter_EQUAL, Target: 100},
+			expectedError: rpctypes.ErrGRPCInvalidRangeFilter,
+		},
+		{
+			filter:        nil,
+			expectedError: rpctypes.ErrGRPCInvalidRangeFilter,
+		},
+	}
+
+	for i, tt := range tests {
+		rangeReq := pb.RangeRequest{
+			Key:     []byte{1, 2, 3},
+			Filters: []*pb.RangeFilter{tt.filter},
+		}
+		actualRet := checkRangeRequest(&rangeReq)
+		if getError(actualRet) != getError(tt.expectedError) {
+			t.Errorf("#%d: expected %q, but got %q", i, getError(tt.expectedError), getError(actualRet))
+		}
+	}
+}
+
+func TestCheckRangeRequestMaxStaleness(t *testing.T) {
+	tests := []struct {
+		maxStaleness  *pb.Staleness
+		expectedError error
+	}{
+		{
+			maxStaleness: nil,
+		},
+		{
+			maxStaleness: &pb.Staleness{Milliseconds: 100, Revisions: 10},
+		},
+		{
+			maxStaleness:  &pb.Staleness{Milliseconds: -1},
+			expectedError: rpctypes.ErrGRPCInvalidMaxStaleness,
+		},
+		{
+			maxStaleness:  &pb.Staleness{Revisions: -1},
+			expectedError: rpctypes.ErrGRPCInvalidMaxStaleness,
+		},
+	}
+
+	for i, tt := range tests {
+		rangeReq := pb.RangeRequest{
+			Key:          []byte{1, 2, 3},
+			MaxStaleness: tt.maxStaleness,
+		}
+		actualRet := checkRangeRequest(&rangeReq)
+		if getError(actualRet) != getError(tt.expectedError) {
+			t.Errorf("#%d: expected %q, but got %q", i, getError(tt.expectedError), getError(actualRet))
+		}
+	}
+}
@@ -69,6 +69,18 @@ type ConfigGetter interface {
 	Config() config.ServerConfig
 }
 
+type Defragmenter interface {
+	Defragment() error
+}
+
+type WatchStreamsGetter interface {
+	WatchStreams() *etcdserver.WatchStreams
+}
+
+type RevisionTimeGetter interface {
+	RevisionAt(t time.Time) (int64, time.Time, error)
+}
+
 type maintenanceServer struct {
 	lg     *zap.Logger
 	rg     apply.RaftStatusGetter
@@ -81,24 +93,27 @@ type maintenanceServer struct {
 	d      Downgrader
 	vs     serverversion.Server
 	cg     ConfigGetter
-
-	healthNotifier notifier
+	wg     WatchStreamsGetter
+	rtg    RevisionTimeGetter
+	df     Defragmenter
 }
 
-func NewMaintenanceServer(s *etcdserver.EtcdServer, healthNotifier notifier) pb.MaintenanceServer {
+func NewMaintenanceServer(s *etcdserver.EtcdServer) pb.MaintenanceServer {
 	srv := &maintenanceServer{
-		lg:             s.Cfg.Logger,
-		rg:             s,
-		hasher:         s.KV().HashStorage(),
-		bg:             s,
-		a:              s,
-		lt:             s,
-		hdr:            newHeader(s),
-		cs:             s,
-		d:              s,
-		vs:             etcdserver.NewServerVersionAdapter(s),
-		healthNotifier: healthNotifier,
-		cg:             s,
+		lg:     s.Cfg.Logger,
+		rg:     s,
+		hasher: s.KV().HashStorage(),
+		bg:     s,
+		a:      s,
+		lt:     s,
+		hdr:    newHeader(s),
+		cs:     s,
+		d:      s,
+		vs:     etcdserver.NewServerVersionAdapter(s),
+		cg:     s,
+		wg:     s,
+		rtg:    s,
+		df:     s,
 	}
 	if srv.lg == nil {
 		srv.lg = zap.NewNop()
@@ -107,10 +122,15 @@ func NewMaintenanceServer(s *etcdserver.EtcdServer, healthNotifier notifier) pb.
 }
 
 func (ms *maintenanceServer) Defragment(ctx context.Context, sr *pb.DefragmentRequest) (*pb.DefragmentResponse, error) {
-	ms.lg.Info("starting defragment")
-	ms.healthNotifier.defragStarted()
-	defer ms.healthNotifier.defragFinished()
-	err := ms.bg.Backend().Defrag()
+	ms.lg.Info("starting defragment", zap.Stringer("mode", sr.Mode))
+	var err error
+	switch sr.Mode {
+	case pb.DefragmentRequest_INCREMENTAL:
+		// incremental defragmentation keeps serving requests
+		err = ms.bg.Backend().DefragIncremental()
+	default:
+		err = ms.df.Defragment()
+	}
 	if err != nil {
 		ms.lg.Warn("failed to defragment", zap.Error(err))
 		return nil, togRPCError(err)
@@ -128,7 +148,10 @@ func (ms *maintenanceServer) Snapshot(sr *pb.SnapshotRequest, srv pb.Maintenance
 	if ver != nil {
 		storageVersion = ver.String()
 	}
-	snap := ms.bg.Backend().Snapshot()
+	snap, err := ms.snapshot(sr)
+	if err != nil {
+		return togRPCError(err)
+	}
 	pr, pw := io.Pipe()
 
 	defer pr.Close()
@@ -154,6 +177,7 @@ func (ms *maintenanceServer) Snapshot(sr *pb.SnapshotRequest, srv pb.Maintenance
 		zap.Int64("total-bytes", total),
 		zap.String("size", size),
 		zap.String("storage-version", storageVersion),
+		zap.Int64("since-revision", sr.SinceRevision),
 	)
 	for total-sent > 0 {
 		// buffer just holds read bytes from stream
@@ -210,6 +234,27 @@ func (ms *maintenanceServer) Snapshot(sr *pb.SnapshotRequest, srv pb.Maintenance
 	return nil
 }
 
+// snapshot returns a full snapshot of the backend, or a delta snapshot
+// holding the revisions after sr.SinceRevision if it is set.
+func (ms *maintenanceServer) snapshot(sr *pb.SnapshotRequest) (backend.Snapshot, error) {
+	since := sr.SinceRevision
+	if since <= 0 {
+		return ms.bg.Backend().Snapshot(), nil
+	}
+	if since > ms.hdr.rev() {
+		return nil, mvcc.ErrFutureRev
+	}
+	from := mvcc.RevToBytes(mvcc.Revision{Main: since + 1}, mvcc.NewRevBytes())
+	return ms.bg.Backend().SnapshotSince(schema.Key, from, func(src backend.UnsafeReader, tx backend.UnsafeReadWriter) error {
+		// the tombstones compacted after since are missing from the delta
+		if compactRev, _ := mvcc.UnsafeReadScheduledCompact(tx); compactRev > since {
+			mvcc.UnsafeSetSnapshotKeptRevisions(src, tx, since)
+		}
+		mvcc.UnsafeSetSnapshotBaseRevision(tx, since)
+		return nil
+	})
+}
+
 func (ms *maintenanceServer) Hash(ctx context.Context, r *pb.HashRequest) (*pb.HashResponse, error) {
 	h, rev, err := ms.hasher.Hash()
 	if err != nil {
@@ -296,6 +341,29 @@ func (ms *maintenanceServer) Downgrade(ctx context.Context, r *pb.DowngradeReque
 	return resp, nil
 }
 
+func (ms *maintenanceServer) WatchLag(ctx context.Context, r *pb.WatchLagRequest) (*pb.WatchLagResponse, error) {
+	resp := &pb.WatchLagResponse{
+		Header:  &pb.ResponseHeader{},
+		Streams: ms.wg.WatchStreams().Lags(),
+	}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func (ms *maintenanceServer) RevisionAt(ctx context.Context, r *pb.RevisionAtRequest) (*pb.RevisionAtResponse, error) {
+	rev, at, err := ms.rtg.RevisionAt(time.Unix(0, r.Timestamp))
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	resp := &pb.RevisionAtResponse{
+		Header:    &pb.ResponseHeader{},
+		Revision:  rev,
+		Timestamp: at.UnixNano(),
+	}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
 type authMaintenanceServer struct {
 	*maintenanceServer
 	*AuthAdmin
@@ -355,3 +423,11 @@ func (ams *authMaintenanceServer) Downgrade(ctx context.Context, r *pb.Downgrade
 
 	return ams.maintenanceServer.Downgrade(ctx, r)
 }
+
+func (ams *authMaintenanceServer) WatchLag(ctx context.Context, r *pb.WatchLagRequest) (*pb.WatchLagResponse, error) {
+	if err := ams.isPermitted(ctx); err != nil {
+		return nil, togRPCError(err)
+	}
+
+	return ams.maintenanceServer.WatchLag(ctx, r)
+}
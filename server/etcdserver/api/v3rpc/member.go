@@ -44,12 +44,9 @@ func (cs *ClusterServer) MemberAdd(ctx context.Context, r *pb.MemberAddRequest)
 		return nil, rpctypes.ErrGRPCMemberBadURLs
 	}
 
-	now := time.Now()
-	var m *membership.Member
-	if r.IsLearner {
-		m = membership.NewMemberAsLearner("", urls, "", &now)
-	} else {
-		m = membership.NewMember("", urls, "", &now)
+	m, err := newMember(r, urls, time.Now())
+	if err != nil {
+		return nil, err
 	}
 	membs, merr := cs.server.AddMember(ctx, *m)
 	if merr != nil {
@@ -59,9 +56,11 @@ func (cs *ClusterServer) MemberAdd(ctx context.Context, r *pb.MemberAddRequest)
 	return &pb.MemberAddResponse{
 		Header: cs.header(),
 		Member: &pb.Member{
-			ID:        uint64(m.ID),
-			PeerURLs:  m.PeerURLs,
-			IsLearner: m.IsLearner,
+			ID:          uint64(m.ID),
+			PeerURLs:    m.PeerURLs,
+			IsLearner:   m.IsLearner,
+			IsWitness:   m.IsWitness,
+			AutoPromote: m.AutoPromote,
 		},
 		Members: membersToProtoMembers(membs),
 	}, nil
@@ -105,6 +104,52 @@ func (cs *ClusterServer) MemberPromote(ctx context.Context, r *pb.MemberPromoteR
 	return &pb.MemberPromoteResponse{Header: cs.header(), Members: membersToProtoMembers(membs)}, nil
 }
 
+func (cs *ClusterServer) MemberReconfigure(ctx context.Context, r *pb.MemberReconfigureRequest) (*pb.MemberReconfigureResponse, error) {
+	now := time.Now()
+	add := make([]membership.Member, len(r.Add))
+	added := make([]*pb.Member, len(r.Add))
+	for i, ar := range r.Add {
+		urls, err := types.NewURLs(ar.PeerURLs)
+		if err != nil {
+			return nil, rpctypes.ErrGRPCMemberBadURLs
+		}
+		m, err := newMember(ar, urls, now)
+		if err != nil {
+			return nil, err
+		}
+		add[i] = *m
+		added[i] = &pb.Member{
+			ID:          uint64(m.ID),
+			PeerURLs:    m.PeerURLs,
+			IsLearner:   m.IsLearner,
+			IsWitness:   m.IsWitness,
+			AutoPromote: m.AutoPromote,
+		}
+	}
+	membs, err := cs.server.ReconfigureMembers(ctx, add, r.Remove, r.Promote)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return &pb.MemberReconfigureResponse{Header: cs.header(), Added: added, Members: membersToProtoMembers(membs)}, nil
+}
+
+func newMember(r *pb.MemberAddRequest, urls types.URLs, now time.Time) (*membership.Member, error) {
+	switch {
+	case r.IsLearner && r.IsWitness:
+		return nil, rpctypes.ErrGRPCWitnessLearner
+	case r.AutoPromote && !r.IsLearner:
+		return nil, rpctypes.ErrGRPCAutoPromoteNotLearner
+	case r.IsLearner:
+		m := membership.NewMemberAsLearner("", urls, "", &now)
+		m.AutoPromote = r.AutoPromote
+		return m, nil
+	case r.IsWitness:
+		return membership.NewMemberAsWitness("", urls, "", &now), nil
+	default:
+		return membership.NewMember("", urls, "", &now), nil
+	}
+}
+
 func (cs *ClusterServer) header() *pb.ResponseHeader {
 	return &pb.ResponseHeader{ClusterId: uint64(cs.cluster.ID()), MemberId: uint64(cs.server.MemberID()), RaftTerm: cs.server.Term()}
 }
@@ -113,11 +158,13 @@ func membersToProtoMembers(membs []*membership.Member) []*pb.Member {
 	protoMembs := make([]*pb.Member, len(membs))
 	for i := range membs {
 		protoMembs[i] = &pb.Member{
-			Name:       membs[i].Name,
-			ID:         uint64(membs[i].ID),
-			PeerURLs:   membs[i].PeerURLs,
-			ClientURLs: membs[i].ClientURLs,
-			IsLearner:  membs[i].IsLearner,
+			Name:        membs[i].Name,
+			ID:          uint64(membs[i].ID),
+			PeerURLs:    membs[i].PeerURLs,
+			ClientURLs:  membs[i].ClientURLs,
+			IsLearner:   membs[i].IsLearner,
+			IsWitness:   membs[i].IsWitness,
+			AutoPromote: membs[i].AutoPromote,
 		}
 	}
 	return protoMembs
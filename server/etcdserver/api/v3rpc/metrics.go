@@ -48,6 +48,31 @@ var (
 	},
 		[]string{"type", "client_api_version"},
 	)
+
+	watchSendRevisionLag = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "server",
+		Name:      "watch_send_revision_lag",
+		Help:      "The number of revisions between the current revision and the revision of a watch response when sent.",
+
+		// lowest bucket start of upper bound 1 with factor 4
+		// highest bucket start of 1 * 4^9 == 262144
+		Buckets: prometheus.ExponentialBuckets(1, 4, 10),
+	})
+
+	watchBufferedBytes = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "server",
+		Name:      "watch_buffered_bytes",
+		Help:      "The total size of the watch responses held back by watch flow control.",
+	})
+
+	watchLagCanceled = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "server",
+		Name:      "watch_lag_canceled_total",
+		Help:      "The total number of watchers canceled for lagging behind.",
+	})
 )
 
 func init() {
@@ -55,4 +80,7 @@ func init() {
 	prometheus.MustRegister(receivedBytes)
 	prometheus.MustRegister(streamFailures)
 	prometheus.MustRegister(clientRequests)
+	prometheus.MustRegister(watchSendRevisionLag)
+	prometheus.MustRegister(watchBufferedBytes)
+	prometheus.MustRegister(watchLagCanceled)
 }
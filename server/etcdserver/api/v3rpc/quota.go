@@ -91,5 +91,5 @@ func NewQuotaLeaseServer(s *etcdserver.EtcdServer) pb.LeaseServer {
 }
 
 func newBackendQuota(s *etcdserver.EtcdServer, name string) storage.Quota {
-	return storage.NewBackendQuota(s.Logger(), s.Cfg.QuotaBackendBytes, s.Backend(), name)
+	return storage.NewBackendQuota(s.Logger(), s.Cfg.QuotaBackendBytes, s.Backend(), name, s.ValueCodec())
 }
@@ -33,14 +33,20 @@ import (
 )
 
 var toGRPCErrorMap = map[error]error{
-	membership.ErrIDRemoved:           rpctypes.ErrGRPCMemberNotFound,
-	membership.ErrIDNotFound:          rpctypes.ErrGRPCMemberNotFound,
-	membership.ErrIDExists:            rpctypes.ErrGRPCMemberExist,
-	membership.ErrPeerURLexists:       rpctypes.ErrGRPCPeerURLExist,
-	membership.ErrMemberNotLearner:    rpctypes.ErrGRPCMemberNotLearner,
-	membership.ErrTooManyLearners:     rpctypes.ErrGRPCTooManyLearners,
-	errors.ErrNotEnoughStartedMembers: rpctypes.ErrMemberNotEnoughStarted,
-	errors.ErrLearnerNotReady:         rpctypes.ErrGRPCLearnerNotReady,
+	membership.ErrIDRemoved:             rpctypes.ErrGRPCMemberNotFound,
+	membership.ErrIDNotFound:            rpctypes.ErrGRPCMemberNotFound,
+	membership.ErrIDExists:              rpctypes.ErrGRPCMemberExist,
+	membership.ErrPeerURLexists:         rpctypes.ErrGRPCPeerURLExist,
+	membership.ErrMemberNotLearner:      rpctypes.ErrGRPCMemberNotLearner,
+	membership.ErrTooManyLearners:       rpctypes.ErrGRPCTooManyLearners,
+	membership.ErrWitnessLearner:        rpctypes.ErrGRPCWitnessLearner,
+	membership.ErrAutoPromoteNotLearner: rpctypes.ErrGRPCAutoPromoteNotLearner,
+	membership.ErrIDDuplicated:          rpctypes.ErrGRPCMemberIDDuplicated,
+	membership.ErrNoVotingMember:        rpctypes.ErrGRPCNoVotingMember,
+	errors.ErrNotEnoughStartedMembers:   rpctypes.ErrMemberNotEnoughStarted,
+	errors.ErrLearnerNotReady:           rpctypes.ErrGRPCLearnerNotReady,
+	errors.ErrNoMemberChange:            rpctypes.ErrGRPCNoMemberChange,
+	errors.ErrReconfigureUnsupported:    rpctypes.ErrGRPCReconfigureUnsupported,
 
 	mvcc.ErrCompacted:         rpctypes.ErrGRPCCompacted,
 	mvcc.ErrFutureRev:         rpctypes.ErrGRPCFutureRev,
@@ -58,6 +64,8 @@ var toGRPCErrorMap = map[error]error{
 	errors.ErrTimeoutWaitAppliedIndex:    rpctypes.ErrGRPCTimeoutWaitAppliedIndex,
 	errors.ErrUnhealthy:                  rpctypes.ErrGRPCUnhealthy,
 	errors.ErrKeyNotFound:                rpctypes.ErrGRPCKeyNotFound,
+	errors.ErrInvalidContinueToken:       rpctypes.ErrGRPCInvalidContinueToken,
+	errors.ErrRevisionTimeUnknown:        rpctypes.ErrGRPCRevisionTimeUnknown,
 	errors.ErrCorrupt:                    rpctypes.ErrGRPCCorrupt,
 	errors.ErrBadLeaderTransferee:        rpctypes.ErrGRPCBadLeaderTransferee,
 
@@ -138,6 +146,16 @@ func isClientCtxErr(ctxErr error, err error) bool {
 	return false
 }
 
+// witness does not keep the key-value data, it only serves endpoint status and member list
+func isRPCSupportedForWitness(req any) bool {
+	switch req.(type) {
+	case *pb.StatusRequest, *pb.MemberListRequest:
+		return true
+	default:
+		return false
+	}
+}
+
 // in v3.4, learner is allowed to serve serializable read and endpoint status
 func isRPCSupportedForLearner(req any) bool {
 	switch r := req.(type) {
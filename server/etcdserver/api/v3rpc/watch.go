@@ -15,22 +15,28 @@
 package v3rpc
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io"
 	"math/rand"
+	"path"
+	"regexp"
 	"sync"
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/peer"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/client/pkg/v3/verify"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/pkg/v3/traceutil"
 	"go.etcd.io/etcd/server/v3/auth"
 	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
 	"go.etcd.io/etcd/server/v3/etcdserver/apply"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 )
@@ -48,6 +54,12 @@ type watchServer struct {
 	sg        apply.RaftStatusGetter
 	watchable mvcc.WatchableKV
 	ag        AuthGetter
+	holds     *v3compactor.Holds
+	streams   *etcdserver.WatchStreams
+
+	lagCancelRevisions int64
+	lagCancelBytes     int64
+	maxQueuedBytes     int64
 }
 
 // NewWatchServer returns a new watch server.
@@ -63,6 +75,12 @@ func NewWatchServer(s *etcdserver.EtcdServer) pb.WatchServer {
 		sg:        s,
 		watchable: s.Watchable(),
 		ag:        s,
+		holds:     s.WatchResumeHolds(),
+		streams:   s.WatchStreams(),
+
+		lagCancelRevisions: s.Cfg.WatchLagCancelRevisions,
+		lagCancelBytes:     s.Cfg.WatchLagCancelBytes,
+		maxQueuedBytes:     s.Cfg.WatchMaxQueuedBytes,
 	}
 	if srv.lg == nil {
 		srv.lg = zap.NewNop()
@@ -131,12 +149,21 @@ type serverWatchStream struct {
 	sg        apply.RaftStatusGetter
 	watchable mvcc.WatchableKV
 	ag        AuthGetter
+	holds     *v3compactor.Holds
+
+	streamID   uint64
+	remoteAddr string
+
+	lagCancelRevisions int64
+	lagCancelBytes     int64
 
 	gRPCStream  pb.Watch_WatchServer
 	watchStream mvcc.WatchStream
 	ctrlStream  chan *pb.WatchResponse
+	flow        *watchFlow
 
-	// mu protects progress, prevKV, fragment
+	// mu protects progress, prevKV, fragment, initialState,
+	// initialStateSenders, resumeIDs
 	mu sync.RWMutex
 	// tracks the watchID that stream might need to send progress to
 	// TODO: combine progress and prevKV into a single struct?
@@ -145,6 +172,12 @@ type serverWatchStream struct {
 	prevKV map[mvcc.WatchID]bool
 	// records fragmented watch IDs
 	fragment map[mvcc.WatchID]bool
+	// records watch IDs whose initial state is not fully sent yet
+	initialState map[mvcc.WatchID]bool
+	// tracks the goroutines sending the initial state of watch IDs
+	initialStateSenders map[mvcc.WatchID]*initialStateSender
+	// maps watch IDs to the compaction holds of their resume tokens
+	resumeIDs map[mvcc.WatchID]uint64
 
 	// closec indicates the stream is closed.
 	closec chan struct{}
@@ -165,18 +198,31 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		sg:        ws.sg,
 		watchable: ws.watchable,
 		ag:        ws.ag,
+		holds:     ws.holds,
+
+		lagCancelRevisions: ws.lagCancelRevisions,
+		lagCancelBytes:     ws.lagCancelBytes,
 
 		gRPCStream:  stream,
 		watchStream: ws.watchable.NewWatchStream(),
 		// chan for sending control response like watcher created and canceled.
 		ctrlStream: make(chan *pb.WatchResponse, ctrlStreamBufLen),
+		flow:       newWatchFlow(ws.maxQueuedBytes),
 
-		progress: make(map[mvcc.WatchID]bool),
-		prevKV:   make(map[mvcc.WatchID]bool),
-		fragment: make(map[mvcc.WatchID]bool),
+		progress:            make(map[mvcc.WatchID]bool),
+		prevKV:              make(map[mvcc.WatchID]bool),
+		fragment:            make(map[mvcc.WatchID]bool),
+		initialState:        make(map[mvcc.WatchID]bool),
+		initialStateSenders: make(map[mvcc.WatchID]*initialStateSender),
+		resumeIDs:           make(map[mvcc.WatchID]uint64),
 
 		closec: make(chan struct{}),
 	}
+	if p, ok := peer.FromContext(stream.Context()); ok && p.Addr != nil {
+		sws.remoteAddr = p.Addr.String()
+	}
+	sws.streamID = ws.streams.Register(&sws)
+	defer ws.streams.Unregister(sws.streamID)
 
 	sws.wg.Add(1)
 	go func() {
@@ -215,6 +261,8 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		if errors.Is(err, context.Canceled) {
 			err = rpctypes.ErrGRPCWatchCanceled
 		}
+		// no initial state may be sent on the closed chan
+		sws.stopInitialStates()
 		close(sws.ctrlStream)
 	case <-stream.Context().Done():
 		err = stream.Context().Err()
@@ -303,6 +351,27 @@ func (sws *serverWatchStream) recvLoop() error {
 				}
 			}
 
+			holdID, err := resumeStart(creq)
+			if err == nil {
+				err = CheckWatchFilters(creq)
+			}
+			if err != nil {
+				wr := &pb.WatchResponse{
+					Header:       sws.newResponseHeader(sws.watchStream.Rev()),
+					WatchId:      clientv3.InvalidWatchID,
+					Canceled:     true,
+					Created:      true,
+					CancelReason: rpctypes.ErrorDesc(err),
+				}
+
+				select {
+				case sws.ctrlStream <- wr:
+					continue
+				case <-sws.closec:
+					return nil
+				}
+			}
+
 			filters := FiltersFromRequest(creq)
 
 			wsrev := sws.watchStream.Rev()
@@ -310,7 +379,12 @@ func (sws *serverWatchStream) recvLoop() error {
 			if rev == 0 {
 				rev = wsrev + 1
 			}
-			id, err := sws.watchStream.Watch(mvcc.WatchID(creq.WatchId), creq.Key, creq.RangeEnd, rev, filters...)
+			opts := mvcc.WatchOptions{
+				Coalesce:       creq.Coalesce,
+				CoalesceWindow: time.Duration(creq.CoalesceWindowMs) * time.Millisecond,
+				NoUnchanged:    HasFilter(creq, pb.WatchCreateRequest_NOUNCHANGED),
+			}
+			id, err := sws.watchStream.WatchWithOptions(mvcc.WatchID(creq.WatchId), creq.Key, creq.RangeEnd, rev, opts, filters...)
 			if err == nil {
 				sws.mu.Lock()
 				if creq.ProgressNotify {
@@ -322,6 +396,15 @@ func (sws *serverWatchStream) recvLoop() error {
 				if creq.Fragment {
 					sws.fragment[id] = true
 				}
+				if creq.InitialState {
+					sws.initialState[id] = true
+				}
+				if sws.holds != nil {
+					if holdID == 0 {
+						holdID = newHoldID()
+					}
+					sws.resumeIDs[id] = holdID
+				}
 				sws.mu.Unlock()
 			} else {
 				id = clientv3.InvalidWatchID
@@ -335,6 +418,8 @@ func (sws *serverWatchStream) recvLoop() error {
 			}
 			if err != nil {
 				wr.CancelReason = err.Error()
+			} else {
+				wr.ResumeToken = sws.holdResume(id, rev)
 			}
 			select {
 			case sws.ctrlStream <- wr:
@@ -342,21 +427,26 @@ func (sws *serverWatchStream) recvLoop() error {
 				return nil
 			}
 
+			if err == nil && creq.InitialState {
+				// events from rev on are held back by sendLoop
+				// until the initial state at rev-1 is sent
+				sws.startInitialState(id, creq.Key, creq.RangeEnd, rev-1, filters)
+			}
+
 		case *pb.WatchRequest_CancelRequest:
 			if uv.CancelRequest != nil {
 				id := uv.CancelRequest.WatchId
+				// no initial state may follow the cancel response
+				sws.stopInitialState(mvcc.WatchID(id))
 				err := sws.watchStream.Cancel(mvcc.WatchID(id))
 				if err == nil {
+					sws.releaseResume(mvcc.WatchID(id))
 					sws.ctrlStream <- &pb.WatchResponse{
 						Header:   sws.newResponseHeader(sws.watchStream.Rev()),
 						WatchId:  id,
 						Canceled: true,
 					}
-					sws.mu.Lock()
-					delete(sws.progress, mvcc.WatchID(id))
-					delete(sws.prevKV, mvcc.WatchID(id))
-					delete(sws.fragment, mvcc.WatchID(id))
-					sws.mu.Unlock()
+					sws.deleteWatcher(mvcc.WatchID(id))
 				}
 			}
 		case *pb.WatchRequest_ProgressRequest:
@@ -365,6 +455,10 @@ func (sws *serverWatchStream) recvLoop() error {
 				sws.watchStream.RequestProgressAll()
 				sws.mu.Unlock()
 			}
+		case *pb.WatchRequest_CreditRequest:
+			if uv.CreditRequest != nil {
+				sws.flow.grant(uv.CreditRequest.Credits)
+			}
 		default:
 			// we probably should not shutdown the entire stream when
 			// receive an invalid command.
@@ -378,23 +472,17 @@ func (sws *serverWatchStream) recvLoop() error {
 func (sws *serverWatchStream) sendLoop() {
 	// watch ids that are currently active
 	ids := make(map[mvcc.WatchID]struct{})
-	// watch responses pending on a watch id creation message
-	pending := make(map[mvcc.WatchID][]*pb.WatchResponse)
 
 	interval := GetProgressReportInterval()
 	progressTicker := time.NewTicker(interval)
 
 	defer func() {
 		progressTicker.Stop()
+		sws.flow.dropAll()
 		// drain the chan to clean up pending events
 		for ws := range sws.watchStream.Chan() {
 			mvcc.ReportEventReceived(len(ws.Events))
 		}
-		for _, wrs := range pending {
-			for _, ws := range wrs {
-				mvcc.ReportEventReceived(len(ws.Events))
-			}
-		}
 	}()
 
 	for {
@@ -431,39 +519,34 @@ func (sws *serverWatchStream) sendLoop() {
 				CompactRevision: wresp.CompactRevision,
 				Canceled:        canceled,
 			}
+			if canceled {
+				sws.releaseResume(wresp.WatchID)
+			} else {
+				// resume after the last event, even if it was filtered out
+				next := wresp.Revision + 1
+				if len(evs) > 0 {
+					next = evs[len(evs)-1].Kv.ModRevision + 1
+				}
+				// the hold only moves once the response is sent
+				wr.ResumeToken = sws.resumeToken(wresp.WatchID, next)
+			}
 
 			// Progress notifications can have WatchID -1
 			// if they announce on behalf of multiple watchers
 			if wresp.WatchID != clientv3.InvalidWatchID {
 				if _, okID := ids[wresp.WatchID]; !okID {
 					// buffer if id not yet announced
-					wrs := append(pending[wresp.WatchID], wr)
-					pending[wresp.WatchID] = wrs
+					sws.flow.hold(wr)
+					if !sws.cancelOverflowing(ids) {
+						return
+					}
 					continue
 				}
 			}
 
-			mvcc.ReportEventReceived(len(evs))
-
-			sws.mu.RLock()
-			fragmented, ok := sws.fragment[wresp.WatchID]
-			sws.mu.RUnlock()
-
-			var serr error
-			// gofail: var beforeSendWatchResponse struct{}
-			if !fragmented && !ok {
-				serr = sws.gRPCStream.Send(wr)
-			} else {
-				serr = sendFragments(wr, sws.maxRequestBytes, sws.gRPCStream.Send)
-			}
+			mvcc.ReportEventReceived(len(events))
 
-			if serr != nil {
-				if isClientCtxErr(sws.gRPCStream.Context().Err(), serr) {
-					sws.lg.Debug("failed to send watch response to gRPC stream", zap.Error(serr))
-				} else {
-					sws.lg.Warn("failed to send watch response to gRPC stream", zap.Error(serr))
-					streamFailures.WithLabelValues("send", "watch").Inc()
-				}
+			if !sws.deliver(wr, ids) {
 				return
 			}
 
@@ -479,41 +562,69 @@ func (sws *serverWatchStream) sendLoop() {
 				return
 			}
 
-			if err := sws.gRPCStream.Send(c); err != nil {
-				if isClientCtxErr(sws.gRPCStream.Context().Err(), err) {
-					sws.lg.Debug("failed to send watch control response to gRPC stream", zap.Error(err))
+			// track id creation
+			wid := mvcc.WatchID(c.WatchId)
+
+			sws.mu.RLock()
+			fragmented := sws.fragment[wid]
+			initializing := sws.initialState[wid]
+			sws.mu.RUnlock()
+
+			if c.InitialState || c.InitialStateDone {
+				if !initializing {
+					// the watcher was canceled as lagging
+					continue
+				}
+				// the initial state is subject to flow control like
+				// events, and its end must follow it
+				if !sws.deliver(c, ids) {
+					return
+				}
+				if c.InitialStateDone && !sws.activate(wid, ids) {
+					return
+				}
+				continue
+			}
+
+			var serr error
+			if fragmented && len(c.Events) > 0 {
+				serr = sendFragments(c, sws.maxRequestBytes, sws.gRPCStream.Send)
+			} else {
+				serr = sws.gRPCStream.Send(c)
+			}
+			if serr != nil {
+				if isClientCtxErr(sws.gRPCStream.Context().Err(), serr) {
+					sws.lg.Debug("failed to send watch control response to gRPC stream", zap.Error(serr))
 				} else {
-					sws.lg.Warn("failed to send watch control response to gRPC stream", zap.Error(err))
+					sws.lg.Warn("failed to send watch control response to gRPC stream", zap.Error(serr))
 					streamFailures.WithLabelValues("send", "watch").Inc()
 				}
 				return
 			}
 
-			// track id creation
-			wid := mvcc.WatchID(c.WatchId)
-
 			verify.Assert(!(c.Canceled && c.Created) || wid == clientv3.InvalidWatchID, "unexpected watchId: %d, wanted: %d, since both 'Canceled' and 'Created' are true", wid, clientv3.InvalidWatchID)
 
 			if c.Canceled && wid != clientv3.InvalidWatchID {
 				delete(ids, wid)
+				sws.flow.drop(int64(wid))
 				continue
 			}
 			if c.Created {
-				// flush buffered events
-				ids[wid] = struct{}{}
-				for _, v := range pending[wid] {
-					mvcc.ReportEventReceived(len(v.Events))
-					if err := sws.gRPCStream.Send(v); err != nil {
-						if isClientCtxErr(sws.gRPCStream.Context().Err(), err) {
-							sws.lg.Debug("failed to send pending watch response to gRPC stream", zap.Error(err))
-						} else {
-							sws.lg.Warn("failed to send pending watch response to gRPC stream", zap.Error(err))
-							streamFailures.WithLabelValues("send", "watch").Inc()
-						}
-						return
-					}
+				if !c.Canceled {
+					sws.flow.announce(int64(wid))
+				}
+				if initializing {
+					// keep buffering events until the initial state is sent
+					continue
+				}
+				if !sws.activate(wid, ids) {
+					return
 				}
-				delete(pending, wid)
+			}
+
+		case <-sws.flow.creditc:
+			if !sws.flushQueued(ids) {
+				return
 			}
 
 		case <-progressTicker.C:
@@ -525,6 +636,10 @@ func (sws *serverWatchStream) sendLoop() {
 				sws.progress[id] = true
 			}
 			sws.mu.Unlock()
+			// catch lagging watchers even if no responses arrive
+			if !sws.cancelLagging(ids) {
+				return
+			}
 
 		case <-sws.closec:
 			return
@@ -532,6 +647,22 @@ func (sws *serverWatchStream) sendLoop() {
 	}
 }
 
+// activate makes the watcher active, flushing its buffered events.
+// It returns false if the stream failed.
+func (sws *serverWatchStream) activate(id mvcc.WatchID, ids map[mvcc.WatchID]struct{}) bool {
+	sws.mu.Lock()
+	delete(sws.initialState, id)
+	sws.mu.Unlock()
+	ids[id] = struct{}{}
+	for _, v := range sws.flow.release(int64(id)) {
+		mvcc.ReportEventReceived(len(v.Events))
+		if !sws.deliver(v, ids) {
+			return false
+		}
+	}
+	return true
+}
+
 func IsCreateEvent(e mvccpb.Event) bool {
 	return e.Type == mvccpb.PUT && e.Kv.CreateRevision == e.Kv.ModRevision
 }
@@ -575,10 +706,182 @@ func sendFragments(
 	return nil
 }
 
+// initialStatePageSize is the maximum number of key-value pairs
+// sent in a single initial state watch response.
+const initialStatePageSize = 1000
+
+// initialStateSender is a goroutine sending the initial state of a watcher.
+type initialStateSender struct {
+	cancel context.CancelFunc
+	donec  chan struct{}
+}
+
+// stop stops sending the initial state and waits until nothing more of it
+// can be sent.
+func (s *initialStateSender) stop() {
+	s.cancel()
+	<-s.donec
+}
+
+// startInitialState sends the initial state of a watcher in its own
+// goroutine, so that paging through a large range does not hold back the
+// other requests of the stream.
+func (sws *serverWatchStream) startInitialState(id mvcc.WatchID, key, end []byte, rev int64, fs []mvcc.FilterFunc) {
+	ctx, cancel := context.WithCancel(sws.gRPCStream.Context())
+	s := &initialStateSender{cancel: cancel, donec: make(chan struct{})}
+	sws.mu.Lock()
+	sws.initialStateSenders[id] = s
+	sws.mu.Unlock()
+
+	go func() {
+		defer close(s.donec)
+		defer cancel()
+		sws.sendInitialState(ctx, id, key, end, rev, fs)
+		sws.mu.Lock()
+		// the watch ID may have been reused once the watcher got canceled
+		if sws.initialStateSenders[id] == s {
+			delete(sws.initialStateSenders, id)
+		}
+		sws.mu.Unlock()
+	}()
+}
+
+// stopInitialState stops sending the initial state of a watcher, if any.
+func (sws *serverWatchStream) stopInitialState(id mvcc.WatchID) {
+	sws.mu.Lock()
+	s, ok := sws.initialStateSenders[id]
+	delete(sws.initialStateSenders, id)
+	sws.mu.Unlock()
+	if ok {
+		s.stop()
+	}
+}
+
+// stopInitialStates stops sending the initial state of all watchers.
+func (sws *serverWatchStream) stopInitialStates() {
+	sws.mu.Lock()
+	senders := make([]*initialStateSender, 0, len(sws.initialStateSenders))
+	for id, s := range sws.initialStateSenders {
+		senders = append(senders, s)
+		delete(sws.initialStateSenders, id)
+	}
+	sws.mu.Unlock()
+	for _, s := range senders {
+		s.stop()
+	}
+}
+
+// sendInitialState sends the key-value pairs in the watched range at revision
+// rev as put events over the control stream, followed by a response marking the
+// end of the initial state. The watcher is canceled if rev has been compacted.
+// It returns early if ctx is done or the stream is closed.
+func (sws *serverWatchStream) sendInitialState(ctx context.Context, id mvcc.WatchID, key, end []byte, rev int64, fs []mvcc.FilterFunc) {
+	// nothing exists before the first revision
+	for rev > 0 {
+		r, err := sws.watchable.Range(ctx, key, end, mvcc.RangeOptions{Rev: rev, Limit: initialStatePageSize})
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			sws.cancelInitialState(ctx, id, key, end, err)
+			return
+		}
+
+		events := make([]*mvccpb.Event, 0, len(r.KVs))
+	loop:
+		for i := range r.KVs {
+			ev := mvccpb.Event{Type: mvccpb.PUT, Kv: &r.KVs[i]}
+			for _, filter := range fs {
+				if filter(ev) {
+					continue loop
+				}
+			}
+			events = append(events, &ev)
+		}
+		if len(events) > 0 {
+			wr := &pb.WatchResponse{
+				Header:       sws.newResponseHeader(rev),
+				WatchId:      int64(id),
+				Events:       events,
+				InitialState: true,
+			}
+			select {
+			case sws.ctrlStream <- wr:
+			case <-ctx.Done():
+				return
+			case <-sws.closec:
+				return
+			}
+		}
+
+		if end == nil || len(r.KVs) < initialStatePageSize {
+			break
+		}
+		// continue right after the last key of the page
+		key = append(bytes.Clone(r.KVs[len(r.KVs)-1].Key), 0)
+	}
+
+	wr := &pb.WatchResponse{
+		Header:           sws.newResponseHeader(rev),
+		WatchId:          int64(id),
+		InitialStateDone: true,
+	}
+	select {
+	case sws.ctrlStream <- wr:
+	case <-ctx.Done():
+	case <-sws.closec:
+	}
+}
+
+// cancelInitialState cancels a watcher whose initial state cannot be read.
+func (sws *serverWatchStream) cancelInitialState(ctx context.Context, id mvcc.WatchID, key, end []byte, err error) {
+	if sws.watchStream.Cancel(id) != nil {
+		return
+	}
+	sws.releaseResume(id)
+	sws.deleteWatcher(id)
+
+	wr := &pb.WatchResponse{
+		Header:   sws.newResponseHeader(sws.watchStream.Rev()),
+		WatchId:  int64(id),
+		Canceled: true,
+	}
+	if errors.Is(err, mvcc.ErrCompacted) {
+		txn := sws.watchable.Read(mvcc.ConcurrentReadTxMode, traceutil.TODO())
+		wr.CompactRevision = txn.FirstRangeRev(key, end)
+		txn.End()
+	} else {
+		wr.CancelReason = err.Error()
+	}
+	select {
+	case sws.ctrlStream <- wr:
+	case <-ctx.Done():
+	case <-sws.closec:
+	}
+}
+
+// deleteWatcher removes the state kept for a canceled watcher.
+func (sws *serverWatchStream) deleteWatcher(id mvcc.WatchID) {
+	sws.mu.Lock()
+	delete(sws.progress, id)
+	delete(sws.prevKV, id)
+	delete(sws.fragment, id)
+	delete(sws.initialState, id)
+	sws.mu.Unlock()
+}
+
 func (sws *serverWatchStream) close() {
 	sws.watchStream.Close()
 	close(sws.closec)
 	sws.wg.Wait()
+	if sws.holds != nil {
+		// give the watchers of the stream the full retention to resume
+		sws.mu.RLock()
+		for _, holdID := range sws.resumeIDs {
+			sws.holds.Refresh(holdID)
+		}
+		sws.mu.RUnlock()
+	}
 }
 
 func (sws *serverWatchStream) newResponseHeader(rev int64) *pb.ResponseHeader {
@@ -598,17 +901,85 @@ func filterNoPut(e mvccpb.Event) bool {
 	return e.Type == mvccpb.PUT
 }
 
+func filterNoUpdate(e mvccpb.Event) bool {
+	return e.Type == mvccpb.PUT && e.Kv.Version > 1
+}
+
+// IsUnchangedPutEvent returns true if the event is a put that kept the
+// previous value of the key.
+func IsUnchangedPutEvent(e mvccpb.Event, prevKV *mvccpb.KeyValue) bool {
+	return e.Type == mvccpb.PUT && prevKV != nil && bytes.Equal(e.Kv.Value, prevKV.Value)
+}
+
+// HasFilter returns true if the watch create request sets the given filter type.
+func HasFilter(creq *pb.WatchCreateRequest, filter pb.WatchCreateRequest_FilterType) bool {
+	for _, ft := range creq.Filters {
+		if ft == filter {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckWatchFilters returns an error if any event filter of the given
+// watch create request is malformed.
+func CheckWatchFilters(creq *pb.WatchCreateRequest) error {
+	for _, wf := range creq.EventFilters {
+		if wf == nil {
+			return rpctypes.ErrGRPCInvalidWatchFilter
+		}
+		switch f := wf.Filter.(type) {
+		case *pb.WatchFilter_KeyGlob:
+			if _, err := path.Match(f.KeyGlob, ""); err != nil {
+				return rpctypes.ErrGRPCInvalidWatchFilter
+			}
+		case *pb.WatchFilter_KeyRegex:
+			if _, err := regexp.Compile(f.KeyRegex); err != nil {
+				return rpctypes.ErrGRPCInvalidWatchFilter
+			}
+		case *pb.WatchFilter_Lease:
+		default:
+			return rpctypes.ErrGRPCInvalidWatchFilter
+		}
+	}
+	return nil
+}
+
 // FiltersFromRequest returns "mvcc.FilterFunc" from a given watch create request.
+// The event filters of the request must have been checked by CheckWatchFilters.
+// NOUNCHANGED is applied by mvcc through mvcc.WatchOptions and is not returned here.
 func FiltersFromRequest(creq *pb.WatchCreateRequest) []mvcc.FilterFunc {
-	filters := make([]mvcc.FilterFunc, 0, len(creq.Filters))
+	filters := make([]mvcc.FilterFunc, 0, len(creq.Filters)+len(creq.EventFilters))
 	for _, ft := range creq.Filters {
 		switch ft {
 		case pb.WatchCreateRequest_NOPUT:
 			filters = append(filters, filterNoPut)
 		case pb.WatchCreateRequest_NODELETE:
 			filters = append(filters, filterNoDelete)
+		case pb.WatchCreateRequest_NOUPDATE:
+			filters = append(filters, filterNoUpdate)
 		default:
 		}
 	}
+	for _, wf := range creq.EventFilters {
+		switch f := wf.Filter.(type) {
+		case *pb.WatchFilter_KeyGlob:
+			pattern := f.KeyGlob
+			filters = append(filters, func(e mvccpb.Event) bool {
+				ok, _ := path.Match(pattern, string(e.Kv.Key))
+				return !ok
+			})
+		case *pb.WatchFilter_KeyRegex:
+			re := regexp.MustCompile(f.KeyRegex)
+			filters = append(filters, func(e mvccpb.Event) bool {
+				return !re.Match(e.Kv.Key)
+			})
+		case *pb.WatchFilter_Lease:
+			leaseID := f.Lease
+			filters = append(filters, func(e mvccpb.Event) bool {
+				return e.Type == mvccpb.PUT && e.Kv.Lease != leaseID
+			})
+		}
+	}
 	return filters
 }
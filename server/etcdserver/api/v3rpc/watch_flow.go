@@ -0,0 +1,376 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"sync/atomic"
+
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+// watchFlow holds back the event responses of a watch stream while its
+// client has no credits left or their watcher is not announced yet, and
+// tracks how far behind the stream is.
+type watchFlow struct {
+	// enabled is set once the client grants the first credits.
+	enabled atomic.Bool
+	// credits is the number of events the client can still buffer.
+	credits atomic.Int64
+	// creditc notifies the send loop of granted credits.
+	creditc chan struct{}
+
+	// queued are the held back responses in send order, pending the
+	// responses of the watchers whose created response or initial state
+	// is not sent yet, and bytes the size of both by watch ID. announced
+	// are the watchers whose created response was sent, the only ones
+	// canceled when overflowing. All are only accessed by the send loop.
+	queued    []*pb.WatchResponse
+	pending   map[int64][]*pb.WatchResponse
+	bytes     map[int64]int64
+	announced map[int64]struct{}
+
+	// queuedBytes is the size of the queued and pending responses,
+	// and maxQueuedBytes its bound. Past it, the watchers with the most
+	// held back are canceled as lagging.
+	queuedBytes    atomic.Int64
+	maxQueuedBytes int64
+	// unsentRev is the revision of the oldest response not sent to the
+	// client yet, or 0 if there is none.
+	unsentRev atomic.Int64
+}
+
+func newWatchFlow(maxQueuedBytes int64) *watchFlow {
+	return &watchFlow{
+		creditc:        make(chan struct{}, 1),
+		pending:        make(map[int64][]*pb.WatchResponse),
+		bytes:          make(map[int64]int64),
+		announced:      make(map[int64]struct{}),
+		maxQueuedBytes: maxQueuedBytes,
+	}
+}
+
+// grant adds credits to the stream, enabling flow control on the first call.
+func (f *watchFlow) grant(credits int64) {
+	f.credits.Add(credits)
+	f.enabled.Store(true)
+	select {
+	case f.creditc <- struct{}{}:
+	default:
+	}
+}
+
+// holding returns true if the next response must be queued, either
+// because the client has no credits left or to keep responses in order.
+func (f *watchFlow) holding() bool {
+	return f.enabled.Load() && (len(f.queued) != 0 || f.credits.Load() <= 0)
+}
+
+func (f *watchFlow) push(wr *pb.WatchResponse) {
+	if len(f.queued) == 0 {
+		f.unsentRev.Store(wr.Header.Revision)
+	}
+	f.queued = append(f.queued, wr)
+	f.account(wr)
+}
+
+// hold keeps the response of a watcher not announced yet until release.
+func (f *watchFlow) hold(wr *pb.WatchResponse) {
+	f.pending[wr.WatchId] = append(f.pending[wr.WatchId], wr)
+	f.account(wr)
+}
+
+// release removes and returns the held responses of the watcher.
+func (f *watchFlow) release(id int64) []*pb.WatchResponse {
+	wrs := f.pending[id]
+	delete(f.pending, id)
+	for _, wr := range wrs {
+		f.unqueue(wr)
+	}
+	return wrs
+}
+
+// announce marks the created response of the watcher as sent.
+func (f *watchFlow) announce(id int64) {
+	f.announced[id] = struct{}{}
+}
+
+// pop removes the oldest queued response if the client has credits for it.
+func (f *watchFlow) pop() *pb.WatchResponse {
+	if len(f.queued) == 0 || f.credits.Load() <= 0 {
+		return nil
+	}
+	wr := f.queued[0]
+	f.queued[0] = nil
+	f.queued = f.queued[1:]
+	f.unqueue(wr)
+	return wr
+}
+
+// drop removes the queued and held responses of the watcher.
+func (f *watchFlow) drop(id int64) {
+	delete(f.announced, id)
+	for _, wr := range f.release(id) {
+		mvcc.ReportEventReceived(len(wr.Events))
+	}
+	if f.bytes[id] == 0 {
+		return
+	}
+	queued := f.queued[:0]
+	for _, wr := range f.queued {
+		if wr.WatchId == id {
+			f.unqueue(wr)
+			continue
+		}
+		queued = append(queued, wr)
+	}
+	clear(f.queued[len(queued):])
+	f.queued = queued
+	f.resetUnsentRev()
+}
+
+// overflowing returns the watcher with the most queued responses if the
+// queued responses exceed their bound.
+func (f *watchFlow) overflowing() (id int64, ok bool) {
+	if f.queuedBytes.Load() <= f.maxQueuedBytes {
+		return 0, false
+	}
+	n := int64(0)
+	for wid, b := range f.bytes {
+		// progress notifications and watchers not announced yet are not canceled
+		if _, ok := f.announced[wid]; ok && wid != clientv3.InvalidWatchID && b > n {
+			id, n = wid, b
+		}
+	}
+	return id, n > 0
+}
+
+// dropAll removes all queued and held responses.
+func (f *watchFlow) dropAll() {
+	for id := range f.pending {
+		for _, wr := range f.release(id) {
+			mvcc.ReportEventReceived(len(wr.Events))
+		}
+	}
+	for _, wr := range f.queued {
+		f.unqueue(wr)
+	}
+	f.queued = nil
+	f.resetUnsentRev()
+}
+
+func (f *watchFlow) account(wr *pb.WatchResponse) {
+	n := int64(wr.Size())
+	f.bytes[wr.WatchId] += n
+	f.queuedBytes.Add(n)
+	watchBufferedBytes.Add(float64(n))
+}
+
+func (f *watchFlow) unqueue(wr *pb.WatchResponse) {
+	n := int64(wr.Size())
+	if f.bytes[wr.WatchId] -= n; f.bytes[wr.WatchId] <= 0 {
+		delete(f.bytes, wr.WatchId)
+	}
+	f.queuedBytes.Add(-n)
+	watchBufferedBytes.Sub(float64(n))
+}
+
+// sending marks wr as the oldest response not sent yet.
+func (f *watchFlow) sending(wr *pb.WatchResponse) {
+	f.unsentRev.Store(wr.Header.Revision)
+}
+
+// sent takes the credits of the events of wr.
+func (f *watchFlow) sent(wr *pb.WatchResponse) {
+	if f.enabled.Load() {
+		f.credits.Add(-int64(len(wr.Events)))
+	}
+	f.resetUnsentRev()
+}
+
+func (f *watchFlow) resetUnsentRev() {
+	if len(f.queued) != 0 {
+		f.unsentRev.Store(f.queued[0].Header.Revision)
+	} else {
+		f.unsentRev.Store(0)
+	}
+}
+
+// Lag implements etcdserver.WatchStreamLagger.
+func (sws *serverWatchStream) Lag() *pb.WatchStreamLag {
+	l := &pb.WatchStreamLag{
+		StreamId:      sws.streamID,
+		RemoteAddr:    sws.remoteAddr,
+		Watchers:      int64(sws.watchStream.Watchers()),
+		BufferedBytes: sws.flow.queuedBytes.Load(),
+		FlowControl:   sws.flow.enabled.Load(),
+	}
+	if l.FlowControl {
+		l.Credits = sws.flow.credits.Load()
+	}
+	if rev := sws.flow.unsentRev.Load(); rev != 0 {
+		l.RevisionGap = sws.watchStream.Rev() - rev
+	}
+	return l
+}
+
+// deliver sends an event response to the client, or queues it while the
+// client has no credits left. It returns false if the stream failed.
+func (sws *serverWatchStream) deliver(wr *pb.WatchResponse, ids map[mvcc.WatchID]struct{}) bool {
+	if sws.flow.holding() {
+		sws.flow.push(wr)
+		if !sws.cancelOverflowing(ids) {
+			return false
+		}
+		return sws.cancelLagging(ids)
+	}
+	if sws.lagged(wr, sws.watchStream.Rev()) {
+		return sws.cancelLagged(mvcc.WatchID(wr.WatchId), ids)
+	}
+	return sws.sendResponse(wr)
+}
+
+// flushQueued sends the queued responses the client has credits for.
+// It returns false if the stream failed.
+func (sws *serverWatchStream) flushQueued(ids map[mvcc.WatchID]struct{}) bool {
+	if !sws.cancelLagging(ids) {
+		return false
+	}
+	for wr := sws.flow.pop(); wr != nil; wr = sws.flow.pop() {
+		if !sws.sendResponse(wr) {
+			return false
+		}
+	}
+	return true
+}
+
+func (sws *serverWatchStream) sendResponse(wr *pb.WatchResponse) bool {
+	sws.mu.RLock()
+	fragmented := sws.fragment[mvcc.WatchID(wr.WatchId)]
+	sws.mu.RUnlock()
+
+	sws.flow.sending(wr)
+	watchSendRevisionLag.Observe(float64(sws.watchStream.Rev() - wr.Header.Revision))
+
+	var serr error
+	// gofail: var beforeSendWatchResponse struct{}
+	if !fragmented {
+		serr = sws.gRPCStream.Send(wr)
+	} else {
+		serr = sendFragments(wr, sws.maxRequestBytes, sws.gRPCStream.Send)
+	}
+	sws.flow.sent(wr)
+
+	if serr != nil {
+		if isClientCtxErr(sws.gRPCStream.Context().Err(), serr) {
+			sws.lg.Debug("failed to send watch response to gRPC stream", zap.Error(serr))
+		} else {
+			sws.lg.Warn("failed to send watch response to gRPC stream", zap.Error(serr))
+			streamFailures.WithLabelValues("send", "watch").Inc()
+		}
+		return false
+	}
+	sws.sentResume(wr)
+	return true
+}
+
+// lagged returns true if the watcher of wr lags too far behind rev.
+func (sws *serverWatchStream) lagged(wr *pb.WatchResponse, rev int64) bool {
+	return sws.lagCancelRevisions > 0 &&
+		wr.WatchId != clientv3.InvalidWatchID && !wr.Canceled &&
+		rev-wr.Header.Revision > sws.lagCancelRevisions
+}
+
+// cancelLagging cancels the watchers whose queued responses lag too far
+// behind. It returns false if the stream failed.
+func (sws *serverWatchStream) cancelLagging(ids map[mvcc.WatchID]struct{}) bool {
+	if sws.lagCancelRevisions <= 0 && sws.lagCancelBytes <= 0 {
+		return true
+	}
+	rev := sws.watchStream.Rev()
+	var lagging []mvcc.WatchID
+	seen := make(map[int64]struct{})
+	for _, wr := range sws.flow.queued {
+		if _, ok := seen[wr.WatchId]; ok || wr.WatchId == clientv3.InvalidWatchID || wr.Canceled {
+			continue
+		}
+		// the first queued response of a watcher is its oldest
+		seen[wr.WatchId] = struct{}{}
+		if sws.lagged(wr, rev) || (sws.lagCancelBytes > 0 && sws.flow.bytes[wr.WatchId] > sws.lagCancelBytes) {
+			lagging = append(lagging, mvcc.WatchID(wr.WatchId))
+		}
+	}
+	for _, id := range lagging {
+		if !sws.cancelLagged(id, ids) {
+			return false
+		}
+	}
+	return true
+}
+
+// cancelOverflowing cancels the watchers with the most queued responses
+// until the queued responses fit their bound. It returns false if the
+// stream failed.
+func (sws *serverWatchStream) cancelOverflowing(ids map[mvcc.WatchID]struct{}) bool {
+	for id, ok := sws.flow.overflowing(); ok; id, ok = sws.flow.overflowing() {
+		if !sws.cancelLagged(mvcc.WatchID(id), ids) {
+			return false
+		}
+	}
+	return true
+}
+
+// cancelLagged cancels a lagging watcher, dropping its queued responses.
+// It returns false if the stream failed.
+func (sws *serverWatchStream) cancelLagged(id mvcc.WatchID, ids map[mvcc.WatchID]struct{}) bool {
+	sws.flow.drop(int64(id))
+	// no initial state may follow the cancel response
+	sws.stopInitialState(id)
+	if sws.watchStream.Cancel(id) != nil {
+		// already canceled by the client
+		return true
+	}
+	sws.releaseResume(id)
+	sws.deleteWatcher(id)
+	delete(ids, id)
+
+	watchLagCanceled.Inc()
+	sws.lg.Warn(
+		"canceled lagging watcher",
+		zap.Uint64("stream-id", sws.streamID),
+		zap.String("remote-addr", sws.remoteAddr),
+		zap.Int64("watch-id", int64(id)),
+	)
+	err := sws.gRPCStream.Send(&pb.WatchResponse{
+		Header:       sws.newResponseHeader(sws.watchStream.Rev()),
+		WatchId:      int64(id),
+		Canceled:     true,
+		CancelReason: rpctypes.ErrorDesc(rpctypes.ErrGRPCWatchLagged),
+	})
+	if err != nil {
+		if isClientCtxErr(sws.gRPCStream.Context().Err(), err) {
+			sws.lg.Debug("failed to send watch control response to gRPC stream", zap.Error(err))
+		} else {
+			sws.lg.Warn("failed to send watch control response to gRPC stream", zap.Error(err))
+			streamFailures.WithLabelValues("send", "watch").Inc()
+		}
+		return false
+	}
+	return true
+}
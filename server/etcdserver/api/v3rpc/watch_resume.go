@@ -0,0 +1,146 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+// resumeTokenV1 marks the first watch resume token encoding:
+//
+//	version (1 byte) | hold id (uvarint) | resume revision (uvarint)
+//
+// The hold id names the compaction hold of the watcher, so that a
+// resumed watcher keeps moving the same hold instead of adding one.
+const resumeTokenV1 byte = 1
+
+func encodeResumeToken(holdID uint64, rev int64) []byte {
+	tok := make([]byte, 1+2*binary.MaxVarintLen64)
+	tok[0] = resumeTokenV1
+	n := 1 + binary.PutUvarint(tok[1:], holdID)
+	n += binary.PutUvarint(tok[n:], uint64(rev))
+	return tok[:n]
+}
+
+func decodeResumeToken(tok []byte) (holdID uint64, rev int64, err error) {
+	if len(tok) < 3 || tok[0] != resumeTokenV1 {
+		return 0, 0, rpctypes.ErrGRPCInvalidResumeToken
+	}
+	holdID, n := binary.Uvarint(tok[1:])
+	if n <= 0 || holdID == 0 {
+		return 0, 0, rpctypes.ErrGRPCInvalidResumeToken
+	}
+	r, m := binary.Uvarint(tok[1+n:])
+	if m <= 0 || r == 0 || r > math.MaxInt64 || len(tok) != 1+n+m {
+		return 0, 0, rpctypes.ErrGRPCInvalidResumeToken
+	}
+	return holdID, int64(r), nil
+}
+
+// resumeStart resolves the resume token of the watch create request,
+// moving its start revision up to the token revision. It returns the
+// hold id of the token, or 0 if the request has no resume token.
+func resumeStart(creq *pb.WatchCreateRequest) (holdID uint64, err error) {
+	if len(creq.ResumeToken) == 0 {
+		return 0, nil
+	}
+	holdID, rev, err := decodeResumeToken(creq.ResumeToken)
+	if err != nil {
+		return 0, err
+	}
+	if creq.StartRevision < rev {
+		creq.StartRevision = rev
+	}
+	return holdID, nil
+}
+
+// newHoldID returns a random non-zero compaction hold id.
+func newHoldID() uint64 {
+	for {
+		if id := rand.Uint64(); id != 0 {
+			return id
+		}
+	}
+}
+
+// holdResume moves the compaction hold of the watcher to rev and returns
+// the token to resume the watcher at rev, or nil if the watcher has no hold.
+func (sws *serverWatchStream) holdResume(id mvcc.WatchID, rev int64) []byte {
+	if sws.holds == nil {
+		return nil
+	}
+	sws.mu.RLock()
+	holdID, ok := sws.resumeIDs[id]
+	sws.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+	sws.holds.Hold(holdID, rev)
+	return encodeResumeToken(holdID, rev)
+}
+
+// resumeToken returns the token to resume the watcher at rev, or nil if the
+// watcher has no hold. The hold is only moved to rev by sentResume.
+func (sws *serverWatchStream) resumeToken(id mvcc.WatchID, rev int64) []byte {
+	if sws.holds == nil {
+		return nil
+	}
+	sws.mu.RLock()
+	holdID, ok := sws.resumeIDs[id]
+	sws.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+	return encodeResumeToken(holdID, rev)
+}
+
+// sentResume moves the compaction hold of the watcher to the revision of the
+// resume token of wr, once wr is sent to the client. Until then, the client
+// may have to resume from the revision of a previous token.
+func (sws *serverWatchStream) sentResume(wr *pb.WatchResponse) {
+	if sws.holds == nil || len(wr.ResumeToken) == 0 {
+		return
+	}
+	holdID, rev, err := decodeResumeToken(wr.ResumeToken)
+	if err != nil {
+		return
+	}
+	sws.mu.RLock()
+	defer sws.mu.RUnlock()
+	// the watcher may have been canceled since
+	if id, ok := sws.resumeIDs[mvcc.WatchID(wr.WatchId)]; ok && id == holdID {
+		sws.holds.Hold(holdID, rev)
+	}
+}
+
+// releaseResume removes the compaction hold of the watcher.
+func (sws *serverWatchStream) releaseResume(id mvcc.WatchID) {
+	if sws.holds == nil {
+		return
+	}
+	sws.mu.Lock()
+	holdID, ok := sws.resumeIDs[id]
+	delete(sws.resumeIDs, id)
+	sws.mu.Unlock()
+	if ok {
+		sws.holds.Release(holdID)
+	}
+}
@@ -18,10 +18,15 @@ import (
 	"bytes"
 	"errors"
 	"math"
+	"reflect"
 	"testing"
+	"time"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
 )
 
 func TestSendFragment(t *testing.T) {
@@ -83,6 +88,158 @@ func TestSendFragment(t *testing.T) {
 	}
 }
 
+func TestFiltersFromRequest(t *testing.T) {
+	created := mvccpb.Event{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("/a/b.txt"), Version: 1, Lease: 1}}
+	updated := mvccpb.Event{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("/a/c"), Version: 2}}
+	deleted := mvccpb.Event{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: []byte("/a/b.txt")}}
+	events := []mvccpb.Event{created, updated, deleted}
+
+	tests := []struct {
+		creq *pb.WatchCreateRequest
+		want []mvccpb.Event
+	}{
+		{
+			creq: &pb.WatchCreateRequest{},
+			want: []mvccpb.Event{created, updated, deleted},
+		},
+		{
+			creq: &pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_NOUPDATE}},
+			want: []mvccpb.Event{created, deleted},
+		},
+		{
+			creq: &pb.WatchCreateRequest{EventFilters: []*pb.WatchFilter{{Filter: &pb.WatchFilter_KeyGlob{KeyGlob: "/a/*.txt"}}}},
+			want: []mvccpb.Event{created, deleted},
+		},
+		{
+			creq: &pb.WatchCreateRequest{EventFilters: []*pb.WatchFilter{{Filter: &pb.WatchFilter_KeyRegex{KeyRegex: "c$"}}}},
+			want: []mvccpb.Event{updated},
+		},
+		{
+			creq: &pb.WatchCreateRequest{EventFilters: []*pb.WatchFilter{{Filter: &pb.WatchFilter_Lease{Lease: 1}}}},
+			want: []mvccpb.Event{created, deleted},
+		},
+		{
+			creq: &pb.WatchCreateRequest{
+				Filters:      []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_NODELETE},
+				EventFilters: []*pb.WatchFilter{{Filter: &pb.WatchFilter_Lease{Lease: 1}}},
+			},
+			want: []mvccpb.Event{created},
+		},
+	}
+	for i, tt := range tests {
+		if err := CheckWatchFilters(tt.creq); err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		filters := FiltersFromRequest(tt.creq)
+		var got []mvccpb.Event
+		for _, ev := range events {
+			filtered := false
+			for _, filter := range filters {
+				if filter(ev) {
+					filtered = true
+					break
+				}
+			}
+			if !filtered {
+				got = append(got, ev)
+			}
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("#%d: expected events %v, got %v", i, tt.want, got)
+		}
+	}
+}
+
+func TestCheckWatchFilters(t *testing.T) {
+	tests := []*pb.WatchFilter{
+		nil,
+		{},
+		{Filter: &pb.WatchFilter_KeyGlob{KeyGlob: "["}},
+		{Filter: &pb.WatchFilter_KeyRegex{KeyRegex: "("}},
+	}
+	for i, tt := range tests {
+		err := CheckWatchFilters(&pb.WatchCreateRequest{EventFilters: []*pb.WatchFilter{tt}})
+		if !errors.Is(err, rpctypes.ErrGRPCInvalidWatchFilter) {
+			t.Errorf("#%d: expected error %v, got %v", i, rpctypes.ErrGRPCInvalidWatchFilter, err)
+		}
+	}
+}
+
+func TestIsUnchangedPutEvent(t *testing.T) {
+	put := mvccpb.Event{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("a"), Value: []byte("v1")}}
+	if !IsUnchangedPutEvent(put, &mvccpb.KeyValue{Key: []byte("a"), Value: []byte("v1")}) {
+		t.Error("expected put keeping the value to be unchanged")
+	}
+	if IsUnchangedPutEvent(put, &mvccpb.KeyValue{Key: []byte("a"), Value: []byte("v0")}) {
+		t.Error("expected put changing the value not to be unchanged")
+	}
+	if IsUnchangedPutEvent(put, nil) {
+		t.Error("expected put creating the key not to be unchanged")
+	}
+	del := mvccpb.Event{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: []byte("a")}}
+	if IsUnchangedPutEvent(del, &mvccpb.KeyValue{Key: []byte("a")}) {
+		t.Error("expected delete not to be unchanged")
+	}
+}
+
+func TestResumeStart(t *testing.T) {
+	tok := encodeResumeToken(7, 100)
+	tests := []struct {
+		creq *pb.WatchCreateRequest
+
+		wantHoldID uint64
+		wantRev    int64
+		wantErr    error
+	}{
+		{creq: &pb.WatchCreateRequest{StartRevision: 5}, wantRev: 5},
+		{creq: &pb.WatchCreateRequest{ResumeToken: tok}, wantHoldID: 7, wantRev: 100},
+		{creq: &pb.WatchCreateRequest{ResumeToken: tok, StartRevision: 50}, wantHoldID: 7, wantRev: 100},
+		{creq: &pb.WatchCreateRequest{ResumeToken: tok, StartRevision: 150}, wantHoldID: 7, wantRev: 150},
+		{creq: &pb.WatchCreateRequest{ResumeToken: []byte("bad")}, wantErr: rpctypes.ErrGRPCInvalidResumeToken},
+		{creq: &pb.WatchCreateRequest{ResumeToken: encodeResumeToken(0, 100)}, wantErr: rpctypes.ErrGRPCInvalidResumeToken},
+		{creq: &pb.WatchCreateRequest{ResumeToken: append(tok, 0)}, wantErr: rpctypes.ErrGRPCInvalidResumeToken},
+		{creq: &pb.WatchCreateRequest{ResumeToken: tok[:len(tok)-1]}, wantErr: rpctypes.ErrGRPCInvalidResumeToken},
+	}
+	for i, tt := range tests {
+		holdID, err := resumeStart(tt.creq)
+		if !errors.Is(err, tt.wantErr) {
+			t.Fatalf("#%d: expected error %v, got %v", i, tt.wantErr, err)
+		}
+		if err != nil {
+			continue
+		}
+		if holdID != tt.wantHoldID {
+			t.Errorf("#%d: expected hold id %d, got %d", i, tt.wantHoldID, holdID)
+		}
+		if tt.creq.StartRevision != tt.wantRev {
+			t.Errorf("#%d: expected start revision %d, got %d", i, tt.wantRev, tt.creq.StartRevision)
+		}
+	}
+}
+
+func TestSentResume(t *testing.T) {
+	holds := v3compactor.NewHolds(time.Minute)
+	sws := &serverWatchStream{holds: holds, resumeIDs: map[mvcc.WatchID]uint64{1: 7}}
+
+	sws.holdResume(1, 5)
+	tok := sws.resumeToken(1, 10)
+	if rev := holds.LocalMinRev(); rev != 5 {
+		t.Fatalf("held revision before the response is sent = %d, want 5", rev)
+	}
+	sws.sentResume(&pb.WatchResponse{WatchId: 1, ResumeToken: tok})
+	if rev := holds.LocalMinRev(); rev != 10 {
+		t.Fatalf("held revision after the response is sent = %d, want 10", rev)
+	}
+
+	// a response sent after its watcher is canceled holds nothing
+	tok = sws.resumeToken(1, 15)
+	sws.releaseResume(1)
+	sws.sentResume(&pb.WatchResponse{WatchId: 1, ResumeToken: tok})
+	if rev := holds.LocalMinRev(); rev != 0 {
+		t.Fatalf("held revision after the watcher is canceled = %d, want 0", rev)
+	}
+}
+
 func createResponse(dataSize, events int) (resp *pb.WatchResponse) {
 	resp = &pb.WatchResponse{Events: make([]*mvccpb.Event, events)}
 	for i := range resp.Events {
@@ -94,3 +251,85 @@ func createResponse(dataSize, events int) (resp *pb.WatchResponse) {
 	}
 	return resp
 }
+
+func TestWatchFlow(t *testing.T) {
+	f := newWatchFlow(1024 * 1024)
+	wr := func(id, rev int64) *pb.WatchResponse {
+		return &pb.WatchResponse{
+			Header:  &pb.ResponseHeader{Revision: rev},
+			WatchId: id,
+			Events:  []*mvccpb.Event{{Kv: &mvccpb.KeyValue{Key: []byte("foo"), ModRevision: rev}}},
+		}
+	}
+
+	// without credits granted flow control is disabled
+	if f.holding() {
+		t.Fatal("expected no flow control before credits are granted")
+	}
+	f.grant(1)
+	if f.holding() {
+		t.Fatal("expected no holding with credits left")
+	}
+	f.sending(wr(1, 2))
+	f.sent(wr(1, 2))
+	if !f.holding() {
+		t.Fatal("expected holding without credits")
+	}
+
+	f.push(wr(1, 3))
+	f.push(wr(2, 4))
+	f.push(wr(1, 5))
+	if rev := f.unsentRev.Load(); rev != 3 {
+		t.Fatalf("unsent revision = %d, want 3", rev)
+	}
+	if f.pop() != nil {
+		t.Fatal("expected nothing popped without credits")
+	}
+
+	f.drop(1)
+	if len(f.queued) != 1 || f.queued[0].WatchId != 2 {
+		t.Fatalf("expected only the response of watcher 2 queued, got %v", f.queued)
+	}
+	if rev := f.unsentRev.Load(); rev != 4 {
+		t.Fatalf("unsent revision = %d, want 4", rev)
+	}
+
+	f.maxQueuedBytes = int64(wr(2, 4).Size())
+	if _, ok := f.overflowing(); ok {
+		t.Fatal("expected no overflow within the bound")
+	}
+	f.announce(3)
+	f.push(wr(3, 6))
+	f.push(wr(3, 7))
+	if id, ok := f.overflowing(); !ok || id != 3 {
+		t.Fatalf("expected watcher 3 overflowing, got %d (%v)", id, ok)
+	}
+	f.drop(3)
+
+	f.grant(1)
+	if r := f.pop(); r == nil || r.WatchId != 2 {
+		t.Fatalf("expected the response of watcher 2 popped, got %v", r)
+	}
+	if n := f.queuedBytes.Load(); n != 0 || len(f.bytes) != 0 {
+		t.Fatalf("expected no queued bytes, got %d (%v)", n, f.bytes)
+	}
+
+	// held responses count in the bound, but only announced watchers are canceled
+	f.hold(wr(4, 8))
+	f.hold(wr(4, 9))
+	if _, ok := f.overflowing(); ok {
+		t.Fatal("expected watcher 4 not announced yet to be kept")
+	}
+	f.announce(4)
+	if id, ok := f.overflowing(); !ok || id != 4 {
+		t.Fatalf("expected watcher 4 overflowing, got %d (%v)", id, ok)
+	}
+	if wrs := f.release(4); len(wrs) != 2 || wrs[0].Header.Revision != 8 {
+		t.Fatalf("expected the responses of watcher 4 released in order, got %v", wrs)
+	}
+	f.hold(wr(5, 10))
+	f.drop(5)
+	if n := f.queuedBytes.Load(); n != 0 || len(f.bytes) != 0 || len(f.pending) != 0 {
+		t.Fatalf("expected no held bytes, got %d (%v)", n, f.bytes)
+	}
+}
@@ -110,6 +110,7 @@ type SnapshotServer interface {
 
 type applierV3backend struct {
 	lg              *zap.Logger
+	be              backend.Backend
 	kv              mvcc.KV
 	alarmStore      *v3alarm.AlarmStore
 	authStore       auth.AuthStore
@@ -124,6 +125,7 @@ type applierV3backend struct {
 
 func newApplierV3Backend(
 	lg *zap.Logger,
+	be backend.Backend,
 	kv mvcc.KV,
 	alarmStore *v3alarm.AlarmStore,
 	authStore auth.AuthStore,
@@ -135,6 +137,7 @@ func newApplierV3Backend(
 	txnModeWriteWithSharedBuffer bool) applierV3 {
 	return &applierV3backend{
 		lg:                           lg,
+		be:                           be,
 		kv:                           kv,
 		alarmStore:                   alarmStore,
 		authStore:                    authStore,
@@ -174,7 +177,7 @@ func (a *applierV3backend) Compaction(compaction *pb.CompactionRequest) (*pb.Com
 		traceutil.Field{Key: "revision", Value: compaction.Revision},
 	)
 
-	ch, err := a.kv.Compact(trace, compaction.Revision)
+	ch, err := a.kv.CompactRetaining(trace, compaction.Revision, a.compactionRetentions())
 	if err != nil {
 		return nil, ch, nil, err
 	}
@@ -450,8 +453,8 @@ type quotaApplierV3 struct {
 	q serverstorage.Quota
 }
 
-func newQuotaApplierV3(lg *zap.Logger, quotaBackendBytesCfg int64, be backend.Backend, app applierV3) applierV3 {
-	return &quotaApplierV3{app, serverstorage.NewBackendQuota(lg, quotaBackendBytesCfg, be, "v3-applier")}
+func newQuotaApplierV3(lg *zap.Logger, quotaBackendBytesCfg int64, be backend.Backend, valueCodec mvcc.ValueCodec, app applierV3) applierV3 {
+	return &quotaApplierV3{app, serverstorage.NewBackendQuota(lg, quotaBackendBytesCfg, be, "v3-applier", valueCodec)}
 }
 
 func (a *quotaApplierV3) Put(p *pb.PutRequest) (*pb.PutResponse, *traceutil.Trace, error) {
@@ -96,6 +96,7 @@ func defaultAuthApplierV3(t *testing.T) *authApplierV3 {
 		authStore,
 		newApplierV3Backend(
 			lg,
+			be,
 			kv,
 			alarmStore,
 			authStore,
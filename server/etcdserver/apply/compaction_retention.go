@@ -0,0 +1,71 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// compactionRetentions resolves the compaction retention policy of the
+// cluster to the revisions the history of the prefixes is kept since. They
+// are resolved from replicated data only, the current revision and the
+// revision time index, so that every member retains the same history.
+func (a *applierV3backend) compactionRetentions() []mvcc.Retention {
+	tx := a.be.ReadTx()
+	tx.RLock()
+	defer tx.RUnlock()
+
+	p, err := schema.UnsafeReadCompactionRetentionPolicy(tx)
+	if err != nil {
+		a.lg.Panic("failed to read the compaction retention policy", zap.Error(err))
+	}
+	if p == nil {
+		return nil
+	}
+	rev := a.kv.Rev()
+	// durations are counted back from the latest recorded revision time
+	_, now, known := schema.UnsafeReadLatestRevisionTime(tx)
+	rs := make([]mvcc.Retention, 0, len(p.Retentions))
+	for _, pr := range p.Retentions {
+		// unless known otherwise, the whole history is kept
+		var retainRev int64
+		switch {
+		case pr.Revisions > 0:
+			retainRev = rev - pr.Revisions
+		case pr.Duration > 0:
+			if !known {
+				break
+			}
+			if r, _, ok := schema.UnsafeReadRevisionAt(tx, now.Add(-time.Duration(pr.Duration))); ok {
+				retainRev = r
+			}
+		default:
+			continue
+		}
+		end := []byte(clientv3.GetPrefixRangeEnd(pr.Prefix))
+		if len(end) == 1 && end[0] == 0 {
+			// all keys greater than or equal to the prefix
+			end = []byte{}
+		}
+		rs = append(rs, mvcc.Retention{Key: []byte(pr.Prefix), End: end, Rev: retainRev})
+	}
+	return rs
+}
@@ -61,8 +61,9 @@ func NewUberApplier(
 	consistentIndex cindex.ConsistentIndexer,
 	warningApplyDuration time.Duration,
 	txnModeWriteWithSharedBuffer bool,
-	quotaBackendBytesCfg int64) UberApplier {
-	applyV3base := newApplierV3(lg, be, kv, alarmStore, authStore, lessor, cluster, raftStatus, snapshotServer, consistentIndex, txnModeWriteWithSharedBuffer, quotaBackendBytesCfg)
+	quotaBackendBytesCfg int64,
+	valueCodec mvcc.ValueCodec) UberApplier {
+	applyV3base := newApplierV3(lg, be, kv, alarmStore, authStore, lessor, cluster, raftStatus, snapshotServer, consistentIndex, txnModeWriteWithSharedBuffer, quotaBackendBytesCfg, valueCodec)
 
 	ua := &uberApplier{
 		lg:                   lg,
@@ -87,11 +88,12 @@ func newApplierV3(
 	snapshotServer SnapshotServer,
 	consistentIndex cindex.ConsistentIndexer,
 	txnModeWriteWithSharedBuffer bool,
-	quotaBackendBytesCfg int64) applierV3 {
-	applierBackend := newApplierV3Backend(lg, kv, alarmStore, authStore, lessor, cluster, raftStatus, snapshotServer, consistentIndex, txnModeWriteWithSharedBuffer)
+	quotaBackendBytesCfg int64,
+	valueCodec mvcc.ValueCodec) applierV3 {
+	applierBackend := newApplierV3Backend(lg, be, kv, alarmStore, authStore, lessor, cluster, raftStatus, snapshotServer, consistentIndex, txnModeWriteWithSharedBuffer)
 	return newAuthApplierV3(
 		authStore,
-		newQuotaApplierV3(lg, quotaBackendBytesCfg, be, applierBackend),
+		newQuotaApplierV3(lg, quotaBackendBytesCfg, be, valueCodec, applierBackend),
 		lessor,
 	)
 }
@@ -73,6 +73,7 @@ func defaultUberApplier(t *testing.T) UberApplier {
 		1*time.Hour,
 		false,
 		16*1024*1024, //16MB
+		mvcc.ValueCodecNone,
 	)
 }
 
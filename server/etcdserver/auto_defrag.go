@@ -0,0 +1,231 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// autoDefragLockTTL is the TTL in seconds of the lease of the automatic
+// defragmentation lock, renewed while the member defragments.
+const autoDefragLockTTL = 60
+
+var errAutoDefragLockHeld = errors.New("etcdserver: automatic defragmentation lock is held by another member")
+
+// DefragNotifier is notified around the full defragmentations of the
+// backend, during which the member stops serving requests.
+type DefragNotifier interface {
+	DefragStarted()
+	DefragFinished()
+}
+
+// AddDefragNotifier registers n to be notified around every full
+// defragmentation of the backend.
+func (s *EtcdServer) AddDefragNotifier(n DefragNotifier) {
+	s.defragMu.Lock()
+	defer s.defragMu.Unlock()
+	s.defragNotifiers = append(s.defragNotifiers, n)
+}
+
+// Defragment fully defragments the backend, notifying the registered
+// DefragNotifiers before and after. It is shared by the Defragment RPC and
+// the automatic defragmentation.
+func (s *EtcdServer) Defragment() error {
+	s.defragMu.Lock()
+	notifiers := s.defragNotifiers
+	s.defragMu.Unlock()
+
+	for _, n := range notifiers {
+		n.DefragStarted()
+	}
+	defer func() {
+		for _, n := range notifiers {
+			n.DefragFinished()
+		}
+	}()
+	return s.Backend().Defrag()
+}
+
+// monitorAutoDefrag every AutoDefragCheckTime checks the free space in the
+// backend and defragments it once it crosses the configured ratio.
+func (s *EtcdServer) monitorAutoDefrag() {
+	if s.Cfg.AutoDefragRatio <= 0 {
+		return
+	}
+	lg := s.Logger()
+	if s.Cfg.Witness {
+		lg.Info("witness member does not defragment automatically")
+		return
+	}
+	lg.Info(
+		"enabled automatic defragmentation",
+		zap.String("local-member-id", s.MemberID().String()),
+		zap.Float64("ratio", s.Cfg.AutoDefragRatio),
+		zap.Uint("threshold-megabytes", s.Cfg.AutoDefragThresholdMegabytes),
+		zap.Duration("interval", s.Cfg.AutoDefragCheckTime),
+		zap.Bool("skip-leader", s.Cfg.AutoDefragSkipLeader),
+	)
+	for {
+		select {
+		case <-time.After(s.Cfg.AutoDefragCheckTime):
+		case <-s.stopping:
+			lg.Info("server has stopped; stopping auto defrag's monitor")
+			return
+		}
+
+		be := s.Backend()
+		size, sizeInUse := be.Size(), be.SizeInUse()
+		if !needsAutoDefrag(size, sizeInUse, s.Cfg.AutoDefragRatio, s.Cfg.AutoDefragThresholdMegabytes) {
+			continue
+		}
+		if s.Cfg.AutoDefragSkipLeader && s.isLeader() {
+			continue
+		}
+		// older members cannot apply the lock
+		if cv := s.ClusterVersion(); cv == nil || cv.LessThan(version.V3_6) {
+			continue
+		}
+		lg.Info(
+			"backend crossed the automatic defragmentation ratio",
+			zap.Int64("current-db-size-bytes", size),
+			zap.String("current-db-size", humanize.Bytes(uint64(size))),
+			zap.Int64("current-db-size-in-use-bytes", sizeInUse),
+			zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse))),
+		)
+		if err := s.autoDefrag(); err != nil {
+			lg.Warn("failed to defragment automatically", zap.Error(err))
+		}
+	}
+}
+
+// needsAutoDefrag returns true if the free space of a backend of the given
+// size crosses both the ratio and the threshold.
+func needsAutoDefrag(size, sizeInUse int64, ratio float64, thresholdMegabytes uint) bool {
+	free := size - sizeInUse
+	if size <= 0 || free <= 0 {
+		return false
+	}
+	if uint64(free) < uint64(thresholdMegabytes)*1024*1024 {
+		return false
+	}
+	return float64(free)/float64(size) >= ratio
+}
+
+// autoDefrag defragments the backend while holding the automatic
+// defragmentation lock, taken through raft with a lease renewed for the
+// whole defragmentation. It returns without defragmenting if another member
+// holds the lock.
+func (s *EtcdServer) autoDefrag() error {
+	lg := s.Logger()
+
+	ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+	lresp, err := s.LeaseGrant(ctx, &pb.LeaseGrantRequest{TTL: autoDefragLockTTL})
+	cancel()
+	if err != nil {
+		return err
+	}
+	id := lease.LeaseID(lresp.ID)
+	// revoking the lease releases the lock
+	defer func() {
+		ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+		if _, err := s.LeaseRevoke(ctx, &pb.LeaseRevokeRequest{ID: int64(id)}); err != nil {
+			lg.Warn("failed to release automatic defragmentation lock", zap.Error(err))
+		}
+		cancel()
+	}()
+
+	ctx, cancel = context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+	_, err = s.raftRequestOnce(ctx, pb.InternalRaftRequest{AutoDefragLock: &pb.AutoDefragLockRequest{
+		MemberId: uint64(s.MemberID()),
+		Lease:    int64(id),
+	}})
+	cancel()
+	if errors.Is(err, errAutoDefragLockHeld) {
+		lg.Info("skipped automatic defragmentation; another member is defragmenting")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	donec := make(chan struct{})
+	defer close(donec)
+	go s.keepAutoDefragLock(id, donec)
+
+	lg.Info("starting automatic defragmentation")
+	if err = s.Defragment(); err != nil {
+		return err
+	}
+	lg.Info("finished automatic defragmentation")
+	return nil
+}
+
+// keepAutoDefragLock renews the lease of the lock until donec is closed.
+func (s *EtcdServer) keepAutoDefragLock(id lease.LeaseID, donec <-chan struct{}) {
+	ticker := time.NewTicker(autoDefragLockTTL * time.Second / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-donec:
+			return
+		case <-s.stopping:
+			return
+		}
+		if _, err := s.LeaseRenew(s.ctx, id); err != nil {
+			s.Logger().Warn("failed to renew automatic defragmentation lock", zap.Error(err))
+		}
+	}
+}
+
+// applyAutoDefragLock takes the automatic defragmentation lock for the
+// member of r, unless another member holds it. The lock is held as long as
+// its lease is alive; leases only expire through raft, so every member
+// agrees on the holder.
+func (s *EtcdServer) applyAutoDefragLock(r *pb.AutoDefragLockRequest) error {
+	tx := s.Backend().BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	cur, err := schema.UnsafeReadAutoDefragLock(tx)
+	if err != nil {
+		s.Logger().Panic("failed to read the automatic defragmentation lock", zap.Error(err))
+	}
+	alive := func(id int64) bool { return s.lessor.Lookup(lease.LeaseID(id)) != nil }
+	if autoDefragLockHeld(cur, r, alive) {
+		return errAutoDefragLockHeld
+	}
+	schema.UnsafeSaveAutoDefragLock(tx, r)
+	return nil
+}
+
+// autoDefragLockHeld returns true if the lock cur prevents r from taking
+// it: its lease is alive and it belongs to another member, or the lease of
+// r already expired.
+func autoDefragLockHeld(cur, r *pb.AutoDefragLockRequest, alive func(id int64) bool) bool {
+	if !alive(r.Lease) {
+		return true
+	}
+	return cur != nil && cur.MemberId != r.MemberId && alive(cur.Lease)
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+func TestNeedsAutoDefrag(t *testing.T) {
+	const mb = 1024 * 1024
+	tcs := []struct {
+		name               string
+		size, sizeInUse    int64
+		ratio              float64
+		thresholdMegabytes uint
+		want               bool
+	}{
+		{name: "empty backend", size: 0, sizeInUse: 0, ratio: 0.5},
+		{name: "below ratio", size: 100 * mb, sizeInUse: 60 * mb, ratio: 0.5},
+		{name: "at ratio", size: 100 * mb, sizeInUse: 50 * mb, ratio: 0.5, want: true},
+		{name: "above ratio", size: 100 * mb, sizeInUse: 10 * mb, ratio: 0.5, want: true},
+		{name: "below threshold", size: 100 * mb, sizeInUse: 10 * mb, ratio: 0.5, thresholdMegabytes: 100},
+		{name: "above threshold", size: 100 * mb, sizeInUse: 10 * mb, ratio: 0.5, thresholdMegabytes: 90, want: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, needsAutoDefrag(tc.size, tc.sizeInUse, tc.ratio, tc.thresholdMegabytes))
+		})
+	}
+}
+
+func TestAutoDefragLockHeld(t *testing.T) {
+	alive := func(id int64) bool { return id%2 == 1 }
+	tcs := []struct {
+		name string
+		cur  *pb.AutoDefragLockRequest
+		r    *pb.AutoDefragLockRequest
+		want bool
+	}{
+		{name: "never taken", r: &pb.AutoDefragLockRequest{MemberId: 1, Lease: 1}},
+		{name: "held by another member", cur: &pb.AutoDefragLockRequest{MemberId: 2, Lease: 3}, r: &pb.AutoDefragLockRequest{MemberId: 1, Lease: 1}, want: true},
+		{name: "lease of another member expired", cur: &pb.AutoDefragLockRequest{MemberId: 2, Lease: 2}, r: &pb.AutoDefragLockRequest{MemberId: 1, Lease: 1}},
+		{name: "held by the member", cur: &pb.AutoDefragLockRequest{MemberId: 1, Lease: 3}, r: &pb.AutoDefragLockRequest{MemberId: 1, Lease: 1}},
+		{name: "lease of the request expired", r: &pb.AutoDefragLockRequest{MemberId: 1, Lease: 2}, want: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, autoDefragLockHeld(tc.cur, tc.r, alive))
+		})
+	}
+}
@@ -415,6 +415,16 @@ func recoverSnapshot(cfg config.ServerConfig, st v2store.Store, be backend.Backe
 			zap.String("snapshot-size", humanize.Bytes(uint64(snapshot.Size()))),
 		)
 
+		if cfg.Witness {
+			// witnesses never receive database snapshots, their backend only
+			// holds the membership of the snapshot.
+			if err = recoverWitnessBackend(cfg.Logger, be, st); err != nil {
+				return nil, be, err
+			}
+			ci.SetConsistentIndex(snapshot.Metadata.Index, snapshot.Metadata.Term)
+			return snapshot, be, nil
+		}
+
 		if be, err = serverstorage.RecoverSnapshotBackend(cfg, be, *snapshot, beExist, beHooks); err != nil {
 			cfg.Logger.Panic("failed to recover v3 backend from snapshot", zap.Error(err))
 		}
@@ -450,12 +460,23 @@ func (c *bootstrappedCluster) Finalize(cfg config.ServerConfig, s *bootstrappedS
 	c.cl.SetBackend(schema.NewMembershipBackend(cfg.Logger, s.backend.be))
 	if s.wal.haveWAL {
 		c.cl.Recover(api.UpdateCapability)
-		if c.databaseFileMissing(s) {
+		if !cfg.Witness && c.databaseFileMissing(s) {
 			bepath := cfg.BackendPath()
 			os.RemoveAll(bepath)
 			return fmt.Errorf("database file (%v) of the backend is missing", bepath)
 		}
 	}
+	local := c.cl.Member(c.nodeID)
+	for _, m := range c.remotes {
+		// a member joining the cluster only knows if it was added as a
+		// witness from the existing members.
+		if m.ID == c.nodeID {
+			local = m
+		}
+	}
+	if err := validateWitness(cfg, local); err != nil {
+		return err
+	}
 	scaleUpLearners := false
 	return membership.ValidateMaxLearnerConfig(cfg.ExperimentalMaxLearners, c.cl.Members(), scaleUpLearners)
 }
@@ -603,6 +624,7 @@ func openWALFromSnapshot(cfg config.ServerConfig, snapshot *raftpb.Snapshot) (*w
 		if cfg.UnsafeNoFsync {
 			w.SetUnsafeNoFsync()
 		}
+		setWALCodec(cfg, w)
 		wmetadata, st, ents, err := w.ReadAll()
 		if err != nil {
 			w.Close()
@@ -623,6 +645,7 @@ func openWALFromSnapshot(cfg config.ServerConfig, snapshot *raftpb.Snapshot) (*w
 		id := types.ID(metadata.NodeID)
 		cid := types.ID(metadata.ClusterID)
 		meta := &snapshotMetadata{clusterID: cid, nodeID: id}
+		setWALArchiver(cfg, w)
 		return w, &st, ents, snapshot, meta
 	}
 }
@@ -645,12 +668,41 @@ func bootstrapNewWAL(cfg config.ServerConfig, cl *bootstrappedCluster) *bootstra
 	if cfg.UnsafeNoFsync {
 		w.SetUnsafeNoFsync()
 	}
+	setWALCodec(cfg, w)
+	setWALArchiver(cfg, w)
 	return &bootstrappedWAL{
 		lg: cfg.Logger,
 		w:  w,
 	}
 }
 
+// setWALCodec compresses the data of the entries saved to w with the
+// configured codec, if any.
+func setWALCodec(cfg config.ServerConfig, w *wal.WAL) {
+	if cfg.WALCompression == "" {
+		return
+	}
+	codec, err := wal.ParseCodec(cfg.WALCompression)
+	if err != nil {
+		cfg.Logger.Fatal("failed to parse WAL compression", zap.String("codec", cfg.WALCompression), zap.Error(err))
+	}
+	w.SetCodec(codec)
+}
+
+// setWALArchiver archives the sealed segments of w to the configured target,
+// if any.
+func setWALArchiver(cfg config.ServerConfig, w *wal.WAL) {
+	if cfg.WALArchiveTarget == "" {
+		return
+	}
+	sink, err := wal.NewArchiveSink(cfg.WALArchiveTarget)
+	if err != nil {
+		cfg.Logger.Fatal("failed to create WAL archive sink", zap.String("target", cfg.WALArchiveTarget), zap.Error(err))
+	}
+	w.SetArchiver(wal.NewArchiver(cfg.Logger, sink))
+	cfg.Logger.Info("archiving WAL segments", zap.String("target", cfg.WALArchiveTarget))
+}
+
 type bootstrappedWAL struct {
 	lg *zap.Logger
 
@@ -0,0 +1,114 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// compactionRetentionPolicyInterval is how often the leader checks that
+// the compaction retention policy of the cluster is the configured one.
+const compactionRetentionPolicyInterval = time.Second
+
+// compactionRetentionPolicy returns the compaction prefix retentions of the
+// configuration as a policy to propose.
+func (s *EtcdServer) compactionRetentionPolicy() *pb.CompactionRetentionPolicyRequest {
+	p := &pb.CompactionRetentionPolicyRequest{}
+	for _, pr := range s.Cfg.CompactionPrefixRetentions {
+		p.Retentions = append(p.Retentions, &pb.CompactionPrefixRetention{
+			Prefix:    pr.Prefix,
+			Revisions: pr.Revisions,
+			Duration:  int64(pr.Duration),
+		})
+	}
+	return p
+}
+
+// monitorCompactionRetentionPolicy, while the member is the leader, proposes
+// the compaction prefix retentions of its configuration whenever they
+// differ from the policy of the cluster. Compactions are applied with the
+// policy of the cluster, whichever member receives them.
+func (s *EtcdServer) monitorCompactionRetentionPolicy() {
+	if s.Cfg.Witness {
+		return
+	}
+	lg := s.Logger()
+	for {
+		select {
+		case <-time.After(compactionRetentionPolicyInterval):
+		case <-s.stopping:
+			return
+		}
+
+		if !s.isLeader() {
+			continue
+		}
+		// older members cannot apply the request
+		if cv := s.ClusterVersion(); cv == nil || cv.LessThan(version.V3_6) {
+			continue
+		}
+		p := s.compactionRetentionPolicy()
+		cur, err := s.readCompactionRetentionPolicy()
+		if err != nil {
+			lg.Panic("failed to read the compaction retention policy", zap.Error(err))
+		}
+		if cur == nil {
+			cur = &pb.CompactionRetentionPolicyRequest{}
+		}
+		if proto.Equal(cur, p) {
+			continue
+		}
+		lg.Info(
+			"updating compaction retention policy of the cluster",
+			zap.String("local-member-id", s.MemberID().String()),
+			zap.Stringer("from", cur),
+			zap.Stringer("to", p),
+		)
+		ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+		_, err = s.raftRequestOnce(ctx, pb.InternalRaftRequest{CompactionRetentionPolicy: p})
+		cancel()
+		if err != nil {
+			lg.Warn(
+				"failed to update compaction retention policy of the cluster",
+				zap.String("local-member-id", s.MemberID().String()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func (s *EtcdServer) readCompactionRetentionPolicy() (*pb.CompactionRetentionPolicyRequest, error) {
+	tx := s.Backend().ConcurrentReadTx()
+	tx.RLock()
+	defer tx.RUnlock()
+	return schema.UnsafeReadCompactionRetentionPolicy(tx)
+}
+
+// applyCompactionRetentionPolicy sets the compaction retention policy of
+// the cluster proposed by the leader.
+func (s *EtcdServer) applyCompactionRetentionPolicy(p *pb.CompactionRetentionPolicyRequest) {
+	tx := s.Backend().BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	schema.UnsafeSaveCompactionRetentionPolicy(tx, p)
+}
@@ -42,6 +42,10 @@ var (
 	ErrClusterVersionUnavailable   = errors.New("etcdserver: cluster version not found during downgrade")
 	ErrWrongDowngradeVersionFormat = errors.New("etcdserver: wrong downgrade target version format")
 	ErrKeyNotFound                 = errors.New("etcdserver: key not found")
+	ErrInvalidContinueToken        = errors.New("etcdserver: invalid continue token")
+	ErrRevisionTimeUnknown         = errors.New("etcdserver: no revision is known at the given time")
+	ErrNoMemberChange              = errors.New("etcdserver: member reconfigure has no member change")
+	ErrReconfigureUnsupported      = errors.New("etcdserver: member reconfigure needs cluster version 3.6 or later")
 )
 
 type DiscoveryError struct {
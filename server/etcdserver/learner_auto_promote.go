@@ -0,0 +1,117 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/raft/v3"
+	"go.etcd.io/raft/v3/tracker"
+)
+
+// monitorLearnerAutoPromote, while the member is the leader, promotes the
+// learners added with auto-promote once their match index stayed within
+// LearnerAutoPromoteMaxLag of the one of the leader for the whole
+// LearnerAutoPromoteStableWindow. The promotion goes through the same checks
+// as MemberPromote, including StrictReconfigCheck.
+func (s *EtcdServer) monitorLearnerAutoPromote() {
+	lg := s.Logger()
+	interval := time.Duration(s.Cfg.TickMs) * time.Millisecond
+	if interval == 0 {
+		return
+	}
+	inSyncSince := make(map[types.ID]time.Time)
+	for {
+		select {
+		case <-time.After(interval):
+		case <-s.stopping:
+			return
+		}
+
+		if !s.isLeader() {
+			clear(inSyncSince)
+			continue
+		}
+		ids := learnersToAutoPromote(s.raftStatus(), s.cluster.Members(), inSyncSince, time.Now(), s.Cfg.LearnerAutoPromoteMaxLag, s.Cfg.LearnerAutoPromoteStableWindow)
+		for _, id := range ids {
+			ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+			_, err := s.promoteLearner(ctx, uint64(id))
+			cancel()
+			if err != nil {
+				// wait for another stable window before retrying.
+				delete(inSyncSince, id)
+				learnerPromoteFailed.WithLabelValues(err.Error()).Inc()
+				lg.Warn(
+					"failed to promote learner automatically",
+					zap.String("local-member-id", s.MemberID().String()),
+					zap.String("learner-member-id", id.String()),
+					zap.Error(err),
+				)
+				continue
+			}
+			learnerPromoteSucceed.Inc()
+			lg.Info(
+				"promoted learner automatically",
+				zap.String("local-member-id", s.MemberID().String()),
+				zap.String("learner-member-id", id.String()),
+			)
+		}
+	}
+}
+
+// learnersToAutoPromote returns the learners added with auto-promote that
+// have been replicating within maxLag entries of the leader for the window,
+// given the raft status of the leader. inSyncSince tracks since when each of
+// them has been within maxLag.
+func learnersToAutoPromote(rs raft.Status, members []*membership.Member, inSyncSince map[types.ID]time.Time, now time.Time, maxLag uint64, window time.Duration) []types.ID {
+	leader, ok := rs.Progress[rs.ID]
+	if !ok {
+		clear(inSyncSince)
+		return nil
+	}
+
+	var ids []types.ID
+	learners := make(map[types.ID]bool)
+	for _, m := range members {
+		if !m.IsLearner || !m.AutoPromote {
+			continue
+		}
+		learners[m.ID] = true
+		pr, ok := rs.Progress[uint64(m.ID)]
+		if !ok || pr.State != tracker.StateReplicate || pr.Match+maxLag < leader.Match {
+			delete(inSyncSince, m.ID)
+			continue
+		}
+		since, ok := inSyncSince[m.ID]
+		if !ok {
+			since = now
+			inSyncSince[m.ID] = now
+		}
+		if now.Sub(since) >= window {
+			ids = append(ids, m.ID)
+		}
+	}
+	for id := range inSyncSince {
+		if !learners[id] {
+			delete(inSyncSince, id)
+		}
+	}
+	return ids
+}
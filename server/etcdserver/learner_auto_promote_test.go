@@ -0,0 +1,76 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/raft/v3"
+	"go.etcd.io/raft/v3/tracker"
+)
+
+func TestLearnersToAutoPromote(t *testing.T) {
+	const (
+		maxLag = 10
+		window = time.Minute
+	)
+	members := []*membership.Member{
+		{ID: 1},
+		{ID: 2, RaftAttributes: membership.RaftAttributes{IsLearner: true, AutoPromote: true}},
+		{ID: 3, RaftAttributes: membership.RaftAttributes{IsLearner: true}},
+		{ID: 4, RaftAttributes: membership.RaftAttributes{IsLearner: true, AutoPromote: true}},
+	}
+	status := func(match2, match4 uint64, state2 tracker.StateType) raft.Status {
+		rs := raft.Status{Progress: map[uint64]tracker.Progress{
+			1: {Match: 100, State: tracker.StateReplicate},
+			2: {Match: match2, State: state2},
+			3: {Match: 100, State: tracker.StateReplicate},
+			4: {Match: match4, State: tracker.StateReplicate},
+		}}
+		rs.ID = 1
+		return rs
+	}
+
+	now := time.Now()
+	inSyncSince := make(map[types.ID]time.Time)
+	tcs := []struct {
+		name    string
+		rs      raft.Status
+		members []*membership.Member
+		now     time.Time
+		want    []types.ID
+	}{
+		{name: "learner within the lag", rs: status(90, 50, tracker.StateReplicate), members: members, now: now},
+		{name: "learner within the lag during the window", rs: status(95, 50, tracker.StateReplicate), members: members, now: now.Add(window - time.Second)},
+		{name: "learner within the lag for the window", rs: status(100, 50, tracker.StateReplicate), members: members, now: now.Add(window), want: []types.ID{2}},
+		{name: "learner behind the lag", rs: status(89, 95, tracker.StateReplicate), members: members, now: now.Add(2 * window)},
+		{name: "learner within the lag again", rs: status(100, 95, tracker.StateReplicate), members: members, now: now.Add(2*window + time.Second)},
+		{name: "window restarted", rs: status(100, 95, tracker.StateReplicate), members: members, now: now.Add(3 * window), want: []types.ID{4}},
+		{name: "learner receiving a snapshot", rs: status(100, 95, tracker.StateSnapshot), members: members, now: now.Add(4 * window), want: []types.ID{4}},
+		{name: "learner replicating again", rs: status(100, 95, tracker.StateReplicate), members: members, now: now.Add(4*window + time.Second), want: []types.ID{4}},
+		{name: "learner removed", rs: status(100, 95, tracker.StateReplicate), members: members[:2:2], now: now.Add(5 * window)},
+		{name: "not leader", rs: raft.Status{}, members: members, now: now.Add(6 * window)},
+	}
+	for _, tc := range tcs {
+		got := learnersToAutoPromote(tc.rs, tc.members, inSyncSince, tc.now, maxLag, window)
+		assert.Equalf(t, tc.want, got, tc.name)
+	}
+	assert.Empty(t, inSyncSince)
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+)
+
+// leaderLease is the lease of the leader on its leadership. With CheckQuorum,
+// a follower does not vote for another member within the election timeout
+// of hearing from the leader, so that once a quorum acknowledged a ReadIndex
+// sent at start, no other leader can be elected before start plus the
+// election timeout, minus the clock drift between the members. Linearizable
+// reads can be served locally on the leader until then.
+type leaderLease struct {
+	mu sync.RWMutex
+	// expiry is the time the lease expires at.
+	expiry time.Time
+	// leaderChanged is the leader change notifier of the term the lease
+	// was acquired in; it is closed when the leadership is lost.
+	leaderChanged <-chan struct{}
+	// blockedUntil is the time before which the lease cannot be renewed,
+	// as the leadership may have been transferred to another member.
+	blockedUntil time.Time
+
+	// transferring is set while the leadership is being transferred, as
+	// the transferee campaigns without waiting for the election timeout.
+	transferring atomic.Bool
+	// commitIndex is the raft commit index of the leader. Unlike the
+	// committedIndex of the server, it does not lag behind the followers
+	// when the committed entries of a Ready are limited in size. It is
+	// also piggybacked on the heartbeats, see piggybackLeaderProgress.
+	commitIndex atomic.Uint64
+}
+
+// leaderLeaseDuration returns how long the leader lease lasts after the
+// ReadIndex acquiring it was sent. Followers count their election timeout
+// in ticks, the first of which may come right after the heartbeat, so they
+// may campaign as soon as ElectionTicks-1 tick intervals after it.
+func (s *EtcdServer) leaderLeaseDuration() time.Duration {
+	return time.Duration(s.Cfg.ElectionTicks-1)*time.Duration(s.Cfg.TickMs)*time.Millisecond - s.Cfg.LeaderLeaseMaxClockDrift
+}
+
+// renewLeaderLease renews the leader lease with a ReadIndex sent at start
+// and confirmed by a quorum, if the member is still the leader of the term
+// of leaderChanged.
+func (s *EtcdServer) renewLeaderLease(start time.Time, leaderChanged <-chan struct{}) {
+	if !s.Cfg.LeaderLeaseReads || !s.isLeader() {
+		return
+	}
+	l := &s.leaderLease
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.transferring.Load() || start.Before(l.blockedUntil) {
+		return
+	}
+	if expiry := start.Add(s.leaderLeaseDuration()); expiry.After(l.expiry) || l.leaderChanged != leaderChanged {
+		l.expiry = expiry
+		l.leaderChanged = leaderChanged
+	}
+}
+
+// validLeaderLease returns how long the leader lease is still valid for, or
+// zero if it is not valid.
+func (s *EtcdServer) validLeaderLease() time.Duration {
+	if !s.Cfg.LeaderLeaseReads || !s.isLeader() {
+		return 0
+	}
+	l := &s.leaderLease
+	if l.transferring.Load() {
+		return 0
+	}
+	l.mu.RLock()
+	expiry, leaderChanged := l.expiry, l.leaderChanged
+	l.mu.RUnlock()
+	if leaderChanged == nil {
+		return 0
+	}
+	select {
+	case <-leaderChanged:
+		return 0
+	default:
+	}
+	return max(time.Until(expiry), 0)
+}
+
+// blockLeaderLease invalidates the leader lease while the leadership is
+// transferred, and keeps it from being renewed until the election timeout
+// after the transfer, when the transferee can no longer campaign for it.
+func (s *EtcdServer) blockLeaderLease() (unblock func()) {
+	l := &s.leaderLease
+	l.transferring.Store(true)
+	l.mu.Lock()
+	l.expiry = time.Time{}
+	l.mu.Unlock()
+	return func() {
+		l.mu.Lock()
+		l.blockedUntil = time.Now().Add(time.Duration(s.Cfg.ElectionTicks) * time.Duration(s.Cfg.TickMs) * time.Millisecond)
+		l.mu.Unlock()
+		l.transferring.Store(false)
+	}
+}
+
+// leaseReadNotify serves a linearizable read locally if the leader lease is
+// valid, by waiting for the raft commit index of the leader to be applied.
+// It returns false if the lease is not valid, for the read to be confirmed
+// with a ReadIndex instead.
+func (s *EtcdServer) leaseReadNotify(ctx context.Context) (bool, error) {
+	remaining := s.validLeaderLease()
+	if remaining <= 0 {
+		return false, nil
+	}
+	// renew the lease ahead of its expiry, so that the reads keep being
+	// served locally under a steady load.
+	if remaining < s.leaderLeaseDuration()/2 {
+		select {
+		case s.readwaitc <- struct{}{}:
+		default:
+		}
+	}
+	linearizableReads.WithLabelValues("lease").Inc()
+
+	index := s.leaderLease.commitIndex.Load()
+	if s.getAppliedIndex() >= index {
+		return true, nil
+	}
+	select {
+	case <-s.applyWait.Wait(index):
+		return true, nil
+	case <-ctx.Done():
+		return true, ctx.Err()
+	case <-s.done:
+		return true, errors.ErrStopped
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.etcd.io/etcd/pkg/v3/notify"
+	"go.etcd.io/etcd/server/v3/config"
+)
+
+func TestLeaderLease(t *testing.T) {
+	s := &EtcdServer{
+		Cfg: config.ServerConfig{
+			LeaderLeaseReads:         true,
+			TickMs:                   100,
+			ElectionTicks:            10,
+			LeaderLeaseMaxClockDrift: 100 * time.Millisecond,
+		},
+		memberID: 1,
+		lead:     1,
+	}
+	assert.Equal(t, 800*time.Millisecond, s.leaderLeaseDuration())
+	assert.Zero(t, s.validLeaderLease())
+
+	leaderChanged := notify.NewNotifier()
+	s.renewLeaderLease(time.Now(), leaderChanged.Receive())
+	assert.Positive(t, s.validLeaderLease())
+
+	s.renewLeaderLease(time.Now().Add(-time.Second), leaderChanged.Receive())
+	assert.Positive(t, s.validLeaderLease(), "an older ReadIndex must not shorten the lease")
+
+	// an expired lease is not valid
+	s.leaderLease.expiry = time.Now().Add(-time.Millisecond)
+	assert.Zero(t, s.validLeaderLease())
+
+	// the lease is lost with the leadership
+	s.renewLeaderLease(time.Now(), leaderChanged.Receive())
+	assert.Positive(t, s.validLeaderLease())
+	leaderChanged.Notify()
+	assert.Zero(t, s.validLeaderLease())
+	s.renewLeaderLease(time.Now(), leaderChanged.Receive())
+	assert.Positive(t, s.validLeaderLease())
+	s.lead = 2
+	assert.Zero(t, s.validLeaderLease())
+	s.leaderLease.expiry = time.Time{}
+	s.renewLeaderLease(time.Now(), leaderChanged.Receive())
+	s.lead = 1
+	assert.Zero(t, s.validLeaderLease(), "a follower must not renew the lease")
+
+	// the lease is not valid during a leadership transfer, nor renewed
+	// until the election timeout after it
+	start := time.Now()
+	unblock := s.blockLeaderLease()
+	assert.Zero(t, s.validLeaderLease())
+	s.renewLeaderLease(time.Now(), leaderChanged.Receive())
+	assert.Zero(t, s.validLeaderLease())
+	unblock()
+	s.renewLeaderLease(start, leaderChanged.Receive())
+	s.renewLeaderLease(time.Now(), leaderChanged.Receive())
+	assert.Zero(t, s.validLeaderLease())
+	s.renewLeaderLease(time.Now().Add(time.Second), leaderChanged.Receive())
+	assert.Positive(t, s.validLeaderLease())
+
+	// the lease is not used when lease reads are disabled
+	s.Cfg.LeaderLeaseReads = false
+	assert.Zero(t, s.validLeaderLease())
+}
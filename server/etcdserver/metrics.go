@@ -106,6 +106,22 @@ var (
 		Name:      "read_indexes_failed_total",
 		Help:      "The total number of failed read indexes seen.",
 	})
+	linearizableReads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "linearizable_reads_total",
+		Help:      "The total number of linearizable reads, by whether they were served with the leader lease or a read index.",
+	},
+		[]string{"mode"},
+	)
+	boundedStalenessReads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "bounded_staleness_reads_total",
+		Help:      "The total number of ranges with a max staleness, by whether they were served locally, after waiting for the local member to catch up, or forwarded as linearizable reads.",
+	},
+		[]string{"mode"},
+	)
 	leaseExpired = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: "etcd_debugging",
 		Subsystem: "server",
@@ -161,6 +177,8 @@ func init() {
 	prometheus.MustRegister(proposalsFailed)
 	prometheus.MustRegister(slowReadIndex)
 	prometheus.MustRegister(readIndexFailed)
+	prometheus.MustRegister(linearizableReads)
+	prometheus.MustRegister(boundedStalenessReads)
 	prometheus.MustRegister(leaseExpired)
 	prometheus.MustRegister(currentVersion)
 	prometheus.MustRegister(currentGoVersion)
@@ -205,6 +205,12 @@ func (r *raftNode) start(rh *raftReadyHandler) {
 					r.td.Reset()
 				}
 
+				// the leader lease reads must see the entries the followers
+				// may apply once they receive the messages of this Ready.
+				if islead && !raft.IsEmptyHardState(rd.HardState) {
+					rh.updateLeaderCommit(rd.HardState.Commit)
+				}
+
 				if len(rd.ReadStates) != 0 {
 					select {
 					case r.readStateC <- rd.ReadStates[len(rd.ReadStates)-1]:
@@ -237,6 +243,7 @@ func (r *raftNode) start(rh *raftReadyHandler) {
 				// For more details, check raft thesis 10.2.1
 				if islead {
 					// gofail: var raftBeforeLeaderSend struct{}
+					piggybackLeaderProgress(rd.Messages, rh)
 					r.transport.Send(r.processMessages(rd.Messages))
 				}
 
@@ -284,11 +291,13 @@ func (r *raftNode) start(rh *raftReadyHandler) {
 
 				r.raftStorage.Append(rd.Entries)
 
-				confChanged := false
+				confChanged, confChangedV2 := false, false
 				for _, ent := range rd.CommittedEntries {
-					if ent.Type == raftpb.EntryConfChange {
+					switch ent.Type {
+					case raftpb.EntryConfChange:
 						confChanged = true
-						break
+					case raftpb.EntryConfChangeV2:
+						confChanged, confChangedV2 = true, true
 					}
 				}
 
@@ -323,6 +332,18 @@ func (r *raftNode) start(rh *raftReadyHandler) {
 				} else {
 					// leader already processed 'MsgSnap' and signaled
 					notifyc <- struct{}{}
+
+					// Leader needs to wait for the joint configuration changes to be
+					// applied before advancing, as raft proposes leaving a joint
+					// configuration once advanced past it, and would propose it again
+					// if the leaving one were not applied yet.
+					if confChangedV2 {
+						select {
+						case notifyc <- struct{}{}:
+						case <-r.stopped:
+							return
+						}
+					}
 				}
 
 				// gofail: var raftBeforeAdvance struct{}
@@ -0,0 +1,294 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/raft/v3"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// ReconfigureMembers adds, removes and promotes the given members at once,
+// by entering and then leaving a joint configuration through raft. It
+// blocks until the joint configuration is entered, as leaving it needs a
+// quorum of the new voting members, which may not be started yet. The
+// returned members are the ones of the new configuration.
+// As with PromoteMember, only the raft leader knows whether the learners
+// to promote are ready, so that promoting them fails with ErrNotLeader on
+// the other members.
+func (s *EtcdServer) ReconfigureMembers(ctx context.Context, add []membership.Member, remove []uint64, promote []uint64) ([]*membership.Member, error) {
+	if err := s.checkMembershipOperationPermission(ctx); err != nil {
+		return nil, err
+	}
+	if len(add)+len(remove)+len(promote) == 0 {
+		return nil, errors.ErrNoMemberChange
+	}
+	// members before v3.6 cannot apply the joint configuration changes
+	if cv := s.ClusterVersion(); cv == nil || cv.LessThan(version.V3_6) {
+		return nil, errors.ErrReconfigureUnsupported
+	}
+
+	// by default StrictReconfigCheck is enabled; reject the changes if they lead to quorum loss
+	if err := s.mayReconfigureMembers(add, remove, promote); err != nil {
+		return nil, err
+	}
+
+	rc := membership.ReconfigureContext{ID: s.reqIDGen.Next()}
+	cc := raftpb.ConfChangeV2{Transition: raftpb.ConfChangeTransitionJointImplicit}
+	for _, memb := range add {
+		ccType := raftpb.ConfChangeAddNode
+		if memb.IsLearner {
+			ccType = raftpb.ConfChangeAddLearnerNode
+		}
+		cc.Changes = append(cc.Changes, raftpb.ConfChangeSingle{Type: ccType, NodeID: uint64(memb.ID)})
+		rc.Changes = append(rc.Changes, membership.ConfigChangeContext{Member: memb})
+	}
+	for _, id := range remove {
+		cc.Changes = append(cc.Changes, raftpb.ConfChangeSingle{Type: raftpb.ConfChangeRemoveNode, NodeID: id})
+		rc.Changes = append(rc.Changes, membership.ConfigChangeContext{Member: membership.Member{ID: types.ID(id)}})
+	}
+	for _, id := range promote {
+		cc.Changes = append(cc.Changes, raftpb.ConfChangeSingle{Type: raftpb.ConfChangeAddNode, NodeID: id})
+		rc.Changes = append(rc.Changes, membership.ConfigChangeContext{Member: membership.Member{ID: types.ID(id)}, IsPromote: true})
+	}
+
+	b, err := json.Marshal(rc)
+	if err != nil {
+		return nil, err
+	}
+	cc.Context = b
+	membs, err := s.configureV2(ctx, rc.ID, cc)
+	if err != nil {
+		return nil, err
+	}
+	removed := make(map[types.ID]bool)
+	for _, id := range remove {
+		removed[types.ID(id)] = true
+	}
+	var newMembs []*membership.Member
+	for _, m := range membs {
+		if !removed[m.ID] {
+			newMembs = append(newMembs, m)
+		}
+	}
+	return newMembs, nil
+}
+
+func (s *EtcdServer) mayReconfigureMembers(add []membership.Member, remove []uint64, promote []uint64) error {
+	lg := s.Logger()
+	for _, id := range promote {
+		if err := s.isLearnerReady(lg, id); err != nil {
+			return err
+		}
+	}
+
+	if !s.Cfg.StrictReconfigCheck {
+		return nil
+	}
+
+	addVoting := 0
+	for _, memb := range add {
+		if !memb.IsLearner {
+			addVoting++
+		}
+	}
+	removeIDs := make([]types.ID, len(remove))
+	removed := make(map[types.ID]bool)
+	for i, id := range remove {
+		removeIDs[i] = types.ID(id)
+		removed[types.ID(id)] = true
+	}
+	promoteIDs := make([]types.ID, len(promote))
+	for i, id := range promote {
+		promoteIDs[i] = types.ID(id)
+	}
+	if !s.cluster.IsReadyToReconfigure(addVoting, removeIDs, promoteIDs) {
+		lg.Warn(
+			"rejecting member reconfigure request; not enough healthy members",
+			zap.String("local-member-id", s.MemberID().String()),
+			zap.Int("requested-member-add", len(add)),
+			zap.Int("requested-member-remove", len(remove)),
+			zap.Int("requested-member-promote", len(promote)),
+			zap.Error(errors.ErrNotEnoughStartedMembers),
+		)
+		return errors.ErrNotEnoughStartedMembers
+	}
+
+	// the removed members may be down, as they are not part of the new quorum.
+	var kept []*membership.Member
+	for _, m := range s.cluster.VotingMembers() {
+		if !removed[m.ID] {
+			kept = append(kept, m)
+		}
+	}
+	if !isConnectedFullySince(s.r.transport, time.Now().Add(-HealthInterval), s.MemberID(), kept) {
+		lg.Warn(
+			"rejecting member reconfigure request; local member has not been connected to all peers, reconfigure breaks active quorum",
+			zap.String("local-member-id", s.MemberID().String()),
+			zap.Error(errors.ErrUnhealthy),
+		)
+		return errors.ErrUnhealthy
+	}
+
+	return nil
+}
+
+// configureV2 sends a ConfChangeV2 entering a joint configuration through
+// consensus and then waits for it to be applied to the server. It will
+// block until the change is performed or there is an error.
+func (s *EtcdServer) configureV2(ctx context.Context, id uint64, cc raftpb.ConfChangeV2) ([]*membership.Member, error) {
+	lg := s.Logger()
+	ch := s.w.Register(id)
+
+	start := time.Now()
+	if err := s.r.ProposeConfChange(ctx, cc); err != nil {
+		s.w.Trigger(id, nil)
+		return nil, err
+	}
+
+	select {
+	case x := <-ch:
+		if x == nil {
+			lg.Panic("failed to configure")
+		}
+		resp := x.(*confChangeResponse)
+		// etcdserver need to ensure the raft has already been notified
+		// or advanced before it responds to the client. Otherwise, the
+		// following config change request may be rejected.
+		// See https://github.com/etcd-io/etcd/issues/15528.
+		<-resp.raftAdvanceC
+		if resp.err != nil {
+			return nil, resp.err
+		}
+		lg.Info(
+			"applied a joint configuration change through raft",
+			zap.String("local-member-id", s.MemberID().String()),
+			zap.Int("raft-conf-changes", len(cc.Changes)),
+		)
+		return resp.membs, nil
+
+	case <-ctx.Done():
+		s.w.Trigger(id, nil) // GC wait
+		return nil, s.parseProposeCtxErr(ctx.Err(), start)
+
+	case <-s.stopping:
+		return nil, errors.ErrStopped
+	}
+}
+
+// applyConfChangeV2 applies a ConfChangeV2 to the server. It is only
+// invoked with a ConfChangeV2 that has already passed through Raft. It
+// returns the ID of the MemberReconfigure request that proposed it, which
+// is zero when leaving a joint configuration.
+//
+// The added members and the promoted learners are applied when entering the
+// joint configuration, while the removed voting members are only removed
+// when leaving it, so that the outgoing quorum stays reachable until then.
+func (s *EtcdServer) applyConfChangeV2(cc raftpb.ConfChangeV2, confState *raftpb.ConfState, shouldApplyV3 membership.ShouldApplyV3) (uint64, bool, error) {
+	lg := s.Logger()
+	// The txPostLock callback is not called when the change does not write
+	// the membership to the backend, so we set the consistent index directly.
+	defer func() {
+		if s.consistIndex != nil && membership.ApplyBoth == shouldApplyV3 {
+			applyingIndex, applyingTerm := s.consistIndex.ConsistentApplyingIndex()
+			s.consistIndex.SetConsistentIndex(applyingIndex, applyingTerm)
+		}
+	}()
+
+	if cc.LeaveJoint() {
+		outgoing := confState.VotersOutgoing
+		*confState = *s.r.ApplyConfChange(cc)
+		s.beHooks.SetConfState(confState)
+		kept := make(map[uint64]bool)
+		for _, id := range append(confState.Voters, confState.Learners...) {
+			kept[id] = true
+		}
+		removedSelf := false
+		for _, id := range outgoing {
+			if kept[id] {
+				continue
+			}
+			s.cluster.RemoveMember(types.ID(id), shouldApplyV3)
+			if types.ID(id) == s.MemberID() {
+				removedSelf = true
+				continue
+			}
+			s.r.transport.RemovePeer(types.ID(id))
+		}
+		return 0, removedSelf, nil
+	}
+
+	rc := new(membership.ReconfigureContext)
+	if err := json.Unmarshal(cc.Context, rc); err != nil {
+		lg.Panic("failed to unmarshal reconfigureContext", zap.Error(err))
+	}
+	if err := s.cluster.ValidateConfigurationChangeV2(cc); err != nil {
+		lg.Error("Validation on configuration change failed", zap.Bool("shouldApplyV3", bool(shouldApplyV3)), zap.Error(err))
+		s.r.ApplyConfChange(raftpb.ConfChange{NodeID: raft.None})
+		return rc.ID, false, err
+	}
+
+	*confState = *s.r.ApplyConfChange(cc)
+	s.beHooks.SetConfState(confState)
+	voting := make(map[uint64]bool)
+	for _, id := range confState.VotersOutgoing {
+		voting[id] = true
+	}
+	removedSelf := false
+	for i, change := range cc.Changes {
+		confChangeContext := rc.Changes[i]
+		if change.NodeID != uint64(confChangeContext.Member.ID) {
+			lg.Panic(
+				"got different member ID",
+				zap.String("member-id-from-config-change-entry", types.ID(change.NodeID).String()),
+				zap.String("member-id-from-message", confChangeContext.Member.ID.String()),
+			)
+		}
+		id := confChangeContext.Member.ID
+		switch change.Type {
+		case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode:
+			if confChangeContext.IsPromote {
+				s.cluster.PromoteMember(id, shouldApplyV3)
+			} else {
+				s.cluster.AddMember(&confChangeContext.Member, shouldApplyV3)
+				if id != s.MemberID() {
+					s.r.transport.AddPeer(id, confChangeContext.PeerURLs)
+				}
+			}
+
+		case raftpb.ConfChangeRemoveNode:
+			// the removed voting members are removed when leaving the joint configuration
+			if voting[change.NodeID] {
+				continue
+			}
+			s.cluster.RemoveMember(id, shouldApplyV3)
+			if id == s.MemberID() {
+				removedSelf = true
+				continue
+			}
+			s.r.transport.RemovePeer(id)
+		}
+	}
+	return rc.ID, removedSelf, nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// revisionTimeInterval is how often the leader records the current
+// revision in the revision time index.
+const revisionTimeInterval = time.Second
+
+// monitorRevisionTime, while the member is the leader, proposes its current
+// time every revisionTimeInterval the revision moved, so that every member
+// records the same times in the revision time index. The revision recorded
+// is the one current when the proposal is applied.
+func (s *EtcdServer) monitorRevisionTime() {
+	if s.Cfg.Witness {
+		return
+	}
+	lg := s.Logger()
+	var lastRev int64
+	for {
+		select {
+		case <-time.After(revisionTimeInterval):
+		case <-s.stopping:
+			return
+		}
+
+		if !s.isLeader() {
+			lastRev = 0
+			continue
+		}
+		// older members cannot apply the request
+		if cv := s.ClusterVersion(); cv == nil || cv.LessThan(version.V3_6) {
+			continue
+		}
+		rev := s.KV().Rev()
+		if rev == lastRev {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+		_, err := s.raftRequestOnce(ctx, pb.InternalRaftRequest{RevisionTime: &pb.RevisionTimeRequest{Timestamp: time.Now().UnixNano()}})
+		cancel()
+		if err != nil {
+			lg.Warn(
+				"failed to record the revision time",
+				zap.String("local-member-id", s.MemberID().String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		lastRev = rev
+	}
+}
+
+// applyRevisionTime records the current revision at the time proposed by
+// the leader in the revision time index. A record is skipped unless both
+// its time and its revision are past the ones of the latest record, so that
+// the index stays ordered across leaders with skewed clocks.
+func (s *EtcdServer) applyRevisionTime(r *pb.RevisionTimeRequest) {
+	t := time.Unix(0, r.Timestamp)
+	rev := s.kv.Rev()
+
+	tx := s.Backend().BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	// the bucket is missing on data dirs created before the index
+	schema.UnsafeCreateRevisionTimeBucket(tx)
+	if lastRev, last, ok := schema.UnsafeReadLatestRevisionTime(tx); ok && (rev <= lastRev || !t.After(last)) {
+		return
+	}
+	schema.UnsafeSaveRevisionTime(tx, t, rev)
+}
+
+// pruneRevisionTimes deletes the records of the revision time index that
+// only hold compacted revisions. It is called by apply after a compaction,
+// so that every member prunes the same records.
+func (s *EtcdServer) pruneRevisionTimes() {
+	tx := s.Backend().BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	schema.UnsafeCreateRevisionTimeBucket(tx)
+	schema.UnsafePruneRevisionTimes(tx, unsafeRevisionTimePruneRev(tx))
+}
+
+// unsafeRevisionTimePruneRev returns the lowest revision still readable in
+// some key range, before which the revision time index can be pruned.
+func unsafeRevisionTimePruneRev(tx backend.UnsafeReader) int64 {
+	rev, _ := mvcc.UnsafeReadScheduledCompact(tx)
+	rs, err := mvcc.UnsafeReadCompactRetention(tx)
+	if err != nil {
+		return 0
+	}
+	for _, r := range rs {
+		if r.Rev < rev {
+			rev = r.Rev
+		}
+	}
+	return rev
+}
+
+// RevisionAt returns the latest revision recorded at or before t, and the
+// time it was recorded at.
+func (s *EtcdServer) RevisionAt(t time.Time) (int64, time.Time, error) {
+	txn := s.KV().Read(mvcc.ConcurrentReadTxMode, traceutil.TODO())
+	defer txn.End()
+
+	rev, at, ok := s.revisionAt(t)
+	if !ok {
+		return 0, time.Time{}, errors.ErrRevisionTimeUnknown
+	}
+	if rev < txn.FirstRev() {
+		return 0, time.Time{}, mvcc.ErrCompacted
+	}
+	return rev, at, nil
+}
+
+// revisionAt is like RevisionAt, but also returns compacted revisions.
+func (s *EtcdServer) revisionAt(t time.Time) (int64, time.Time, bool) {
+	tx := s.Backend().ConcurrentReadTx()
+	tx.RLock()
+	defer tx.RUnlock()
+	return schema.UnsafeReadRevisionAt(tx, t)
+}
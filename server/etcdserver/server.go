@@ -240,6 +240,12 @@ type EtcdServer struct {
 	done chan struct{}
 	// leaderChanged is used to notify the linearizable read loop to drop the old read requests.
 	leaderChanged *notify.Notifier
+	// leaderLease is the lease of the leader the linearizable reads are
+	// served locally with, if LeaderLeaseReads is enabled.
+	leaderLease leaderLease
+	// leaderProgress is the progress of the leader as of its last
+	// heartbeat, for the ranges with a max staleness to be served locally.
+	leaderProgress atomic.Pointer[leaderProgress]
 
 	errorc     chan error
 	memberID   types.ID
@@ -255,6 +261,7 @@ type EtcdServer struct {
 	applyWait wait.WaitTime
 
 	kv         mvcc.WatchableKV
+	valueCodec mvcc.ValueCodec
 	lessor     lease.Lessor
 	bemu       sync.RWMutex
 	be         backend.Backend
@@ -268,6 +275,13 @@ type EtcdServer struct {
 	SyncTicker *time.Ticker
 	// compactor is used to auto-compact the KV.
 	compactor v3compactor.Compactor
+	// resumeHolds keeps the revisions of watch resume tokens from auto compaction.
+	resumeHolds *v3compactor.Holds
+	// defragNotifiers are notified around the full defragmentations.
+	defragMu        sync.Mutex
+	defragNotifiers []DefragNotifier
+	// watchStreams tracks the open watch streams to report their lag.
+	watchStreams *WatchStreams
 
 	// peerRt used to send requests (version, lease) to peers.
 	peerRt   http.RoundTripper
@@ -338,6 +352,7 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		consistIndex:          b.storage.backend.ci,
 		firstCommitInTerm:     notify.NewNotifier(),
 		clusterVersionChanged: notify.NewNotifier(),
+		watchStreams:          newWatchStreams(),
 	}
 	serverID.With(prometheus.Labels{"server_id": b.cluster.nodeID.String()}).Set(1)
 	srv.cluster.SetVersionChangedNotifier(srv.clusterVersionChanged)
@@ -366,9 +381,20 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		return nil, err
 	}
 
+	srv.valueCodec, err = mvcc.ParseValueCodec(cfg.ValueCompression)
+	if err != nil {
+		return nil, err
+	}
+	if srv.valueCodec != mvcc.ValueCodecNone {
+		// etcd versions before v3.6 cannot read the compressed values
+		if err = schema.RaiseStorageVersion(cfg.Logger, srv.be.BatchTx(), version.V3_6); err != nil {
+			return nil, err
+		}
+	}
 	mvccStoreConfig := mvcc.StoreConfig{
 		CompactionBatchLimit:    cfg.CompactionBatchLimit,
 		CompactionSleepInterval: cfg.CompactionSleepInterval,
+		ValueCompression:        srv.valueCodec,
 	}
 	srv.kv = mvcc.New(srv.Logger(), srv.be, srv.lessor, mvccStoreConfig)
 	srv.corruptionChecker = newCorruptionChecker(cfg.Logger, srv, srv.kv.HashStorage())
@@ -383,8 +409,13 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 			newSrv.kv.Close()
 		}
 	}()
+	var compactable v3compactor.Compactable = srv
+	if cfg.WatchResumeRetention > 0 {
+		srv.resumeHolds = v3compactor.NewHolds(cfg.WatchResumeRetention)
+		compactable = srv.resumeHolds.Compactable(srv)
+	}
 	if num := cfg.AutoCompactionRetention; num != 0 {
-		srv.compactor, err = v3compactor.New(cfg.Logger, cfg.AutoCompactionMode, num, srv.kv, srv)
+		srv.compactor, err = v3compactor.New(cfg.Logger, cfg.AutoCompactionMode, num, srv.kv, compactable)
 		if err != nil {
 			return nil, err
 		}
@@ -545,6 +576,12 @@ func (s *EtcdServer) Start() {
 	s.GoAttach(s.monitorKVHash)
 	s.GoAttach(s.monitorCompactHash)
 	s.GoAttach(s.monitorDowngrade)
+	s.GoAttach(s.monitorAutoDefrag)
+	s.GoAttach(s.monitorLearnerAutoPromote)
+	s.GoAttach(s.monitorWatchResumeHolds)
+	s.GoAttach(s.monitorWitnessLeadership)
+	s.GoAttach(s.monitorRevisionTime)
+	s.GoAttach(s.monitorCompactionRetentionPolicy)
 }
 
 // start prepares and starts server in a new goroutine. It is no longer safe to
@@ -658,6 +695,7 @@ type ServerPeerV2 interface {
 	ServerPeer
 	HashKVHandler() http.Handler
 	DowngradeEnabledHandler() http.Handler
+	WatchResumeHoldsHandler() http.Handler
 }
 
 func (s *EtcdServer) DowngradeInfo() *serverversion.DowngradeInfo { return s.cluster.DowngradeInfo() }
@@ -727,6 +765,15 @@ func (s *EtcdServer) Process(ctx context.Context, m raftpb.Message) error {
 	if m.Type == raftpb.MsgApp {
 		s.stats.RecvAppendReq(types.ID(m.From).String(), m.Size())
 	}
+	if m.Type == raftpb.MsgTimeoutNow && s.Cfg.Witness {
+		lg.Warn(
+			"ignored leadership transfer to witness",
+			zap.String("local-member-id", s.MemberID().String()),
+			zap.String("from", types.ID(m.From).String()),
+		)
+		return nil
+	}
+	s.observeLeaderProgress(m)
 	return s.r.Step(ctx, m)
 }
 
@@ -755,6 +802,8 @@ type raftReadyHandler struct {
 	updateLead           func(lead uint64)
 	updateLeadership     func(newLeader bool)
 	updateCommittedIndex func(uint64)
+	updateLeaderCommit   func(uint64)
+	getLeaderProgress    func() (commit uint64, rev int64)
 }
 
 func (s *EtcdServer) run() {
@@ -785,8 +834,12 @@ func (s *EtcdServer) run() {
 					s.leadTimeMu.Lock()
 					s.leadElectedTime = t
 					s.leadTimeMu.Unlock()
+					if s.resumeHolds != nil {
+						s.resumeHolds.ResetPeers()
+					}
 				}
-				if s.compactor != nil {
+				// a witness has no key-value data to compact.
+				if s.compactor != nil && !s.Cfg.Witness {
 					s.compactor.Resume()
 				}
 			}
@@ -805,6 +858,14 @@ func (s *EtcdServer) run() {
 				s.setCommittedIndex(ci)
 			}
 		},
+		updateLeaderCommit: func(ci uint64) { s.leaderLease.commitIndex.Store(ci) },
+		getLeaderProgress: func() (uint64, int64) {
+			if s.Cfg.Witness {
+				// the revision of a witness is not the one of the cluster.
+				return 0, 0
+			}
+			return s.leaderLease.commitIndex.Load(), s.KV().Rev()
+		},
 	}
 	s.r.start(rh)
 
@@ -983,6 +1044,18 @@ func (s *EtcdServer) applyAll(ep *etcdProgress, apply *toApply) {
 	select {
 	// snapshot requested via send()
 	case m := <-s.r.msgSnapC:
+		if s.isWitnessMember(types.ID(m.To)) {
+			// witnesses do not keep the key-value data, so they are sent
+			// the raft snapshot alone.
+			s.r.transport.Send([]raftpb.Message{s.createWitnessSnapshotMessage(m, ep.appliedt, ep.appliedi, ep.confState)})
+			break
+		}
+		if s.Cfg.Witness {
+			// a witness leads only until it transfers the leadership, and has
+			// no key-value data to send to the full members.
+			s.ReportSnapshot(m.To, raft.SnapshotFailure)
+			break
+		}
 		merged := s.createMergedSnapshotMessage(m, ep.appliedt, ep.appliedi, ep.confState)
 		s.sendMergedSnap(merged)
 	default:
@@ -1028,7 +1101,13 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, toApply *toApply) {
 	<-toApply.notifyc
 
 	// gofail: var applyBeforeOpenSnapshot struct{}
-	newbe, err := serverstorage.OpenSnapshotBackend(s.Cfg, s.snapshotter, toApply.snapshot, s.beHooks)
+	var newbe backend.Backend
+	var err error
+	if s.Cfg.Witness {
+		newbe, err = openWitnessSnapshotBackend(s.Cfg, toApply.snapshot, s.beHooks)
+	} else {
+		newbe, err = serverstorage.OpenSnapshotBackend(s.Cfg, s.snapshotter, toApply.snapshot, s.beHooks)
+	}
 	if err != nil {
 		lg.Panic("failed to open snapshot backend", zap.Error(err))
 	}
@@ -1039,6 +1118,9 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, toApply *toApply) {
 	// Eventually the new consistent_index value coming from snapshot is overwritten
 	// by the old value.
 	s.consistIndex.SetBackend(newbe)
+	if s.Cfg.Witness {
+		s.consistIndex.SetConsistentIndex(toApply.snapshot.Metadata.Index, toApply.snapshot.Metadata.Term)
+	}
 	verifySnapshotIndex(toApply.snapshot, s.consistIndex.ConsistentIndex())
 
 	// always recover lessor before kv. When we recover the mvcc.KV it will reattach keys to its leases.
@@ -1142,7 +1224,7 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, toApply *toApply) {
 
 func (s *EtcdServer) NewUberApplier() apply.UberApplier {
 	return apply.NewUberApplier(s.lg, s.be, s.KV(), s.alarmStore, s.authStore, s.lessor, s.cluster, s, s, s.consistIndex,
-		s.Cfg.WarningApplyDuration, s.Cfg.ExperimentalTxnModeWriteWithSharedBuffer, s.Cfg.QuotaBackendBytes)
+		s.Cfg.WarningApplyDuration, s.Cfg.ExperimentalTxnModeWriteWithSharedBuffer, s.Cfg.QuotaBackendBytes, s.valueCodec)
 }
 
 func verifySnapshotIndex(snapshot raftpb.Snapshot, cindex uint64) {
@@ -1225,7 +1307,7 @@ func (s *EtcdServer) isLeader() bool {
 // MoveLeader transfers the leader to the given transferee.
 func (s *EtcdServer) MoveLeader(ctx context.Context, lead, transferee uint64) error {
 	member := s.cluster.Member(types.ID(transferee))
-	if member == nil || member.IsLearner {
+	if member == nil || member.IsLearner || member.IsWitness {
 		return errors.ErrBadLeaderTransferee
 	}
 
@@ -1240,6 +1322,8 @@ func (s *EtcdServer) MoveLeader(ctx context.Context, lead, transferee uint64) er
 		zap.String("transferee-member-id", types.ID(transferee).String()),
 	)
 
+	unblock := s.blockLeaderLease()
+	defer unblock()
 	s.r.TransferLeadership(ctx, lead, transferee)
 	for s.Lead() != transferee {
 		select {
@@ -1281,7 +1365,13 @@ func (s *EtcdServer) TryTransferLeadershipOnShutdown() error {
 		return nil
 	}
 
-	transferee, ok := longestConnected(s.r.transport, s.cluster.VotingMemberIDs())
+	var candidates []types.ID
+	for _, m := range s.cluster.VotingMembers() {
+		if !m.IsWitness {
+			candidates = append(candidates, m.ID)
+		}
+	}
+	transferee, ok := longestConnected(s.r.transport, candidates)
 	if !ok {
 		return errors.ErrUnhealthy
 	}
@@ -1488,7 +1578,12 @@ func (s *EtcdServer) promoteMember(ctx context.Context, id uint64) ([]*membershi
 	if err := s.checkMembershipOperationPermission(ctx); err != nil {
 		return nil, err
 	}
+	return s.promoteLearner(ctx, id)
+}
 
+// promoteLearner sends the promote request to raft once mayPromoteMember
+// allows it, without checking the permission of the caller.
+func (s *EtcdServer) promoteLearner(ctx context.Context, id uint64) ([]*membership.Member, error) {
 	// check if we can promote this learner.
 	if err := s.mayPromoteMember(types.ID(id)); err != nil {
 		return nil, err
@@ -1899,10 +1994,19 @@ func (s *EtcdServer) apply(
 			shouldStop = shouldStop || removedSelf
 			s.w.Trigger(cc.ID, &confChangeResponse{s.cluster.Members(), raftAdvancedC, err})
 
+		case raftpb.EntryConfChangeV2:
+			var cc raftpb.ConfChangeV2
+			pbutil.MustUnmarshal(&cc, e.Data)
+			id, removedSelf, err := s.applyConfChangeV2(cc, confState, shouldApplyV3)
+			s.setAppliedIndex(e.Index)
+			s.setTerm(e.Term)
+			shouldStop = shouldStop || removedSelf
+			s.w.Trigger(id, &confChangeResponse{s.cluster.Members(), raftAdvancedC, err})
+
 		default:
 			lg := s.Logger()
 			lg.Panic(
-				"unknown entry type; must be either EntryNormal or EntryConfChange",
+				"unknown entry type; must be either EntryNormal, EntryConfChange or EntryConfChangeV2",
 				zap.String("type", e.Type.String()),
 			)
 		}
@@ -2007,11 +2111,51 @@ func (s *EtcdServer) applyEntryNormal(e *raftpb.Entry, shouldApplyV3 membership.
 }
 
 func (s *EtcdServer) applyInternalRaftRequest(r *pb.InternalRaftRequest, shouldApplyV3 membership.ShouldApplyV3) *apply.Result {
+	if r.RevisionTime != nil {
+		if !shouldApplyV3 {
+			return nil
+		}
+		if s.Cfg.Witness {
+			// witnesses keep no key-value data to index.
+			return nil
+		}
+		s.applyRevisionTime(r.RevisionTime)
+		return &apply.Result{}
+	}
+	if r.CompactionRetentionPolicy != nil {
+		if !shouldApplyV3 {
+			return nil
+		}
+		if s.Cfg.Witness {
+			// witnesses never compact.
+			return nil
+		}
+		s.applyCompactionRetentionPolicy(r.CompactionRetentionPolicy)
+		return &apply.Result{}
+	}
+	if r.AutoDefragLock != nil {
+		if !shouldApplyV3 {
+			return nil
+		}
+		if s.Cfg.Witness {
+			// witnesses never defragment automatically.
+			return nil
+		}
+		return &apply.Result{Err: s.applyAutoDefragLock(r.AutoDefragLock)}
+	}
 	if r.ClusterVersionSet == nil && r.ClusterMemberAttrSet == nil && r.DowngradeInfoSet == nil {
 		if !shouldApplyV3 {
 			return nil
 		}
-		return s.uberApply.Apply(r)
+		if s.Cfg.Witness {
+			// witnesses only apply the membership.
+			return nil
+		}
+		ar := s.uberApply.Apply(r)
+		if r.Compaction != nil && ar.Err == nil {
+			s.pruneRevisionTimes()
+		}
+		return ar
 	}
 	membershipApplier := apply.NewApplierMembership(s.lg, s.cluster, s)
 	op := "unknown"
@@ -2424,6 +2568,10 @@ func (s *EtcdServer) parseProposeCtxErr(err error, start time.Time) error {
 }
 
 func (s *EtcdServer) KV() mvcc.WatchableKV { return s.kv }
+
+// ValueCodec returns the codec the values of the keys are compressed with.
+func (s *EtcdServer) ValueCodec() mvcc.ValueCodec { return s.valueCodec }
+
 func (s *EtcdServer) Backend() backend.Backend {
 	s.bemu.RLock()
 	defer s.bemu.RUnlock()
@@ -961,6 +961,28 @@ func TestProcessIgnoreMismatchMessage(t *testing.T) {
 	}
 }
 
+// TestReconfigureMembersClusterVersion ensures members are not reconfigured
+// before the cluster version is 3.6.
+func TestReconfigureMembersClusterVersion(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	cl := newTestCluster(t)
+	cl.AddMember(&membership.Member{ID: 1234}, true)
+	s := &EtcdServer{
+		lgMu:    new(sync.RWMutex),
+		lg:      lg,
+		cluster: cl,
+	}
+	for _, ver := range []*semver.Version{nil, &version.V3_5} {
+		if ver != nil {
+			cl.SetVersion(ver, api.UpdateCapability, membership.ApplyBoth)
+		}
+		_, err := s.ReconfigureMembers(context.Background(), nil, []uint64{1234}, nil)
+		if !errorspkg.Is(err, errors.ErrReconfigureUnsupported) {
+			t.Errorf("cluster version %v: err = %v, want %v", ver, err, errors.ErrReconfigureUnsupported)
+		}
+	}
+}
+
 // TestRemoveMember tests RemoveMember can propose and perform node removal.
 func TestRemoveMember(t *testing.T) {
 	lg := zaptest.NewLogger(t)
@@ -0,0 +1,104 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// leaderProgress is the progress of the leader, as piggybacked on its last
+// heartbeat to the member.
+type leaderProgress struct {
+	// commit is the raft commit index of the leader.
+	commit uint64
+	// rev is the current revision of the leader.
+	rev int64
+	// receivedAt is the time the heartbeat was received at.
+	receivedAt time.Time
+}
+
+// piggybackLeaderProgress sets the commit index and the current revision of
+// the leader on its heartbeats, so that the followers know how far behind
+// they are. raft ignores the Index and LogTerm fields of heartbeats.
+func piggybackLeaderProgress(ms []raftpb.Message, rh *raftReadyHandler) {
+	var commit uint64
+	var rev int64
+	for i := range ms {
+		if ms[i].Type != raftpb.MsgHeartbeat {
+			continue
+		}
+		if commit == 0 {
+			commit, rev = rh.getLeaderProgress()
+		}
+		ms[i].Index = commit
+		ms[i].LogTerm = uint64(rev)
+	}
+}
+
+// observeLeaderProgress records the progress of the leader piggybacked on a
+// heartbeat. Heartbeats from members other than the known leader are
+// ignored, as a deposed leader may not know the latest commit.
+func (s *EtcdServer) observeLeaderProgress(m raftpb.Message) {
+	if m.Type != raftpb.MsgHeartbeat || m.Index == 0 || m.From != s.Lead() {
+		return
+	}
+	s.leaderProgress.Store(&leaderProgress{commit: m.Index, rev: int64(m.LogTerm), receivedAt: time.Now()})
+}
+
+// boundedStalenessReadNotify waits for the member to be within the given
+// staleness bound of the leader. On the leader, or when the bound cannot be
+// met in time, it falls back to a linearizable read.
+func (s *EtcdServer) boundedStalenessReadNotify(ctx context.Context, st *pb.Staleness) error {
+	p := s.leaderProgress.Load()
+	if s.isLeader() || p == nil {
+		boundedStalenessReads.WithLabelValues("forward").Inc()
+		return s.linearizableReadNotify(ctx)
+	}
+
+	// the leader keeps writing after its heartbeat, so its revision is only
+	// trusted until the next heartbeat is due, one heartbeat interval later.
+	since := time.Since(p.receivedAt)
+	if st.Revisions > 0 && since < time.Duration(s.Cfg.TickMs)*time.Millisecond && p.rev-s.KV().Rev() <= st.Revisions {
+		boundedStalenessReads.WithLabelValues("local").Inc()
+		return nil
+	}
+
+	if maxStaleness := time.Duration(st.Milliseconds) * time.Millisecond; since < maxStaleness {
+		if s.getAppliedIndex() >= p.commit {
+			boundedStalenessReads.WithLabelValues("local").Inc()
+			return nil
+		}
+		timer := time.NewTimer(maxStaleness - since)
+		defer timer.Stop()
+		select {
+		case <-s.applyWait.Wait(p.commit):
+			boundedStalenessReads.WithLabelValues("wait").Inc()
+			return nil
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.done:
+			return errors.ErrStopped
+		}
+	}
+
+	boundedStalenessReads.WithLabelValues("forward").Inc()
+	return s.linearizableReadNotify(ctx)
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestLeaderProgressPiggyback(t *testing.T) {
+	rh := &raftReadyHandler{getLeaderProgress: func() (uint64, int64) { return 42, 7 }}
+	ms := []raftpb.Message{
+		{Type: raftpb.MsgApp, From: 1, To: 2, Index: 10, LogTerm: 2},
+		{Type: raftpb.MsgHeartbeat, From: 1, To: 2, Commit: 40},
+	}
+	piggybackLeaderProgress(ms, rh)
+	assert.Equal(t, raftpb.Message{Type: raftpb.MsgApp, From: 1, To: 2, Index: 10, LogTerm: 2}, ms[0])
+	assert.Equal(t, raftpb.Message{Type: raftpb.MsgHeartbeat, From: 1, To: 2, Commit: 40, Index: 42, LogTerm: 7}, ms[1])
+
+	s := &EtcdServer{memberID: 2, lead: 3}
+	s.observeLeaderProgress(ms[1])
+	assert.Nil(t, s.leaderProgress.Load(), "heartbeats from a member other than the leader must be ignored")
+	s.lead = 1
+	s.observeLeaderProgress(ms[0])
+	assert.Nil(t, s.leaderProgress.Load())
+	s.observeLeaderProgress(ms[1])
+	p := s.leaderProgress.Load()
+	require.NotNil(t, p)
+	assert.Equal(t, uint64(42), p.commit)
+	assert.Equal(t, int64(7), p.rev)
+}
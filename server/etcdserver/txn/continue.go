@@ -0,0 +1,83 @@
+// Copyright 2024 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+)
+
+// continueTokenV1 marks the first continue token encoding:
+//
+//	version (1 byte) | pinned revision (uvarint) | resume key
+//
+// Tokens are opaque to clients, the version byte lets the encoding
+// evolve without misinterpreting tokens issued by older members.
+const continueTokenV1 byte = 1
+
+func encodeContinueToken(rev int64, resumeKey []byte) []byte {
+	tok := make([]byte, 1+binary.MaxVarintLen64, 1+binary.MaxVarintLen64+len(resumeKey))
+	tok[0] = continueTokenV1
+	n := binary.PutUvarint(tok[1:], uint64(rev))
+	return append(tok[:1+n], resumeKey...)
+}
+
+func decodeContinueToken(tok []byte) (rev int64, resumeKey []byte, err error) {
+	if len(tok) < 2 || tok[0] != continueTokenV1 {
+		return 0, nil, errors.ErrInvalidContinueToken
+	}
+	r, n := binary.Uvarint(tok[1:])
+	if n <= 0 || r == 0 || r > math.MaxInt64 || len(tok) == 1+n {
+		return 0, nil, errors.ErrInvalidContinueToken
+	}
+	return int64(r), tok[1+n:], nil
+}
+
+// isKeyOrdered returns true if the range response is sorted by key in
+// ascending order, which is the only order a continue token can resume.
+func isKeyOrdered(r *pb.RangeRequest) bool {
+	return r.SortTarget == pb.RangeRequest_KEY && r.SortOrder != pb.RangeRequest_DESCEND
+}
+
+// rangeStart returns the key and revision the range request starts at,
+// resolving its continue token if one is given.
+func rangeStart(r *pb.RangeRequest) (key []byte, rev int64, err error) {
+	if len(r.ContinueToken) == 0 {
+		return r.Key, r.Revision, nil
+	}
+	if len(r.RangeEnd) == 0 || !isKeyOrdered(r) {
+		return nil, 0, errors.ErrInvalidContinueToken
+	}
+	rev, key, err = decodeContinueToken(r.ContinueToken)
+	if err != nil {
+		return nil, 0, err
+	}
+	if r.Revision > 0 && r.Revision != rev {
+		return nil, 0, errors.ErrInvalidContinueToken
+	}
+	// The resume key must stay within the requested range, so that the
+	// permission check on [key, range_end) still covers the request.
+	if bytes.Compare(key, r.Key) < 0 {
+		return nil, 0, errors.ErrInvalidContinueToken
+	}
+	if end := mkGteRange(r.RangeEnd); len(end) > 0 && bytes.Compare(key, end) >= 0 {
+		return nil, 0, errors.ErrInvalidContinueToken
+	}
+	return key, rev, nil
+}
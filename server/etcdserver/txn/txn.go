@@ -149,14 +149,58 @@ func Range(ctx context.Context, lg *zap.Logger, kv mvcc.KV, r *pb.RangeRequest)
 	return resp, trace, err
 }
 
+// MultiRange serves all ranges of the request from a single read transaction,
+// so that every range observes the same revision.
+func MultiRange(ctx context.Context, lg *zap.Logger, kv mvcc.KV, r *pb.MultiRangeRequest) (resp *pb.MultiRangeResponse, trace *traceutil.Trace, err error) {
+	trace = traceutil.Get(ctx)
+	if trace.IsEmpty() {
+		trace = traceutil.New("multi_range", lg)
+		ctx = context.WithValue(ctx, traceutil.TraceKey{}, trace)
+	}
+	defer func(start time.Time) {
+		success := err == nil
+		RangeSecObserve(success, time.Since(start))
+	}(time.Now())
+	txnRead := kv.Read(mvcc.ConcurrentReadTxMode, trace)
+	defer txnRead.End()
+
+	rev := r.Revision
+	if rev <= 0 {
+		rev = txnRead.Rev()
+	}
+	resp = &pb.MultiRangeResponse{
+		Header:    &pb.ResponseHeader{Revision: txnRead.Rev()},
+		Responses: make([]*pb.RangeResponse, 0, len(r.Ranges)),
+	}
+	for _, rr := range r.Ranges {
+		req := *rr
+		req.Revision = rev
+		var rresp *pb.RangeResponse
+		rresp, err = executeRange(ctx, lg, txnRead, &req)
+		if err != nil {
+			return nil, trace, err
+		}
+		resp.Responses = append(resp.Responses, rresp)
+	}
+	return resp, trace, nil
+}
+
 func executeRange(ctx context.Context, lg *zap.Logger, txnRead mvcc.TxnRead, r *pb.RangeRequest) (*pb.RangeResponse, error) {
 	trace := traceutil.Get(ctx)
 
 	resp := &pb.RangeResponse{}
 	resp.Header = &pb.ResponseHeader{}
 
+	key, rev, err := rangeStart(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// the index can serve keys in descending order directly
+	reverse := r.SortTarget == pb.RangeRequest_KEY && r.SortOrder == pb.RangeRequest_DESCEND
+
 	limit := r.Limit
-	if r.SortOrder != pb.RangeRequest_NONE ||
+	if (r.SortOrder != pb.RangeRequest_NONE && !reverse) ||
 		r.MinModRevision != 0 || r.MaxModRevision != 0 ||
 		r.MinCreateRevision != 0 || r.MaxCreateRevision != 0 {
 		// fetch everything; sort and truncate afterwards
@@ -168,12 +212,18 @@ func executeRange(ctx context.Context, lg *zap.Logger, txnRead mvcc.TxnRead, r *
 	}
 
 	ro := mvcc.RangeOptions{
-		Limit: limit,
-		Rev:   r.Revision,
-		Count: r.CountOnly,
+		Limit:   limit,
+		Rev:     rev,
+		Count:   r.CountOnly,
+		Filter:  rangeFilter(r.Filters),
+		Reverse: reverse,
+	}
+	if r.SortTarget != pb.RangeRequest_VALUE {
+		// values are dropped after sorting when sorted by value
+		ro.KeysOnly = r.KeysOnly
 	}
 
-	rr, err := txnRead.Range(ctx, r.Key, mkGteRange(r.RangeEnd), ro)
+	rr, err := txnRead.Range(ctx, key, mkGteRange(r.RangeEnd), ro)
 	if err != nil {
 		return nil, err
 	}
@@ -206,6 +256,10 @@ func executeRange(ctx context.Context, lg *zap.Logger, txnRead mvcc.TxnRead, r *
 		// sorted by keys in lexiographically ascending order,
 		// don't re-sort when target is 'KEY' and order is ASCEND
 		sortOrder = pb.RangeRequest_NONE
+	} else if reverse {
+		// The mvcc.Range already returned the results in
+		// descending key order, so don't re-sort either
+		sortOrder = pb.RangeRequest_NONE
 	}
 	if sortOrder != pb.RangeRequest_NONE {
 		var sorter sort.Interface
@@ -234,6 +288,16 @@ func executeRange(ctx context.Context, lg *zap.Logger, txnRead mvcc.TxnRead, r *
 	if r.Limit > 0 && len(rr.KVs) > int(r.Limit) {
 		rr.KVs = rr.KVs[:r.Limit]
 		resp.More = true
+		if isKeyOrdered(r) {
+			if rev <= 0 {
+				rev = rr.Rev
+			}
+			// resume right after the last returned key
+			lastKey := rr.KVs[len(rr.KVs)-1].Key
+			resumeKey := make([]byte, len(lastKey)+1)
+			copy(resumeKey, lastKey)
+			resp.ContinueToken = encodeContinueToken(rev, resumeKey)
+		}
 	}
 	trace.Step("filter and sort the key-value pairs")
 	resp.Header.Revision = rr.Rev
@@ -429,12 +493,16 @@ func checkPut(rv mvcc.ReadView, lessor lease.Lessor, req *pb.PutRequest) error {
 }
 
 func checkRange(rv mvcc.ReadView, req *pb.RangeRequest) error {
+	key, rev, err := rangeStart(req)
+	if err != nil {
+		return err
+	}
 	switch {
-	case req.Revision == 0:
+	case rev == 0:
 		return nil
-	case req.Revision > rv.Rev():
+	case rev > rv.Rev():
 		return mvcc.ErrFutureRev
-	case req.Revision < rv.FirstRev():
+	case rev < rv.FirstRangeRev(key, mkGteRange(req.RangeEnd)):
 		return mvcc.ErrCompacted
 	}
 	return nil
@@ -638,6 +706,42 @@ func compareKV(c *pb.Compare, ckv mvccpb.KeyValue) bool {
 	return true
 }
 
+// rangeFilter returns a predicate matching the key-value pairs that satisfy
+// all given filters, or nil if there are no filters.
+func rangeFilter(filters []*pb.RangeFilter) func(*mvccpb.KeyValue) bool {
+	if len(filters) == 0 {
+		return nil
+	}
+	return func(kv *mvccpb.KeyValue) bool {
+		for _, f := range filters {
+			if !matchRangeFilter(f, kv) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func matchRangeFilter(f *pb.RangeFilter, kv *mvccpb.KeyValue) bool {
+	if f.Result == pb.RangeFilter_HAS_PREFIX {
+		return f.Target == pb.Compare_VALUE && bytes.HasPrefix(kv.Value, f.GetValue())
+	}
+	c := &pb.Compare{Result: pb.Compare_CompareResult(f.Result), Target: f.Target}
+	switch tv := f.TargetUnion.(type) {
+	case *pb.RangeFilter_Version:
+		c.TargetUnion = &pb.Compare_Version{Version: tv.Version}
+	case *pb.RangeFilter_CreateRevision:
+		c.TargetUnion = &pb.Compare_CreateRevision{CreateRevision: tv.CreateRevision}
+	case *pb.RangeFilter_ModRevision:
+		c.TargetUnion = &pb.Compare_ModRevision{ModRevision: tv.ModRevision}
+	case *pb.RangeFilter_Value:
+		c.TargetUnion = &pb.Compare_Value{Value: tv.Value}
+	case *pb.RangeFilter_Lease:
+		c.TargetUnion = &pb.Compare_Lease{Lease: tv.Lease}
+	}
+	return compareKV(c, *kv)
+}
+
 func IsTxnSerializable(r *pb.TxnRequest) bool {
 	for _, u := range r.Success {
 		if r := u.GetRequestRange(); r == nil || !r.Serializable {
@@ -28,6 +28,7 @@ import (
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/pkg/v3/traceutil"
 	"go.etcd.io/etcd/server/v3/auth"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
 	"go.etcd.io/etcd/server/v3/lease"
 	"go.etcd.io/etcd/server/v3/storage/backend"
 	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
@@ -649,3 +650,242 @@ var (
 		},
 	}
 )
+
+func TestRangeContinueToken(t *testing.T) {
+	s, _ := setup(t, testSetup{})
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		s.Put([]byte(k), []byte("v1"), lease.NoLease)
+	}
+	lg := zaptest.NewLogger(t)
+	ctx := context.Background()
+
+	req := &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 2}
+	resp, _, err := Range(ctx, lg, s, req)
+	require.NoError(t, err)
+	require.True(t, resp.More)
+	require.NotEmpty(t, resp.ContinueToken)
+	pinned := resp.Header.Revision
+
+	// writes after the first page must not be visible in the following pages
+	s.Put([]byte("c"), []byte("v2"), lease.NoLease)
+	s.Put([]byte("bb"), []byte("v2"), lease.NoLease)
+
+	var keys []string
+	for _, kv := range resp.Kvs {
+		keys = append(keys, string(kv.Key))
+	}
+	for resp.More {
+		req.ContinueToken = resp.ContinueToken
+		resp, _, err = Range(ctx, lg, s, req)
+		require.NoError(t, err)
+		for _, kv := range resp.Kvs {
+			keys = append(keys, string(kv.Key))
+			assert.Equal(t, "v1", string(kv.Value))
+			assert.LessOrEqual(t, kv.ModRevision, pinned)
+		}
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, keys)
+	assert.Empty(t, resp.ContinueToken)
+
+	tok := encodeContinueToken(pinned, []byte("c"))
+	invalid := []*pb.RangeRequest{
+		{Key: []byte("a"), RangeEnd: []byte("z"), ContinueToken: []byte("garbage")},
+		{Key: []byte("a"), RangeEnd: []byte("z"), ContinueToken: tok, Revision: pinned + 1},
+		{Key: []byte("d"), RangeEnd: []byte("z"), ContinueToken: tok},
+		{Key: []byte("a"), RangeEnd: []byte("c"), ContinueToken: tok},
+		{Key: []byte("a"), ContinueToken: tok},
+		{Key: []byte("a"), RangeEnd: []byte("z"), ContinueToken: tok, SortOrder: pb.RangeRequest_DESCEND},
+		{Key: []byte("a"), RangeEnd: []byte("z"), ContinueToken: tok, SortTarget: pb.RangeRequest_MOD},
+	}
+	for _, r := range invalid {
+		_, _, err = Range(ctx, lg, s, r)
+		require.ErrorIs(t, err, errors.ErrInvalidContinueToken)
+		_, err = checkTxn(s, &pb.TxnRequest{Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestRange{RequestRange: r}}}}, nil, []bool{true})
+		require.ErrorIs(t, err, errors.ErrInvalidContinueToken)
+	}
+
+	s.Compact(traceutil.TODO(), pinned+1)
+	_, _, err = Range(ctx, lg, s, &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), ContinueToken: tok})
+	require.ErrorIs(t, err, mvcc.ErrCompacted)
+}
+
+func TestRangeFilters(t *testing.T) {
+	s, lessor := setup(t, testSetup{lease: 1})
+	require.NoError(t, lessor.Attach(1, nil))
+	s.Put([]byte("a"), []byte("json:1"), lease.NoLease)
+	s.Put([]byte("b"), []byte("yaml:1"), 1)
+	s.Put([]byte("c"), []byte("json:2"), 1)
+	s.Put([]byte("c"), []byte("json:3"), 1)
+
+	tests := []struct {
+		name      string
+		filters   []*pb.RangeFilter
+		limit     int64
+		keysOnly  bool
+		wantKeys  []string
+		wantCount int64
+		wantMore  bool
+	}{
+		{
+			name:      "value prefix",
+			filters:   []*pb.RangeFilter{{Result: pb.RangeFilter_HAS_PREFIX, Target: pb.Compare_VALUE, TargetUnion: &pb.RangeFilter_Value{Value: []byte("json:")}}},
+			wantKeys:  []string{"a", "c"},
+			wantCount: 2,
+		},
+		{
+			name:      "lease",
+			filters:   []*pb.RangeFilter{{Result: pb.RangeFilter_EQUAL, Target: pb.Compare_LEASE, TargetUnion: &pb.RangeFilter_Lease{Lease: 1}}},
+			wantKeys:  []string{"b", "c"},
+			wantCount: 2,
+		},
+		{
+			name:      "version",
+			filters:   []*pb.RangeFilter{{Result: pb.RangeFilter_GREATER, Target: pb.Compare_VERSION, TargetUnion: &pb.RangeFilter_Version{Version: 1}}},
+			wantKeys:  []string{"c"},
+			wantCount: 1,
+		},
+		{
+			name: "all filters must match",
+			filters: []*pb.RangeFilter{
+				{Result: pb.RangeFilter_HAS_PREFIX, Target: pb.Compare_VALUE, TargetUnion: &pb.RangeFilter_Value{Value: []byte("json:")}},
+				{Result: pb.RangeFilter_NOT_EQUAL, Target: pb.Compare_LEASE, TargetUnion: &pb.RangeFilter_Lease{Lease: 1}},
+			},
+			wantKeys:  []string{"a"},
+			wantCount: 1,
+		},
+		{
+			name:      "limit applies after filtering",
+			filters:   []*pb.RangeFilter{{Result: pb.RangeFilter_EQUAL, Target: pb.Compare_LEASE, TargetUnion: &pb.RangeFilter_Lease{Lease: 1}}},
+			limit:     1,
+			wantKeys:  []string{"b"},
+			wantCount: 2,
+			wantMore:  true,
+		},
+		{
+			name:      "keys only evaluates value filters",
+			filters:   []*pb.RangeFilter{{Result: pb.RangeFilter_HAS_PREFIX, Target: pb.Compare_VALUE, TargetUnion: &pb.RangeFilter_Value{Value: []byte("yaml:")}}},
+			keysOnly:  true,
+			wantKeys:  []string{"b"},
+			wantCount: 1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Filters: tc.filters, Limit: tc.limit, KeysOnly: tc.keysOnly}
+			resp, _, err := Range(context.Background(), zaptest.NewLogger(t), s, req)
+			require.NoError(t, err)
+			var keys []string
+			for _, kv := range resp.Kvs {
+				keys = append(keys, string(kv.Key))
+				if tc.keysOnly {
+					assert.Nil(t, kv.Value)
+				}
+			}
+			assert.Equal(t, tc.wantKeys, keys)
+			assert.Equal(t, tc.wantCount, resp.Count)
+			assert.Equal(t, tc.wantMore, resp.More)
+		})
+	}
+}
+
+func TestMultiRange(t *testing.T) {
+	s, _ := setup(t, testSetup{})
+	s.Put([]byte("a/1"), []byte("v1"), lease.NoLease)
+	s.Put([]byte("b/1"), []byte("v1"), lease.NoLease)
+	s.Put([]byte("b/2"), []byte("v1"), lease.NoLease)
+	rev := s.Put([]byte("a/1"), []byte("v2"), lease.NoLease)
+	lg := zaptest.NewLogger(t)
+	ctx := context.Background()
+
+	req := &pb.MultiRangeRequest{Ranges: []*pb.RangeRequest{
+		{Key: []byte("a/"), RangeEnd: []byte("a0")},
+		{Key: []byte("b/"), RangeEnd: []byte("b0"), CountOnly: true},
+		{Key: []byte("c/"), RangeEnd: []byte("c0")},
+	}}
+	resp, _, err := MultiRange(ctx, lg, s, req)
+	require.NoError(t, err)
+	assert.Equal(t, rev, resp.Header.Revision)
+	require.Len(t, resp.Responses, 3)
+	require.Len(t, resp.Responses[0].Kvs, 1)
+	assert.Equal(t, "v2", string(resp.Responses[0].Kvs[0].Value))
+	assert.Equal(t, int64(2), resp.Responses[1].Count)
+	assert.Empty(t, resp.Responses[2].Kvs)
+
+	// every range is served at the requested revision
+	req.Revision = rev - 1
+	resp, _, err = MultiRange(ctx, lg, s, req)
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(resp.Responses[0].Kvs[0].Value))
+	assert.Equal(t, int64(2), resp.Responses[1].Count)
+
+	req.Revision = rev + 1
+	_, _, err = MultiRange(ctx, lg, s, req)
+	require.ErrorIs(t, err, mvcc.ErrFutureRev)
+
+	// a continue token issued at another revision cannot be mixed in
+	page, _, err := Range(ctx, lg, s, &pb.RangeRequest{Key: []byte("b/"), RangeEnd: []byte("b0"), Limit: 1, Revision: rev - 1})
+	require.NoError(t, err)
+	require.NotEmpty(t, page.ContinueToken)
+	req = &pb.MultiRangeRequest{Ranges: []*pb.RangeRequest{
+		{Key: []byte("b/"), RangeEnd: []byte("b0"), ContinueToken: page.ContinueToken},
+	}}
+	_, _, err = MultiRange(ctx, lg, s, req)
+	require.ErrorIs(t, err, errors.ErrInvalidContinueToken)
+	req.Revision = rev - 1
+	resp, _, err = MultiRange(ctx, lg, s, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Responses[0].Kvs, 1)
+	assert.Equal(t, "b/2", string(resp.Responses[0].Kvs[0].Key))
+}
+
+func TestRangeDescendByKey(t *testing.T) {
+	s, _ := setup(t, testSetup{})
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		s.Put([]byte(k), []byte("v1"), lease.NoLease)
+	}
+	rev := s.Put([]byte("b"), []byte("v2"), lease.NoLease)
+
+	tests := []struct {
+		name     string
+		req      *pb.RangeRequest
+		wantKeys []string
+		wantMore bool
+	}{
+		{
+			name:     "limit keeps the last keys",
+			req:      &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 2},
+			wantKeys: []string{"e", "d"},
+			wantMore: true,
+		},
+		{
+			name:     "from key",
+			req:      &pb.RangeRequest{Key: []byte("c"), RangeEnd: []byte{0}},
+			wantKeys: []string{"e", "d", "c"},
+		},
+		{
+			name:     "range end is excluded",
+			req:      &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("c"), Limit: 5},
+			wantKeys: []string{"b", "a"},
+		},
+		{
+			name:     "revision filters are applied before the limit",
+			req:      &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 1, MinModRevision: rev},
+			wantKeys: []string{"b"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.req.SortTarget = pb.RangeRequest_KEY
+			tc.req.SortOrder = pb.RangeRequest_DESCEND
+			resp, _, err := Range(context.Background(), zaptest.NewLogger(t), s, tc.req)
+			require.NoError(t, err)
+			var keys []string
+			for _, kv := range resp.Kvs {
+				keys = append(keys, string(kv.Key))
+			}
+			assert.Equal(t, tc.wantKeys, keys)
+			assert.Equal(t, tc.wantMore, resp.More)
+			assert.Empty(t, resp.ContinueToken)
+		})
+	}
+}
@@ -88,6 +88,21 @@ func WarnOfExpensiveReadOnlyRangeRequest(lg *zap.Logger, warningApplyDuration ti
 	warnOfExpensiveGenericRequest(lg, warningApplyDuration, now, reqStringer, "read-only range ", resp, err)
 }
 
+func WarnOfExpensiveReadOnlyMultiRangeRequest(lg *zap.Logger, warningApplyDuration time.Duration, now time.Time, r *pb.MultiRangeRequest, multiRangeResponse *pb.MultiRangeResponse, err error) {
+	if time.Since(now) <= warningApplyDuration {
+		return
+	}
+	var resp string
+	if !isNil(multiRangeResponse) {
+		var resps []string
+		for _, rr := range multiRangeResponse.Responses {
+			resps = append(resps, fmt.Sprintf("range_response_count:%d", len(rr.GetKvs())))
+		}
+		resp = fmt.Sprintf("responses:<%s> size:%d", strings.Join(resps, " "), multiRangeResponse.Size())
+	}
+	warnOfExpensiveGenericRequest(lg, warningApplyDuration, now, r, "read-only multi-range ", resp, err)
+}
+
 // callers need make sure time has passed warningApplyDuration
 func warnOfExpensiveGenericRequest(lg *zap.Logger, warningApplyDuration time.Duration, now time.Time, reqStringer fmt.Stringer, prefix string, resp string, err error) {
 	lg.Warn(
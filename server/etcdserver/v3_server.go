@@ -32,6 +32,7 @@ import (
 	"go.etcd.io/etcd/pkg/v3/traceutil"
 	"go.etcd.io/etcd/server/v3/auth"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
 	apply2 "go.etcd.io/etcd/server/v3/etcdserver/apply"
 	"go.etcd.io/etcd/server/v3/etcdserver/errors"
 	"go.etcd.io/etcd/server/v3/etcdserver/txn"
@@ -57,6 +58,7 @@ const (
 
 type RaftKV interface {
 	Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error)
+	MultiRange(ctx context.Context, r *pb.MultiRangeRequest) (*pb.MultiRangeResponse, error)
 	Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error)
 	DeleteRange(ctx context.Context, r *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error)
 	Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error)
@@ -121,7 +123,13 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 		trace.LogIfLong(traceThreshold)
 	}(time.Now())
 
-	if !r.Serializable {
+	if !r.Serializable && r.MaxStaleness != nil {
+		err = s.boundedStalenessReadNotify(ctx, r.MaxStaleness)
+		trace.Step("local member within max staleness of leader")
+		if err != nil {
+			return nil, err
+		}
+	} else if !r.Serializable {
 		err = s.linearizableReadNotify(ctx)
 		trace.Step("agreement among raft nodes before linearized reading")
 		if err != nil {
@@ -140,6 +148,49 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 	return resp, err
 }
 
+func (s *EtcdServer) MultiRange(ctx context.Context, r *pb.MultiRangeRequest) (*pb.MultiRangeResponse, error) {
+	trace := traceutil.New("multi_range",
+		s.Logger(),
+		traceutil.Field{Key: "range_count", Value: len(r.Ranges)},
+	)
+	ctx = context.WithValue(ctx, traceutil.TraceKey{}, trace)
+
+	var resp *pb.MultiRangeResponse
+	var err error
+	defer func(start time.Time) {
+		txn.WarnOfExpensiveReadOnlyMultiRangeRequest(s.Logger(), s.Cfg.WarningApplyDuration, start, r, resp, err)
+		if resp != nil {
+			trace.AddField(traceutil.Field{Key: "response_revision", Value: resp.Header.Revision})
+		}
+		trace.LogIfLong(traceThreshold)
+	}(time.Now())
+
+	// a single read index wait covers every range, since they are
+	// all served from the same read transaction afterwards
+	if !r.Serializable {
+		err = s.linearizableReadNotify(ctx)
+		trace.Step("agreement among raft nodes before linearized reading")
+		if err != nil {
+			return nil, err
+		}
+	}
+	chk := func(ai *auth.AuthInfo) error {
+		for _, rr := range r.Ranges {
+			if err := s.authStore.IsRangePermitted(ai, rr.Key, rr.RangeEnd); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	get := func() { resp, _, err = txn.MultiRange(ctx, s.Logger(), s.KV(), r) }
+	if serr := s.doSerialize(ctx, chk, get); serr != nil {
+		err = serr
+		return nil, err
+	}
+	return resp, err
+}
+
 func (s *EtcdServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
 	ctx = context.WithValue(ctx, traceutil.StartTimeKey{}, time.Now())
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{Put: r})
@@ -801,6 +852,13 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 // Watchable returns a watchable interface attached to the etcdserver.
 func (s *EtcdServer) Watchable() mvcc.WatchableKV { return s.KV() }
 
+// WatchResumeHolds returns the holds of watch resume token revisions on
+// auto compaction, or nil if watch resume tokens are disabled.
+func (s *EtcdServer) WatchResumeHolds() *v3compactor.Holds { return s.resumeHolds }
+
+// WatchStreams returns the open watch streams of the member.
+func (s *EtcdServer) WatchStreams() *WatchStreams { return s.watchStreams }
+
 func (s *EtcdServer) linearizableReadLoop() {
 	for {
 		requestID := s.reqIDGen.Next()
@@ -823,6 +881,7 @@ func (s *EtcdServer) linearizableReadLoop() {
 		s.readNotifier = nextnr
 		s.readMu.Unlock()
 
+		start := time.Now()
 		confirmedIndex, err := s.requestCurrentIndex(leaderChangedNotifier, requestID)
 		if isStopped(err) {
 			return
@@ -831,6 +890,7 @@ func (s *EtcdServer) linearizableReadLoop() {
 			nr.notify(err)
 			continue
 		}
+		s.renewLeaderLease(start, leaderChangedNotifier)
 
 		trace.Step("read index received")
 
@@ -960,6 +1020,11 @@ func (s *EtcdServer) LinearizableReadNotify(ctx context.Context) error {
 }
 
 func (s *EtcdServer) linearizableReadNotify(ctx context.Context) error {
+	if served, err := s.leaseReadNotify(ctx); served {
+		return err
+	}
+	linearizableReads.WithLabelValues("read_index").Inc()
+
 	s.readMu.RLock()
 	nc := s.readNotifier
 	s.readMu.RUnlock()
@@ -0,0 +1,185 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
+)
+
+const PeerWatchResumeHoldsPath = "/members/watchresumeholds"
+
+// errLeaderNoWatchResumeHolds is returned when reporting the watch resume
+// holds to a leader that does not collect them, either because it runs with
+// watch resume tokens disabled or it is of an older version.
+var errLeaderNoWatchResumeHolds = errors.New("etcdserver: leader does not collect watch resume holds")
+
+// watchResumeHoldsReport carries the lowest revision held by the watch
+// resume tokens issued by a member, or 0 if it holds none.
+type watchResumeHoldsReport struct {
+	MemberID uint64 `json:"member-id"`
+	Revision int64  `json:"revision"`
+}
+
+type watchResumeHoldsHandler struct {
+	lg     *zap.Logger
+	server *EtcdServer
+}
+
+// WatchResumeHoldsHandler returns the handler of the reports of the
+// revisions held by the peers, or nil if watch resume tokens are disabled.
+func (s *EtcdServer) WatchResumeHoldsHandler() http.Handler {
+	if s.resumeHolds == nil {
+		return nil
+	}
+	return &watchResumeHoldsHandler{lg: s.Logger(), server: s}
+}
+
+func (h *watchResumeHoldsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.URL.Path != PeerWatchResumeHoldsPath {
+		http.Error(w, "bad path", http.StatusBadRequest)
+		return
+	}
+	if gcid := r.Header.Get("X-Etcd-Cluster-ID"); gcid != "" && gcid != h.server.cluster.ID().String() {
+		http.Error(w, rafthttp.ErrClusterIDMismatch.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	defer r.Body.Close()
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "error reading body", http.StatusBadRequest)
+		return
+	}
+	report := &watchResumeHoldsReport{}
+	if err = json.Unmarshal(b, report); err != nil {
+		h.lg.Warn("failed to unmarshal watch resume holds report", zap.Error(err))
+		http.Error(w, "error unmarshalling request", http.StatusBadRequest)
+		return
+	}
+	h.server.resumeHolds.HoldPeer(types.ID(report.MemberID), report.Revision)
+
+	w.Header().Set("X-Etcd-Cluster-ID", h.server.Cluster().ID().String())
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// monitorWatchResumeHolds prunes the expired watch resume holds and, while
+// the member is a follower, reports the lowest revision it holds to the
+// leader, which is the only member compacting.
+func (s *EtcdServer) monitorWatchResumeHolds() {
+	if s.resumeHolds == nil {
+		return
+	}
+	lg := s.Logger()
+	interval := s.resumeHolds.ReportInterval()
+	// noHoldsLeader is the last leader found not collecting the holds,
+	// so that it is only logged once.
+	var noHoldsLeader types.ID
+	for {
+		select {
+		case <-time.After(interval):
+		case <-s.stopping:
+			return
+		}
+
+		s.resumeHolds.Prune()
+		if s.isLeader() {
+			continue
+		}
+		leader := s.cluster.Member(types.ID(s.Lead()))
+		if leader == nil {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+		err := s.reportWatchResumeHolds(ctx, leader.PeerURLs, s.resumeHolds.LocalMinRev())
+		cancel()
+		if errors.Is(err, errLeaderNoWatchResumeHolds) {
+			if noHoldsLeader != leader.ID {
+				noHoldsLeader = leader.ID
+				lg.Warn(
+					"leader does not collect watch resume holds; auto compaction may compact the revisions of the issued resume tokens",
+					zap.String("local-member-id", s.MemberID().String()),
+					zap.String("leader-member-id", leader.ID.String()),
+				)
+			}
+			continue
+		}
+		noHoldsLeader = 0
+		if err != nil {
+			lg.Warn(
+				"failed to report watch resume holds to leader",
+				zap.String("local-member-id", s.MemberID().String()),
+				zap.String("leader-member-id", leader.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// reportWatchResumeHolds reports rev as the lowest revision held by the
+// member to the first of urls accepting it.
+func (s *EtcdServer) reportWatchResumeHolds(ctx context.Context, urls []string, rev int64) error {
+	b, err := json.Marshal(&watchResumeHoldsReport{MemberID: uint64(s.MemberID()), Revision: rev})
+	if err != nil {
+		return err
+	}
+	cc := &http.Client{Transport: s.peerRt}
+	var lastErr error
+	for _, url := range urls {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url+PeerWatchResumeHoldsPath, bytes.NewReader(b))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Etcd-Cluster-ID", s.cluster.ID().String())
+
+		resp, err := cc.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return errLeaderNoWatchResumeHolds
+		}
+		if resp.StatusCode != http.StatusNoContent {
+			lastErr = fmt.Errorf("unexpected response %q (%s)", resp.Status, bytes.TrimSpace(body))
+			continue
+		}
+		return nil
+	}
+	return lastErr
+}
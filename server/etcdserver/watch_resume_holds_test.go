@@ -0,0 +1,60 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
+)
+
+func TestReportWatchResumeHolds(t *testing.T) {
+	leader := &EtcdServer{
+		lgMu:        new(sync.RWMutex),
+		lg:          zaptest.NewLogger(t),
+		cluster:     newTestCluster(t),
+		resumeHolds: v3compactor.NewHolds(time.Minute),
+	}
+	mux := http.NewServeMux()
+	mux.Handle(PeerWatchResumeHoldsPath, leader.WatchResumeHoldsHandler())
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	s := &EtcdServer{
+		lgMu:     new(sync.RWMutex),
+		lg:       zaptest.NewLogger(t),
+		cluster:  leader.cluster,
+		memberID: 2,
+		peerRt:   http.DefaultTransport,
+	}
+	ctx := context.Background()
+	require.NoError(t, s.reportWatchResumeHolds(ctx, []string{srv.URL}, 5))
+	assert.Equal(t, int64(5), leader.resumeHolds.MinRev())
+
+	// a leader with watch resume tokens disabled does not register the handler
+	noHolds := httptest.NewServer(http.NotFoundHandler())
+	defer noHolds.Close()
+	err := s.reportWatchResumeHolds(ctx, []string{noHolds.URL}, 5)
+	require.ErrorIs(t, err, errLeaderNoWatchResumeHolds)
+}
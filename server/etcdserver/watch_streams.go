@@ -0,0 +1,72 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"sort"
+	"sync"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+// WatchStreamLagger reports the lag of a watch stream.
+type WatchStreamLagger interface {
+	Lag() *pb.WatchStreamLag
+}
+
+// WatchStreams tracks the open watch streams of a member, so that their
+// lag can be reported across all the gRPC servers serving the member.
+type WatchStreams struct {
+	mu      sync.Mutex
+	nextID  uint64
+	streams map[uint64]WatchStreamLagger
+}
+
+func newWatchStreams() *WatchStreams {
+	return &WatchStreams{streams: make(map[uint64]WatchStreamLagger)}
+}
+
+// Register adds a watch stream and returns its member local ID.
+func (ws *WatchStreams) Register(l WatchStreamLagger) uint64 {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.nextID++
+	ws.streams[ws.nextID] = l
+	return ws.nextID
+}
+
+// Unregister removes the watch stream with the given ID.
+func (ws *WatchStreams) Unregister(id uint64) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	delete(ws.streams, id)
+}
+
+// Lags returns the lag of every registered watch stream, ordered by ID.
+func (ws *WatchStreams) Lags() []*pb.WatchStreamLag {
+	ws.mu.Lock()
+	ls := make([]WatchStreamLagger, 0, len(ws.streams))
+	for _, l := range ws.streams {
+		ls = append(ls, l)
+	}
+	ws.mu.Unlock()
+
+	lags := make([]*pb.WatchStreamLag, 0, len(ls))
+	for _, l := range ls {
+		lags = append(lags, l.Lag())
+	}
+	sort.Slice(lags, func(i, j int) bool { return lags[i].StreamId < lags[j].StreamId })
+	return lags
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/config"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v2store"
+	serverstorage "go.etcd.io/etcd/server/v3/storage"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.etcd.io/raft/v3"
+	"go.etcd.io/raft/v3/raftpb"
+	"go.etcd.io/raft/v3/tracker"
+)
+
+// A witness is a voting member that acknowledges the raft log, but does not
+// apply the entries to the key-value store. Its backend is kept in memory and
+// only holds the membership, so that it never receives database snapshots and
+// rebuilds the membership from the raft snapshot and the WAL on restart. It
+// campaigns, so that a full member with a shorter log than the one of the
+// witness can still be elected, but as it cannot serve as leader, it
+// transfers the leadership to a full member as soon as it wins.
+
+// IsWitness returns true if the local member is a witness.
+func (s *EtcdServer) IsWitness() bool {
+	return s.Cfg.Witness
+}
+
+// isWitnessMember returns true if the member with the given id is a witness.
+func (s *EtcdServer) isWitnessMember(id types.ID) bool {
+	m := s.cluster.Member(id)
+	return m != nil && m.IsWitness
+}
+
+// validateWitness checks that the local member is started as a witness if,
+// and only if, it was added as one.
+func validateWitness(cfg config.ServerConfig, local *membership.Member) error {
+	if local == nil || local.IsWitness == cfg.Witness {
+		return nil
+	}
+	if local.IsWitness {
+		return fmt.Errorf("member %s is a witness, it must be started with --experimental-witness", local.ID)
+	}
+	return fmt.Errorf("member %s is not a witness, it cannot be started with --experimental-witness", local.ID)
+}
+
+// recoverWitnessBackend saves the membership of the v2 store, as recovered
+// from a raft snapshot, in the backend of a witness.
+func recoverWitnessBackend(lg *zap.Logger, be backend.Backend, st v2store.Store) error {
+	mb := schema.NewMembershipBackend(lg, be)
+	mb.MustCreateBackendBuckets()
+	return membership.RecoverBackendFromStore(lg, st, mb)
+}
+
+// openWitnessSnapshotBackend opens the backend of a witness applying a raft
+// snapshot: a new backend holding the membership of the snapshot.
+func openWitnessSnapshotBackend(cfg config.ServerConfig, snapshot raftpb.Snapshot, hooks *serverstorage.BackendHooks) (backend.Backend, error) {
+	st := v2store.New(StoreClusterPrefix, StoreKeysPrefix)
+	if err := st.Recovery(snapshot.Data); err != nil {
+		return nil, err
+	}
+	be := serverstorage.OpenBackend(cfg, hooks)
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	schema.UnsafeCreateMetaBucket(tx)
+	tx.UnsafeCreateBucket(schema.Key)
+	tx.Unlock()
+	if err := recoverWitnessBackend(cfg.Logger, be, st); err != nil {
+		be.Close()
+		return nil, err
+	}
+	return be, nil
+}
+
+// createWitnessSnapshotMessage creates a snapshot message for a witness. It
+// only carries the v2 store snapshot of the membership, without the database
+// snapshot merged in by createMergedSnapshotMessage.
+func (s *EtcdServer) createWitnessSnapshotMessage(m raftpb.Message, snapt, snapi uint64, confState raftpb.ConfState) raftpb.Message {
+	m.Snapshot = &raftpb.Snapshot{
+		Metadata: raftpb.SnapshotMetadata{
+			Index:     snapi,
+			Term:      snapt,
+			ConfState: confState,
+		},
+		Data: GetMembershipInfoInV2Format(s.Logger(), s.cluster),
+	}
+	return m
+}
+
+// monitorWitnessLeadership, while the witness is the leader, transfers the
+// leadership to the most caught up full voting member. raft brings the
+// transferee up to date with the log of the witness before handing over.
+func (s *EtcdServer) monitorWitnessLeadership() {
+	if !s.Cfg.Witness {
+		return
+	}
+	lg := s.Logger()
+	interval := time.Duration(s.Cfg.TickMs) * time.Millisecond
+	for {
+		select {
+		case <-time.After(interval):
+		case <-s.stopping:
+			return
+		}
+
+		if !s.isLeader() {
+			continue
+		}
+		transferee := witnessTransferee(s.raftStatus(), s.cluster.VotingMembers())
+		if transferee == 0 {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+		err := s.MoveLeader(ctx, uint64(s.MemberID()), uint64(transferee))
+		cancel()
+		if err != nil {
+			lg.Warn(
+				"failed to transfer leadership from witness",
+				zap.String("local-member-id", s.MemberID().String()),
+				zap.String("transferee-member-id", transferee.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		lg.Info(
+			"transferred leadership from witness",
+			zap.String("local-member-id", s.MemberID().String()),
+			zap.String("transferee-member-id", transferee.String()),
+		)
+	}
+}
+
+// witnessTransferee returns the full voting member with the highest match
+// index, given the raft status of the witness leading, or 0 if there is
+// none. Members waiting for a snapshot are skipped, as the witness has no
+// key-value data to send them.
+func witnessTransferee(rs raft.Status, members []*membership.Member) types.ID {
+	var id types.ID
+	var match uint64
+	for _, m := range members {
+		if m.IsWitness || m.ID == types.ID(rs.ID) {
+			continue
+		}
+		pr, ok := rs.Progress[uint64(m.ID)]
+		if !ok || pr.State == tracker.StateSnapshot {
+			continue
+		}
+		if id == 0 || pr.Match > match {
+			id, match = m.ID, pr.Match
+		}
+	}
+	return id
+}
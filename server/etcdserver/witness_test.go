@@ -0,0 +1,78 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/raft/v3"
+	"go.etcd.io/raft/v3/tracker"
+)
+
+func TestWitnessTransferee(t *testing.T) {
+	members := []*membership.Member{
+		{ID: 1, RaftAttributes: membership.RaftAttributes{IsWitness: true}},
+		{ID: 2},
+		{ID: 3},
+		{ID: 4, RaftAttributes: membership.RaftAttributes{IsWitness: true}},
+	}
+	status := func(pr2, pr3 tracker.Progress) raft.Status {
+		rs := raft.Status{Progress: map[uint64]tracker.Progress{
+			1: {Match: 100, State: tracker.StateReplicate},
+			2: pr2,
+			3: pr3,
+			4: {Match: 100, State: tracker.StateReplicate},
+		}}
+		rs.ID = 1
+		return rs
+	}
+
+	tcs := []struct {
+		name    string
+		rs      raft.Status
+		members []*membership.Member
+		want    types.ID
+	}{
+		{
+			name:    "most caught up member",
+			rs:      status(tracker.Progress{Match: 90, State: tracker.StateReplicate}, tracker.Progress{Match: 95, State: tracker.StateProbe}),
+			members: members,
+			want:    3,
+		},
+		{
+			name:    "member waiting for a snapshot",
+			rs:      status(tracker.Progress{Match: 90, State: tracker.StateReplicate}, tracker.Progress{Match: 95, State: tracker.StateSnapshot}),
+			members: members,
+			want:    2,
+		},
+		{
+			name:    "only witnesses",
+			rs:      status(tracker.Progress{}, tracker.Progress{}),
+			members: []*membership.Member{members[0], members[3]},
+		},
+		{
+			name:    "not leader",
+			rs:      raft.Status{},
+			members: members,
+		},
+	}
+	for _, tc := range tcs {
+		assert.Equalf(t, tc.want, witnessTransferee(tc.rs, tc.members), tc.name)
+	}
+}
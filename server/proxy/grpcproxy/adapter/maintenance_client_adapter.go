@@ -56,6 +56,14 @@ func (s *mts2mtc) Downgrade(ctx context.Context, r *pb.DowngradeRequest, opts ..
 	return s.mts.Downgrade(ctx, r)
 }
 
+func (s *mts2mtc) WatchLag(ctx context.Context, r *pb.WatchLagRequest, opts ...grpc.CallOption) (*pb.WatchLagResponse, error) {
+	return s.mts.WatchLag(ctx, r)
+}
+
+func (s *mts2mtc) RevisionAt(ctx context.Context, r *pb.RevisionAtRequest, opts ...grpc.CallOption) (*pb.RevisionAtResponse, error) {
+	return s.mts.RevisionAt(ctx, r)
+}
+
 func (s *mts2mtc) Snapshot(ctx context.Context, in *pb.SnapshotRequest, opts ...grpc.CallOption) (pb.Maintenance_SnapshotClient, error) {
 	cs := newPipeStream(ctx, func(ss chanServerStream) error {
 		return s.mts.Snapshot(in, &ss2scServerStream{ss})
@@ -134,6 +134,10 @@ func (cp *clusterProxy) MemberUpdate(ctx context.Context, r *pb.MemberUpdateRequ
 	return cp.clus.MemberUpdate(ctx, r)
 }
 
+func (cp *clusterProxy) MemberReconfigure(ctx context.Context, r *pb.MemberReconfigureRequest) (*pb.MemberReconfigureResponse, error) {
+	return cp.clus.MemberReconfigure(ctx, r)
+}
+
 func (cp *clusterProxy) membersFromUpdates() ([]*pb.Member, error) {
 	cp.umu.RLock()
 	defer cp.umu.RUnlock()
@@ -16,6 +16,7 @@ package grpcproxy
 
 import (
 	"context"
+	"time"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -67,6 +68,22 @@ func (p *kvProxy) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRespo
 	return gresp, nil
 }
 
+func (p *kvProxy) MultiRange(ctx context.Context, r *pb.MultiRangeRequest) (*pb.MultiRangeResponse, error) {
+	ops := make([]clientv3.Op, 0, len(r.Ranges))
+	for _, rr := range r.Ranges {
+		// revision and consistency are set on the whole request
+		req := *rr
+		req.Revision = r.Revision
+		req.Serializable = r.Serializable
+		ops = append(ops, RangeRequestToOp(&req))
+	}
+	resp, err := p.kv.MultiGet(ctx, ops...)
+	if err != nil {
+		return nil, err
+	}
+	return (*pb.MultiRangeResponse)(resp), nil
+}
+
 func (p *kvProxy) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
 	p.cache.Invalidate(r.Key, nil)
 	cacheKeys.Set(float64(p.cache.Size()))
@@ -185,10 +202,40 @@ func RangeRequestToOp(r *pb.RangeRequest) clientv3.Op {
 	if r.Serializable {
 		opts = append(opts, clientv3.WithSerializable())
 	}
+	if len(r.ContinueToken) != 0 {
+		opts = append(opts, clientv3.WithContinueToken(r.ContinueToken))
+	}
+	for _, f := range r.Filters {
+		opts = append(opts, rangeFilterToOpOption(f))
+	}
+	if st := r.MaxStaleness; st != nil {
+		opts = append(opts, clientv3.WithMaxStaleness(time.Duration(st.Milliseconds)*time.Millisecond))
+		opts = append(opts, clientv3.WithMaxStalenessRevisions(st.Revisions))
+	}
 
 	return clientv3.OpGet(string(r.Key), opts...)
 }
 
+func rangeFilterToOpOption(f *pb.RangeFilter) clientv3.OpOption {
+	if f.Result == pb.RangeFilter_HAS_PREFIX {
+		return clientv3.WithValuePrefix(string(f.GetValue()))
+	}
+	cmp := clientv3.Cmp{Result: pb.Compare_CompareResult(f.Result), Target: f.Target}
+	switch tv := f.TargetUnion.(type) {
+	case *pb.RangeFilter_Version:
+		cmp.TargetUnion = &pb.Compare_Version{Version: tv.Version}
+	case *pb.RangeFilter_CreateRevision:
+		cmp.TargetUnion = &pb.Compare_CreateRevision{CreateRevision: tv.CreateRevision}
+	case *pb.RangeFilter_ModRevision:
+		cmp.TargetUnion = &pb.Compare_ModRevision{ModRevision: tv.ModRevision}
+	case *pb.RangeFilter_Value:
+		cmp.TargetUnion = &pb.Compare_Value{Value: tv.Value}
+	case *pb.RangeFilter_Lease:
+		cmp.TargetUnion = &pb.Compare_Lease{Lease: tv.Lease}
+	}
+	return clientv3.WithFilter(cmp)
+}
+
 func PutRequestToOp(r *pb.PutRequest) clientv3.Op {
 	var opts []clientv3.OpOption
 	opts = append(opts, clientv3.WithLease(clientv3.LeaseID(r.Lease)))
@@ -86,3 +86,11 @@ func (mp *maintenanceProxy) MoveLeader(ctx context.Context, r *pb.MoveLeaderRequ
 func (mp *maintenanceProxy) Downgrade(ctx context.Context, r *pb.DowngradeRequest) (*pb.DowngradeResponse, error) {
 	return mp.maintenanceClient.Downgrade(ctx, r)
 }
+
+func (mp *maintenanceProxy) WatchLag(ctx context.Context, r *pb.WatchLagRequest) (*pb.WatchLagResponse, error) {
+	return mp.maintenanceClient.WatchLag(ctx, r)
+}
+
+func (mp *maintenanceProxy) RevisionAt(ctx context.Context, r *pb.RevisionAtRequest) (*pb.RevisionAtResponse, error) {
+	return mp.maintenanceClient.RevisionAt(ctx, r)
+}
@@ -16,6 +16,7 @@ package grpcproxy
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"go.uber.org/zap"
@@ -29,6 +30,15 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3rpc"
 )
 
+var (
+	// errInitialStateNotSupported is returned for watches asking for the initial
+	// state, which cannot be served from the shared broadcasts of the proxy.
+	errInitialStateNotSupported = errors.New("grpcproxy: watch initial state is not supported")
+	// errCoalesceNotSupported is returned for coalescing watches, since the
+	// proxy forwards the events of the shared broadcasts as they come.
+	errCoalesceNotSupported = errors.New("grpcproxy: watch coalescing is not supported")
+)
+
 type watchProxy struct {
 	cw  clientv3.Watcher
 	ctx context.Context
@@ -235,7 +245,17 @@ func (wps *watchProxyStream) recvLoop() error {
 		case *pb.WatchRequest_CreateRequest:
 			cr := uv.CreateRequest
 
-			if err := wps.checkPermissionForWatch(cr.Key, cr.RangeEnd); err != nil {
+			err := wps.checkPermissionForWatch(cr.Key, cr.RangeEnd)
+			if err == nil {
+				err = v3rpc.CheckWatchFilters(cr)
+			}
+			if err == nil && cr.InitialState {
+				err = errInitialStateNotSupported
+			}
+			if err == nil && cr.Coalesce {
+				err = errCoalesceNotSupported
+			}
+			if err != nil {
 				wps.watchCh <- &pb.WatchResponse{
 					Header:       &pb.ResponseHeader{},
 					WatchId:      clientv3.InvalidWatchID,
@@ -256,6 +276,8 @@ func (wps *watchProxyStream) recvLoop() error {
 				progress: cr.ProgressNotify,
 				prevKV:   cr.PrevKv,
 				filters:  v3rpc.FiltersFromRequest(cr),
+
+				noUnchanged: v3rpc.HasFilter(cr, pb.WatchCreateRequest_NOUNCHANGED),
 			}
 			if !w.wr.valid() {
 				w.post(&pb.WatchResponse{WatchId: clientv3.InvalidWatchID, Created: true, Canceled: true})
@@ -20,6 +20,7 @@ import (
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3rpc"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 )
 
@@ -38,6 +39,9 @@ type watcher struct {
 	filters  []mvcc.FilterFunc
 	progress bool
 	prevKV   bool
+	// noUnchanged filters out puts keeping the previous value, which
+	// the broadcast watchers always fetch
+	noUnchanged bool
 
 	// id is the id returned to the client on its watch stream.
 	id int64
@@ -85,7 +89,7 @@ func (w *watcher) send(wr clientv3.WatchResponse) {
 				break
 			}
 		}
-		if filtered {
+		if filtered || (w.noUnchanged && v3rpc.IsUnchangedPutEvent(*ev, ev.PrevKv)) {
 			continue
 		}
 
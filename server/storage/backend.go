@@ -30,6 +30,7 @@ import (
 
 func newBackend(cfg config.ServerConfig, hooks backend.Hooks) backend.Backend {
 	bcfg := backend.DefaultBackendConfig(cfg.Logger)
+	bcfg.Engine = cfg.BackendEngine
 	bcfg.Path = cfg.BackendPath()
 	bcfg.UnsafeNoFsync = cfg.UnsafeNoFsync
 	if cfg.BackendBatchLimit != 0 {
@@ -52,6 +53,13 @@ func newBackend(cfg config.ServerConfig, hooks backend.Hooks) backend.Backend {
 	}
 	bcfg.Mlock = cfg.ExperimentalMemoryMlock
 	bcfg.Hooks = hooks
+	if cfg.Witness {
+		// witnesses do not keep the key-value data, their backend only holds
+		// the membership, which is rebuilt from the snapshot and the WAL on
+		// restart, so it is never saved.
+		bcfg.Engine = backend.EngineMemory
+		bcfg.Path = ""
+	}
 	return backend.New(bcfg)
 }
 
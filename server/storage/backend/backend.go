@@ -36,6 +36,13 @@ var (
 
 	defragLimit = 10000
 
+	// defragSliceDuration bounds the time the incremental defragmentation
+	// keeps a read tx open to copy keys between two commits of the copy.
+	defragSliceDuration = 10 * time.Millisecond
+	// defragChunkSize is the number of keys the incremental defragmentation
+	// copies between two checks of defragSliceDuration.
+	defragChunkSize = 256
+
 	// InitialMmapSize is the initial size of the mmapped region. Setting this larger than
 	// the potential max db size can prevent writer from blocking reader.
 	// This only works for linux.
@@ -53,6 +60,11 @@ type Backend interface {
 	ConcurrentReadTx() ReadTx
 
 	Snapshot() Snapshot
+	// SnapshotSince returns a snapshot in which bucket only holds its keys at
+	// or after from, for the other buckets to be layered on a full snapshot.
+	// write can add keys to the snapshot before it is taken, reading src, the
+	// whole backend at the time of the snapshot.
+	SnapshotSince(bucket Bucket, from []byte, write func(src UnsafeReader, tx UnsafeReadWriter) error) (Snapshot, error)
 	Hash(ignores func(bucketName, keyName []byte) bool) (uint32, error)
 	// Size returns the current size of the backend physically allocated.
 	// The backend can hold DB space that is not utilized at the moment,
@@ -66,6 +78,11 @@ type Backend interface {
 	// OpenReadTxN returns the number of currently open read transactions in the backend.
 	OpenReadTxN() int64
 	Defrag() error
+	// DefragIncremental rewrites the backend into a new file like Defrag, but
+	// copies it bucket by bucket in bounded time slices while writes go on.
+	// Writes are only blocked at the end, to copy again the keys written
+	// during the copy and to replace the backend file.
+	DefragIncremental() error
 	ForceCommit()
 	Close() error
 
@@ -130,21 +147,23 @@ type backend struct {
 }
 
 type BackendConfig struct {
+	// Engine is the name of the engine of the backend, EngineBbolt if empty.
+	Engine string
 	// Path is the file path to the backend file.
 	Path string
 	// BatchInterval is the maximum time before flushing the BatchTx.
 	BatchInterval time.Duration
 	// BatchLimit is the maximum puts before flushing the BatchTx.
 	BatchLimit int
-	// BackendFreelistType is the backend boltdb's freelist type.
+	// BackendFreelistType is the backend boltdb's freelist type. Only used by EngineBbolt.
 	BackendFreelistType bolt.FreelistType
-	// MmapSize is the number of bytes to mmap for the backend.
+	// MmapSize is the number of bytes to mmap for the backend. Only used by EngineBbolt.
 	MmapSize uint64
 	// Logger logs backend-side operations.
 	Logger *zap.Logger
 	// UnsafeNoFsync disables all uses of fsync.
 	UnsafeNoFsync bool `json:"unsafe-no-fsync"`
-	// Mlock prevents backend database file to be swapped
+	// Mlock prevents backend database file to be swapped. Only used by EngineBbolt.
 	Mlock bool
 
 	// Hooks are getting executed during lifecycle of Backend's transactions.
@@ -162,8 +181,9 @@ func DefaultBackendConfig(lg *zap.Logger) BackendConfig {
 	}
 }
 
+// New opens a backend with the engine of the configuration.
 func New(bcfg BackendConfig) Backend {
-	return newBackend(bcfg)
+	return openEngine(bcfg)
 }
 
 func WithMmapSize(size uint64) BackendConfigOption {
@@ -172,6 +192,12 @@ func WithMmapSize(size uint64) BackendConfigOption {
 	}
 }
 
+func WithEngine(engine string) BackendConfigOption {
+	return func(bcfg *BackendConfig) {
+		bcfg.Engine = engine
+	}
+}
+
 func NewDefaultBackend(lg *zap.Logger, path string, opts ...BackendConfigOption) Backend {
 	bcfg := DefaultBackendConfig(lg)
 	bcfg.Path = path
@@ -179,7 +205,7 @@ func NewDefaultBackend(lg *zap.Logger, path string, opts ...BackendConfigOption)
 		opt(&bcfg)
 	}
 
-	return newBackend(bcfg)
+	return openEngine(bcfg)
 }
 
 func newBackend(bcfg BackendConfig) *backend {
@@ -481,24 +507,7 @@ func (b *backend) defrag() error {
 
 	b.batchTx.tx = nil
 
-	// Create a temporary file to ensure we start with a clean slate.
-	// Snapshotter.cleanupSnapdir cleans up any of these that are found during startup.
-	dir := filepath.Dir(b.db.Path())
-	temp, err := os.CreateTemp(dir, "db.tmp.*")
-	if err != nil {
-		return err
-	}
-	options := bolt.Options{}
-	if boltOpenOptions != nil {
-		options = *boltOpenOptions
-	}
-	options.OpenFile = func(_ string, _ int, _ os.FileMode) (file *os.File, err error) {
-		return temp, nil
-	}
-	// Don't load tmp db into memory regardless of opening options
-	options.Mlock = false
-	tdbp := temp.Name()
-	tmpdb, err := bolt.Open(tdbp, 0600, &options)
+	tmpdb, err := b.openDefragTmpDB()
 	if err != nil {
 		return err
 	}
@@ -525,7 +534,56 @@ func (b *backend) defrag() error {
 		return err
 	}
 
-	err = b.db.Close()
+	b.unsafeReplaceDB(tmpdb)
+
+	took := time.Since(now)
+	defragSec.Observe(took.Seconds())
+
+	size2, sizeInUse2 := b.Size(), b.SizeInUse()
+	if b.lg != nil {
+		b.lg.Info(
+			"finished defragmenting directory",
+			zap.String("path", dbp),
+			zap.Int64("current-db-size-bytes-diff", size2-size1),
+			zap.Int64("current-db-size-bytes", size2),
+			zap.String("current-db-size", humanize.Bytes(uint64(size2))),
+			zap.Int64("current-db-size-in-use-bytes-diff", sizeInUse2-sizeInUse1),
+			zap.Int64("current-db-size-in-use-bytes", sizeInUse2),
+			zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse2))),
+			zap.Duration("took", took),
+		)
+	}
+	return nil
+}
+
+// openDefragTmpDB opens a database in a new temporary file next to the
+// backend file to copy the backend into.
+func (b *backend) openDefragTmpDB() (*bolt.DB, error) {
+	// Create a temporary file to ensure we start with a clean slate.
+	// Snapshotter.cleanupSnapdir cleans up any of these that are found during startup.
+	dir := filepath.Dir(b.db.Path())
+	temp, err := os.CreateTemp(dir, "db.tmp.*")
+	if err != nil {
+		return nil, err
+	}
+	options := bolt.Options{}
+	if boltOpenOptions != nil {
+		options = *boltOpenOptions
+	}
+	options.OpenFile = func(_ string, _ int, _ os.FileMode) (file *os.File, err error) {
+		return temp, nil
+	}
+	// Don't load tmp db into memory regardless of opening options
+	options.Mlock = false
+	return bolt.Open(temp.Name(), 0600, &options)
+}
+
+// unsafeReplaceDB replaces the backend database by tmpdb, which holds a copy
+// of its data, and reopens the transactions on it. It must be called holding
+// the batch tx, backend and read tx locks, with the batch tx closed.
+func (b *backend) unsafeReplaceDB(tmpdb *bolt.DB) {
+	dbp, tdbp := b.db.Path(), tmpdb.Path()
+	err := b.db.Close()
 	if err != nil {
 		b.lg.Fatal("failed to close database", zap.Error(err))
 	}
@@ -552,25 +610,6 @@ func (b *backend) defrag() error {
 	db := b.readTx.tx.DB()
 	atomic.StoreInt64(&b.size, size)
 	atomic.StoreInt64(&b.sizeInUse, size-(int64(db.Stats().FreePageN)*int64(db.Info().PageSize)))
-
-	took := time.Since(now)
-	defragSec.Observe(took.Seconds())
-
-	size2, sizeInUse2 := b.Size(), b.SizeInUse()
-	if b.lg != nil {
-		b.lg.Info(
-			"finished defragmenting directory",
-			zap.String("path", dbp),
-			zap.Int64("current-db-size-bytes-diff", size2-size1),
-			zap.Int64("current-db-size-bytes", size2),
-			zap.String("current-db-size", humanize.Bytes(uint64(size2))),
-			zap.Int64("current-db-size-in-use-bytes-diff", sizeInUse2-sizeInUse1),
-			zap.Int64("current-db-size-in-use-bytes", sizeInUse2),
-			zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse2))),
-			zap.Duration("took", took),
-		)
-	}
-	return nil
 }
 
 func defragdb(odb, tmpdb *bolt.DB, limit int) error {
@@ -17,11 +17,13 @@ package backend_test
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
 	bolt "go.etcd.io/bbolt"
@@ -87,6 +89,63 @@ func TestBackendSnapshot(t *testing.T) {
 	newTx.Unlock()
 }
 
+func TestBackendSnapshotSince(t *testing.T) {
+	b, tmpPath := betesting.NewTmpBackend(t, time.Hour, 10000)
+	defer betesting.Close(t, b)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(schema.Key)
+	tx.UnsafeCreateBucket(schema.Test)
+	for _, k := range []string{"a", "b", "c", "d"} {
+		tx.UnsafePut(schema.Key, []byte(k), []byte("bar"))
+	}
+	tx.UnsafePut(schema.Test, []byte("foo"), []byte("bar"))
+	tx.Unlock()
+	b.ForceCommit()
+
+	// write snapshot to a new file
+	f, err := os.CreateTemp(t.TempDir(), "etcd_backend_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	snap, err := b.SnapshotSince(schema.Key, []byte("c"), func(_ backend.UnsafeReader, tx backend.UnsafeReadWriter) error {
+		tx.UnsafePut(schema.Test, []byte("written"), []byte("bar"))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := snap.WriteTo(f); err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, snap.Close())
+	assert.NoError(t, f.Close())
+
+	// bootstrap new backend from the snapshot
+	bcfg := backend.DefaultBackendConfig(zaptest.NewLogger(t))
+	bcfg.Path, bcfg.BatchInterval, bcfg.BatchLimit = f.Name(), time.Hour, 10000
+	nb := backend.New(bcfg)
+	defer betesting.Close(t, nb)
+
+	newTx := nb.BatchTx()
+	newTx.Lock()
+	defer newTx.Unlock()
+	ks, _ := newTx.UnsafeRange(schema.Key, []byte("a"), []byte("z"), 0)
+	assert.Equal(t, [][]byte{[]byte("c"), []byte("d")}, ks)
+	var tks []string
+	assert.NoError(t, newTx.UnsafeForEach(schema.Test, func(k, _ []byte) error {
+		tks = append(tks, string(k))
+		return nil
+	}))
+	assert.Equal(t, []string{"foo", "written"}, tks)
+
+	// the temporary snapshot file is removed on close
+	temps, err := filepath.Glob(filepath.Join(filepath.Dir(tmpPath), "db.tmp.*"))
+	assert.NoError(t, err)
+	assert.Empty(t, temps)
+}
+
 func TestBackendBatchIntervalCommit(t *testing.T) {
 	// start backend with super short batch interval so
 	// we do not need to wait long before commit to happen.
@@ -194,6 +253,128 @@ func TestBackendDefrag(t *testing.T) {
 	b.ForceCommit()
 }
 
+func TestBackendDefragIncremental(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(schema.Test)
+	for i := 0; i < 20000; i++ {
+		tx.UnsafePut(schema.Test, []byte(fmt.Sprintf("foo_%05d", i)), []byte("bar"))
+	}
+	tx.Unlock()
+	b.ForceCommit()
+
+	// leave most pages underfilled
+	tx = b.BatchTx()
+	tx.Lock()
+	for i := 0; i < 20000; i++ {
+		if i%4 != 0 {
+			tx.UnsafeDelete(schema.Test, []byte(fmt.Sprintf("foo_%05d", i)))
+		}
+	}
+	tx.Unlock()
+	b.ForceCommit()
+
+	oh, err := b.Hash(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	size := b.Size()
+
+	if err = b.DefragIncremental(); err != nil {
+		t.Fatal(err)
+	}
+
+	nh, err := b.Hash(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oh != nh {
+		t.Errorf("hash = %v, want %v", nh, oh)
+	}
+	if nsize := b.Size(); nsize >= size {
+		t.Errorf("new size = %v, want < %d", nsize, size)
+	}
+
+	rtx := b.ReadTx()
+	rtx.RLock()
+	n := 0
+	err = rtx.UnsafeForEach(schema.Test, func(k, v []byte) error {
+		n++
+		return nil
+	})
+	rtx.RUnlock()
+	assert.NoError(t, err)
+	if n != 5000 {
+		t.Errorf("len(keys) = %d, want 5000", n)
+	}
+}
+
+// TestBackendDefragIncrementalWrites ensures the writes made while the
+// incremental defragmentation copies the backend are kept.
+func TestBackendDefragIncrementalWrites(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+
+	want := make(map[string]string)
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(schema.Test)
+	for i := 0; i < 50000; i++ {
+		k := fmt.Sprintf("foo_%05d", i)
+		tx.UnsafePut(schema.Test, []byte(k), []byte("bar"))
+		want[k] = "bar"
+	}
+	tx.Unlock()
+	b.ForceCommit()
+
+	donec := make(chan struct{})
+	writec := make(chan struct{})
+	go func() {
+		defer close(writec)
+		for i := 0; ; i++ {
+			select {
+			case <-donec:
+				return
+			default:
+			}
+			k := fmt.Sprintf("foo_%05d", (i*7919)%50000)
+			tx.Lock()
+			if i%2 == 0 {
+				tx.UnsafeDelete(schema.Test, []byte(k))
+				delete(want, k)
+			} else {
+				tx.UnsafePut(schema.Test, []byte(k), []byte(fmt.Sprintf("v%d", i)))
+				want[k] = fmt.Sprintf("v%d", i)
+			}
+			if i == 0 {
+				tx.UnsafeCreateBucket(schema.Key)
+				tx.UnsafePut(schema.Key, []byte("k"), []byte("v"))
+			}
+			tx.Unlock()
+		}
+	}()
+	err := b.DefragIncremental()
+	close(donec)
+	<-writec
+	require.NoError(t, err)
+
+	got := make(map[string]string)
+	rtx := b.ReadTx()
+	rtx.RLock()
+	err = rtx.UnsafeForEach(schema.Test, func(k, v []byte) error {
+		got[string(k)] = string(v)
+		return nil
+	})
+	ks, _ := rtx.UnsafeRange(schema.Key, []byte("k"), nil, 0)
+	rtx.RUnlock()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Len(t, ks, 1)
+}
+
 // TestBackendWriteback ensures writes are stored to the read txn on write txn unlock.
 func TestBackendWriteback(t *testing.T) {
 	b, _ := betesting.NewDefaultTmpBackend(t)
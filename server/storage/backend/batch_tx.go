@@ -76,6 +76,9 @@ type batchTx struct {
 	backend *backend
 
 	pending int
+	// dirty records the keys written while the incremental defragmentation
+	// copies the backend, if one is running.
+	dirty *dirtyKeys
 }
 
 // Lock is supposed to be called only by the unit test.
@@ -114,6 +117,9 @@ func (t *batchTx) Unlock() {
 }
 
 func (t *batchTx) UnsafeCreateBucket(bucket Bucket) {
+	if t.dirty != nil && t.tx.Bucket(bucket.Name()) == nil {
+		t.dirty.bucket(bucket.Name())
+	}
 	if _, err := t.tx.CreateBucketIfNotExists(bucket.Name()); err != nil {
 		t.backend.lg.Fatal(
 			"failed to create a bucket",
@@ -125,6 +131,9 @@ func (t *batchTx) UnsafeCreateBucket(bucket Bucket) {
 }
 
 func (t *batchTx) UnsafeDeleteBucket(bucket Bucket) {
+	if t.dirty != nil {
+		t.dirty.bucket(bucket.Name())
+	}
 	err := t.tx.DeleteBucket(bucket.Name())
 	if err != nil && !errors.Is(err, bolterrors.ErrBucketNotFound) {
 		t.backend.lg.Fatal(
@@ -167,6 +176,9 @@ func (t *batchTx) unsafePut(bucketType Bucket, key []byte, value []byte, seq boo
 			zap.Error(err),
 		)
 	}
+	if t.dirty != nil {
+		t.dirty.key(bucketType.Name(), key)
+	}
 	t.pending++
 }
 
@@ -223,6 +235,9 @@ func (t *batchTx) UnsafeDelete(bucketType Bucket, key []byte) {
 			zap.Error(err),
 		)
 	}
+	if t.dirty != nil {
+		t.dirty.key(bucketType.Name(), key)
+	}
 	t.pending++
 }
 
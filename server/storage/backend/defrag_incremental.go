@@ -0,0 +1,317 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+
+	bolt "go.etcd.io/bbolt"
+	bolterrors "go.etcd.io/bbolt/errors"
+)
+
+// dirtyKeys records the keys written to the backend while the incremental
+// defragmentation copies it, to copy them again once the copy is done.
+type dirtyKeys struct {
+	keys map[string]map[string]struct{}
+	// buckets are the buckets created or deleted, which are copied again
+	// as a whole.
+	buckets map[string]struct{}
+}
+
+func newDirtyKeys() *dirtyKeys {
+	return &dirtyKeys{
+		keys:    make(map[string]map[string]struct{}),
+		buckets: make(map[string]struct{}),
+	}
+}
+
+func (d *dirtyKeys) key(bucket, key []byte) {
+	ks, ok := d.keys[string(bucket)]
+	if !ok {
+		ks = make(map[string]struct{})
+		d.keys[string(bucket)] = ks
+	}
+	ks[string(key)] = struct{}{}
+}
+
+func (d *dirtyKeys) bucket(bucket []byte) {
+	d.buckets[string(bucket)] = struct{}{}
+}
+
+func (b *backend) DefragIncremental() error {
+	now := time.Now()
+	isIncrementalDefragActive.Set(1)
+	defer isIncrementalDefragActive.Set(0)
+	defragProgress.Set(0)
+
+	// record the keys written from now on, and commit the ones written
+	// before so that the copy sees them.
+	b.batchTx.LockOutsideApply()
+	if b.batchTx.dirty != nil {
+		b.batchTx.Unlock()
+		return errors.New("backend: incremental defragmentation already in progress")
+	}
+	b.batchTx.dirty = newDirtyKeys()
+	b.batchTx.commit(false)
+	b.batchTx.Unlock()
+
+	b.mu.RLock()
+	tmpdb, err := b.openDefragTmpDB()
+	b.mu.RUnlock()
+	if err != nil {
+		b.stopDefragIncremental(nil)
+		return err
+	}
+
+	dbp := b.db.Path()
+	size1, sizeInUse1 := b.Size(), b.SizeInUse()
+	b.lg.Info(
+		"defragmenting incrementally",
+		zap.String("path", dbp),
+		zap.Int64("current-db-size-bytes", size1),
+		zap.String("current-db-size", humanize.Bytes(uint64(size1))),
+		zap.Int64("current-db-size-in-use-bytes", sizeInUse1),
+		zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse1))),
+	)
+
+	if err = b.defragCopy(tmpdb, sizeInUse1); err != nil {
+		b.stopDefragIncremental(tmpdb)
+		return err
+	}
+
+	b.batchTx.LockOutsideApply()
+	defer b.batchTx.Unlock()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.readTx.Lock()
+	defer b.readTx.Unlock()
+
+	b.batchTx.unsafeCommit(true)
+	b.batchTx.tx = nil
+	dirty := b.batchTx.dirty
+	b.batchTx.dirty = nil
+
+	if err = defragCopyDirty(b.db, tmpdb, dirty); err != nil {
+		tmpdb.Close()
+		if rmErr := os.RemoveAll(tmpdb.Path()); rmErr != nil {
+			b.lg.Error("failed to remove db.tmp after defragmentation failed", zap.Error(rmErr))
+		}
+		b.batchTx.tx = b.unsafeBegin(true)
+		b.readTx.reset()
+		b.readTx.tx = b.unsafeBegin(false)
+		return err
+	}
+	b.unsafeReplaceDB(tmpdb)
+	defragProgress.Set(1)
+
+	took := time.Since(now)
+	defragSec.Observe(took.Seconds())
+
+	size2, sizeInUse2 := b.Size(), b.SizeInUse()
+	b.lg.Info(
+		"finished defragmenting incrementally",
+		zap.String("path", dbp),
+		zap.Int("dirty-buckets", len(dirty.buckets)),
+		zap.Int("dirty-key-buckets", len(dirty.keys)),
+		zap.Int64("current-db-size-bytes-diff", size2-size1),
+		zap.Int64("current-db-size-bytes", size2),
+		zap.String("current-db-size", humanize.Bytes(uint64(size2))),
+		zap.Int64("current-db-size-in-use-bytes-diff", sizeInUse2-sizeInUse1),
+		zap.Int64("current-db-size-in-use-bytes", sizeInUse2),
+		zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse2))),
+		zap.Duration("took", took),
+	)
+	return nil
+}
+
+// stopDefragIncremental stops recording the written keys and removes the
+// copy of a failed incremental defragmentation.
+func (b *backend) stopDefragIncremental(tmpdb *bolt.DB) {
+	b.batchTx.LockOutsideApply()
+	b.batchTx.dirty = nil
+	b.batchTx.Unlock()
+	if tmpdb == nil {
+		return
+	}
+	tmpdb.Close()
+	if err := os.RemoveAll(tmpdb.Path()); err != nil {
+		b.lg.Error("failed to remove db.tmp after defragmentation failed", zap.Error(err))
+	}
+}
+
+// defragCopy copies the backend into tmpdb bucket by bucket, in slices of
+// defragSliceDuration. The progress is reported as the ratio of the copied
+// bytes to sizeInUse.
+func (b *backend) defragCopy(tmpdb *bolt.DB, sizeInUse int64) error {
+	buckets, err := b.defragBuckets()
+	if err != nil {
+		return err
+	}
+	var copied int64
+	for _, name := range buckets {
+		var next []byte
+		for first := true; first || next != nil; first = false {
+			var n int64
+			next, n, err = b.defragCopySlice(tmpdb, name, next)
+			if err != nil {
+				return err
+			}
+			copied += n
+			if sizeInUse > 0 {
+				// page overheads are not counted, so stay below 1 until done
+				defragProgress.Set(min(float64(copied)/float64(sizeInUse), 0.99))
+			}
+		}
+	}
+	return nil
+}
+
+// defragBuckets returns the names of the buckets in the backend.
+func (b *backend) defragBuckets() (names [][]byte, err error) {
+	tx := b.begin(false)
+	defer tx.Rollback()
+	err = tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+		names = append(names, append([]byte(nil), name...))
+		return nil
+	})
+	return names, err
+}
+
+// defragCopySlice copies the keys of the named bucket from key onwards into
+// tmpdb, until defragSliceDuration elapses. It returns the key to resume
+// from, which is nil once the bucket is done, and the number of bytes
+// copied. The keys written meanwhile are copied again at the end.
+func (b *backend) defragCopySlice(tmpdb *bolt.DB, name, key []byte) ([]byte, int64, error) {
+	start := time.Now()
+	// prevent a full defragmentation from closing the database
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	tx, err := b.db.Begin(false)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer tx.Rollback()
+	bucket := tx.Bucket(name)
+	if bucket == nil {
+		// the bucket was deleted in the meantime
+		return nil, 0, nil
+	}
+
+	tmptx, err := tmpdb.Begin(true)
+	if err != nil {
+		return nil, 0, err
+	}
+	tmpb, err := tmptx.CreateBucketIfNotExists(name)
+	if err != nil {
+		tmptx.Rollback()
+		return nil, 0, err
+	}
+	tmpb.FillPercent = 0.9 // for bucket2seq write in for each
+
+	var n int64
+	c := bucket.Cursor()
+	k, v := c.First()
+	if key != nil {
+		k, v = c.Seek(key)
+	}
+	for count := 0; k != nil; k, v = c.Next() {
+		if count == defragChunkSize {
+			if time.Since(start) >= defragSliceDuration {
+				break
+			}
+			count = 0
+		}
+		if v == nil {
+			// nested buckets are not used by the backend
+			continue
+		}
+		if err = tmpb.Put(k, v); err != nil {
+			tmptx.Rollback()
+			return nil, 0, err
+		}
+		n += int64(len(k) + len(v))
+		count++
+	}
+	var next []byte
+	if k != nil {
+		next = append([]byte(nil), k...)
+	}
+	return next, n, tmptx.Commit()
+}
+
+// defragCopyDirty copies again into tmpdb the keys and buckets of db written
+// during the copy.
+func defragCopyDirty(db, tmpdb *bolt.DB, dirty *dirtyKeys) error {
+	tx, err := db.Begin(false)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	tmptx, err := tmpdb.Begin(true)
+	if err != nil {
+		return err
+	}
+
+	for name := range dirty.buckets {
+		if err = tmptx.DeleteBucket([]byte(name)); err != nil && !errors.Is(err, bolterrors.ErrBucketNotFound) {
+			tmptx.Rollback()
+			return err
+		}
+		bucket := tx.Bucket([]byte(name))
+		if bucket == nil {
+			continue
+		}
+		tmpb, err := tmptx.CreateBucket([]byte(name))
+		if err != nil {
+			tmptx.Rollback()
+			return err
+		}
+		if err = bucket.ForEach(tmpb.Put); err != nil {
+			tmptx.Rollback()
+			return err
+		}
+	}
+	for name, keys := range dirty.keys {
+		if _, ok := dirty.buckets[name]; ok {
+			continue
+		}
+		bucket := tx.Bucket([]byte(name))
+		if bucket == nil {
+			continue
+		}
+		tmpb, err := tmptx.CreateBucketIfNotExists([]byte(name))
+		if err != nil {
+			tmptx.Rollback()
+			return err
+		}
+		for k := range keys {
+			if v := bucket.Get([]byte(k)); v != nil {
+				err = tmpb.Put([]byte(k), v)
+			} else {
+				err = tmpb.Delete([]byte(k))
+			}
+			if err != nil {
+				tmptx.Rollback()
+				return err
+			}
+		}
+	}
+	return tmptx.Commit()
+}
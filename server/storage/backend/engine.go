@@ -0,0 +1,94 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// EngineBbolt keeps the buckets in a bbolt database file. It is the
+	// default engine.
+	EngineBbolt = "bbolt"
+	// EngineMemory keeps the buckets in memory, loading them from the
+	// database file on open and saving them to it on close, in the bbolt
+	// format. The writes since the last close are lost on a crash, while the
+	// WAL is released up to the raft snapshots, so it is not crash safe: it is
+	// meant for tests and for the backends of witnesses, which are not saved.
+	// Saving the whole file on close does not reduce the write amplification
+	// of write-heavy workloads either.
+	EngineMemory = "memory"
+)
+
+// Engine opens a backend with the given configuration.
+type Engine func(bcfg BackendConfig) Backend
+
+var (
+	enginesMu sync.RWMutex
+	engines   = map[string]Engine{
+		EngineBbolt:  func(bcfg BackendConfig) Backend { return newBackend(bcfg) },
+		EngineMemory: func(bcfg BackendConfig) Backend { return newMemoryBackend(bcfg) },
+	}
+)
+
+// RegisterEngine makes the engine available to New under the given name,
+// replacing any engine registered under it.
+//
+// The snapshots of the backends of an engine are sent to the other members
+// and read by etcdutl as bbolt database files, so an engine must write its
+// snapshots in the bbolt format and open the bbolt database files it is
+// restored from.
+func RegisterEngine(name string, engine Engine) {
+	enginesMu.Lock()
+	defer enginesMu.Unlock()
+	engines[name] = engine
+}
+
+// HasEngine returns true if an engine is registered under the given name.
+func HasEngine(name string) bool {
+	enginesMu.RLock()
+	defer enginesMu.RUnlock()
+	_, ok := engines[name]
+	return ok
+}
+
+// Engines returns the sorted names of the registered engines.
+func Engines() []string {
+	enginesMu.RLock()
+	defer enginesMu.RUnlock()
+	names := make([]string, 0, len(engines))
+	for name := range engines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func openEngine(bcfg BackendConfig) Backend {
+	name := bcfg.Engine
+	if name == "" {
+		name = EngineBbolt
+	}
+	enginesMu.RLock()
+	engine, ok := engines[name]
+	enginesMu.RUnlock()
+	if !ok {
+		bcfg.Logger.Panic("unknown backend engine", zap.String("engine", name), zap.Strings("engines", Engines()))
+	}
+	return engine(bcfg)
+}
@@ -0,0 +1,635 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"bytes"
+	"hash/crc32"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/btree"
+	"go.uber.org/zap"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+)
+
+// memoryBackend is the backend of EngineMemory.
+//
+// The writes of its batch tx are visible to its read txs as soon as they are
+// made, not when the batch tx is unlocked: the callers reading the keys of
+// revisions, like mvcc, only read the ones of finished txs anyway.
+type memoryBackend struct {
+	// commits counts number of commits since start
+	commits int64
+
+	lg            *zap.Logger
+	path          string
+	unsafeNoFsync bool
+
+	store   *memoryStore
+	batchTx *memoryBatchTx
+	readTx  *memoryReadTx
+
+	batchInterval time.Duration
+	batchLimit    int
+
+	stopc chan struct{}
+	donec chan struct{}
+
+	hooks Hooks
+
+	// txPostLockInsideApplyHook is called each time right after locking the tx.
+	txPostLockInsideApplyHook func()
+}
+
+func newMemoryBackend(bcfg BackendConfig) *memoryBackend {
+	b := &memoryBackend{
+		lg:            bcfg.Logger,
+		path:          bcfg.Path,
+		unsafeNoFsync: bcfg.UnsafeNoFsync,
+		store:         newMemoryStore(),
+
+		batchInterval: bcfg.BatchInterval,
+		batchLimit:    bcfg.BatchLimit,
+
+		stopc: make(chan struct{}),
+		donec: make(chan struct{}),
+	}
+	if b.path != "" && fileutil.Exist(b.path) {
+		if err := b.store.load(b.path); err != nil {
+			b.lg.Panic("failed to load database", zap.String("path", b.path), zap.Error(err))
+		}
+	}
+	b.batchTx = &memoryBatchTx{backend: b, store: b.store, lg: b.lg}
+	b.readTx = &memoryReadTx{store: b.store}
+	b.hooks = bcfg.Hooks
+
+	go b.run()
+	return b
+}
+
+func (b *memoryBackend) ReadTx() ReadTx           { return b.readTx }
+func (b *memoryBackend) BatchTx() BatchTx         { return b.batchTx }
+func (b *memoryBackend) ConcurrentReadTx() ReadTx { return b.readTx }
+
+func (b *memoryBackend) SetTxPostLockInsideApplyHook(hook func()) {
+	b.batchTx.lock()
+	defer b.batchTx.Unlock()
+	b.txPostLockInsideApplyHook = hook
+}
+
+func (b *memoryBackend) ForceCommit() {
+	b.batchTx.Commit()
+}
+
+func (b *memoryBackend) Snapshot() Snapshot {
+	b.batchTx.Commit()
+	s, err := b.snapshot(b.store)
+	if err != nil {
+		b.lg.Fatal("failed to snapshot backend", zap.Error(err))
+	}
+	return s
+}
+
+func (b *memoryBackend) SnapshotSince(bucket Bucket, from []byte, write func(src UnsafeReader, tx UnsafeReadWriter) error) (Snapshot, error) {
+	b.batchTx.Commit()
+	// the buckets are cloned copy on write, src keeps the whole store
+	src := b.store.copySince(bucket, nil)
+	store := src.copySince(bucket, from)
+	if write != nil {
+		if err := write(&memoryBatchTx{store: src, lg: b.lg}, &memoryBatchTx{store: store, lg: b.lg}); err != nil {
+			return nil, err
+		}
+	}
+	return b.snapshot(store)
+}
+
+// snapshot writes store to a temporary bbolt database file next to the
+// backend, removed on Close.
+func (b *memoryBackend) snapshot(store *memoryStore) (Snapshot, error) {
+	dir := os.TempDir()
+	if b.path != "" {
+		dir = filepath.Dir(b.path)
+	}
+	// Snapshotter.cleanupSnapdir cleans up any of these that are found during startup.
+	temp, err := os.CreateTemp(dir, "db.tmp.*")
+	if err != nil {
+		return nil, err
+	}
+	path := temp.Name()
+	if err = store.save(temp, b.unsafeNoFsync); err != nil {
+		os.Remove(path)
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		os.Remove(path)
+		return nil, err
+	}
+	return &fileSnapshot{f}, nil
+}
+
+func (b *memoryBackend) Hash(ignores func(bucketName, keyName []byte) bool) (uint32, error) {
+	h := crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	b.store.mu.RLock()
+	defer b.store.mu.RUnlock()
+	for _, name := range b.store.unsafeNames() {
+		h.Write([]byte(name))
+		b.store.buckets[name].Ascend(func(item memoryItem) bool {
+			if ignores != nil && !ignores([]byte(name), item.key) {
+				h.Write(item.key)
+				h.Write(item.value)
+			}
+			return true
+		})
+	}
+	return h.Sum32(), nil
+}
+
+// Size returns the size of the keys and values held by the backend.
+func (b *memoryBackend) Size() int64 {
+	return b.store.size()
+}
+
+func (b *memoryBackend) SizeInUse() int64 {
+	return b.store.size()
+}
+
+func (b *memoryBackend) OpenReadTxN() int64 {
+	return 0
+}
+
+// Defrag does nothing, the memory of deleted keys is reclaimed by the
+// garbage collector.
+func (b *memoryBackend) Defrag() error {
+	return nil
+}
+
+func (b *memoryBackend) DefragIncremental() error {
+	return nil
+}
+
+func (b *memoryBackend) run() {
+	defer close(b.donec)
+	t := time.NewTimer(b.batchInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+		case <-b.stopc:
+			b.batchTx.CommitAndStop()
+			return
+		}
+		if b.batchTx.safePending() != 0 {
+			b.batchTx.Commit()
+		}
+		t.Reset(b.batchInterval)
+	}
+}
+
+// Close saves the buckets to the database file of the backend.
+func (b *memoryBackend) Close() error {
+	close(b.stopc)
+	<-b.donec
+	if b.path == "" {
+		return nil
+	}
+	temp, err := os.CreateTemp(filepath.Dir(b.path), "db.tmp.*")
+	if err != nil {
+		return err
+	}
+	if err = b.store.save(temp, b.unsafeNoFsync); err != nil {
+		os.Remove(temp.Name())
+		return err
+	}
+	if err = os.Rename(temp.Name(), b.path); err != nil {
+		os.Remove(temp.Name())
+		return err
+	}
+	if b.unsafeNoFsync {
+		return nil
+	}
+	dir, err := fileutil.OpenDir(filepath.Dir(b.path))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return fileutil.Fsync(dir)
+}
+
+// Commits returns total number of commits since start
+func (b *memoryBackend) Commits() int64 {
+	return atomic.LoadInt64(&b.commits)
+}
+
+type memoryBatchTx struct {
+	sync.Mutex
+	// backend is nil for the batch tx writing to a snapshot.
+	backend *memoryBackend
+	store   *memoryStore
+	lg      *zap.Logger
+
+	pending int
+}
+
+// Lock is supposed to be called only by the unit test.
+func (t *memoryBatchTx) Lock() {
+	ValidateCalledInsideUnittest(t.lg)
+	t.lock()
+}
+
+func (t *memoryBatchTx) lock() {
+	t.Mutex.Lock()
+}
+
+func (t *memoryBatchTx) LockInsideApply() {
+	t.lock()
+	if t.backend.txPostLockInsideApplyHook != nil {
+		ValidateCalledInsideApply(t.lg)
+		t.backend.txPostLockInsideApplyHook()
+	}
+}
+
+func (t *memoryBatchTx) LockOutsideApply() {
+	ValidateCalledOutSideApply(t.lg)
+	t.lock()
+}
+
+func (t *memoryBatchTx) Unlock() {
+	if t.pending >= t.backend.batchLimit {
+		t.commit()
+	}
+	t.Mutex.Unlock()
+}
+
+// Commit runs the pre commit hook of the backend.
+func (t *memoryBatchTx) Commit() {
+	t.lock()
+	t.commit()
+	t.Unlock()
+}
+
+func (t *memoryBatchTx) CommitAndStop() {
+	t.Commit()
+}
+
+func (t *memoryBatchTx) commit() {
+	if t.backend.hooks != nil {
+		t.backend.hooks.OnPreCommitUnsafe(t)
+	}
+	t.pending = 0
+	atomic.AddInt64(&t.backend.commits, 1)
+}
+
+func (t *memoryBatchTx) safePending() int {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+	return t.pending
+}
+
+func (t *memoryBatchTx) UnsafeCreateBucket(bucket Bucket) {
+	t.store.createBucket(bucket.Name())
+	t.pending++
+}
+
+func (t *memoryBatchTx) UnsafeDeleteBucket(bucket Bucket) {
+	t.store.deleteBucket(bucket.Name())
+	t.pending++
+}
+
+func (t *memoryBatchTx) UnsafePut(bucket Bucket, key []byte, value []byte) {
+	if !t.store.put(bucket.Name(), key, value) {
+		t.lg.Fatal(
+			"failed to find a bucket",
+			zap.Stringer("bucket-name", bucket),
+			zap.Stack("stack"),
+		)
+	}
+	t.pending++
+}
+
+func (t *memoryBatchTx) UnsafeSeqPut(bucket Bucket, key []byte, value []byte) {
+	t.UnsafePut(bucket, key, value)
+}
+
+func (t *memoryBatchTx) UnsafeDelete(bucket Bucket, key []byte) {
+	if !t.store.delete(bucket.Name(), key) {
+		t.lg.Fatal(
+			"failed to find a bucket",
+			zap.Stringer("bucket-name", bucket),
+			zap.Stack("stack"),
+		)
+	}
+	t.pending++
+}
+
+func (t *memoryBatchTx) UnsafeRange(bucket Bucket, key, endKey []byte, limit int64) ([][]byte, [][]byte) {
+	keys, vals, ok := t.store.rangeKeys(bucket.Name(), key, endKey, limit)
+	if !ok {
+		t.lg.Fatal(
+			"failed to find a bucket",
+			zap.Stringer("bucket-name", bucket),
+			zap.Stack("stack"),
+		)
+	}
+	return keys, vals
+}
+
+func (t *memoryBatchTx) UnsafeForEach(bucket Bucket, visitor func(k, v []byte) error) error {
+	return t.store.forEach(bucket.Name(), visitor)
+}
+
+type memoryReadTx struct {
+	store *memoryStore
+}
+
+// RLock is no-op, the reads lock the store.
+func (rt *memoryReadTx) RLock()   {}
+func (rt *memoryReadTx) RUnlock() {}
+
+func (rt *memoryReadTx) UnsafeRange(bucket Bucket, key, endKey []byte, limit int64) ([][]byte, [][]byte) {
+	if endKey == nil {
+		// forbid duplicates for single keys
+		limit = 1
+	}
+	if limit <= 0 {
+		limit = math.MaxInt64
+	}
+	if limit > 1 && !bucket.IsSafeRangeBucket() {
+		panic("do not use unsafeRange on non-keys bucket")
+	}
+	// ignore missing bucket as the bbolt engine does
+	keys, vals, _ := rt.store.rangeKeys(bucket.Name(), key, endKey, limit)
+	return keys, vals
+}
+
+func (rt *memoryReadTx) UnsafeForEach(bucket Bucket, visitor func(k, v []byte) error) error {
+	return rt.store.forEach(bucket.Name(), visitor)
+}
+
+type memoryItem struct {
+	key, value []byte
+}
+
+// memoryStore holds the buckets of a memory backend as btrees.
+type memoryStore struct {
+	mu      sync.RWMutex
+	buckets map[string]*btree.BTreeG[memoryItem]
+	// bytes is the size of the keys and values of the buckets.
+	bytes int64
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{buckets: make(map[string]*btree.BTreeG[memoryItem])}
+}
+
+func newMemoryBucket() *btree.BTreeG[memoryItem] {
+	return btree.NewG(32, func(a, b memoryItem) bool { return bytes.Compare(a.key, b.key) < 0 })
+}
+
+func (s *memoryStore) size() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.bytes
+}
+
+func (s *memoryStore) createBucket(name []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.buckets[string(name)]; !ok {
+		s.buckets[string(name)] = newMemoryBucket()
+	}
+}
+
+func (s *memoryStore) deleteBucket(name []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if bucket, ok := s.buckets[string(name)]; ok {
+		bucket.Ascend(func(item memoryItem) bool {
+			s.bytes -= int64(len(item.key) + len(item.value))
+			return true
+		})
+		delete(s.buckets, string(name))
+	}
+}
+
+// put copies key and value to the bucket, returning false if it does not
+// exist.
+func (s *memoryStore) put(name, key, value []byte) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bucket, ok := s.buckets[string(name)]
+	if !ok {
+		return false
+	}
+	item := memoryItem{key: bytes.Clone(key), value: bytes.Clone(value)}
+	if item.value == nil {
+		item.value = []byte{}
+	}
+	if old, replaced := bucket.ReplaceOrInsert(item); replaced {
+		s.bytes -= int64(len(old.key) + len(old.value))
+	}
+	s.bytes += int64(len(item.key) + len(item.value))
+	return true
+}
+
+// delete deletes key from the bucket, returning false if it does not exist.
+func (s *memoryStore) delete(name, key []byte) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bucket, ok := s.buckets[string(name)]
+	if !ok {
+		return false
+	}
+	if old, deleted := bucket.Delete(memoryItem{key: key}); deleted {
+		s.bytes -= int64(len(old.key) + len(old.value))
+	}
+	return true
+}
+
+// rangeKeys returns the keys of the bucket from key to endKey, or key alone
+// if endKey is empty, as unsafeRange does. It returns false if the bucket
+// does not exist.
+func (s *memoryStore) rangeKeys(name, key, endKey []byte, limit int64) (keys [][]byte, vals [][]byte, ok bool) {
+	if limit <= 0 {
+		limit = math.MaxInt64
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	bucket, ok := s.buckets[string(name)]
+	if !ok {
+		return nil, nil, false
+	}
+	if len(endKey) == 0 {
+		if item, found := bucket.Get(memoryItem{key: key}); found {
+			return [][]byte{item.key}, [][]byte{item.value}, true
+		}
+		return nil, nil, true
+	}
+	bucket.AscendRange(memoryItem{key: key}, memoryItem{key: endKey}, func(item memoryItem) bool {
+		keys = append(keys, item.key)
+		vals = append(vals, item.value)
+		return int64(len(keys)) < limit
+	})
+	return keys, vals, true
+}
+
+// forEach visits the keys of the bucket in order, without holding the lock
+// of the store so that visitor can write to it.
+func (s *memoryStore) forEach(name []byte, visitor func(k, v []byte) error) error {
+	s.mu.RLock()
+	bucket, ok := s.buckets[string(name)]
+	var items []memoryItem
+	if ok {
+		items = make([]memoryItem, 0, bucket.Len())
+		bucket.Ascend(func(item memoryItem) bool {
+			items = append(items, item)
+			return true
+		})
+	}
+	s.mu.RUnlock()
+	for _, item := range items {
+		if err := visitor(item.key, item.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unsafeNames returns the sorted names of the buckets.
+func (s *memoryStore) unsafeNames() []string {
+	names := make([]string, 0, len(s.buckets))
+	for name := range s.buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// copySince returns a copy of the store in which bucket only holds its keys
+// at or after from.
+func (s *memoryStore) copySince(bucket Bucket, from []byte) *memoryStore {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c := newMemoryStore()
+	for name, src := range s.buckets {
+		dst := src.Clone()
+		if name == string(bucket.Name()) {
+			var old []memoryItem
+			dst.AscendLessThan(memoryItem{key: from}, func(item memoryItem) bool {
+				old = append(old, item)
+				return true
+			})
+			for _, item := range old {
+				dst.Delete(item)
+			}
+		}
+		dst.Ascend(func(item memoryItem) bool {
+			c.bytes += int64(len(item.key) + len(item.value))
+			return true
+		})
+		c.buckets[name] = dst
+	}
+	return c
+}
+
+// load reads the buckets of the bbolt database file at path.
+func (s *memoryStore) load(path string) error {
+	db, err := bolt.Open(path, 0600, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			s.createBucket(name)
+			return b.ForEach(func(k, v []byte) error {
+				s.put(name, k, v)
+				return nil
+			})
+		})
+	})
+}
+
+// save writes the buckets to a bbolt database opened on temp, and closes it.
+func (s *memoryStore) save(temp *os.File, noSync bool) error {
+	options := bolt.Options{}
+	if boltOpenOptions != nil {
+		options = *boltOpenOptions
+	}
+	options.OpenFile = func(_ string, _ int, _ os.FileMode) (*os.File, error) {
+		return temp, nil
+	}
+	options.Mlock = false
+	options.NoSync = noSync
+	db, err := bolt.Open(temp.Name(), 0600, &options)
+	if err != nil {
+		temp.Close()
+		return err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	tx, err := db.Begin(true)
+	if err != nil {
+		db.Close()
+		return err
+	}
+	count := 0
+	for _, name := range s.unsafeNames() {
+		dst, err := tx.CreateBucketIfNotExists([]byte(name))
+		if err != nil {
+			tx.Rollback()
+			db.Close()
+			return err
+		}
+		dst.FillPercent = 0.9
+		s.buckets[name].Ascend(func(item memoryItem) bool {
+			count++
+			if count > defragLimit {
+				if err = tx.Commit(); err != nil {
+					return false
+				}
+				if tx, err = db.Begin(true); err != nil {
+					return false
+				}
+				dst = tx.Bucket([]byte(name))
+				dst.FillPercent = 0.9
+				count = 0
+			}
+			err = dst.Put(item.key, item.value)
+			return err == nil
+		})
+		if err != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+			db.Close()
+			return err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		db.Close()
+		return err
+	}
+	return db.Close()
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func newTmpMemoryBackend(t *testing.T) (backend.Backend, string) {
+	bcfg := backend.DefaultBackendConfig(zaptest.NewLogger(t))
+	bcfg.Engine = backend.EngineMemory
+	bcfg.BatchInterval, bcfg.BatchLimit = time.Hour, 10000
+	return betesting.NewTmpBackendFromCfg(t, bcfg)
+}
+
+func TestMemoryBackendReadWrite(t *testing.T) {
+	b, _ := newTmpMemoryBackend(t)
+	defer betesting.Close(t, b)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(schema.Key)
+	for _, k := range []string{"d", "a", "c", "b"} {
+		tx.UnsafePut(schema.Key, []byte(k), []byte("v"+k))
+	}
+	tx.UnsafeDelete(schema.Key, []byte("c"))
+	ks, vs := tx.UnsafeRange(schema.Key, []byte("a"), []byte("z"), 2)
+	tx.Unlock()
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b")}, ks)
+	assert.Equal(t, [][]byte{[]byte("va"), []byte("vb")}, vs)
+
+	rtx := b.ReadTx()
+	rtx.RLock()
+	defer rtx.RUnlock()
+	ks, _ = rtx.UnsafeRange(schema.Key, []byte("a"), []byte("z"), 0)
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b"), []byte("d")}, ks)
+	ks, vs = rtx.UnsafeRange(schema.Key, []byte("d"), nil, 0)
+	assert.Equal(t, [][]byte{[]byte("d")}, ks)
+	assert.Equal(t, [][]byte{[]byte("vd")}, vs)
+	ks, _ = rtx.UnsafeRange(schema.Test, []byte("a"), nil, 0)
+	assert.Empty(t, ks, "missing buckets are empty")
+
+	var keys []string
+	require.NoError(t, rtx.UnsafeForEach(schema.Key, func(k, _ []byte) error {
+		keys = append(keys, string(k))
+		return nil
+	}))
+	assert.Equal(t, []string{"a", "b", "d"}, keys)
+	assert.Equal(t, int64(9), b.Size())
+}
+
+func TestMemoryBackendHooks(t *testing.T) {
+	var commits int
+	bcfg := backend.DefaultBackendConfig(zaptest.NewLogger(t))
+	bcfg.Engine = backend.EngineMemory
+	bcfg.BatchInterval, bcfg.BatchLimit = time.Hour, 2
+	bcfg.Hooks = backend.NewHooks(func(tx backend.UnsafeReadWriter) {
+		commits++
+	})
+	b, _ := betesting.NewTmpBackendFromCfg(t, bcfg)
+	defer betesting.Close(t, b)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(schema.Test)
+	tx.Unlock()
+	assert.Equal(t, 0, commits)
+	tx.Lock()
+	tx.UnsafePut(schema.Test, []byte("foo"), []byte("bar"))
+	tx.Unlock()
+	assert.Equal(t, 1, commits, "the batch limit is reached")
+	b.ForceCommit()
+	assert.Equal(t, 2, commits)
+}
+
+// TestMemoryBackendSnapshot checks that the snapshots of the memory engine
+// are bbolt database files holding the same keys.
+func TestMemoryBackendSnapshot(t *testing.T) {
+	b, tmpPath := newTmpMemoryBackend(t)
+	defer betesting.Close(t, b)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(schema.Key)
+	tx.UnsafeCreateBucket(schema.Test)
+	for _, k := range []string{"a", "b", "c", "d"} {
+		tx.UnsafePut(schema.Key, []byte(k), []byte("bar"))
+	}
+	tx.UnsafePut(schema.Test, []byte("foo"), []byte("bar"))
+	tx.Unlock()
+
+	path := filepath.Join(t.TempDir(), "snapshot")
+	writeSnapshot(t, b.Snapshot(), path)
+	nb := backend.NewDefaultBackend(zaptest.NewLogger(t), path)
+	defer betesting.Close(t, nb)
+	want, err := b.Hash(nil)
+	require.NoError(t, err)
+	got, err := nb.Hash(nil)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	snap, err := b.SnapshotSince(schema.Key, []byte("c"), func(_ backend.UnsafeReader, tx backend.UnsafeReadWriter) error {
+		tx.UnsafePut(schema.Test, []byte("written"), []byte("bar"))
+		return nil
+	})
+	require.NoError(t, err)
+	path = filepath.Join(t.TempDir(), "snapshot-since")
+	writeSnapshot(t, snap, path)
+	nb = backend.NewDefaultBackend(zaptest.NewLogger(t), path)
+	defer betesting.Close(t, nb)
+	rtx := nb.ReadTx()
+	rtx.RLock()
+	ks, _ := rtx.UnsafeRange(schema.Key, []byte("a"), []byte("z"), 0)
+	var tks []string
+	require.NoError(t, rtx.UnsafeForEach(schema.Test, func(k, _ []byte) error {
+		tks = append(tks, string(k))
+		return nil
+	}))
+	rtx.RUnlock()
+	assert.Equal(t, [][]byte{[]byte("c"), []byte("d")}, ks)
+	assert.Equal(t, []string{"foo", "written"}, tks)
+
+	// the snapshot since does not change the backend
+	ks, _ = b.ReadTx().UnsafeRange(schema.Key, []byte("a"), []byte("z"), 0)
+	assert.Len(t, ks, 4)
+
+	// the temporary snapshot files are removed on close
+	temps, err := filepath.Glob(filepath.Join(filepath.Dir(tmpPath), "db.tmp.*"))
+	require.NoError(t, err)
+	assert.Empty(t, temps)
+}
+
+func writeSnapshot(t *testing.T, snap backend.Snapshot, path string) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	_, err = snap.WriteTo(f)
+	require.NoError(t, err)
+	require.NoError(t, snap.Close())
+	require.NoError(t, f.Close())
+}
+
+func TestMemoryBackendPersist(t *testing.T) {
+	b, tmpPath := newTmpMemoryBackend(t)
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(schema.Test)
+	tx.UnsafePut(schema.Test, []byte("foo"), []byte("bar"))
+	tx.Unlock()
+	require.NoError(t, b.Close())
+
+	// the database file can be opened by both engines
+	for _, engine := range []string{backend.EngineBbolt, backend.EngineMemory} {
+		nb := backend.NewDefaultBackend(zaptest.NewLogger(t), tmpPath, backend.WithEngine(engine))
+		_, vs := nb.ReadTx().UnsafeRange(schema.Test, []byte("foo"), nil, 0)
+		assert.Equal(t, [][]byte{[]byte("bar")}, vs, engine)
+		betesting.Close(t, nb)
+	}
+}
@@ -90,6 +90,20 @@ var (
 		Name:      "defrag_inflight",
 		Help:      "Whether or not defrag is active on the member. 1 means active, 0 means not.",
 	})
+
+	isIncrementalDefragActive = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "disk",
+		Name:      "defrag_incremental_inflight",
+		Help:      "Whether or not incremental defrag is active on the member. 1 means active, 0 means not.",
+	})
+
+	defragProgress = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "disk",
+		Name:      "defrag_progress",
+		Help:      "The estimated fraction of the running or last incremental defrag on the member done, from 0 to 1.",
+	})
 )
 
 func init() {
@@ -100,4 +114,6 @@ func init() {
 	prometheus.MustRegister(defragSec)
 	prometheus.MustRegister(snapshotTransferSec)
 	prometheus.MustRegister(isDefragActive)
+	prometheus.MustRegister(isIncrementalDefragActive)
+	prometheus.MustRegister(defragProgress)
 }
@@ -0,0 +1,165 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// SnapshotSince returns a snapshot of the backend in which bucket only holds
+// its keys at or after from. The snapshot is built in a temporary file next to
+// the backend, removed on Close, and write is called on it, holding a copy
+// of all the other buckets from the same point in time, before it is taken.
+// write reads the whole backend at that point in time from src.
+func (b *backend) SnapshotSince(bucket Bucket, from []byte, write func(src UnsafeReader, tx UnsafeReadWriter) error) (Snapshot, error) {
+	b.batchTx.Commit()
+
+	b.mu.RLock()
+	tx, err := b.db.Begin(false)
+	dir := filepath.Dir(b.db.Path())
+	b.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Snapshotter.cleanupSnapdir cleans up any of these that are found during startup.
+	temp, err := os.CreateTemp(dir, "db.tmp.*")
+	if err != nil {
+		return nil, err
+	}
+	path := temp.Name()
+	if err = b.writeSnapshotSince(tx, temp, bucket, from, write); err != nil {
+		os.Remove(path)
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		os.Remove(path)
+		return nil, err
+	}
+	return &fileSnapshot{f}, nil
+}
+
+// writeSnapshotSince copies tx into the bolt db opened on temp, and closes it.
+func (b *backend) writeSnapshotSince(tx *bolt.Tx, temp *os.File, bucket Bucket, from []byte, write func(src UnsafeReader, tx UnsafeReadWriter) error) error {
+	options := bolt.Options{}
+	if boltOpenOptions != nil {
+		options = *boltOpenOptions
+	}
+	options.OpenFile = func(_ string, _ int, _ os.FileMode) (*os.File, error) {
+		return temp, nil
+	}
+	options.Mlock = false
+	tmpdb, err := bolt.Open(temp.Name(), 0600, &options)
+	if err != nil {
+		temp.Close()
+		return err
+	}
+	if err = copySince(tx, tmpdb, bucket, from, func(tmptx *bolt.Tx) error {
+		if write == nil {
+			return nil
+		}
+		return write(&batchTx{tx: tx, backend: b}, &batchTx{tx: tmptx, backend: b})
+	}); err != nil {
+		tmpdb.Close()
+		return err
+	}
+	return tmpdb.Close()
+}
+
+// copySince copies all the buckets of tx to tmpdb, where bucket only gets its
+// keys at or after from, and calls write before the last commit.
+func copySince(tx *bolt.Tx, tmpdb *bolt.DB, bucket Bucket, from []byte, write func(tmptx *bolt.Tx) error) (err error) {
+	tmptx, err := tmpdb.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmptx.Rollback()
+		}
+	}()
+
+	count := 0
+	c := tx.Cursor()
+	for name, _ := c.First(); name != nil; name, _ = c.Next() {
+		src := tx.Bucket(name)
+		if src == nil {
+			return fmt.Errorf("backend: cannot snapshot bucket %s", name)
+		}
+		dst, berr := tmptx.CreateBucketIfNotExists(name)
+		if berr != nil {
+			return berr
+		}
+		dst.FillPercent = 0.9
+
+		bc := src.Cursor()
+		k, v := bc.First()
+		if bytes.Equal(name, bucket.Name()) {
+			k, v = bc.Seek(from)
+		}
+		for ; k != nil; k, v = bc.Next() {
+			count++
+			if count > defragLimit {
+				if err = tmptx.Commit(); err != nil {
+					return err
+				}
+				if tmptx, err = tmpdb.Begin(true); err != nil {
+					return err
+				}
+				dst = tmptx.Bucket(name)
+				dst.FillPercent = 0.9
+				count = 0
+			}
+			if err = dst.Put(k, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = write(tmptx); err != nil {
+		return err
+	}
+	return tmptx.Commit()
+}
+
+// fileSnapshot is a snapshot held in a temporary file, removed on Close.
+type fileSnapshot struct {
+	f *os.File
+}
+
+func (s *fileSnapshot) Size() int64 {
+	fi, err := s.f.Stat()
+	if err != nil {
+		return 0
+	}
+	return fi.Size()
+}
+
+func (s *fileSnapshot) WriteTo(w io.Writer) (int64, error) {
+	return io.Copy(w, s.f)
+}
+
+func (s *fileSnapshot) Close() error {
+	s.f.Close()
+	return os.Remove(s.f.Name())
+}
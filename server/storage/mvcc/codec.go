@@ -0,0 +1,185 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// ValueCodec is the codec the values of the keys are compressed with in the
+// key bucket.
+type ValueCodec byte
+
+const (
+	ValueCodecNone ValueCodec = iota
+	ValueCodecSnappy
+	ValueCodecZstd
+)
+
+const (
+	// compressedMarker is the first byte of the key bucket values whose
+	// mvccpb.KeyValue has a compressed Value. It is followed by the codec
+	// and the encoded mvccpb.KeyValue. No encoded mvccpb.KeyValue starts
+	// with it, as field number 0 is invalid in protobuf.
+	compressedMarker = schema.CompressedKeyValueMarker
+
+	// minCompressValueBytes is the size of the values below which they are
+	// not compressed.
+	minCompressValueBytes = 256
+
+	// maxDecompressedValueBytes bounds the size of decompressed values, so
+	// that a corrupted value does not make mvcc allocate without limit.
+	maxDecompressedValueBytes = math.MaxInt32
+)
+
+var (
+	valueCodecNames = map[string]ValueCodec{
+		"":       ValueCodecNone,
+		"none":   ValueCodecNone,
+		"snappy": ValueCodecSnappy,
+		"zstd":   ValueCodecZstd,
+	}
+
+	valueZstdEncoder = sync.OnceValue(func() *zstd.Encoder {
+		e, err := zstd.NewWriter(nil)
+		if err != nil {
+			panic(err)
+		}
+		return e
+	})
+	valueZstdDecoder = sync.OnceValue(func() *zstd.Decoder {
+		d, err := zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxDecompressedValueBytes))
+		if err != nil {
+			panic(err)
+		}
+		return d
+	})
+)
+
+// ParseValueCodec returns the value codec of the given name, "none",
+// "snappy" or "zstd". An empty name is "none".
+func ParseValueCodec(name string) (ValueCodec, error) {
+	codec, ok := valueCodecNames[name]
+	if !ok {
+		return ValueCodecNone, fmt.Errorf("mvcc: unknown value codec %q", name)
+	}
+	return codec, nil
+}
+
+// StoredValueSize returns the size value is stored with in the key bucket
+// when compressed with codec.
+func StoredValueSize(codec ValueCodec, value []byte) int {
+	if compressed := compressValue(codec, value); compressed != nil {
+		return len(compressed)
+	}
+	return len(value)
+}
+
+// encodeKeyValue returns the key bucket value of kv, with its Value
+// compressed with codec if it is worth it.
+func encodeKeyValue(kv *mvccpb.KeyValue, codec ValueCodec) ([]byte, error) {
+	compressed := compressValue(codec, kv.Value)
+	if compressed == nil {
+		return kv.Marshal()
+	}
+	ckv := *kv
+	ckv.Value = compressed
+	d := make([]byte, 2+ckv.Size())
+	d[0], d[1] = compressedMarker, byte(codec)
+	if _, err := ckv.MarshalToSizedBuffer(d[2:]); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// DecodeKeyValue decodes the key bucket value d into kv, decompressing its
+// Value if it was stored compressed.
+func DecodeKeyValue(d []byte, kv *mvccpb.KeyValue) error {
+	if len(d) == 0 || d[0] != compressedMarker {
+		return kv.Unmarshal(d)
+	}
+	if len(d) < 2 {
+		return fmt.Errorf("mvcc: truncated compressed key value")
+	}
+	if err := kv.Unmarshal(d[2:]); err != nil {
+		return err
+	}
+	value, err := decompressValue(ValueCodec(d[1]), kv.Value)
+	if err != nil {
+		return err
+	}
+	kv.Value = value
+	return nil
+}
+
+// plainKeyValue returns the key bucket value d with its Value decompressed,
+// so that the hashes of the key bucket do not depend on the compression.
+func plainKeyValue(d []byte) ([]byte, error) {
+	if len(d) == 0 || d[0] != compressedMarker {
+		return d, nil
+	}
+	var kv mvccpb.KeyValue
+	if err := DecodeKeyValue(d, &kv); err != nil {
+		return nil, err
+	}
+	return kv.Marshal()
+}
+
+// compressValue returns value compressed with codec, or nil if it is not
+// worth it.
+func compressValue(codec ValueCodec, value []byte) []byte {
+	if len(value) < minCompressValueBytes {
+		return nil
+	}
+	var compressed []byte
+	switch codec {
+	case ValueCodecSnappy:
+		compressed = snappy.Encode(nil, value)
+	case ValueCodecZstd:
+		compressed = valueZstdEncoder().EncodeAll(value, nil)
+	default:
+		return nil
+	}
+	if len(compressed) >= len(value) {
+		return nil
+	}
+	return compressed
+}
+
+func decompressValue(codec ValueCodec, value []byte) ([]byte, error) {
+	switch codec {
+	case ValueCodecSnappy:
+		n, err := snappy.DecodedLen(value)
+		if err != nil {
+			return nil, err
+		}
+		if n > maxDecompressedValueBytes {
+			return nil, fmt.Errorf("mvcc: decompressed value size %d exceeds %d", n, maxDecompressedValueBytes)
+		}
+		return snappy.Decode(nil, value)
+	case ValueCodecZstd:
+		return valueZstdDecoder().DecodeAll(value, nil)
+	default:
+		return nil, fmt.Errorf("mvcc: unknown value codec %d", codec)
+	}
+}
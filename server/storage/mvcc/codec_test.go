@@ -0,0 +1,124 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func TestValueCompression(t *testing.T) {
+	big := bytes.Repeat([]byte(`{"name":"value"}`), 100)
+	for _, name := range []string{"snappy", "zstd"} {
+		t.Run(name, func(t *testing.T) {
+			codec, err := ParseValueCodec(name)
+			require.NoError(t, err)
+
+			b0, _ := betesting.NewDefaultTmpBackend(t)
+			s0 := newWatchableStore(zaptest.NewLogger(t), b0, &lease.FakeLessor{}, StoreConfig{})
+			defer cleanup(s0, b0)
+			b1, _ := betesting.NewDefaultTmpBackend(t)
+			s1 := newWatchableStore(zaptest.NewLogger(t), b1, &lease.FakeLessor{}, StoreConfig{ValueCompression: codec})
+			defer cleanup(s1, b1)
+
+			for _, s := range []*watchableStore{s0, s1} {
+				s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+				s.Put([]byte("foo"), big, lease.NoLease)
+				s.DeleteRange([]byte("foo"), nil)
+				s.Put([]byte("foo"), big, lease.NoLease)
+			}
+
+			// the big values are stored compressed
+			tx := b1.ReadTx()
+			tx.RLock()
+			_, vs := tx.UnsafeRange(schema.Key, RevToBytes(Revision{Main: 2}, NewRevBytes()), RevToBytes(Revision{Main: 6}, NewRevBytes()), 0)
+			tx.RUnlock()
+			require.Len(t, vs, 4)
+			assert.NotEqual(t, byte(compressedMarker), vs[0][0])
+			assert.Equal(t, []byte{compressedMarker, byte(codec)}, vs[1][:2])
+			assert.Less(t, len(vs[1]), len(big))
+
+			r, err := s1.Range(context.TODO(), []byte("foo"), nil, RangeOptions{})
+			require.NoError(t, err)
+			require.Len(t, r.KVs, 1)
+			assert.Equal(t, big, r.KVs[0].Value)
+
+			// the hashes do not depend on the compression
+			h0, _, err := s0.HashStorage().HashByRev(0)
+			require.NoError(t, err)
+			h1, _, err := s1.HashStorage().HashByRev(0)
+			require.NoError(t, err)
+			assert.Equal(t, h0, h1)
+
+			// the watchers catching up read the values from the backend
+			w := s1.NewWatchStream()
+			defer w.Close()
+			_, err = w.Watch(0, []byte("foo"), nil, 1)
+			require.NoError(t, err)
+			var values [][]byte
+			for len(values) < 4 {
+				select {
+				case resp := <-w.Chan():
+					for _, ev := range resp.Events {
+						values = append(values, ev.Kv.Value)
+					}
+				case <-time.After(5 * time.Second):
+					t.Fatalf("got %d events, want 4", len(values))
+				}
+			}
+			assert.Equal(t, [][]byte{[]byte("bar"), big, nil, big}, values)
+
+			// a store not compressing the values reads the compressed ones
+			s := NewStore(zaptest.NewLogger(t), b1, &lease.FakeLessor{}, StoreConfig{})
+			defer s.Close()
+			r, err = s.Range(context.TODO(), []byte("foo"), nil, RangeOptions{})
+			require.NoError(t, err)
+			require.Len(t, r.KVs, 1)
+			assert.Equal(t, big, r.KVs[0].Value)
+		})
+	}
+}
+
+func TestDecodeKeyValue(t *testing.T) {
+	kv := mvccpb.KeyValue{Key: []byte("foo"), Value: bytes.Repeat([]byte("bar"), 100), ModRevision: 2}
+	plain, err := kv.Marshal()
+	require.NoError(t, err)
+	d, err := encodeKeyValue(&kv, ValueCodecZstd)
+	require.NoError(t, err)
+	var got mvccpb.KeyValue
+	require.NoError(t, DecodeKeyValue(d, &got))
+	assert.Equal(t, kv, got)
+	p, err := plainKeyValue(d)
+	require.NoError(t, err)
+	assert.Equal(t, plain, p)
+
+	d[1] = 42
+	require.Error(t, DecodeKeyValue(d, &got))
+	_, err = ParseValueCodec("lz4")
+	require.Error(t, err)
+	assert.Equal(t, len(kv.Value), StoredValueSize(ValueCodecNone, kv.Value))
+	assert.Less(t, StoredValueSize(ValueCodecSnappy, kv.Value), len(kv.Value))
+}
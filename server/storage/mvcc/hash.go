@@ -85,6 +85,11 @@ func (h *kvHasher) WriteKeyValue(k, v []byte) {
 		return
 	}
 
+	// hash the values as if they were not compressed, so that members
+	// compressing them differently have the same hashes.
+	if plain, err := plainKeyValue(v); err == nil {
+		v = plain
+	}
 	h.hash.Write(k)
 	h.hash.Write(v)
 }
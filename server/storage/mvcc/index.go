@@ -25,10 +25,12 @@ type index interface {
 	Get(key []byte, atRev int64) (rev, created Revision, ver int64, err error)
 	Range(key, end []byte, atRev int64) ([][]byte, []Revision)
 	Revisions(key, end []byte, atRev int64, limit int) ([]Revision, int)
+	ReverseRevisions(key, end []byte, atRev int64, limit int) ([]Revision, int)
 	CountRevisions(key, end []byte, atRev int64) int
 	Put(key []byte, rev Revision)
 	Tombstone(key []byte, rev Revision) error
 	Compact(rev int64) map[Revision]struct{}
+	CompactRetaining(rev int64, rs retentions) (keep, retained map[Revision]struct{})
 	Keep(rev int64) map[Revision]struct{}
 	Equal(b index) bool
 
@@ -106,12 +108,47 @@ func (ti *treeIndex) unsafeVisit(key, end []byte, f func(ki *keyIndex) bool) {
 	})
 }
 
+// unsafeVisitReverse is like unsafeVisit, but visits the keys in descending order.
+func (ti *treeIndex) unsafeVisitReverse(key, end []byte, f func(ki *keyIndex) bool) {
+	keyi, endi := &keyIndex{key: key}, &keyIndex{key: end}
+
+	visit := func(item *keyIndex) bool {
+		if item.Less(keyi) {
+			return false
+		}
+		return f(item)
+	}
+	if len(endi.key) == 0 {
+		ti.tree.Descend(visit)
+		return
+	}
+	ti.tree.DescendLessOrEqual(endi, func(item *keyIndex) bool {
+		if !item.Less(endi) {
+			// end is excluded
+			return true
+		}
+		return visit(item)
+	})
+}
+
 // Revisions returns limited number of revisions from key(included) to end(excluded)
 // at the given rev. The returned slice is sorted in the order of key. There is no limit if limit <= 0.
 // The second return parameter isn't capped by the limit and reflects the total number of revisions.
 func (ti *treeIndex) Revisions(key, end []byte, atRev int64, limit int) (revs []Revision, total int) {
 	ti.RLock()
 	defer ti.RUnlock()
+	return ti.unsafeRevisions(key, end, atRev, limit, ti.unsafeVisit)
+}
+
+// ReverseRevisions is like Revisions, but the returned slice is sorted in
+// the reverse order of key, so that limit keeps the last keys of the range.
+func (ti *treeIndex) ReverseRevisions(key, end []byte, atRev int64, limit int) (revs []Revision, total int) {
+	ti.RLock()
+	defer ti.RUnlock()
+	return ti.unsafeRevisions(key, end, atRev, limit, ti.unsafeVisitReverse)
+}
+
+func (ti *treeIndex) unsafeRevisions(key, end []byte, atRev int64, limit int, visit func(key, end []byte, f func(ki *keyIndex) bool)) (revs []Revision, total int) {
 
 	if end == nil {
 		rev, _, _, err := ti.unsafeGet(key, atRev)
@@ -120,7 +157,7 @@ func (ti *treeIndex) Revisions(key, end []byte, atRev int64, limit int) (revs []
 		}
 		return []Revision{rev}, 1
 	}
-	ti.unsafeVisit(key, end, func(ki *keyIndex) bool {
+	visit(key, end, func(ki *keyIndex) bool {
 		if rev, _, _, err := ki.get(ti.lg, atRev); err == nil {
 			if limit <= 0 || len(revs) < limit {
 				revs = append(revs, rev)
@@ -190,8 +227,17 @@ func (ti *treeIndex) Tombstone(key []byte, rev Revision) error {
 }
 
 func (ti *treeIndex) Compact(rev int64) map[Revision]struct{} {
-	available := make(map[Revision]struct{})
-	ti.lg.Info("compact tree index", zap.Int64("revision", rev))
+	available, _ := ti.CompactRetaining(rev, nil)
+	return available
+}
+
+// CompactRetaining is like Compact, but compacts the keys of the retained
+// ranges at their retained revisions instead. The revisions up to rev kept
+// only for the retained ranges are returned apart from the ones Compact
+// would keep.
+func (ti *treeIndex) CompactRetaining(rev int64, rs retentions) (available, retained map[Revision]struct{}) {
+	available, retained = make(map[Revision]struct{}), make(map[Revision]struct{})
+	ti.lg.Info("compact tree index", zap.Int64("revision", rev), zap.Int("retained-ranges", len(rs)))
 	ti.Lock()
 	clone := ti.tree.Clone()
 	ti.Unlock()
@@ -200,7 +246,11 @@ func (ti *treeIndex) Compact(rev int64) map[Revision]struct{} {
 		// Lock is needed here to prevent modification to the keyIndex while
 		// compaction is going on or revision added to empty before deletion
 		ti.Lock()
-		keyi.compact(ti.lg, rev, available)
+		if retainRev := rs.compactRev(keyi.key, nil, rev); retainRev < rev {
+			keyi.compactRetaining(ti.lg, retainRev, rev, available, retained)
+		} else {
+			keyi.compact(ti.lg, rev, available)
+		}
 		if keyi.isEmpty() {
 			_, ok := ti.tree.Delete(keyi)
 			if !ok {
@@ -210,7 +260,7 @@ func (ti *treeIndex) Compact(rev int64) map[Revision]struct{} {
 		ti.Unlock()
 		return true
 	})
-	return available
+	return available, retained
 }
 
 // Keep finds all revisions to be kept for a Compaction at the given rev.
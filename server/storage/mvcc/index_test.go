@@ -234,6 +234,74 @@ func TestIndexRevision(t *testing.T) {
 	}
 }
 
+func TestIndexReverseRevision(t *testing.T) {
+	allKeys := [][]byte{[]byte("foo"), []byte("foo1"), []byte("foo2"), []byte("foo2"), []byte("foo1"), []byte("foo")}
+	allRevs := []Revision{{Main: 1}, {Main: 2}, {Main: 3}, {Main: 4}, {Main: 5}, {Main: 6}}
+
+	ti := newTreeIndex(zaptest.NewLogger(t))
+	for i := range allKeys {
+		ti.Put(allKeys[i], allRevs[i])
+	}
+
+	tests := []struct {
+		key, end []byte
+		atRev    int64
+		limit    int
+		wrevs    []Revision
+		wcounts  int
+	}{
+		// single key that not found
+		{
+			[]byte("bar"), nil, 6, 0, nil, 0,
+		},
+		// single key that found
+		{
+			[]byte("foo"), nil, 6, 0, []Revision{{Main: 6}}, 1,
+		},
+		// various range keys, fixed atRev, unlimited
+		{
+			[]byte("foo"), []byte("foo1"), 6, 0, []Revision{{Main: 6}}, 1,
+		},
+		{
+			[]byte("foo"), []byte("foo2"), 6, 0, []Revision{{Main: 5}, {Main: 6}}, 2,
+		},
+		{
+			[]byte("foo"), []byte("fop"), 6, 0, []Revision{{Main: 4}, {Main: 5}, {Main: 6}}, 3,
+		},
+		{
+			[]byte("foo1"), []byte("fop"), 6, 0, []Revision{{Main: 4}, {Main: 5}}, 2,
+		},
+		{
+			[]byte("foo1"), []byte{}, 6, 0, []Revision{{Main: 4}, {Main: 5}}, 2,
+		},
+		{
+			[]byte("foo3"), []byte("fop"), 6, 0, nil, 0,
+		},
+		// fixed range keys, fixed atRev, various limit
+		{
+			[]byte("foo"), []byte("fop"), 6, 1, []Revision{{Main: 4}}, 3,
+		},
+		{
+			[]byte("foo"), []byte("fop"), 6, 2, []Revision{{Main: 4}, {Main: 5}}, 3,
+		},
+		{
+			[]byte("foo"), []byte("fop"), 3, 2, []Revision{{Main: 3}, {Main: 2}}, 3,
+		},
+		{
+			[]byte("foo"), []byte("foo2"), 3, 1, []Revision{{Main: 2}}, 2,
+		},
+	}
+	for i, tt := range tests {
+		revs, total := ti.ReverseRevisions(tt.key, tt.end, tt.atRev, tt.limit)
+		if !reflect.DeepEqual(revs, tt.wrevs) {
+			t.Errorf("#%d limit %d: revs = %+v, want %+v", i, tt.limit, revs, tt.wrevs)
+		}
+		if total != tt.wcounts {
+			t.Errorf("#%d: total = %d, want %v", i, total, tt.wcounts)
+		}
+	}
+}
+
 func TestIndexCompactAndKeep(t *testing.T) {
 	maxRev := int64(20)
 
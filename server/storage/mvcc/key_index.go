@@ -227,6 +227,27 @@ func (ki *keyIndex) compact(lg *zap.Logger, atRev int64, available map[Revision]
 	ki.generations = ki.generations[genIdx:]
 }
 
+// compactRetaining compacts a keyIndex at retainRev to keep its history
+// since retainRev. The revisions compact would keep at atRev are added to
+// available, and the other revisions up to atRev kept to retained.
+func (ki *keyIndex) compactRetaining(lg *zap.Logger, retainRev, atRev int64, available, retained map[Revision]struct{}) {
+	kept := make(map[Revision]struct{})
+	for _, g := range ki.generations {
+		for _, rev := range g.revs {
+			if rev.Main > retainRev && rev.Main <= atRev {
+				kept[rev] = struct{}{}
+			}
+		}
+	}
+	ki.doCompact(atRev, available)
+	ki.compact(lg, retainRev, kept)
+	for rev := range kept {
+		if _, ok := available[rev]; !ok {
+			retained[rev] = struct{}{}
+		}
+	}
+}
+
 // keep finds the revision to be kept if compact is called at given atRev.
 func (ki *keyIndex) keep(atRev int64, available map[Revision]struct{}) {
 	if ki.isEmpty() {
@@ -27,6 +27,15 @@ type RangeOptions struct {
 	Limit int64
 	Rev   int64
 	Count bool
+	// Filter, if set, is evaluated against every key-value pair in the range and
+	// only pairs it returns true for are kept. Limit and Count apply to the
+	// filtered result.
+	Filter func(kv *mvccpb.KeyValue) bool
+	// KeysOnly drops the values of the returned key-value pairs.
+	KeysOnly bool
+	// Reverse returns the key-value pairs in descending key order, so that
+	// Limit keeps the last keys of the range.
+	Reverse bool
 }
 
 type RangeResult struct {
@@ -41,6 +50,11 @@ type ReadView interface {
 	// revision.
 	FirstRev() int64
 
+	// FirstRangeRev returns the first revision the keys in the range
+	// [key, end) can be read at, at the time of opening the txn. It is
+	// below FirstRev if the history of the range is retained by compaction.
+	FirstRangeRev(key, end []byte) int64
+
 	// Rev returns the revision of the KV at the time of opening the txn.
 	Rev() int64
 
@@ -125,6 +139,10 @@ type KV interface {
 	// Compact frees all superseded keys with revisions less than rev.
 	Compact(trace *traceutil.Trace, rev int64) (<-chan struct{}, error)
 
+	// CompactRetaining is like Compact, but keeps the history of the
+	// retained key ranges since their revisions.
+	CompactRetaining(trace *traceutil.Trace, rev int64, rs []Retention) (<-chan struct{}, error)
+
 	// Commit commits outstanding txns into the underlying backend.
 	Commit()
 
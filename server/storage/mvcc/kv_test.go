@@ -256,6 +256,51 @@ func testKVRangeLimit(t *testing.T, f rangeFunc) {
 	}
 }
 
+func TestKVRangeFilter(t *testing.T)    { testKVRangeFilter(t, normalRangeFunc) }
+func TestKVTxnRangeFilter(t *testing.T) { testKVRangeFilter(t, txnRangeFunc) }
+
+func testKVRangeFilter(t *testing.T, f rangeFunc) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b)
+
+	kvs := put3TestKVs(s)
+	notFoo1 := func(kv *mvccpb.KeyValue) bool { return string(kv.Key) != "foo1" }
+	keysOnly := func(kvs ...mvccpb.KeyValue) []mvccpb.KeyValue {
+		for i := range kvs {
+			kvs[i].Value = nil
+		}
+		return kvs
+	}
+
+	tests := []struct {
+		ro      RangeOptions
+		wcounts int
+		wkvs    []mvccpb.KeyValue
+	}{
+		{RangeOptions{Filter: notFoo1}, 2, []mvccpb.KeyValue{kvs[0], kvs[2]}},
+		// limit applies after filtering
+		{RangeOptions{Filter: notFoo1, Limit: 2}, 2, []mvccpb.KeyValue{kvs[0], kvs[2]}},
+		{RangeOptions{Filter: notFoo1, Limit: 1}, 2, []mvccpb.KeyValue{kvs[0]}},
+		{RangeOptions{Filter: notFoo1, Count: true}, 2, nil},
+		{RangeOptions{Filter: func(*mvccpb.KeyValue) bool { return false }}, 0, []mvccpb.KeyValue{}},
+		{RangeOptions{Filter: notFoo1, KeysOnly: true}, 2, keysOnly(kvs[0], kvs[2])},
+		{RangeOptions{KeysOnly: true}, 3, keysOnly(kvs[0], kvs[1], kvs[2])},
+	}
+	for i, tt := range tests {
+		r, err := f(s, []byte("foo"), []byte("foo3"), tt.ro)
+		if err != nil {
+			t.Fatalf("#%d: range error (%v)", i, err)
+		}
+		if !reflect.DeepEqual(r.KVs, tt.wkvs) {
+			t.Errorf("#%d: kvs = %+v, want %+v", i, r.KVs, tt.wkvs)
+		}
+		if r.Count != tt.wcounts {
+			t.Errorf("#%d: count = %d, want %d", i, r.Count, tt.wcounts)
+		}
+	}
+}
+
 func TestKVPutMultipleTimes(t *testing.T)    { testKVPutMultipleTimes(t, normalPutFunc) }
 func TestKVTxnPutMultipleTimes(t *testing.T) { testKVPutMultipleTimes(t, txnPutFunc) }
 
@@ -29,6 +29,12 @@ func (rv *readView) FirstRev() int64 {
 	return tr.FirstRev()
 }
 
+func (rv *readView) FirstRangeRev(key, end []byte) int64 {
+	tr := rv.kv.Read(ConcurrentReadTxMode, traceutil.TODO())
+	defer tr.End()
+	return tr.FirstRangeRev(key, end)
+}
+
 func (rv *readView) Rev() int64 {
 	tr := rv.kv.Read(ConcurrentReadTxMode, traceutil.TODO())
 	defer tr.End()
@@ -45,6 +45,9 @@ var defaultCompactionSleepInterval = 10 * time.Millisecond
 type StoreConfig struct {
 	CompactionBatchLimit    int
 	CompactionSleepInterval time.Duration
+	// ValueCompression is the codec the values of the keys are compressed
+	// with in the key bucket. The values of either codec are read back.
+	ValueCompression ValueCodec
 }
 
 type store struct {
@@ -69,6 +72,8 @@ type store struct {
 	currentRev int64
 	// compactMainRev is the main revision of the last compaction.
 	compactMainRev int64
+	// retained are the retentions of the last compaction.
+	retained retentions
 
 	fifoSched schedule.Scheduler
 
@@ -190,7 +195,13 @@ func (s *store) hashByRev(rev int64) (hash KeyValueHash, currentRev int64, err e
 	return hash, currentRev, err
 }
 
-func (s *store) updateCompactRev(rev int64) (<-chan struct{}, int64, error) {
+// compactRev returns the revision the range [key, end) was last compacted
+// at, which is below compactMainRev if the history of the range is retained.
+func (s *store) compactRev(key, end []byte) int64 {
+	return s.retained.compactRev(key, end, s.compactMainRev)
+}
+
+func (s *store) updateCompactRev(rev int64, rs retentions) (<-chan struct{}, int64, error) {
 	s.revMu.Lock()
 	if rev <= s.compactMainRev {
 		ch := make(chan struct{})
@@ -204,7 +215,12 @@ func (s *store) updateCompactRev(rev int64) (<-chan struct{}, int64, error) {
 		return nil, 0, ErrFutureRev
 	}
 	compactMainRev := s.compactMainRev
+	retained := rs.resolve(rev, compactMainRev, s.retained)
+	if len(retained) != 0 || len(s.retained) != 0 {
+		SetCompactRetention(s.b.BatchTx(), retained)
+	}
 	s.compactMainRev = rev
+	s.retained = retained
 
 	SetScheduledCompact(s.b.BatchTx(), rev)
 	// ensure that desired compaction is persisted
@@ -227,14 +243,14 @@ func (s *store) checkPrevCompactionCompleted() bool {
 	return scheduledCompact == finishedCompact && scheduledCompactFound == finishedCompactFound
 }
 
-func (s *store) compact(trace *traceutil.Trace, rev, prevCompactRev int64, prevCompactionCompleted bool) <-chan struct{} {
+func (s *store) compact(trace *traceutil.Trace, rev, prevCompactRev int64, retained retentions, prevCompactionCompleted bool) <-chan struct{} {
 	ch := make(chan struct{})
 	j := schedule.NewJob("kvstore_compact", func(ctx context.Context) {
 		if ctx.Err() != nil {
 			s.compactBarrier(ctx, ch)
 			return
 		}
-		hash, err := s.scheduleCompaction(rev, prevCompactRev)
+		hash, err := s.scheduleCompaction(rev, prevCompactRev, retained)
 		if err != nil {
 			s.lg.Warn("Failed compaction", zap.Error(err))
 			s.compactBarrier(context.TODO(), ch)
@@ -255,28 +271,33 @@ func (s *store) compact(trace *traceutil.Trace, rev, prevCompactRev int64, prevC
 	return ch
 }
 
-func (s *store) compactLockfree(rev int64) (<-chan struct{}, error) {
+func (s *store) compactLockfree(rev int64, rs retentions) (<-chan struct{}, error) {
 	prevCompactionCompleted := s.checkPrevCompactionCompleted()
-	ch, prevCompactRev, err := s.updateCompactRev(rev)
+	ch, prevCompactRev, err := s.updateCompactRev(rev, rs)
 	if err != nil {
 		return ch, err
 	}
 
-	return s.compact(traceutil.TODO(), rev, prevCompactRev, prevCompactionCompleted), nil
+	return s.compact(traceutil.TODO(), rev, prevCompactRev, s.retained, prevCompactionCompleted), nil
 }
 
 func (s *store) Compact(trace *traceutil.Trace, rev int64) (<-chan struct{}, error) {
+	return s.CompactRetaining(trace, rev, nil)
+}
+
+func (s *store) CompactRetaining(trace *traceutil.Trace, rev int64, rs []Retention) (<-chan struct{}, error) {
 	s.mu.Lock()
 	prevCompactionCompleted := s.checkPrevCompactionCompleted()
-	ch, prevCompactRev, err := s.updateCompactRev(rev)
+	ch, prevCompactRev, err := s.updateCompactRev(rev, rs)
 	trace.Step("check and update compact revision")
 	if err != nil {
 		s.mu.Unlock()
 		return ch, err
 	}
+	retained := s.retained
 	s.mu.Unlock()
 
-	return s.compact(trace, rev, prevCompactRev, prevCompactionCompleted), nil
+	return s.compact(trace, rev, prevCompactRev, retained, prevCompactionCompleted), nil
 }
 
 func (s *store) Commit() {
@@ -300,6 +321,7 @@ func (s *store) Restore(b backend.Backend) error {
 		s.revMu.Lock()
 		s.currentRev = 1
 		s.compactMainRev = -1
+		s.retained = nil
 		s.revMu.Unlock()
 	}
 
@@ -309,7 +331,6 @@ func (s *store) Restore(b backend.Backend) error {
 	return s.restore()
 }
 
-//nolint:unparam
 func (s *store) restore() error {
 	s.setupMetricsReporter()
 
@@ -336,6 +357,14 @@ func (s *store) restore() error {
 		s.revMu.Unlock()
 	}
 	scheduledCompact, _ := UnsafeReadScheduledCompact(tx)
+	retained, err := UnsafeReadCompactRetention(tx)
+	if err != nil {
+		tx.RUnlock()
+		return err
+	}
+	s.revMu.Lock()
+	s.retained = retained
+	s.revMu.Unlock()
 	// index keys concurrently as they're loaded in from tx
 	keysGauge.Set(0)
 	rkvc, revc := restoreIntoIndex(s.lg, s.kvindex)
@@ -405,7 +434,7 @@ func (s *store) restore() error {
 	s.lg.Info("kvstore restored", zap.Int64("current-rev", s.currentRev))
 
 	if scheduledCompact != 0 {
-		if _, err := s.compactLockfree(scheduledCompact); err != nil {
+		if _, err := s.compactLockfree(scheduledCompact, retained); err != nil {
 			s.lg.Warn("compaction encountered error",
 				zap.Int64("scheduled-compact-revision", scheduledCompact),
 				zap.Error(err),
@@ -484,7 +513,7 @@ func restoreIntoIndex(lg *zap.Logger, idx index) (chan<- revKeyValue, <-chan int
 func restoreChunk(lg *zap.Logger, kvc chan<- revKeyValue, keys, vals [][]byte, keyToLease map[string]lease.LeaseID) {
 	for i, key := range keys {
 		rkv := revKeyValue{key: key}
-		if err := rkv.kv.Unmarshal(vals[i]); err != nil {
+		if err := DecodeKeyValue(vals[i], &rkv.kv); err != nil {
 			lg.Fatal("failed to unmarshal mvccpb.KeyValue", zap.Error(err))
 		}
 		rkv.kstr = string(rkv.kv.Key)
@@ -25,9 +25,16 @@ import (
 	"go.etcd.io/etcd/server/v3/storage/schema"
 )
 
-func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyValueHash, error) {
+func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64, rs retentions) (KeyValueHash, error) {
 	totalStart := time.Now()
-	keep := s.kvindex.Compact(compactMainRev)
+	var keep, retained map[Revision]struct{}
+	if len(rs) == 0 {
+		keep = s.kvindex.Compact(compactMainRev)
+	} else {
+		// the revisions kept only for retained ranges are left out of the
+		// hash, so that it does not depend on the retention
+		keep, retained = s.kvindex.CompactRetaining(compactMainRev, rs)
+	}
 	indexCompactionPauseMs.Observe(float64(time.Since(totalStart) / time.Millisecond))
 
 	totalStart = time.Now()
@@ -54,7 +61,8 @@ func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyVal
 		keys, values := tx.UnsafeRange(schema.Key, last, end, int64(batchNum))
 		for i := range keys {
 			rev = BytesToRev(keys[i])
-			if _, ok := keep[rev]; !ok {
+			_, kept := keep[rev]
+			if _, ok := retained[rev]; !kept && !ok {
 				tx.UnsafeDelete(schema.Key, keys[i])
 				keyCompactions++
 			}
@@ -83,7 +83,7 @@ func TestScheduleCompaction(t *testing.T) {
 		}
 		tx.Unlock()
 
-		_, err := s.scheduleCompaction(tt.rev, 0)
+		_, err := s.scheduleCompaction(tt.rev, 0, nil)
 		if err != nil {
 			t.Error(err)
 		}
@@ -148,3 +148,90 @@ func TestCompactAllAndRestore(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestCompactRetaining(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s0 := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer b.Close()
+	rb, _ := betesting.NewDefaultTmpBackend(t)
+	ref := NewStore(zaptest.NewLogger(t), rb, &lease.FakeLessor{}, StoreConfig{})
+	defer rb.Close()
+
+	for _, s := range []*store{s0, ref} {
+		for _, v := range []string{"1", "2", "3"} {
+			s.Put([]byte("/audit/a"), []byte(v), lease.NoLease)
+			s.Put([]byte("/tmp/a"), []byte(v), lease.NoLease)
+		}
+	}
+
+	// the history of /audit/ is kept readable since revision 3
+	rs := []Retention{{Key: []byte("/audit/"), End: []byte("/audit0"), Rev: 3}}
+	for _, done := range []<-chan struct{}{
+		mustCompact(t, s0, 6, rs),
+		mustCompact(t, ref, 6, nil),
+	} {
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			t.Fatal("timeout waiting for compaction to finish")
+		}
+	}
+
+	assertRetained := func(s *store) {
+		r, err := s.Range(context.TODO(), []byte("/audit/a"), nil, RangeOptions{Rev: 3})
+		if err != nil || len(r.KVs) != 1 || string(r.KVs[0].Value) != "1" {
+			t.Errorf("range /audit/a at 3 = %+v, %v, want value 1", r, err)
+		}
+		if _, err = s.Range(context.TODO(), []byte("/audit/"), []byte("/audit0"), RangeOptions{Rev: 4}); err != nil {
+			t.Errorf("unexpected range /audit/ error %v", err)
+		}
+		if _, err = s.Range(context.TODO(), []byte("/audit/a"), nil, RangeOptions{Rev: 2}); err != ErrCompacted {
+			t.Errorf("range /audit/a at 2 error = %v, want %v", err, ErrCompacted)
+		}
+		if _, err = s.Range(context.TODO(), []byte("/tmp/a"), nil, RangeOptions{Rev: 4}); err != ErrCompacted {
+			t.Errorf("range /tmp/a at 4 error = %v, want %v", err, ErrCompacted)
+		}
+		if rev := s.FirstRangeRev([]byte("/audit/a"), nil); rev != 3 {
+			t.Errorf("first range rev of /audit/a = %d, want 3", rev)
+		}
+		if rev := s.FirstRangeRev([]byte("/"), []byte("0")); rev != 6 {
+			t.Errorf("first range rev of / = %d, want 6", rev)
+		}
+	}
+	assertRetained(s0)
+
+	// the retained history is left out of the hashes
+	hs, rhs := s0.HashStorage().Hashes(), ref.HashStorage().Hashes()
+	if !reflect.DeepEqual(hs, rhs) {
+		t.Errorf("compaction hashes = %+v, want %+v", hs, rhs)
+	}
+	h, _, err := s0.HashStorage().HashByRev(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rh, _, err := ref.HashStorage().HashByRev(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h != rh {
+		t.Errorf("hash = %+v, want %+v", h, rh)
+	}
+
+	if err = s0.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err = ref.Close(); err != nil {
+		t.Fatal(err)
+	}
+	s1 := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer s1.Close()
+	assertRetained(s1)
+}
+
+func mustCompact(t *testing.T, s *store, rev int64, rs []Retention) <-chan struct{} {
+	done, err := s.CompactRetaining(traceutil.TODO(), rev, rs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return done
+}
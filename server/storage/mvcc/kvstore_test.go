@@ -24,6 +24,7 @@ import (
 	"math"
 	mrand "math/rand"
 	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"sync"
@@ -398,6 +399,7 @@ func TestStoreRestore(t *testing.T) {
 	}
 	b.tx.rangeRespc <- rangeResp{[][]byte{schema.FinishedCompactKeyName}, [][]byte{newTestRevBytes(Revision{Main: 3})}}
 	b.tx.rangeRespc <- rangeResp{[][]byte{schema.ScheduledCompactKeyName}, [][]byte{newTestRevBytes(Revision{Main: 3})}}
+	b.tx.rangeRespc <- rangeResp{nil, nil}
 
 	b.tx.rangeRespc <- rangeResp{[][]byte{putkey, delkey}, [][]byte{putkvb, delkvb}}
 	b.tx.rangeRespc <- rangeResp{nil, nil}
@@ -413,6 +415,7 @@ func TestStoreRestore(t *testing.T) {
 	wact := []testutil.Action{
 		{Name: "range", Params: []any{schema.Meta, schema.FinishedCompactKeyName, []byte(nil), int64(0)}},
 		{Name: "range", Params: []any{schema.Meta, schema.ScheduledCompactKeyName, []byte(nil), int64(0)}},
+		{Name: "range", Params: []any{schema.Meta, schema.CompactRetentionKeyName, []byte(nil), int64(0)}},
 		{Name: "range", Params: []any{schema.Key, newTestRevBytes(Revision{Main: 1}), newTestRevBytes(Revision{Main: math.MaxInt64, Sub: math.MaxInt64}), int64(restoreChunkKeys)}},
 	}
 	if g := b.tx.Action(); !reflect.DeepEqual(g, wact) {
@@ -996,9 +999,14 @@ func (b *fakeBackend) OpenReadTxN() int64
 func (b *fakeBackend) Snapshot() backend.Snapshot                                 { return nil }
 func (b *fakeBackend) ForceCommit()                                               {}
 func (b *fakeBackend) Defrag() error                                              { return nil }
+func (b *fakeBackend) DefragIncremental() error                                   { return nil }
 func (b *fakeBackend) Close() error                                               { return nil }
 func (b *fakeBackend) SetTxPostLockInsideApplyHook(func())                        {}
 
+func (b *fakeBackend) SnapshotSince(backend.Bucket, []byte, func(backend.UnsafeReader, backend.UnsafeReadWriter) error) (backend.Snapshot, error) {
+	return nil, nil
+}
+
 type indexGetResp struct {
 	rev     Revision
 	created Revision
@@ -1031,6 +1039,16 @@ func (i *fakeIndex) Revisions(key, end []byte, atRev int64, limit int) ([]Revisi
 	return rev, len(rev)
 }
 
+func (i *fakeIndex) ReverseRevisions(key, end []byte, atRev int64, limit int) ([]Revision, int) {
+	_, rev := i.Range(key, end, atRev)
+	rev = slices.Clone(rev)
+	slices.Reverse(rev)
+	if limit > 0 && len(rev) >= limit {
+		rev = rev[:limit]
+	}
+	return rev, len(rev)
+}
+
 func (i *fakeIndex) CountRevisions(key, end []byte, atRev int64) int {
 	_, rev := i.Range(key, end, atRev)
 	return len(rev)
@@ -1062,6 +1080,10 @@ func (i *fakeIndex) Compact(rev int64) map[Revision]struct{} {
 	i.Recorder.Record(testutil.Action{Name: "compact", Params: []any{rev}})
 	return <-i.indexCompactRespc
 }
+func (i *fakeIndex) CompactRetaining(rev int64, rs retentions) (map[Revision]struct{}, map[Revision]struct{}) {
+	i.Recorder.Record(testutil.Action{Name: "compactRetaining", Params: []any{rev, rs}})
+	return <-i.indexCompactRespc, nil
+}
 func (i *fakeIndex) Keep(rev int64) map[Revision]struct{} {
 	i.Recorder.Record(testutil.Action{Name: "keep", Params: []any{rev}})
 	return <-i.indexCompactRespc
@@ -38,6 +38,8 @@ type storeTxnCommon struct {
 
 	firstRev int64
 	rev      int64
+	// retained are the retentions of the last compaction when the txn began
+	retained retentions
 
 	trace *traceutil.Trace
 }
@@ -57,14 +59,18 @@ func (s *store) Read(mode ReadTxMode, trace *traceutil.Trace) TxnRead {
 	}
 
 	tx.RLock() // RLock is no-op. concurrentReadTx does not need to be locked after it is created.
-	firstRev, rev := s.compactMainRev, s.currentRev
+	firstRev, rev, retained := s.compactMainRev, s.currentRev, s.retained
 	s.revMu.RUnlock()
-	return newMetricsTxnRead(&storeTxnRead{storeTxnCommon{s, tx, firstRev, rev, trace}, tx})
+	return newMetricsTxnRead(&storeTxnRead{storeTxnCommon{s, tx, firstRev, rev, retained, trace}, tx})
 }
 
 func (tr *storeTxnCommon) FirstRev() int64 { return tr.firstRev }
 func (tr *storeTxnCommon) Rev() int64      { return tr.rev }
 
+func (tr *storeTxnCommon) FirstRangeRev(key, end []byte) int64 {
+	return tr.retained.compactRev(key, end, tr.firstRev)
+}
+
 func (tr *storeTxnCommon) Range(ctx context.Context, key, end []byte, ro RangeOptions) (r *RangeResult, err error) {
 	return tr.rangeKeys(ctx, key, end, tr.Rev(), ro)
 }
@@ -77,15 +83,24 @@ func (tr *storeTxnCommon) rangeKeys(ctx context.Context, key, end []byte, curRev
 	if rev <= 0 {
 		rev = curRev
 	}
-	if rev < tr.s.compactMainRev {
+	if rev < tr.retained.compactRev(key, end, tr.s.compactMainRev) {
 		return &RangeResult{KVs: nil, Count: -1, Rev: 0}, ErrCompacted
 	}
-	if ro.Count {
+	if ro.Count && ro.Filter == nil {
 		total := tr.s.kvindex.CountRevisions(key, end, rev)
 		tr.trace.Step("count revisions from in-memory index tree")
 		return &RangeResult{KVs: nil, Count: total, Rev: curRev}, nil
 	}
-	revpairs, total := tr.s.kvindex.Revisions(key, end, rev, int(ro.Limit))
+	indexLimit := int(ro.Limit)
+	if ro.Filter != nil {
+		// the limit applies to the filtered key-value pairs
+		indexLimit = 0
+	}
+	revisions := tr.s.kvindex.Revisions
+	if ro.Reverse {
+		revisions = tr.s.kvindex.ReverseRevisions
+	}
+	revpairs, total := revisions(key, end, rev, indexLimit)
 	tr.trace.Step("range keys from in-memory index tree")
 	if len(revpairs) == 0 {
 		return &RangeResult{KVs: nil, Count: total, Rev: curRev}, nil
@@ -95,10 +110,16 @@ func (tr *storeTxnCommon) rangeKeys(ctx context.Context, key, end []byte, curRev
 	if limit <= 0 || limit > len(revpairs) {
 		limit = len(revpairs)
 	}
+	if ro.Filter != nil {
+		total = 0
+	}
 
-	kvs := make([]mvccpb.KeyValue, limit)
+	kvs := make([]mvccpb.KeyValue, 0, limit)
 	revBytes := NewRevBytes()
-	for i, revpair := range revpairs[:len(kvs)] {
+	for _, revpair := range revpairs {
+		if ro.Filter == nil && len(kvs) == limit {
+			break
+		}
 		select {
 		case <-ctx.Done():
 			return nil, fmt.Errorf("rangeKeys: context cancelled: %w", ctx.Err())
@@ -120,12 +141,30 @@ func (tr *storeTxnCommon) rangeKeys(ctx context.Context, key, end []byte, curRev
 				zap.Int("len-values", len(vs)),
 			)
 		}
-		if err := kvs[i].Unmarshal(vs[0]); err != nil {
+		var kv mvccpb.KeyValue
+		if err := DecodeKeyValue(vs[0], &kv); err != nil {
 			tr.s.lg.Fatal(
 				"failed to unmarshal mvccpb.KeyValue",
 				zap.Error(err),
 			)
 		}
+		if ro.Filter != nil {
+			if !ro.Filter(&kv) {
+				continue
+			}
+			// keep counting matches past the limit to report the total
+			total++
+			if ro.Count || len(kvs) == limit {
+				continue
+			}
+		}
+		if ro.KeysOnly {
+			kv.Value = nil
+		}
+		kvs = append(kvs, kv)
+	}
+	if ro.Count {
+		kvs = nil
 	}
 	tr.trace.Step("range keys from bolt db")
 	return &RangeResult{KVs: kvs, Count: total, Rev: curRev}, nil
@@ -146,10 +185,13 @@ type storeTxnWrite struct {
 
 func (s *store) Write(trace *traceutil.Trace) TxnWrite {
 	s.mu.RLock()
+	s.revMu.RLock()
+	retained := s.retained
+	s.revMu.RUnlock()
 	tx := s.b.BatchTx()
 	tx.LockInsideApply()
 	tw := &storeTxnWrite{
-		storeTxnCommon: storeTxnCommon{s, tx, 0, 0, trace},
+		storeTxnCommon: storeTxnCommon{s, tx, 0, 0, retained, trace},
 		tx:             tx,
 		beginRev:       s.currentRev,
 		changes:        make([]mvccpb.KeyValue, 0, 4),
@@ -220,7 +262,7 @@ func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID) {
 		Lease:          int64(leaseID),
 	}
 
-	d, err := kv.Marshal()
+	d, err := encodeKeyValue(&kv, tw.s.cfg.ValueCompression)
 	if err != nil {
 		tw.storeTxnCommon.s.lg.Fatal(
 			"failed to marshal mvccpb.KeyValue",
@@ -0,0 +1,82 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+)
+
+// Retention keeps the history of the keys in the range [Key, End) readable
+// since Rev when the store is compacted past Rev. If End is empty, the range
+// is all keys greater than or equal to Key.
+type Retention struct {
+	Key []byte `json:"key"`
+	End []byte `json:"end"`
+	Rev int64  `json:"rev"`
+}
+
+// covers returns true if the range [key, end) is within the retained range.
+// If end is nil, the range is the single key.
+func (r Retention) covers(key, end []byte) bool {
+	if bytes.Compare(key, r.Key) < 0 {
+		return false
+	}
+	switch {
+	case len(r.End) == 0:
+		return true
+	case end == nil:
+		return bytes.Compare(key, r.End) < 0
+	default:
+		return len(end) != 0 && bytes.Compare(end, r.End) <= 0
+	}
+}
+
+type retentions []Retention
+
+// compactRev returns the revision the range [key, end) is compacted at when
+// the store is compacted at compactRev.
+func (rs retentions) compactRev(key, end []byte, compactRev int64) int64 {
+	for _, r := range rs {
+		if r.covers(key, end) && r.Rev < compactRev {
+			return r.Rev
+		}
+	}
+	return compactRev
+}
+
+// resolve returns the retentions of a compaction at compactRev following
+// the one at prevCompactRev with the retentions prev. History already
+// compacted cannot be retained again, so a retention never goes back before
+// the previous compaction of its range.
+func (rs retentions) resolve(compactRev, prevCompactRev int64, prev retentions) retentions {
+	var resolved retentions
+	for _, r := range rs {
+		floor := prevCompactRev
+		for _, p := range prev {
+			if bytes.Equal(p.Key, r.Key) && bytes.Equal(p.End, r.End) {
+				floor = p.Rev
+				break
+			}
+		}
+		if r.Rev > floor {
+			floor = r.Rev
+		}
+		if floor >= compactRev {
+			continue
+		}
+		resolved = append(resolved, Retention{Key: r.Key, End: r.End, Rev: floor})
+	}
+	return resolved
+}
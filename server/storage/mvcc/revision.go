@@ -76,6 +76,11 @@ func newBucketKey(main, sub int64, isTombstone bool) BucketKey {
 	}
 }
 
+// IsTombstone returns true if the bucket key marks the deletion of a key.
+func (k BucketKey) IsTombstone() bool {
+	return k.tombstone
+}
+
 func NewRevBytes() []byte {
 	return make([]byte, revBytesLen, markedRevBytesLen)
 }
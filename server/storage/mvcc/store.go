@@ -15,6 +15,9 @@
 package mvcc
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"go.etcd.io/etcd/server/v3/storage/backend"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 )
@@ -58,3 +61,74 @@ func UnsafeSetFinishedCompact(tx backend.UnsafeWriter, value int64) {
 	rbytes = RevToBytes(Revision{Main: value}, rbytes)
 	tx.UnsafePut(schema.Meta, schema.FinishedCompactKeyName, rbytes)
 }
+
+// UnsafeReadSnapshotBaseRevision returns the revision a delta snapshot is
+// taken since. It returns false if the snapshot is not a delta.
+func UnsafeReadSnapshotBaseRevision(tx backend.UnsafeReader) (int64, bool) {
+	_, vs := tx.UnsafeRange(schema.Meta, schema.SnapshotBaseRevisionKeyName, nil, 0)
+	if len(vs) != 0 {
+		return BytesToRev(vs[0]).Main, true
+	}
+	return 0, false
+}
+
+func UnsafeSetSnapshotBaseRevision(tx backend.UnsafeWriter, value int64) {
+	rbytes := NewRevBytes()
+	rbytes = RevToBytes(Revision{Main: value}, rbytes)
+	tx.UnsafePut(schema.Meta, schema.SnapshotBaseRevisionKeyName, rbytes)
+}
+
+// UnsafeSetSnapshotKeptRevisions lists in the SnapshotKept bucket of tx the
+// revisions up to since that src still holds, for the revisions compacted
+// after since to be dropped from the snapshot a delta is layered on.
+func UnsafeSetSnapshotKeptRevisions(src backend.UnsafeReader, tx backend.UnsafeWriter, since int64) {
+	tx.UnsafeCreateBucket(schema.SnapshotKept)
+	min, max := NewRevBytes(), NewRevBytes()
+	min = RevToBytes(Revision{Main: 1}, min)
+	max = RevToBytes(Revision{Main: since + 1}, max)
+	for {
+		keys, _ := src.UnsafeRange(schema.Key, min, max, int64(restoreChunkKeys))
+		for _, key := range keys {
+			tx.UnsafePut(schema.SnapshotKept, key, []byte{})
+		}
+		if len(keys) < restoreChunkKeys {
+			return
+		}
+		next := BytesToRev(keys[len(keys)-1][:revBytesLen])
+		next.Sub++
+		min = RevToBytes(next, min)
+	}
+}
+
+// UnsafeReadCompactRetention returns the retentions of the last scheduled
+// compaction.
+func UnsafeReadCompactRetention(tx backend.UnsafeReader) ([]Retention, error) {
+	_, vs := tx.UnsafeRange(schema.Meta, schema.CompactRetentionKeyName, nil, 0)
+	// the key is set empty by the schema migration to v3.6
+	if len(vs) == 0 || len(vs[0]) == 0 {
+		return nil, nil
+	}
+	var rs []Retention
+	if err := json.Unmarshal(vs[0], &rs); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal compact retention: %w", err)
+	}
+	return rs, nil
+}
+
+func SetCompactRetention(tx backend.BatchTx, rs []Retention) {
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	UnsafeSetCompactRetention(tx, rs)
+}
+
+func UnsafeSetCompactRetention(tx backend.UnsafeWriter, rs []Retention) {
+	if len(rs) == 0 {
+		tx.UnsafeDelete(schema.Meta, schema.CompactRetentionKeyName)
+		return
+	}
+	b, err := json.Marshal(rs)
+	if err != nil {
+		panic(fmt.Errorf("cannot marshal compact retention: %w", err))
+	}
+	tx.UnsafePut(schema.Meta, schema.CompactRetentionKeyName, b)
+}
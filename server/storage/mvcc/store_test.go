@@ -105,3 +105,40 @@ func TestFinishedCompact(t *testing.T) {
 		})
 	}
 }
+
+func TestCompactRetention(t *testing.T) {
+	tcs := []struct {
+		name  string
+		value []byte
+		want  []Retention
+	}{
+		{
+			name: "missing",
+		},
+		{
+			name:  "set empty by the schema migration",
+			value: []byte(""),
+		},
+		{
+			name:  "retentions",
+			value: []byte(`[{"key":"Zm9v","end":"Zm9w","rev":5}]`),
+			want:  []Retention{{Key: []byte("foo"), End: []byte("fop"), Rev: 5}},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			be, _ := betesting.NewTmpBackend(t, time.Microsecond, 10)
+			defer be.Close()
+			tx := be.BatchTx()
+			tx.Lock()
+			defer tx.Unlock()
+			tx.UnsafeCreateBucket(schema.Meta)
+			if tc.value != nil {
+				tx.UnsafePut(schema.Meta, schema.CompactRetentionKeyName, tc.value)
+			}
+			rs, err := UnsafeReadCompactRetention(tx)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, rs)
+		})
+	}
+}
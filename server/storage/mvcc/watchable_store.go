@@ -15,6 +15,7 @@
 package mvcc
 
 import (
+	"bytes"
 	"sync"
 	"time"
 
@@ -42,7 +43,7 @@ var (
 func ChanBufLen() int { return chanBufLen }
 
 type watchable interface {
-	watch(key, end []byte, startRev int64, id WatchID, ch chan<- WatchResponse, fcs ...FilterFunc) (*watcher, cancelFunc)
+	watch(key, end []byte, startRev int64, id WatchID, ch chan<- WatchResponse, opts WatchOptions, fcs ...FilterFunc) (*watcher, cancelFunc)
 	progress(w *watcher)
 	progressAll(watchers map[WatchID]*watcher) bool
 	rev() int64
@@ -117,14 +118,19 @@ func (s *watchableStore) NewWatchStream() WatchStream {
 	}
 }
 
-func (s *watchableStore) watch(key, end []byte, startRev int64, id WatchID, ch chan<- WatchResponse, fcs ...FilterFunc) (*watcher, cancelFunc) {
+func (s *watchableStore) watch(key, end []byte, startRev int64, id WatchID, ch chan<- WatchResponse, opts WatchOptions, fcs ...FilterFunc) (*watcher, cancelFunc) {
 	wa := &watcher{
-		key:    key,
-		end:    end,
-		minRev: startRev,
-		id:     id,
-		ch:     ch,
-		fcs:    fcs,
+		key:         key,
+		end:         end,
+		minRev:      startRev,
+		id:          id,
+		ch:          ch,
+		fcs:         fcs,
+		coalesce:    opts.Coalesce,
+		noUnchanged: opts.NoUnchanged,
+	}
+	if opts.Coalesce && opts.CoalesceWindow > 0 {
+		wa.coalescer = newCoalescer(id, ch, opts.CoalesceWindow)
 	}
 
 	s.mu.Lock()
@@ -150,6 +156,9 @@ func (s *watchableStore) watch(key, end []byte, startRev int64, id WatchID, ch c
 
 // cancelWatcher removes references of the watcher from the watchableStore
 func (s *watchableStore) cancelWatcher(wa *watcher) {
+	if wa.coalescer != nil {
+		wa.coalescer.stop()
+	}
 	for {
 		s.mu.Lock()
 		if s.unsynced.delete(wa) {
@@ -351,9 +360,9 @@ func (s *watchableStore) syncWatchers() int {
 	// find min revision index, and these revisions can be used to
 	// query the backend store of key-value pairs
 	curRev := s.store.currentRev
-	compactionRev := s.store.compactMainRev
+	compactRev := s.store.compactRev
 
-	wg, minRev := s.unsynced.choose(maxWatchersPerSync, curRev, compactionRev)
+	wg, minRev := s.unsynced.choose(maxWatchersPerSync, curRev, compactRev)
 	minBytes, maxBytes := NewRevBytes(), NewRevBytes()
 	minBytes = RevToBytes(Revision{Main: minRev}, minBytes)
 	maxBytes = RevToBytes(Revision{Main: curRev + 1}, maxBytes)
@@ -364,15 +373,15 @@ func (s *watchableStore) syncWatchers() int {
 	tx.RLock()
 	revs, vs := tx.UnsafeRange(schema.Key, minBytes, maxBytes, 0)
 	evs := kvsToEvents(s.store.lg, wg, revs, vs)
+	wb := newWatcherBatch(wg, evs, func(ev mvccpb.Event) bool { return s.isUnchangedPut(tx, ev) })
 	// Must unlock after kvsToEvents, because vs (come from boltdb memory) is not deep copy.
 	// We can only unlock after Unmarshal, which will do deep copy.
 	// Otherwise we will trigger SIGSEGV during boltdb re-mmap.
 	tx.RUnlock()
 
 	victims := make(watcherBatch)
-	wb := newWatcherBatch(wg, evs)
 	for w := range wg.watchers {
-		if w.minRev < compactionRev {
+		if w.minRev < compactRev(w.key, w.end) {
 			// Skip the watcher that failed to send compacted watch response due to w.ch is full.
 			// Next retry of syncWatchers would try to resend the compacted watch response to w.ch
 			continue
@@ -423,7 +432,7 @@ func (s *watchableStore) syncWatchers() int {
 func kvsToEvents(lg *zap.Logger, wg *watcherGroup, revs, vals [][]byte) (evs []mvccpb.Event) {
 	for i, v := range vals {
 		var kv mvccpb.KeyValue
-		if err := kv.Unmarshal(v); err != nil {
+		if err := DecodeKeyValue(v, &kv); err != nil {
 			lg.Panic("failed to unmarshal mvccpb.KeyValue", zap.Error(err))
 		}
 
@@ -442,11 +451,33 @@ func kvsToEvents(lg *zap.Logger, wg *watcherGroup, revs, vals [][]byte) (evs []m
 	return evs
 }
 
+// isUnchangedPut returns true if the event is a put that kept the value the
+// key had at the previous revision, read from tx.
+func (s *watchableStore) isUnchangedPut(tx backend.UnsafeReader, ev mvccpb.Event) bool {
+	if ev.Type != mvccpb.PUT || ev.Kv.Version <= 1 {
+		return false
+	}
+	rev, _, _, err := s.store.kvindex.Get(ev.Kv.Key, ev.Kv.ModRevision-1)
+	if err != nil {
+		return false
+	}
+	_, vs := tx.UnsafeRange(schema.Key, RevToBytes(rev, NewRevBytes()), nil, 0)
+	if len(vs) != 1 {
+		return false
+	}
+	var kv mvccpb.KeyValue
+	if err := DecodeKeyValue(vs[0], &kv); err != nil {
+		s.store.lg.Panic("failed to unmarshal mvccpb.KeyValue", zap.Error(err))
+	}
+	return bytes.Equal(kv.Value, ev.Kv.Value)
+}
+
 // notify notifies the fact that given event at the given rev just happened to
-// watchers that watch on the key of the event.
-func (s *watchableStore) notify(rev int64, evs []mvccpb.Event) {
+// watchers that watch on the key of the event. The previous values of the keys
+// are read from tx.
+func (s *watchableStore) notify(rev int64, evs []mvccpb.Event, tx backend.UnsafeReader) {
 	victim := make(watcherBatch)
-	for w, eb := range newWatcherBatch(&s.synced, evs) {
+	for w, eb := range newWatcherBatch(&s.synced, evs, func(ev mvccpb.Event) bool { return s.isUnchangedPut(tx, ev) }) {
 		if eb.revs != 1 {
 			s.store.lg.Panic(
 				"unexpected multiple revisions in watch notification",
@@ -500,6 +531,10 @@ func (s *watchableStore) progressIfSync(watchers map[WatchID]*watcher, responseW
 		if _, ok := s.synced.watchers[w]; !ok {
 			return false
 		}
+		// collected events must be sent before the progress
+		if w.coalescer != nil && w.coalescer.pending() {
+			return false
+		}
 	}
 
 	// If all watchers are synchronised, send out progress
@@ -543,6 +578,15 @@ type watcher struct {
 	// a chan to send out the watch response.
 	// The chan might be shared with other watchers.
 	ch chan<- WatchResponse
+
+	// coalesce is set when only the latest event of each key is sent
+	coalesce bool
+	// coalescer collects the events over a window if set
+	coalescer *coalescer
+
+	// noUnchanged is set when the puts keeping the previous value of the key
+	// are filtered out
+	noUnchanged bool
 }
 
 func (w *watcher) send(wr WatchResponse) bool {
@@ -569,8 +613,18 @@ func (w *watcher) send(wr WatchResponse) bool {
 	if !progressEvent && len(wr.Events) == 0 {
 		return true
 	}
+	skipped := 0
+	if w.coalesce && !progressEvent {
+		if w.coalescer != nil {
+			return w.coalescer.add(wr)
+		}
+		n := len(wr.Events)
+		wr.Events = coalesceEvents(wr.Events)
+		skipped = n - len(wr.Events)
+	}
 	select {
 	case w.ch <- wr:
+		pendingEventsGauge.Sub(float64(skipped))
 		return true
 	default:
 		return false
@@ -251,6 +251,44 @@ func TestWatchCompacted(t *testing.T) {
 	}
 }
 
+func TestWatchCompactedRetained(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := newWatchableStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b)
+
+	for i := 0; i < 5; i++ {
+		s.Put([]byte("/audit/a"), []byte("bar"), lease.NoLease)
+		s.Put([]byte("/tmp/a"), []byte("bar"), lease.NoLease)
+	}
+	rs := []Retention{{Key: []byte("/audit/"), End: []byte("/audit0"), Rev: 4}}
+	if _, err := s.CompactRetaining(traceutil.TODO(), 8, rs); err != nil {
+		t.Fatalf("failed to compact kv (%v)", err)
+	}
+
+	w := s.NewWatchStream()
+	defer w.Close()
+
+	w.Watch(0, []byte("/audit/"), []byte("/audit0"), 4)
+	select {
+	case resp := <-w.Chan():
+		if resp.CompactRevision != 0 || len(resp.Events) != 4 || resp.Events[0].Kv.ModRevision != 4 {
+			t.Errorf("unexpected response %+v, want events since revision 4", resp)
+		}
+	case <-time.After(1 * time.Second):
+		t.Fatalf("failed to receive response (timeout)")
+	}
+
+	w.Watch(0, []byte("/tmp/a"), nil, 4)
+	select {
+	case resp := <-w.Chan():
+		if resp.CompactRevision != 8 {
+			t.Errorf("resp.CompactRevision = %v, want 8", resp.CompactRevision)
+		}
+	case <-time.After(1 * time.Second):
+		t.Fatalf("failed to receive response (timeout)")
+	}
+}
+
 func TestWatchNoEventLossOnCompact(t *testing.T) {
 	oldChanBufLen, oldMaxWatchersPerSync := chanBufLen, maxWatchersPerSync
 
@@ -561,7 +599,7 @@ func TestNewMapwatcherToEventMap(t *testing.T) {
 			wg.add(w)
 		}
 
-		gwe := newWatcherBatch(&wg, tt.evs)
+		gwe := newWatcherBatch(&wg, tt.evs, nil)
 		if len(gwe) != len(tt.wwe) {
 			t.Errorf("#%d: len(gwe) got = %d, want = %d", i, len(gwe), len(tt.wwe))
 		}
@@ -41,7 +41,7 @@ func (tw *watchableStoreTxnWrite) End() {
 	// end write txn under watchable store lock so the updates are visible
 	// when asynchronous event posting checks the current store revision
 	tw.s.mu.Lock()
-	tw.s.notify(rev, evs)
+	tw.s.notify(rev, evs, tw.s.store.b.BatchTx())
 	tw.TxnWrite.End()
 	tw.s.mu.Unlock()
 }
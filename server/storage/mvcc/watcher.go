@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"errors"
 	"sync"
+	"time"
 
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -34,6 +35,19 @@ type WatchID int64
 // FilterFunc returns true if the given event should be filtered out.
 type FilterFunc func(e mvccpb.Event) bool
 
+// WatchOptions are the options of a watcher created by WatchWithOptions.
+type WatchOptions struct {
+	// Coalesce only sends the latest event of each key out of the events of
+	// a response. If CoalesceWindow is positive, the watcher collects events
+	// for up to CoalesceWindow before sending them instead. The
+	// SkippedRevisions of a sent event counts the events it replaces.
+	Coalesce       bool
+	CoalesceWindow time.Duration
+	// NoUnchanged filters out the puts that kept the value the key had at
+	// the previous revision.
+	NoUnchanged bool
+}
+
 type WatchStream interface {
 	// Watch creates a watcher. The watcher watches the events happening or
 	// happened on the given key or range [key, end) from the given startRev.
@@ -47,6 +61,9 @@ type WatchStream interface {
 	// an auto-generated watch ID is returned.
 	Watch(id WatchID, key, end []byte, startRev int64, fcs ...FilterFunc) (WatchID, error)
 
+	// WatchWithOptions creates a watcher like Watch with the given options.
+	WatchWithOptions(id WatchID, key, end []byte, startRev int64, opts WatchOptions, fcs ...FilterFunc) (WatchID, error)
+
 	// Chan returns a chan. All watch response will be sent to the returned chan.
 	Chan() <-chan WatchResponse
 
@@ -74,6 +91,9 @@ type WatchStream interface {
 
 	// Rev returns the current revision of the KV the stream watches on.
 	Rev() int64
+
+	// Watchers returns the number of watchers on the stream.
+	Watchers() int
 }
 
 type WatchResponse struct {
@@ -110,6 +130,11 @@ type watchStream struct {
 
 // Watch creates a new watcher in the stream and returns its WatchID.
 func (ws *watchStream) Watch(id WatchID, key, end []byte, startRev int64, fcs ...FilterFunc) (WatchID, error) {
+	return ws.WatchWithOptions(id, key, end, startRev, WatchOptions{}, fcs...)
+}
+
+// WatchWithOptions creates a new watcher with the given options in the stream and returns its WatchID.
+func (ws *watchStream) WatchWithOptions(id WatchID, key, end []byte, startRev int64, opts WatchOptions, fcs ...FilterFunc) (WatchID, error) {
 	// prevent wrong range where key >= end lexicographically
 	// watch request with 'WithFromKey' has empty-byte range end
 	if len(end) != 0 && bytes.Compare(key, end) != -1 {
@@ -132,7 +157,7 @@ func (ws *watchStream) Watch(id WatchID, key, end []byte, startRev int64, fcs ..
 		return -1, ErrWatcherDuplicateID
 	}
 
-	w, c := ws.watchable.watch(key, end, startRev, id, ws.ch, fcs...)
+	w, c := ws.watchable.watch(key, end, startRev, id, ws.ch, opts, fcs...)
 
 	ws.cancels[id] = c
 	ws.watchers[id] = w
@@ -186,6 +211,12 @@ func (ws *watchStream) Rev() int64 {
 	return ws.watchable.rev()
 }
 
+func (ws *watchStream) Watchers() int {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	return len(ws.watchers)
+}
+
 func (ws *watchStream) RequestProgress(id WatchID) {
 	ws.mu.Lock()
 	w, ok := ws.watchers[id]
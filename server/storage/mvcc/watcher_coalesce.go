@@ -0,0 +1,207 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+// coalesceEvents returns the latest event of each key in evs, keeping
+// their revision order. The SkippedRevisions of a returned event counts
+// the earlier events on its key it replaces. evs must be in revision order.
+func coalesceEvents(evs []mvccpb.Event) []mvccpb.Event {
+	latest := make(map[string]int, len(evs))
+	for i := range evs {
+		latest[string(evs[i].Kv.Key)] = i
+	}
+	if len(latest) == len(evs) {
+		return evs
+	}
+
+	skipped := make(map[string]int64)
+	ne := make([]mvccpb.Event, 0, len(latest))
+	for i := range evs {
+		key := string(evs[i].Kv.Key)
+		if latest[key] != i {
+			skipped[key] += 1 + evs[i].SkippedRevisions
+			continue
+		}
+		ev := evs[i]
+		ev.SkippedRevisions += skipped[key]
+		ne = append(ne, ev)
+	}
+	return ne
+}
+
+// maxCoalescedKeys is the maximum number of keys a coalescer collects
+// events of. Once reached, responses are refused until the collected
+// events are sent, making the watcher a victim meanwhile.
+var maxCoalescedKeys = 4096
+
+// coalescer collects the events sent to a watcher for a window of time,
+// then sends the latest event of each key in a single response.
+type coalescer struct {
+	id     WatchID
+	ch     chan<- WatchResponse
+	window time.Duration
+
+	// stopc is closed to abort sending collected events.
+	stopc chan struct{}
+	// sendMu is held while collected events are sent.
+	sendMu sync.Mutex
+
+	// mu protects the fields below it
+	mu sync.Mutex
+	// evs are the latest events of each key collected since the last
+	// send, in revision order. Replaced events are left with a nil Kv.
+	evs []mvccpb.Event
+	// latest maps the keys to the index of their event in evs
+	latest map[string]int
+	// rev is the revision of the last collected response
+	rev int64
+	// timer is set from the first collected event until the events are sent
+	timer   *time.Timer
+	stopped bool
+}
+
+func newCoalescer(id WatchID, ch chan<- WatchResponse, window time.Duration) *coalescer {
+	return &coalescer{
+		id:     id,
+		ch:     ch,
+		window: window,
+		stopc:  make(chan struct{}),
+		latest: make(map[string]int),
+	}
+}
+
+// add collects the events of the response, replacing the collected
+// events of the same keys, and starts a window if none is open. It
+// returns false, collecting nothing, if the events of too many keys
+// are collected already.
+func (c *coalescer) add(wr WatchResponse) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stopped {
+		return true
+	}
+	if len(c.latest) > 0 {
+		keys := len(c.latest)
+		for i := range wr.Events {
+			if _, ok := c.latest[string(wr.Events[i].Kv.Key)]; !ok {
+				keys++
+			}
+		}
+		if keys > maxCoalescedKeys {
+			return false
+		}
+	}
+
+	for _, ev := range wr.Events {
+		key := string(ev.Kv.Key)
+		if i, ok := c.latest[key]; ok {
+			ev.SkippedRevisions += 1 + c.evs[i].SkippedRevisions
+			c.evs[i].Kv = nil
+			pendingEventsGauge.Dec()
+		}
+		c.latest[key] = len(c.evs)
+		c.evs = append(c.evs, ev)
+	}
+	if len(c.evs) > 2*len(c.latest) {
+		c.compact()
+	}
+	c.rev = wr.Revision
+	if c.timer == nil {
+		c.timer = time.AfterFunc(c.window, c.flush)
+	}
+	return true
+}
+
+// compact drops the replaced events from evs.
+func (c *coalescer) compact() {
+	evs := make([]mvccpb.Event, 0, len(c.latest))
+	for _, ev := range c.evs {
+		if ev.Kv != nil {
+			c.latest[string(ev.Kv.Key)] = len(evs)
+			evs = append(evs, ev)
+		}
+	}
+	c.evs = evs
+}
+
+// pending returns true if there are collected events not sent yet.
+func (c *coalescer) pending() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.timer != nil
+}
+
+// flush sends the collected events, blocking until the watch channel
+// takes them or the coalescer is stopped.
+func (c *coalescer) flush() {
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+
+	c.mu.Lock()
+	if c.stopped {
+		c.mu.Unlock()
+		return
+	}
+	c.compact()
+	evs, rev := c.evs, c.rev
+	c.evs = nil
+	clear(c.latest)
+	c.mu.Unlock()
+
+	wr := WatchResponse{WatchID: c.id, Events: evs, Revision: rev}
+	select {
+	case c.ch <- wr:
+	case <-c.stopc:
+		pendingEventsGauge.Sub(float64(len(wr.Events)))
+		return
+	}
+
+	c.mu.Lock()
+	c.timer = nil
+	if len(c.evs) != 0 && !c.stopped {
+		// events collected while sending open the next window
+		c.timer = time.AfterFunc(c.window, c.flush)
+	}
+	c.mu.Unlock()
+}
+
+// stop drops the collected events and waits for a running send to
+// finish, so that nothing is sent to the watch channel afterwards.
+func (c *coalescer) stop() {
+	c.mu.Lock()
+	if c.stopped {
+		c.mu.Unlock()
+		return
+	}
+	c.stopped = true
+	close(c.stopc)
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	c.compact()
+	pendingEventsGauge.Sub(float64(len(c.evs)))
+	c.evs = nil
+	c.mu.Unlock()
+
+	c.sendMu.Lock()
+	c.sendMu.Unlock()
+}
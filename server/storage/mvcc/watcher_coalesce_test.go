@@ -0,0 +1,95 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+func putEvent(key, val string, rev int64) mvccpb.Event {
+	return mvccpb.Event{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte(key), Value: []byte(val), ModRevision: rev}}
+}
+
+func TestCoalescerAdd(t *testing.T) {
+	defer func(n int) { maxCoalescedKeys = n }(maxCoalescedKeys)
+	maxCoalescedKeys = 2
+
+	ch := make(chan WatchResponse)
+	c := newCoalescer(1, ch, time.Hour)
+	defer c.stop()
+
+	for rev, kv := range [][2]string{{"foo", "1"}, {"bar", "1"}, {"foo", "2"}, {"foo", "3"}} {
+		if !c.add(WatchResponse{Events: []mvccpb.Event{putEvent(kv[0], kv[1], int64(rev+2))}, Revision: int64(rev + 2)}) {
+			t.Fatalf("#%d: expected the events to be collected", rev)
+		}
+	}
+	// the replaced events are dropped as they are collected
+	if len(c.evs) > 2*len(c.latest) {
+		t.Errorf("collected %d events for %d keys", len(c.evs), len(c.latest))
+	}
+	if c.add(WatchResponse{Events: []mvccpb.Event{putEvent("baz", "1", 6)}, Revision: 6}) {
+		t.Fatal("expected the events of a new key to be refused once full")
+	}
+	if !c.add(WatchResponse{Events: []mvccpb.Event{putEvent("bar", "2", 6), putEvent("bar", "3", 7)}, Revision: 7}) {
+		t.Fatal("expected the events of a collected key to be collected once full")
+	}
+
+	go c.flush()
+	select {
+	case wr := <-ch:
+		want := []string{"foo=3 (2)", "bar=3 (2)"}
+		if evs := coalescedEvents(wr.Events); !reflect.DeepEqual(evs, want) {
+			t.Errorf("events = %v, want %v", evs, want)
+		}
+		if wr.Revision != 7 {
+			t.Errorf("revision = %d, want 7", wr.Revision)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("failed to receive coalesced events")
+	}
+
+	// the sent events make room for new keys
+	if !c.add(WatchResponse{Events: []mvccpb.Event{putEvent("baz", "1", 8)}, Revision: 8}) {
+		t.Fatal("expected the events to be collected after the send")
+	}
+}
+
+func TestWatcherGroupCompactAfterCoalesced(t *testing.T) {
+	ch := make(chan WatchResponse, 1)
+	w := &watcher{key: []byte("foo"), id: 1, ch: ch, minRev: 2, coalesce: true, coalescer: newCoalescer(1, ch, time.Hour)}
+	defer w.coalescer.stop()
+	wg := newWatcherGroup()
+	wg.add(w)
+	compactRev := func(key, end []byte) int64 { return 5 }
+
+	w.coalescer.add(WatchResponse{Events: []mvccpb.Event{putEvent("foo", "1", 2)}, Revision: 2})
+	wg.chooseAll(10, compactRev)
+	if len(ch) != 0 || w.compacted {
+		t.Fatal("expected the compaction to wait for the collected events")
+	}
+
+	w.coalescer.flush()
+	if wr := <-ch; len(wr.Events) != 1 {
+		t.Fatalf("expected the collected event, got %+v", wr)
+	}
+	wg.chooseAll(10, compactRev)
+	if wr := <-ch; wr.CompactRevision != 5 || !w.compacted {
+		t.Fatalf("expected the compaction after the collected events, got %+v", wr)
+	}
+}
@@ -77,19 +77,31 @@ func (wb watcherBatch) add(w *watcher, ev mvccpb.Event) {
 }
 
 // newWatcherBatch maps watchers to their matched events. It enables quick
-// events look up by watcher.
-func newWatcherBatch(wg *watcherGroup, evs []mvccpb.Event) watcherBatch {
+// events look up by watcher. isUnchanged reports the puts that kept the
+// previous value of the key, for the watchers filtering them out; it is only
+// called for the events such a watcher matches.
+func newWatcherBatch(wg *watcherGroup, evs []mvccpb.Event, isUnchanged func(mvccpb.Event) bool) watcherBatch {
 	if len(wg.watchers) == 0 {
 		return nil
 	}
 
 	wb := make(watcherBatch)
 	for _, ev := range evs {
+		checked, unchanged := false, false
 		for w := range wg.watcherSetByKey(string(ev.Kv.Key)) {
-			if ev.Kv.ModRevision >= w.minRev {
+			if ev.Kv.ModRevision < w.minRev {
 				// don't double notify
-				wb.add(w, ev)
+				continue
 			}
+			if w.noUnchanged && isUnchanged != nil {
+				if !checked {
+					checked, unchanged = true, isUnchanged(ev)
+				}
+				if unchanged {
+					continue
+				}
+			}
+			wb.add(w, ev)
 		}
 	}
 	return wb
@@ -221,7 +233,7 @@ func (wg *watcherGroup) delete(wa *watcher) bool {
 }
 
 // choose selects watchers from the watcher group to update
-func (wg *watcherGroup) choose(maxWatchers int, curRev, compactRev int64) (*watcherGroup, int64) {
+func (wg *watcherGroup) choose(maxWatchers int, curRev int64, compactRev func(key, end []byte) int64) (*watcherGroup, int64) {
 	if len(wg.watchers) < maxWatchers {
 		return wg, wg.chooseAll(curRev, compactRev)
 	}
@@ -236,7 +248,7 @@ func (wg *watcherGroup) choose(maxWatchers int, curRev, compactRev int64) (*watc
 	return &ret, ret.chooseAll(curRev, compactRev)
 }
 
-func (wg *watcherGroup) chooseAll(curRev, compactRev int64) int64 {
+func (wg *watcherGroup) chooseAll(curRev int64, compactRev func(key, end []byte) int64) int64 {
 	minRev := int64(math.MaxInt64)
 	for w := range wg.watchers {
 		if w.minRev > curRev {
@@ -250,9 +262,13 @@ func (wg *watcherGroup) chooseAll(curRev, compactRev int64) int64 {
 			// mark 'restore' done, since it's chosen
 			w.restore = false
 		}
-		if w.minRev < compactRev {
+		if wrev := compactRev(w.key, w.end); w.minRev < wrev {
+			if w.coalescer != nil && w.coalescer.pending() {
+				// collected events must be sent before the compaction; retry next time
+				continue
+			}
 			select {
-			case w.ch <- WatchResponse{WatchID: w.id, CompactRevision: compactRev}:
+			case w.ch <- WatchResponse{WatchID: w.id, CompactRevision: wrev}:
 				w.compacted = true
 				wg.delete(w)
 			default:
@@ -429,3 +429,125 @@ func TestWatcherWatchWithFilter(t *testing.T) {
 		t.Fatal("failed to receive delete request")
 	}
 }
+
+func TestWatcherWatchWithOptionsCoalesce(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := WatchableKV(newWatchableStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{}))
+	defer cleanup(s, b)
+
+	w := s.NewWatchStream()
+	defer w.Close()
+
+	s.Put([]byte("foo"), []byte("1"), 0)
+	s.Put([]byte("bar"), []byte("1"), 0)
+	s.Put([]byte("foo"), []byte("2"), 0)
+	s.Put([]byte("foo"), []byte("3"), 0)
+
+	// an unsynced watcher gets the history in a single response
+	if _, err := w.WatchWithOptions(0, []byte("bar"), []byte("fop"), 1, WatchOptions{Coalesce: true}); err != nil {
+		t.Fatal(err)
+	}
+	wantEvs := []string{"bar=1 (0)", "foo=3 (2)"}
+	select {
+	case resp := <-w.Chan():
+		if evs := coalescedEvents(resp.Events); !reflect.DeepEqual(evs, wantEvs) {
+			t.Errorf("events = %v, want %v", evs, wantEvs)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("failed to receive coalesced events")
+	}
+
+	// a windowed watcher collects the events of several responses
+	if _, err := w.WatchWithOptions(1, []byte("foo"), nil, 0, WatchOptions{Coalesce: true, CoalesceWindow: 100 * time.Millisecond}); err != nil {
+		t.Fatal(err)
+	}
+	s.Put([]byte("foo"), []byte("4"), 0)
+	s.Put([]byte("foo"), []byte("5"), 0)
+	s.DeleteRange([]byte("foo"), nil)
+	s.Put([]byte("foo"), []byte("6"), 0)
+
+	var got []string
+	for len(got) < 3 {
+		select {
+		case resp := <-w.Chan():
+			got = append(got, fmt.Sprintf("%d: %v", resp.WatchID, coalescedEvents(resp.Events)))
+		case <-time.After(5 * time.Second):
+			t.Fatalf("failed to receive coalesced events, got %v", got)
+		}
+	}
+	// the first watcher sees every revision, the windowed one only the last
+	wantResps := []string{"0: [foo=4 (0)]", "0: [foo=5 (0)]", "0: [foo= (0)]"}
+	if !reflect.DeepEqual(got, wantResps) {
+		t.Fatalf("responses = %v, want %v", got, wantResps)
+	}
+	got = got[:0]
+	for len(got) < 2 {
+		select {
+		case resp := <-w.Chan():
+			got = append(got, fmt.Sprintf("%d: %v", resp.WatchID, coalescedEvents(resp.Events)))
+		case <-time.After(5 * time.Second):
+			t.Fatalf("failed to receive coalesced events, got %v", got)
+		}
+	}
+	wantResps = []string{"0: [foo=6 (0)]", "1: [foo=6 (3)]"}
+	if !reflect.DeepEqual(got, wantResps) {
+		t.Errorf("responses = %v, want %v", got, wantResps)
+	}
+}
+
+func TestWatcherWatchNoUnchanged(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := WatchableKV(newWatchableStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{}))
+	defer cleanup(s, b)
+
+	w := s.NewWatchStream()
+	defer w.Close()
+
+	s.Put([]byte("foo"), []byte("1"), 0)
+	s.Put([]byte("foo"), []byte("1"), 0)
+	s.Put([]byte("foo"), []byte("2"), 0)
+	s.DeleteRange([]byte("foo"), nil)
+	s.Put([]byte("foo"), []byte("2"), 0)
+
+	// an unsynced watcher reads the previous values from the history
+	if _, err := w.WatchWithOptions(0, []byte("foo"), nil, 1, WatchOptions{NoUnchanged: true}); err != nil {
+		t.Fatal(err)
+	}
+	wantEvs := []string{"PUT foo=1", "PUT foo=2", "DELETE foo=", "PUT foo=2"}
+	select {
+	case resp := <-w.Chan():
+		if evs := typedEvents(resp.Events); !reflect.DeepEqual(evs, wantEvs) {
+			t.Errorf("events = %v, want %v", evs, wantEvs)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("failed to receive events")
+	}
+
+	// a synced watcher reads them from the write txn
+	s.Put([]byte("foo"), []byte("2"), 0)
+	s.Put([]byte("foo"), []byte("3"), 0)
+	select {
+	case resp := <-w.Chan():
+		if evs, want := typedEvents(resp.Events), []string{"PUT foo=3"}; !reflect.DeepEqual(evs, want) {
+			t.Errorf("events = %v, want %v", evs, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("failed to receive events")
+	}
+}
+
+func typedEvents(evs []mvccpb.Event) []string {
+	ss := make([]string, len(evs))
+	for i, ev := range evs {
+		ss[i] = fmt.Sprintf("%s %s=%s", ev.Type, ev.Kv.Key, ev.Kv.Value)
+	}
+	return ss
+}
+
+func coalescedEvents(evs []mvccpb.Event) []string {
+	ss := make([]string, len(evs))
+	for i, ev := range evs {
+		ss[i] = fmt.Sprintf("%s=%s (%d)", ev.Kv.Key, ev.Kv.Value, ev.SkippedRevisions)
+	}
+	return ss
+}
@@ -22,6 +22,7 @@ import (
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
 )
 
 const (
@@ -54,6 +55,8 @@ func (*passthroughQuota) Remaining() int64   { return 1 }
 type BackendQuota struct {
 	be              backend.Backend
 	maxBackendBytes int64
+	// valueCodec is the codec mvcc compresses the values of the keys with.
+	valueCodec mvcc.ValueCodec
 }
 
 const (
@@ -71,8 +74,9 @@ var (
 	maxQuotaSize     = humanize.Bytes(uint64(MaxQuotaBytes))
 )
 
-// NewBackendQuota creates a quota layer with the given storage limit.
-func NewBackendQuota(lg *zap.Logger, quotaBackendBytesCfg int64, be backend.Backend, name string) Quota {
+// NewBackendQuota creates a quota layer with the given storage limit, for
+// the values of the keys stored compressed with valueCodec.
+func NewBackendQuota(lg *zap.Logger, quotaBackendBytesCfg int64, be backend.Backend, name string, valueCodec mvcc.ValueCodec) Quota {
 	quotaBackendBytes.Set(float64(quotaBackendBytesCfg))
 	if quotaBackendBytesCfg < 0 {
 		// disable quotas if negative
@@ -99,7 +103,7 @@ func NewBackendQuota(lg *zap.Logger, quotaBackendBytesCfg int64, be backend.Back
 			}
 		})
 		quotaBackendBytes.Set(float64(DefaultQuotaBytes))
-		return &BackendQuota{be, DefaultQuotaBytes}
+		return &BackendQuota{be, DefaultQuotaBytes, valueCodec}
 	}
 
 	quotaLogOnce.Do(func() {
@@ -120,7 +124,7 @@ func NewBackendQuota(lg *zap.Logger, quotaBackendBytesCfg int64, be backend.Back
 			zap.String("quota-size", humanize.Bytes(uint64(quotaBackendBytesCfg))),
 		)
 	})
-	return &BackendQuota{be, quotaBackendBytesCfg}
+	return &BackendQuota{be, quotaBackendBytesCfg, valueCodec}
 }
 
 func (b *BackendQuota) Available(v any) bool {
@@ -130,15 +134,30 @@ func (b *BackendQuota) Available(v any) bool {
 		return true
 	}
 	// TODO: maybe optimize Backend.Size()
-	return b.be.Size()+int64(cost) < b.maxBackendBytes
+	size := b.be.Size()
+	if size+int64(cost) < b.maxBackendBytes {
+		return true
+	}
+	if b.valueCodec == mvcc.ValueCodecNone {
+		return false
+	}
+	// the values may be stored compressed, so only compress them to charge
+	// the bytes they are stored with when the backend is close to its quota.
+	return size+int64(requestCost(v, b.valueCodec)) < b.maxBackendBytes
 }
 
+// Cost returns the charge of a request, as if its values were not
+// compressed.
 func (b *BackendQuota) Cost(v any) int {
+	return requestCost(v, mvcc.ValueCodecNone)
+}
+
+func requestCost(v any, valueCodec mvcc.ValueCodec) int {
 	switch r := v.(type) {
 	case *pb.PutRequest:
-		return costPut(r)
+		return costPut(r, valueCodec)
 	case *pb.TxnRequest:
-		return costTxn(r)
+		return costTxn(r, valueCodec)
 	case *pb.LeaseGrantRequest:
 		return leaseOverhead
 	default:
@@ -146,24 +165,26 @@ func (b *BackendQuota) Cost(v any) int {
 	}
 }
 
-func costPut(r *pb.PutRequest) int { return kvOverhead + len(r.Key) + len(r.Value) }
+func costPut(r *pb.PutRequest, valueCodec mvcc.ValueCodec) int {
+	return kvOverhead + len(r.Key) + mvcc.StoredValueSize(valueCodec, r.Value)
+}
 
-func costTxnReq(u *pb.RequestOp) int {
+func costTxnReq(u *pb.RequestOp, valueCodec mvcc.ValueCodec) int {
 	r := u.GetRequestPut()
 	if r == nil {
 		return 0
 	}
-	return costPut(r)
+	return costPut(r, valueCodec)
 }
 
-func costTxn(r *pb.TxnRequest) int {
+func costTxn(r *pb.TxnRequest, valueCodec mvcc.ValueCodec) int {
 	sizeSuccess := 0
 	for _, u := range r.Success {
-		sizeSuccess += costTxnReq(u)
+		sizeSuccess += costTxnReq(u, valueCodec)
 	}
 	sizeFailure := 0
 	for _, u := range r.Failure {
-		sizeFailure += costTxnReq(u)
+		sizeFailure += costTxnReq(u, valueCodec)
 	}
 	if sizeFailure > sizeSuccess {
 		return sizeFailure
@@ -0,0 +1,46 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+// UnsafeReadAutoDefragLock returns the last taken automatic defragmentation
+// lock, nil if none was taken.
+func UnsafeReadAutoDefragLock(tx backend.UnsafeReader) (*etcdserverpb.AutoDefragLockRequest, error) {
+	_, vs := tx.UnsafeRange(Meta, AutoDefragLockKeyName, nil, 0)
+	// the key is set empty by the schema migration to v3.6
+	if len(vs) == 0 || len(vs[0]) == 0 {
+		return nil, nil
+	}
+	var l etcdserverpb.AutoDefragLockRequest
+	if err := l.Unmarshal(vs[0]); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal auto defrag lock: %w", err)
+	}
+	return &l, nil
+}
+
+// UnsafeSaveAutoDefragLock saves the taken automatic defragmentation lock.
+func UnsafeSaveAutoDefragLock(tx backend.UnsafeWriter, l *etcdserverpb.AutoDefragLockRequest) {
+	b, err := l.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("cannot marshal auto defrag lock: %w", err))
+	}
+	tx.UnsafePut(Meta, AutoDefragLockKeyName, b)
+}
@@ -36,6 +36,10 @@ var (
 	authUsersBucketName = []byte("authUsers")
 	authRolesBucketName = []byte("authRoles")
 
+	revisionTimeBucketName = []byte("revisionTime")
+
+	snapshotKeptBucketName = []byte("snapshotKept")
+
 	testBucketName = []byte("test")
 )
 
@@ -53,9 +57,14 @@ var (
 	AuthUsers = backend.Bucket(bucket{id: 21, name: authUsersBucketName, safeRangeBucket: false})
 	AuthRoles = backend.Bucket(bucket{id: 22, name: authRolesBucketName, safeRangeBucket: false})
 
+	RevisionTime = backend.Bucket(bucket{id: 30, name: revisionTimeBucketName, safeRangeBucket: true})
+
+	// SnapshotKept only exists in delta snapshots.
+	SnapshotKept = backend.Bucket(bucket{id: 31, name: snapshotKeptBucketName, safeRangeBucket: false})
+
 	Test = backend.Bucket(bucket{id: 100, name: testBucketName, safeRangeBucket: false})
 
-	AllBuckets = []backend.Bucket{Key, Meta, Lease, Alarm, Cluster, Members, MembersRemoved, Auth, AuthUsers, AuthRoles}
+	AllBuckets = []backend.Bucket{Key, Meta, Lease, Alarm, Cluster, Members, MembersRemoved, Auth, AuthUsers, AuthRoles, RevisionTime}
 )
 
 type bucket struct {
@@ -82,7 +91,12 @@ var (
 	ClusterClusterVersionKeyName = []byte("clusterVersion")
 	ClusterDowngradeKeyName      = []byte("downgrade")
 	// Since v3.6
-	MetaStorageVersionName = []byte("storageVersion")
+	MetaStorageVersionName        = []byte("storageVersion")
+	CompactRetentionKeyName       = []byte("compactRetention")
+	CompactRetentionPolicyKeyName = []byte("compactRetentionPolicy")
+	AutoDefragLockKeyName         = []byte("autoDefragLock")
+	// SnapshotBaseRevisionKeyName is only set in delta snapshots.
+	SnapshotBaseRevisionKeyName = []byte("snapshotBaseRevision")
 	// Before adding new meta key please update server/etcdserver/version
 )
 
@@ -0,0 +1,51 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+// UnsafeReadCompactionRetentionPolicy returns the prefix retentions set for
+// the compactions of the cluster, nil if none is set.
+func UnsafeReadCompactionRetentionPolicy(tx backend.UnsafeReader) (*etcdserverpb.CompactionRetentionPolicyRequest, error) {
+	_, vs := tx.UnsafeRange(Meta, CompactRetentionPolicyKeyName, nil, 0)
+	// the key is set empty by the schema migration to v3.6
+	if len(vs) == 0 || len(vs[0]) == 0 {
+		return nil, nil
+	}
+	var p etcdserverpb.CompactionRetentionPolicyRequest
+	if err := p.Unmarshal(vs[0]); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal compact retention policy: %w", err)
+	}
+	return &p, nil
+}
+
+// UnsafeSaveCompactionRetentionPolicy sets the prefix retentions of the
+// compactions of the cluster. A policy without retentions is deleted.
+func UnsafeSaveCompactionRetentionPolicy(tx backend.UnsafeWriter, p *etcdserverpb.CompactionRetentionPolicyRequest) {
+	if p == nil || len(p.Retentions) == 0 {
+		tx.UnsafeDelete(Meta, CompactRetentionPolicyKeyName)
+		return
+	}
+	b, err := p.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("cannot marshal compact retention policy: %w", err))
+	}
+	tx.UnsafePut(Meta, CompactRetentionPolicyKeyName, b)
+}
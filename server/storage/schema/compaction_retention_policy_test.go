@@ -0,0 +1,60 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+)
+
+func TestCompactionRetentionPolicy(t *testing.T) {
+	be, _ := betesting.NewTmpBackend(t, time.Microsecond, 10)
+	defer betesting.Close(t, be)
+
+	p := &etcdserverpb.CompactionRetentionPolicyRequest{Retentions: []*etcdserverpb.CompactionPrefixRetention{
+		{Prefix: "/audit/", Revisions: 100},
+		{Prefix: "/events/", Duration: int64(time.Hour)},
+	}}
+	for _, tc := range []struct {
+		name string
+		save *etcdserverpb.CompactionRetentionPolicyRequest
+		want *etcdserverpb.CompactionRetentionPolicyRequest
+	}{
+		{name: "set", save: p, want: p},
+		{name: "cleared", save: &etcdserverpb.CompactionRetentionPolicyRequest{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := be.BatchTx()
+			tx.Lock()
+			tx.UnsafeCreateBucket(Meta)
+			UnsafeSaveCompactionRetentionPolicy(tx, tc.save)
+			tx.Unlock()
+			be.ForceCommit()
+
+			rtx := be.ReadTx()
+			rtx.RLock()
+			got, err := UnsafeReadCompactionRetentionPolicy(rtx)
+			rtx.RUnlock()
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"errors"
+
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+// CompressedKeyValueMarker is the first byte of the values of the Key bucket
+// whose mvccpb.KeyValue has a compressed Value, which etcd versions before
+// v3.6 cannot read.
+const CompressedKeyValueMarker = 0x00
+
+var errFoundCompressedKeyValue = errors.New("found compressed key value")
+
+// unsafeHasCompressedKeyValues returns true if any value of the Key bucket
+// is compressed.
+func unsafeHasCompressedKeyValues(tx backend.UnsafeReader) (bool, error) {
+	err := tx.UnsafeForEach(Key, func(_, v []byte) error {
+		if len(v) > 0 && v[0] == CompressedKeyValueMarker {
+			return errFoundCompressedKeyValue
+		}
+		return nil
+	})
+	if errors.Is(err, errFoundCompressedKeyValue) {
+		return true, nil
+	}
+	return false, err
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+// The revisionTime bucket maps the time a revision was current, as
+// proposed by the leader, to the revision. Keys count down from math.MaxInt64 as time
+// goes on, so that seeking from a time finds the latest entry before it.
+
+// revisionTimeEnd is greater than any key of the revisionTime bucket.
+var revisionTimeEnd = []byte{0xff}
+
+func revisionTimeKey(t time.Time) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, uint64(math.MaxInt64-t.UnixNano()))
+	return k
+}
+
+func revisionTimeFromKey(k []byte) time.Time {
+	return time.Unix(0, math.MaxInt64-int64(binary.BigEndian.Uint64(k)))
+}
+
+func UnsafeCreateRevisionTimeBucket(tx backend.UnsafeWriter) {
+	tx.UnsafeCreateBucket(RevisionTime)
+}
+
+// UnsafeSaveRevisionTime records that rev was the current revision at t.
+func UnsafeSaveRevisionTime(tx backend.UnsafeWriter, t time.Time, rev int64) {
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, uint64(rev))
+	tx.UnsafePut(RevisionTime, revisionTimeKey(t), v)
+}
+
+// UnsafeReadRevisionAt returns the latest revision recorded at or before t,
+// and the time it was recorded at. It returns false if there is none.
+func UnsafeReadRevisionAt(tx backend.UnsafeReader, t time.Time) (rev int64, at time.Time, ok bool) {
+	if t.UnixNano() < 0 {
+		return 0, time.Time{}, false
+	}
+	ks, vs := tx.UnsafeRange(RevisionTime, revisionTimeKey(t), revisionTimeEnd, 1)
+	if len(ks) == 0 {
+		return 0, time.Time{}, false
+	}
+	return int64(binary.BigEndian.Uint64(vs[0])), revisionTimeFromKey(ks[0]), true
+}
+
+// UnsafeReadLatestRevisionTime returns the latest record, or false if there
+// is none.
+func UnsafeReadLatestRevisionTime(tx backend.UnsafeReader) (rev int64, at time.Time, ok bool) {
+	ks, vs := tx.UnsafeRange(RevisionTime, []byte{0}, revisionTimeEnd, 1)
+	if len(ks) == 0 {
+		return 0, time.Time{}, false
+	}
+	return int64(binary.BigEndian.Uint64(vs[0])), revisionTimeFromKey(ks[0]), true
+}
+
+// UnsafePruneRevisionTimes deletes the records older than the latest record
+// of a revision at or below compactRev, which are all compacted.
+func UnsafePruneRevisionTimes(tx backend.UnsafeReadWriter, compactRev int64) {
+	ks, vs := tx.UnsafeRange(RevisionTime, []byte{0}, revisionTimeEnd, 0)
+	for i := range ks {
+		if int64(binary.BigEndian.Uint64(vs[i])) > compactRev {
+			continue
+		}
+		for _, k := range ks[i+1:] {
+			tx.UnsafeDelete(RevisionTime, k)
+		}
+		return
+	}
+}
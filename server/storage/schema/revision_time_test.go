@@ -0,0 +1,86 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+)
+
+func TestRevisionTime(t *testing.T) {
+	be, _ := betesting.NewTmpBackend(t, time.Microsecond, 10)
+	defer betesting.Close(t, be)
+
+	base := time.Unix(1700000000, 0)
+	tx := be.BatchTx()
+	tx.Lock()
+	UnsafeCreateRevisionTimeBucket(tx)
+	UnsafeSaveRevisionTime(tx, base, 10)
+	UnsafeSaveRevisionTime(tx, base.Add(time.Second), 20)
+	UnsafeSaveRevisionTime(tx, base.Add(2*time.Second), 30)
+	tx.Unlock()
+	be.ForceCommit()
+
+	tcs := []struct {
+		name    string
+		at      time.Time
+		wantRev int64
+		wantAt  time.Time
+		wantOk  bool
+	}{
+		{name: "before the first record", at: base.Add(-time.Nanosecond)},
+		{name: "at a record", at: base, wantRev: 10, wantAt: base, wantOk: true},
+		{name: "between records", at: base.Add(1500 * time.Millisecond), wantRev: 20, wantAt: base.Add(time.Second), wantOk: true},
+		{name: "after the last record", at: base.Add(time.Hour), wantRev: 30, wantAt: base.Add(2 * time.Second), wantOk: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			rtx := be.ReadTx()
+			rtx.RLock()
+			rev, at, ok := UnsafeReadRevisionAt(rtx, tc.at)
+			rtx.RUnlock()
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.wantRev, rev)
+			assert.True(t, tc.wantAt.Equal(at), "got time %v, want %v", at, tc.wantAt)
+		})
+	}
+
+	rtx := be.ReadTx()
+	rtx.RLock()
+	rev, at, ok := UnsafeReadLatestRevisionTime(rtx)
+	rtx.RUnlock()
+	assert.True(t, ok)
+	assert.Equal(t, int64(30), rev)
+	assert.True(t, base.Add(2*time.Second).Equal(at), "got time %v, want %v", at, base.Add(2*time.Second))
+
+	// records before the one of revision 20 only hold compacted revisions
+	tx.Lock()
+	UnsafePruneRevisionTimes(tx, 25)
+	tx.Unlock()
+	be.ForceCommit()
+
+	rtx = be.ReadTx()
+	rtx.RLock()
+	defer rtx.RUnlock()
+	_, _, ok = UnsafeReadRevisionAt(rtx, base)
+	assert.False(t, ok)
+	rev, _, ok = UnsafeReadRevisionAt(rtx, base.Add(time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, int64(20), rev)
+}
@@ -75,6 +75,32 @@ func UnsafeMigrate(lg *zap.Logger, tx backend.UnsafeReadWriter, w WALVersion, ta
 		if minVersion != nil && target.LessThan(*minVersion) {
 			return fmt.Errorf("cannot downgrade storage, WAL contains newer entries")
 		}
+		if target.LessThan(version.V3_6) {
+			compressed, err := unsafeHasCompressedKeyValues(tx)
+			if err != nil {
+				return err
+			}
+			if compressed {
+				return fmt.Errorf("cannot downgrade storage, key bucket contains compressed values")
+			}
+		}
+	}
+	return plan.unsafeExecute(lg, tx)
+}
+
+// RaiseStorageVersion migrates the storage schema up to target if it is
+// older. It does nothing if the version of the storage cannot be detected
+// yet, as the storage version is then set from the cluster version later.
+func RaiseStorageVersion(lg *zap.Logger, tx backend.BatchTx, target semver.Version) error {
+	tx.LockOutsideApply()
+	defer tx.Unlock()
+	current, err := UnsafeDetectSchemaVersion(lg, tx)
+	if err != nil || !current.LessThan(target) {
+		return nil
+	}
+	plan, err := newPlan(lg, current, target)
+	if err != nil {
+		return err
 	}
 	return plan.unsafeExecute(lg, tx)
 }
@@ -129,9 +155,17 @@ var (
 	schemaChanges = map[semver.Version][]schemaChange{
 		version.V3_6: {
 			addNewField(Meta, MetaStorageVersionName, emptyStorageVersion),
+			addNewField(Meta, CompactRetentionKeyName, emptyCompactRetention),
+			addNewField(Meta, CompactRetentionPolicyKeyName, emptyCompactRetention),
+			addNewField(Meta, AutoDefragLockKeyName, emptyAutoDefragLock),
 		},
 	}
 	// emptyStorageVersion is used for v3.6 Step for the first time, in all other version StoragetVersion should be set by migrator.
 	// Adding a addNewField for StorageVersion we can reuse logic to remove it when downgrading to v3.5
 	emptyStorageVersion = []byte("")
+	// emptyCompactRetention retains nothing. Downgrading to v3.5 drops the
+	// retentions and their policy, which v3.5 does not know about.
+	emptyCompactRetention = []byte("")
+	// emptyAutoDefragLock is a lock held by no member.
+	emptyAutoDefragLock = []byte("")
 )
@@ -20,6 +20,7 @@ import (
 
 	"github.com/coreos/go-semver/semver"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"go.etcd.io/etcd/api/v3/etcdserverpb"
@@ -191,6 +192,34 @@ func TestMigrate(t *testing.T) {
 			expectError:    true,
 			expectErrorMsg: "cannot downgrade storage, WAL contains newer entries",
 		},
+		{
+			name:          "Downgrading v3.6 to v3.5 works as there are no compressed key values",
+			version:       version.V3_6,
+			targetVersion: version.V3_5,
+			overrideKeys: func(tx backend.UnsafeReadWriter) {
+				MustUnsafeSaveConfStateToBackend(zap.NewNop(), tx, &raftpb.ConfState{})
+				UnsafeUpdateConsistentIndex(tx, 1, 1)
+				UnsafeSetStorageVersion(tx, &version.V3_6)
+				tx.UnsafeCreateBucket(Key)
+				tx.UnsafePut(Key, []byte("rev"), []byte("\x0akey"))
+			},
+			expectVersion: nil,
+		},
+		{
+			name:          "Downgrading v3.6 to v3.5 fails if there are compressed key values",
+			version:       version.V3_6,
+			targetVersion: version.V3_5,
+			overrideKeys: func(tx backend.UnsafeReadWriter) {
+				MustUnsafeSaveConfStateToBackend(zap.NewNop(), tx, &raftpb.ConfState{})
+				UnsafeUpdateConsistentIndex(tx, 1, 1)
+				UnsafeSetStorageVersion(tx, &version.V3_6)
+				tx.UnsafeCreateBucket(Key)
+				tx.UnsafePut(Key, []byte("rev"), []byte{CompressedKeyValueMarker, 1})
+			},
+			expectVersion:  &version.V3_6,
+			expectError:    true,
+			expectErrorMsg: "cannot downgrade storage, key bucket contains compressed values",
+		},
 		{
 			name:           "Downgrading v3.5 to v3.4 is not supported as schema was introduced in v3.6",
 			version:        version.V3_5,
@@ -227,6 +256,48 @@ func TestMigrate(t *testing.T) {
 	}
 }
 
+func TestRaiseStorageVersion(t *testing.T) {
+	tcs := []struct {
+		name          string
+		version       semver.Version
+		overrideKeys  func(tx backend.UnsafeReadWriter)
+		expectVersion *semver.Version
+	}{
+		{
+			name:          "v3.5 is raised to v3.6",
+			version:       version.V3_5,
+			expectVersion: &version.V3_6,
+		},
+		{
+			name:          "v3.6 is kept",
+			version:       version.V3_6,
+			expectVersion: &version.V3_6,
+		},
+		{
+			name:          "v3.7 is kept",
+			version:       version.V3_7,
+			expectVersion: &version.V3_7,
+		},
+		{
+			name:          "undetected version is kept",
+			version:       version.V3_5,
+			overrideKeys:  func(tx backend.UnsafeReadWriter) {},
+			expectVersion: nil,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			lg := zap.NewNop()
+			dataPath := setupBackendData(t, tc.version, tc.overrideKeys)
+			b := backend.NewDefaultBackend(lg, dataPath)
+			defer b.Close()
+
+			require.NoError(t, RaiseStorageVersion(lg, b.BatchTx(), version.V3_6))
+			assert.Equal(t, tc.expectVersion, UnsafeReadStorageVersion(b.BatchTx()))
+		})
+	}
+}
+
 func TestMigrateIsReversible(t *testing.T) {
 	tcs := []struct {
 		initialVersion semver.Version
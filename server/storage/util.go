@@ -133,22 +133,32 @@ func GetEffectiveNodeIDsFromWALEntries(lg *zap.Logger, snap *raftpb.Snapshot, en
 		}
 	}
 	for _, e := range ents {
-		if e.Type != raftpb.EntryConfChange {
+		var changes []raftpb.ConfChangeSingle
+		switch e.Type {
+		case raftpb.EntryConfChange:
+			var cc raftpb.ConfChange
+			pbutil.MustUnmarshal(&cc, e.Data)
+			changes = cc.AsV2().Changes
+		case raftpb.EntryConfChangeV2:
+			var cc raftpb.ConfChangeV2
+			pbutil.MustUnmarshal(&cc, e.Data)
+			changes = cc.Changes
+		default:
 			continue
 		}
-		var cc raftpb.ConfChange
-		pbutil.MustUnmarshal(&cc, e.Data)
-		switch cc.Type {
-		case raftpb.ConfChangeAddLearnerNode:
-			ids[cc.NodeID] = true
-		case raftpb.ConfChangeAddNode:
-			ids[cc.NodeID] = true
-		case raftpb.ConfChangeRemoveNode:
-			delete(ids, cc.NodeID)
-		case raftpb.ConfChangeUpdateNode:
-			// do nothing
-		default:
-			lg.Panic("unknown ConfChange Type", zap.String("type", cc.Type.String()))
+		for _, cc := range changes {
+			switch cc.Type {
+			case raftpb.ConfChangeAddLearnerNode:
+				ids[cc.NodeID] = true
+			case raftpb.ConfChangeAddNode:
+				ids[cc.NodeID] = true
+			case raftpb.ConfChangeRemoveNode:
+				delete(ids, cc.NodeID)
+			case raftpb.ConfChangeUpdateNode:
+				// do nothing
+			default:
+				lg.Panic("unknown ConfChange Type", zap.String("type", cc.Type.String()))
+			}
 		}
 	}
 	sids := make(types.Uint64Slice, 0, len(ids))
@@ -0,0 +1,404 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+)
+
+const (
+	// ManifestSuffix is the suffix of the manifest archived with a segment.
+	ManifestSuffix = ".json"
+
+	archiveRetryInterval = 5 * time.Second
+	// archivePutTimeout bounds the time to store a segment or its manifest
+	// in the sink, so that a stuck sink is retried.
+	archivePutTimeout = 5 * time.Minute
+)
+
+// ArchiveSink stores the WAL segments copied by an Archiver.
+type ArchiveSink interface {
+	// Put stores the content of r under name, replacing any content
+	// previously stored under it.
+	Put(ctx context.Context, name string, r io.Reader) error
+	// Get returns the content stored under name. It returns an error
+	// wrapping fs.ErrNotExist if there is none.
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// NewArchiveSink returns the sink of the given target, an HTTP sink if it is
+// an http or https URL, else a directory sink. The requests of the HTTP sink
+// time out after archivePutTimeout.
+func NewArchiveSink(target string) (ArchiveSink, error) {
+	if strings.HasPrefix(target, "http://") || strings.HasPrefix(target, "https://") {
+		if _, err := url.Parse(target); err != nil {
+			return nil, err
+		}
+		return NewHTTPSink(target, &http.Client{Timeout: archivePutTimeout}), nil
+	}
+	if target == "" {
+		return nil, errors.New("wal: empty archive target")
+	}
+	return NewDirSink(target), nil
+}
+
+type dirSink struct {
+	dir string
+}
+
+// NewDirSink returns a sink storing the segments as files of dir.
+func NewDirSink(dir string) ArchiveSink {
+	return &dirSink{dir: dir}
+}
+
+func (s *dirSink) Put(_ context.Context, name string, r io.Reader) error {
+	if err := os.MkdirAll(s.dir, fileutil.PrivateDirMode); err != nil {
+		return err
+	}
+	path := filepath.Join(s.dir, name)
+	f, err := os.OpenFile(path+".tmp", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileutil.PrivateFileMode)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(f, r); err == nil {
+		err = fileutil.Fsync(f)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(path + ".tmp")
+		return err
+	}
+	if err = os.Rename(path+".tmp", path); err != nil {
+		return err
+	}
+	dirFile, err := fileutil.OpenDir(s.dir)
+	if err != nil {
+		return err
+	}
+	defer dirFile.Close()
+	return fileutil.Fsync(dirFile)
+}
+
+func (s *dirSink) Get(_ context.Context, name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(s.dir, name))
+}
+
+type httpSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPSink returns a sink storing the segments with PUT requests to the
+// given URL joined with their names, and reading them with GET requests.
+func NewHTTPSink(url string, client *http.Client) ArchiveSink {
+	return &httpSink{url: url, client: client}
+}
+
+func (s *httpSink) Put(ctx context.Context, name string, r io.Reader) error {
+	u, err := url.JoinPath(s.url, name)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u, r)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("wal: unexpected status %q archiving %s", resp.Status, u)
+	}
+	return nil
+}
+
+func (s *httpSink) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	u, err := url.JoinPath(s.url, name)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.Body, nil
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("wal: %s: %w", u, fs.ErrNotExist)
+	}
+	return nil, fmt.Errorf("wal: unexpected status %q reading %s", resp.Status, u)
+}
+
+// SegmentManifest describes an archived segment, to check it chains with
+// the previous one and to find where to start reading the archive from.
+type SegmentManifest struct {
+	Name string `json:"name"`
+	Seq  uint64 `json:"seq"`
+	// PrevCRC is the CRC the segment starts from, the CRC of the previous
+	// segment.
+	PrevCRC uint32 `json:"prevCrc"`
+	// CRC is the CRC of the segment, the one the next segment starts from.
+	CRC uint32 `json:"crc"`
+	// FirstIndex and LastIndex are the indexes of the first and the last
+	// entries of the segment, 0 if it has none.
+	FirstIndex uint64 `json:"firstIndex"`
+	LastIndex  uint64 `json:"lastIndex"`
+	// Snapshots are the snapshot markers of the segment.
+	Snapshots []walpb.Snapshot `json:"snapshots,omitempty"`
+}
+
+// Archiver copies the sealed segments of a WAL to a sink, in order. The WAL
+// keeps the segments not archived yet locked, so that they are not purged.
+type Archiver struct {
+	lg   *zap.Logger
+	sink ArchiveSink
+
+	mu      sync.Mutex
+	pending []string // paths of the sealed segments to archive
+	// nextSeq is the sequence number of the first segment not archived.
+	nextSeq uint64
+
+	notifyc chan struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+	donec   chan struct{}
+}
+
+// NewArchiver starts an archiver copying segments to sink.
+func NewArchiver(lg *zap.Logger, sink ArchiveSink) *Archiver {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	a := &Archiver{
+		lg:      lg,
+		sink:    sink,
+		notifyc: make(chan struct{}, 1),
+		ctx:     ctx,
+		cancel:  cancel,
+		donec:   make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+// Stop stops archiving, leaving the segments not archived yet.
+func (a *Archiver) Stop() {
+	a.cancel()
+	<-a.donec
+}
+
+// Lag returns the number of sealed segments not archived yet.
+func (a *Archiver) Lag() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.pending)
+}
+
+func (a *Archiver) enqueue(path string) {
+	a.mu.Lock()
+	a.pending = append(a.pending, path)
+	walArchiveLag.Set(float64(len(a.pending)))
+	a.mu.Unlock()
+	select {
+	case a.notifyc <- struct{}{}:
+	default:
+	}
+}
+
+// archived returns true if the segment of the given sequence number is
+// archived.
+func (a *Archiver) archived(seq uint64) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return seq < a.nextSeq
+}
+
+func (a *Archiver) run() {
+	defer close(a.donec)
+	for {
+		a.mu.Lock()
+		var path string
+		if len(a.pending) > 0 {
+			path = a.pending[0]
+		}
+		a.mu.Unlock()
+
+		if path == "" {
+			select {
+			case <-a.notifyc:
+				continue
+			case <-a.ctx.Done():
+				return
+			}
+		}
+
+		seq, err := a.archive(path)
+		if err != nil {
+			if a.ctx.Err() != nil {
+				return
+			}
+			walArchiveFailures.Inc()
+			a.lg.Warn("failed to archive WAL segment", zap.String("path", path), zap.Error(err))
+			select {
+			case <-time.After(archiveRetryInterval):
+				continue
+			case <-a.ctx.Done():
+				return
+			}
+		}
+
+		a.mu.Lock()
+		a.pending = a.pending[1:]
+		a.nextSeq = seq + 1
+		walArchiveLag.Set(float64(len(a.pending)))
+		a.mu.Unlock()
+		a.lg.Info("archived WAL segment", zap.String("path", path))
+	}
+}
+
+// archive copies the segment at path, then its manifest, to the sink, unless
+// the sink holds the manifest of the segment already, as when the segment was
+// archived before a restart.
+func (a *Archiver) archive(path string) (uint64, error) {
+	name := filepath.Base(path)
+	seq, _, err := parseWALName(name)
+	if err != nil {
+		return 0, err
+	}
+	m, err := readSegmentManifest(path)
+	if err != nil {
+		return 0, err
+	}
+	m.Seq = seq
+	if a.archivedBefore(m) {
+		a.lg.Info("WAL segment already archived", zap.String("path", path))
+		return seq, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	if err = a.put(name, f); err != nil {
+		return 0, err
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return 0, err
+	}
+	return seq, a.put(name+ManifestSuffix, bytes.NewReader(b))
+}
+
+// archivedBefore returns true if the sink holds the manifest m of a segment.
+// A manifest that cannot be read is taken as missing, the segment is archived
+// again.
+func (a *Archiver) archivedBefore(m *SegmentManifest) bool {
+	ctx, cancel := context.WithTimeout(a.ctx, archivePutTimeout)
+	defer cancel()
+	r, err := a.sink.Get(ctx, m.Name+ManifestSuffix)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			a.lg.Debug("failed to read archived WAL segment manifest", zap.String("name", m.Name), zap.Error(err))
+		}
+		return false
+	}
+	defer r.Close()
+	var archived SegmentManifest
+	if err = json.NewDecoder(r).Decode(&archived); err != nil {
+		return false
+	}
+	return archived.Name == m.Name && archived.Seq == m.Seq && archived.PrevCRC == m.PrevCRC &&
+		archived.CRC == m.CRC && archived.LastIndex == m.LastIndex
+}
+
+// put stores r under name in the sink, within archivePutTimeout.
+func (a *Archiver) put(name string, r io.Reader) error {
+	ctx, cancel := context.WithTimeout(a.ctx, archivePutTimeout)
+	defer cancel()
+	return a.sink.Put(ctx, name, r)
+}
+
+// readSegmentManifest reads the sealed segment at path, checking its CRCs.
+func readSegmentManifest(path string) (*SegmentManifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m := &SegmentManifest{Name: filepath.Base(path)}
+	decoder := NewDecoder(fileutil.NewFileReader(f))
+	rec := &walpb.Record{}
+	for err = decoder.Decode(rec); err == nil; err = decoder.Decode(rec) {
+		switch rec.Type {
+		case CrcType:
+			crc := decoder.LastCRC()
+			if crc != 0 && rec.Validate(crc) != nil {
+				return nil, ErrCRCMismatch
+			}
+			decoder.UpdateCRC(rec.Crc)
+			m.PrevCRC = rec.Crc
+		case EntryType:
+			e := MustUnmarshalEntry(rec.Data)
+			if m.FirstIndex == 0 {
+				m.FirstIndex = e.Index
+			}
+			m.LastIndex = e.Index
+		case SnapshotType:
+			var snap walpb.Snapshot
+			pbutil.MustUnmarshal(&snap, rec.Data)
+			m.Snapshots = append(m.Snapshots, snap)
+		}
+	}
+	if !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	m.CRC = decoder.LastCRC()
+	return m, nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestArchiverDirSink(t *testing.T) {
+	p := t.TempDir()
+	archiveDir := t.TempDir()
+	w, err := Create(zaptest.NewLogger(t), p, []byte("metadata"))
+	require.NoError(t, err)
+	defer w.Close()
+	w.SetArchiver(NewArchiver(zaptest.NewLogger(t), NewDirSink(archiveDir)))
+
+	// make 3 sealed segments, with a snapshot marker in the second one
+	snap := walpb.Snapshot{Index: 2, Term: 1, ConfState: &raftpb.ConfState{Voters: []uint64{1}}}
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, w.Save(raftpb.HardState{Term: 1, Commit: uint64(i)}, []raftpb.Entry{{Index: uint64(i), Term: 1}}))
+		if i == 2 {
+			require.NoError(t, w.SaveSnapshot(snap))
+		}
+		require.NoError(t, w.cut())
+	}
+	require.Eventually(t, func() bool { return w.archiver.Lag() == 0 }, 10*time.Second, 10*time.Millisecond)
+
+	names, err := readWALNames(zaptest.NewLogger(t), p)
+	require.NoError(t, err)
+	require.Len(t, names, 4)
+	var manifests []SegmentManifest
+	for i, name := range names[:3] {
+		want, err := os.ReadFile(filepath.Join(p, name))
+		require.NoError(t, err)
+		got, err := os.ReadFile(filepath.Join(archiveDir, name))
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+
+		b, err := os.ReadFile(filepath.Join(archiveDir, name+ManifestSuffix))
+		require.NoError(t, err)
+		var m SegmentManifest
+		require.NoError(t, json.Unmarshal(b, &m))
+		assert.Equal(t, name, m.Name)
+		assert.Equal(t, uint64(i), m.Seq)
+		assert.Equal(t, uint64(i+1), m.FirstIndex)
+		assert.Equal(t, uint64(i+1), m.LastIndex)
+		manifests = append(manifests, m)
+	}
+	assert.Equal(t, []walpb.Snapshot{snap}, manifests[1].Snapshots)
+	for i := 1; i < len(manifests); i++ {
+		assert.Equal(t, manifests[i-1].CRC, manifests[i].PrevCRC)
+	}
+	_, err = os.Stat(filepath.Join(archiveDir, names[3]))
+	assert.True(t, os.IsNotExist(err), "the tail must not be archived")
+
+	// the archived segments are released
+	require.NoError(t, w.ReleaseLockTo(10))
+	assert.Len(t, w.locks, 1)
+}
+
+// countingSink counts the puts to a sink.
+type countingSink struct {
+	ArchiveSink
+	mu   sync.Mutex
+	puts int
+}
+
+func (s *countingSink) Put(ctx context.Context, name string, r io.Reader) error {
+	s.mu.Lock()
+	s.puts++
+	s.mu.Unlock()
+	return s.ArchiveSink.Put(ctx, name, r)
+}
+
+func TestArchiverSkipsArchivedSegmentsOnRestart(t *testing.T) {
+	p := t.TempDir()
+	archiveDir := t.TempDir()
+	w, err := Create(zaptest.NewLogger(t), p, nil)
+	require.NoError(t, err)
+	w.SetArchiver(NewArchiver(zaptest.NewLogger(t), NewDirSink(archiveDir)))
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, w.Save(raftpb.HardState{}, []raftpb.Entry{{Index: uint64(i)}}))
+		require.NoError(t, w.cut())
+	}
+	require.Eventually(t, func() bool { return w.archiver.Lag() == 0 }, 10*time.Second, 10*time.Millisecond)
+	require.NoError(t, w.Close())
+
+	w, err = Open(zaptest.NewLogger(t), p, walpb.Snapshot{})
+	require.NoError(t, err)
+	defer w.Close()
+	_, _, _, err = w.ReadAll()
+	require.NoError(t, err)
+	sink := &countingSink{ArchiveSink: NewDirSink(archiveDir)}
+	w.SetArchiver(NewArchiver(zaptest.NewLogger(t), sink))
+	require.Eventually(t, func() bool { return w.archiver.Lag() == 0 }, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 0, sink.puts)
+
+	// a new sealed segment is archived
+	require.NoError(t, w.Save(raftpb.HardState{}, []raftpb.Entry{{Index: 4}}))
+	require.NoError(t, w.cut())
+	require.Eventually(t, func() bool { return w.archiver.Lag() == 0 }, 10*time.Second, 10*time.Millisecond)
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	assert.Equal(t, 2, sink.puts)
+}
+
+type failingSink struct{}
+
+func (failingSink) Put(context.Context, string, io.Reader) error { return errors.New("sink down") }
+
+func (failingSink) Get(context.Context, string) (io.ReadCloser, error) {
+	return nil, errors.New("sink down")
+}
+
+func TestArchiverKeepsSegmentsNotArchived(t *testing.T) {
+	p := t.TempDir()
+	w, err := Create(zaptest.NewLogger(t), p, nil)
+	require.NoError(t, err)
+	defer w.Close()
+	w.SetArchiver(NewArchiver(zaptest.NewLogger(t), failingSink{}))
+
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, w.Save(raftpb.HardState{}, []raftpb.Entry{{Index: uint64(i)}}))
+		require.NoError(t, w.cut())
+	}
+	require.NoError(t, w.ReleaseLockTo(10))
+	assert.Len(t, w.locks, 4)
+	assert.Equal(t, 3, w.archiver.Lag())
+	assert.InDelta(t, 3, testutil.ToFloat64(walArchiveHeld), 0)
+}
+
+func TestHTTPSink(t *testing.T) {
+	var mu sync.Mutex
+	puts := make(map[string]string)
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			mu.Lock()
+			b, ok := puts[r.URL.Path]
+			mu.Unlock()
+			if !ok {
+				rw.WriteHeader(http.StatusNotFound)
+				return
+			}
+			io.WriteString(rw, b)
+			return
+		}
+		if r.Method != http.MethodPut || strings.HasSuffix(r.URL.Path, "/fail") {
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		puts[r.URL.Path] = string(b)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	sink, err := NewArchiveSink(srv.URL + "/archive")
+	require.NoError(t, err)
+	require.NoError(t, sink.Put(context.Background(), "0000000000000000-0000000000000000.wal", strings.NewReader("segment")))
+	require.Error(t, sink.Put(context.Background(), "fail", strings.NewReader("segment")))
+
+	r, err := sink.Get(context.Background(), "0000000000000000-0000000000000000.wal")
+	require.NoError(t, err)
+	b, err := io.ReadAll(r)
+	r.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "segment", string(b))
+	_, err = sink.Get(context.Background(), "missing")
+	require.ErrorIs(t, err, fs.ErrNotExist)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, map[string]string{"/archive/0000000000000000-0000000000000000.wal": "segment"}, puts)
+}
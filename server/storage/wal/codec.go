@@ -0,0 +1,130 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wal
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Codecs the data of the entry records can be compressed with. The records
+// of WALs written before compression was added have CodecNone.
+const (
+	CodecNone int64 = iota
+	CodecSnappy
+	CodecZstd
+)
+
+const (
+	// codecTypeShift is the shift of the codec in the type of the records
+	// with compressed data. Their CRC is the one of the uncompressed data,
+	// so etcd versions before compression was added fail to read them with
+	// ErrCRCMismatch. The WAL reports a minimal etcd version of 3.6 while it
+	// has them, which blocks downgrades below it.
+	codecTypeShift = 8
+
+	// minCompressBytes is the size of the data of entry records below which
+	// they are not compressed.
+	minCompressBytes = 256
+
+	// maxDecompressedBytes bounds the size of decompressed data, so that a
+	// corrupted record does not make the decoder allocate without limit.
+	maxDecompressedBytes = math.MaxInt32
+)
+
+var (
+	codecNames = map[string]int64{
+		"none":   CodecNone,
+		"snappy": CodecSnappy,
+		"zstd":   CodecZstd,
+	}
+
+	zstdEncoder = sync.OnceValue(func() *zstd.Encoder {
+		e, err := zstd.NewWriter(nil)
+		if err != nil {
+			panic(err)
+		}
+		return e
+	})
+	zstdDecoder = sync.OnceValue(func() *zstd.Decoder {
+		d, err := zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxDecompressedBytes))
+		if err != nil {
+			panic(err)
+		}
+		return d
+	})
+)
+
+// ParseCodec returns the codec of the given name, "none", "snappy" or "zstd".
+func ParseCodec(name string) (int64, error) {
+	codec, ok := codecNames[name]
+	if !ok {
+		return CodecNone, fmt.Errorf("wal: unknown codec %q", name)
+	}
+	return codec, nil
+}
+
+// splitRecordType returns the type and the codec of a record of type typ.
+func splitRecordType(typ int64) (int64, int64) {
+	if t := typ & (1<<codecTypeShift - 1); t == EntryType {
+		return t, typ >> codecTypeShift
+	}
+	return typ, CodecNone
+}
+
+// compress returns data compressed with codec, or nil if it is not worth it.
+func compress(codec int64, data []byte) []byte {
+	if len(data) < minCompressBytes {
+		return nil
+	}
+	var compressed []byte
+	switch codec {
+	case CodecSnappy:
+		compressed = snappy.Encode(nil, data)
+	case CodecZstd:
+		compressed = zstdEncoder().EncodeAll(data, nil)
+	default:
+		return nil
+	}
+	if len(compressed) >= len(data) {
+		return nil
+	}
+	return compressed
+}
+
+// decompress returns data decompressed with codec.
+func decompress(codec int64, data []byte) ([]byte, error) {
+	switch codec {
+	case CodecNone:
+		return data, nil
+	case CodecSnappy:
+		n, err := snappy.DecodedLen(data)
+		if err != nil {
+			return nil, err
+		}
+		if n > maxDecompressedBytes {
+			return nil, fmt.Errorf("wal: decompressed size %d exceeds %d", n, maxDecompressedBytes)
+		}
+		return snappy.Decode(nil, data)
+	case CodecZstd:
+		return zstdDecoder().DecodeAll(data, nil)
+	default:
+		return nil, fmt.Errorf("wal: unknown codec %d", codec)
+	}
+}
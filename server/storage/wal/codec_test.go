@@ -0,0 +1,138 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wal
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestCompressedWAL(t *testing.T) {
+	for _, name := range []string{"snappy", "zstd"} {
+		t.Run(name, func(t *testing.T) {
+			codec, err := ParseCodec(name)
+			require.NoError(t, err)
+
+			p := t.TempDir()
+			w, err := Create(zaptest.NewLogger(t), p, []byte("metadata"))
+			require.NoError(t, err)
+
+			// the first entries are not compressed, as in a WAL written
+			// before compression is enabled
+			big := bytes.Repeat([]byte("value"), 1000)
+			var ents []raftpb.Entry
+			for i := uint64(1); i <= 20; i++ {
+				if i == 10 {
+					w.SetCodec(codec)
+				}
+				data := big
+				if i%2 == 0 {
+					data = []byte("small")
+				}
+				ents = append(ents, raftpb.Entry{Index: i, Term: 1, Data: data})
+				require.NoError(t, w.Save(raftpb.HardState{Term: 1, Vote: 1, Commit: i}, ents[i-1:]))
+			}
+			require.NoError(t, w.cut())
+			require.NoError(t, w.Close())
+
+			counts := countRecordCodecs(t, filepath.Join(p, walName(0, 0)))
+			assert.Equal(t, 5, counts[codec])
+
+			w, err = Open(zaptest.NewLogger(t), p, walpb.Snapshot{})
+			require.NoError(t, err)
+			_, state, got, err := w.ReadAll()
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+			assert.Equal(t, ents, got)
+			assert.Equal(t, uint64(20), state.Commit)
+
+			_, err = Verify(zaptest.NewLogger(t), p, walpb.Snapshot{})
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCompressedWALVersion(t *testing.T) {
+	put := pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{Put: &etcdserverpb.PutRequest{
+		Key:   []byte("key"),
+		Value: bytes.Repeat([]byte("value"), 1000),
+	}})
+	for _, tc := range []struct {
+		codec int64
+		want  *semver.Version
+	}{
+		{codec: CodecNone, want: &version.V3_0},
+		{codec: CodecZstd, want: &version.V3_6},
+	} {
+		p := t.TempDir()
+		w, err := Create(zaptest.NewLogger(t), p, nil)
+		require.NoError(t, err)
+		w.SetCodec(tc.codec)
+		require.NoError(t, w.Save(raftpb.HardState{Term: 1, Commit: 1}, []raftpb.Entry{{Index: 1, Term: 1, Data: put}}))
+		require.NoError(t, w.Close())
+
+		w, err = Open(zaptest.NewLogger(t), p, walpb.Snapshot{})
+		require.NoError(t, err)
+		wv, err := ReadWALVersion(w)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		assert.Equal(t, tc.want, wv.MinimalEtcdVersion())
+	}
+}
+
+// countRecordCodecs returns the number of entry records of the WAL file at
+// path by codec, reading the frames without decoding the records.
+func countRecordCodecs(t *testing.T, path string) map[int64]int {
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	counts := make(map[int64]int)
+	r := bytes.NewReader(b)
+	for {
+		l, err := readInt64(r)
+		if err != nil || l == 0 {
+			return counts
+		}
+		recBytes, padBytes := decodeFrameSize(l)
+		data := make([]byte, recBytes+padBytes)
+		_, err = io.ReadFull(r, data)
+		require.NoError(t, err)
+		var rec walpb.Record
+		require.NoError(t, rec.Unmarshal(data[:recBytes]))
+		if typ, codec := splitRecordType(rec.Type); typ == EntryType {
+			counts[codec]++
+		}
+	}
+}
+
+func TestDecompressUnknownCodec(t *testing.T) {
+	_, err := decompress(42, []byte("data"))
+	require.Error(t, err)
+	_, err = ParseCodec("lz4")
+	require.Error(t, err)
+}
@@ -39,6 +39,9 @@ type Decoder interface {
 	LastOffset() int64
 	LastCRC() uint32
 	UpdateCRC(prevCrc uint32)
+	// LastCodec returns the codec the data of the last decoded record was
+	// compressed with.
+	LastCodec() int64
 }
 
 type decoder struct {
@@ -48,6 +51,8 @@ type decoder struct {
 	// lastValidOff file offset following the last valid decoded record
 	lastValidOff int64
 	crc          hash.Hash32
+	// lastCodec is the codec of the last decoded record
+	lastCodec int64
 
 	// continueOnCrcError - causes the decoder to continue working even in case of crc mismatch.
 	// This is a desired mode for tools performing inspection of the corrupted WAL logs.
@@ -71,7 +76,7 @@ func NewDecoder(r ...fileutil.FileReader) Decoder {
 	return NewDecoderAdvanced(false, r...)
 }
 
-// Decode reads the next record out of the file.
+// Decode reads the next record out of the file, with its data decompressed.
 // In the success path, fills 'rec' and returns nil.
 // When it fails, it returns err and usually resets 'rec' to the defaults.
 // When continueOnCrcError is set, the method may return ErrUnexpectedEOF or ErrCRCMismatch, but preserve the read
@@ -126,6 +131,18 @@ func (d *decoder) decodeRecord(rec *walpb.Record) error {
 		}
 		return err
 	}
+	var codec int64
+	rec.Type, codec = splitRecordType(rec.Type)
+	d.lastCodec = codec
+	if codec != CodecNone {
+		if rec.Data, err = decompress(codec, rec.Data); err != nil {
+			rec.Reset()
+			if d.isTornEntry(data) {
+				return io.ErrUnexpectedEOF
+			}
+			return fmt.Errorf("%w: in file '%s' at position: %d", err, fileBufReader.FileInfo().Name(), d.lastValidOff)
+		}
+	}
 
 	// skip crc checking if the record type is CrcType
 	if rec.Type != CrcType {
@@ -210,6 +227,8 @@ func (d *decoder) LastCRC() uint32 {
 
 func (d *decoder) LastOffset() int64 { return d.lastValidOff }
 
+func (d *decoder) LastCodec() int64 { return d.lastCodec }
+
 func MustUnmarshalEntry(d []byte) raftpb.Entry {
 	var e raftpb.Entry
 	pbutil.MustUnmarshal(&e, d)
@@ -39,6 +39,8 @@ type encoder struct {
 	crc       hash.Hash32
 	buf       []byte
 	uint64buf []byte
+
+	codec int64 // codec the data of the entry records is compressed with
 }
 
 func newEncoder(w io.Writer, prevCrc uint32, pageOffset int) *encoder {
@@ -51,13 +53,16 @@ func newEncoder(w io.Writer, prevCrc uint32, pageOffset int) *encoder {
 	}
 }
 
-// newFileEncoder creates a new encoder with current file offset for the page writer.
-func newFileEncoder(f *os.File, prevCrc uint32) (*encoder, error) {
+// newFileEncoder creates a new encoder with current file offset for the page writer,
+// compressing the data of the entry records with codec.
+func newFileEncoder(f *os.File, prevCrc uint32, codec int64) (*encoder, error) {
 	offset, err := f.Seek(0, io.SeekCurrent)
 	if err != nil {
 		return nil, err
 	}
-	return newEncoder(f, prevCrc, int(offset)), nil
+	e := newEncoder(f, prevCrc, int(offset))
+	e.codec = codec
+	return e, nil
 }
 
 func (e *encoder) encode(rec *walpb.Record) error {
@@ -66,6 +71,12 @@ func (e *encoder) encode(rec *walpb.Record) error {
 
 	e.crc.Write(rec.Data)
 	rec.Crc = e.crc.Sum32()
+	if rec.Type == EntryType {
+		// the CRC is of the data before compression
+		if compressed := compress(e.codec, rec.Data); compressed != nil {
+			rec = &walpb.Record{Type: rec.Type | e.codec<<codecTypeShift, Crc: rec.Crc, Data: compressed}
+		}
+	}
 	var (
 		data []byte
 		err  error
@@ -43,10 +43,34 @@ var (
 		Name:      "wal_write_bytes_total",
 		Help:      "Total number of bytes written in WAL.",
 	})
+
+	walArchiveLag = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "disk",
+		Name:      "wal_archive_lag_segments",
+		Help:      "The number of sealed WAL segments not archived yet.",
+	})
+
+	walArchiveHeld = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "disk",
+		Name:      "wal_archive_held_segments",
+		Help:      "The number of released WAL segments kept from being purged until they are archived.",
+	})
+
+	walArchiveFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "disk",
+		Name:      "wal_archive_failures_total",
+		Help:      "The total number of failed attempts to archive a WAL segment.",
+	})
 )
 
 func init() {
 	prometheus.MustRegister(walFsyncSec)
 	prometheus.MustRegister(walWriteSec)
 	prometheus.MustRegister(walWriteBytes)
+	prometheus.MustRegister(walArchiveLag)
+	prometheus.MustRegister(walArchiveHeld)
+	prometheus.MustRegister(walArchiveFailures)
 }
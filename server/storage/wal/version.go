@@ -36,16 +36,23 @@ func ReadWALVersion(w *WAL) (*walVersion, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &walVersion{entries: ents}, nil
+	return &walVersion{entries: ents, compressed: w.compressedRead}, nil
 }
 
 type walVersion struct {
 	entries []raftpb.Entry
+	// compressed is true if some of the entries were read from compressed
+	// records, which etcd versions before 3.6 cannot read.
+	compressed bool
 }
 
 // MinimalEtcdVersion returns minimal etcd able to interpret entries from  WAL log,
 func (w *walVersion) MinimalEtcdVersion() *semver.Version {
-	return MinimalEtcdVersion(w.entries)
+	ver := MinimalEtcdVersion(w.entries)
+	if w.compressed {
+		ver = maxVersion(ver, &version.V3_6)
+	}
+	return ver
 }
 
 // MinimalEtcdVersion returns minimal etcd able to interpret entries from  WAL log,
@@ -137,7 +144,8 @@ func visitEntryData(entryType raftpb.EntryType, data []byte, visitor Visitor) er
 			return nil
 		}
 		msg = proto.MessageReflect(&confChange)
-		return visitor(msg.Descriptor().FullName(), &version.V3_4)
+		// etcd applies the joint configuration changes since v3.6
+		return visitor(msg.Descriptor().FullName(), &version.V3_6)
 	default:
 		panic("unhandled")
 	}
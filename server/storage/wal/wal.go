@@ -84,7 +84,8 @@ type WAL struct {
 	decoder   Decoder        // decoder to Decode records
 	readClose func() error   // closer for Decode reader
 
-	unsafeNoSync bool // if set, do not fsync
+	unsafeNoSync bool  // if set, do not fsync
+	codec        int64 // codec the data of the entries is compressed with
 
 	mu      sync.Mutex
 	enti    uint64   // index of the last entry saved to the wal
@@ -92,6 +93,11 @@ type WAL struct {
 
 	locks []*fileutil.LockedFile // the locked files the WAL holds (the name is increasing)
 	fp    *filePipeline
+
+	archiver *Archiver // if set, archives the sealed segments
+
+	// compressedRead is true if ReadAll read entries from compressed records
+	compressedRead bool
 }
 
 // Create creates a WAL ready for appending records. The given metadata is
@@ -158,7 +164,7 @@ func Create(lg *zap.Logger, dirpath string, metadata []byte) (*WAL, error) {
 		dir:      dirpath,
 		metadata: metadata,
 	}
-	w.encoder, err = newFileEncoder(f.File, 0)
+	w.encoder, err = newFileEncoder(f.File, 0, CodecNone)
 	if err != nil {
 		return nil, err
 	}
@@ -270,6 +276,32 @@ func (w *WAL) SetUnsafeNoFsync() {
 	w.unsafeNoSync = true
 }
 
+// SetCodec compresses the data of the entries saved from now on with codec.
+// The WAL stays readable whatever the codec, but not by etcd versions before
+// compression was added once an entry is compressed.
+func (w *WAL) SetCodec(codec int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.codec = codec
+	if w.encoder != nil {
+		w.encoder.codec = codec
+	}
+}
+
+// SetArchiver archives the sealed segments of the WAL with a, starting from
+// the ones it holds. The segments not archived yet are kept locked, so that
+// they are not purged. Close stops a.
+func (w *WAL) SetArchiver(a *Archiver) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.archiver = a
+	for i := 0; i < len(w.locks)-1; i++ {
+		if w.locks[i] != nil {
+			a.enqueue(filepath.Join(w.dir, filepath.Base(w.locks[i].Name())))
+		}
+	}
+}
+
 func (w *WAL) cleanupWAL(lg *zap.Logger) {
 	var err error
 	if err = w.Close(); err != nil {
@@ -491,6 +523,9 @@ func (w *WAL) ReadAll() (metadata []byte, state raftpb.HardState, ents []raftpb.
 				}
 				// The line below is potentially overriding some 'uncommitted' entries.
 				ents = append(ents[:up], e)
+				if decoder.LastCodec() != CodecNone {
+					w.compressedRead = true
+				}
 			}
 			w.enti = e.Index
 
@@ -576,7 +611,7 @@ func (w *WAL) ReadAll() (metadata []byte, state raftpb.HardState, ents []raftpb.
 
 	if w.tail() != nil {
 		// create encoder (chain crc with the decoder), enable appending
-		w.encoder, err = newFileEncoder(w.tail().File, w.decoder.LastCRC())
+		w.encoder, err = newFileEncoder(w.tail().File, w.decoder.LastCRC(), w.codec)
 		if err != nil {
 			return nil, state, nil, err
 		}
@@ -764,7 +799,7 @@ func (w *WAL) cut() error {
 	// update writer and save the previous crc
 	w.locks = append(w.locks, newTail)
 	prevCrc := w.encoder.crc.Sum32()
-	w.encoder, err = newFileEncoder(w.tail().File, prevCrc)
+	w.encoder, err = newFileEncoder(w.tail().File, prevCrc, w.codec)
 	if err != nil {
 		return err
 	}
@@ -811,9 +846,14 @@ func (w *WAL) cut() error {
 	}
 
 	w.locks[len(w.locks)-1] = newTail
+	if w.archiver != nil {
+		// the name of the first segment of a created WAL is in the
+		// temporary directory
+		w.archiver.enqueue(filepath.Join(w.dir, filepath.Base(w.locks[len(w.locks)-2].Name())))
+	}
 
 	prevCrc = w.encoder.crc.Sum32()
-	w.encoder, err = newFileEncoder(w.tail().File, prevCrc)
+	w.encoder, err = newFileEncoder(w.tail().File, prevCrc, w.codec)
 	if err != nil {
 		return err
 	}
@@ -885,6 +925,30 @@ func (w *WAL) ReleaseLockTo(index uint64) error {
 		smaller = len(w.locks) - 1
 	}
 
+	// keep the segments not archived yet from being purged
+	if w.archiver != nil {
+		held := 0
+		for i := 0; i < smaller; i++ {
+			seq, _, err := parseWALName(filepath.Base(w.locks[i].Name()))
+			if err != nil {
+				return err
+			}
+			if !w.archiver.archived(seq) {
+				held = smaller - i
+				smaller = i
+				break
+			}
+		}
+		walArchiveHeld.Set(float64(held))
+		if held > 0 {
+			w.lg.Warn(
+				"holding back WAL segments not archived yet",
+				zap.Int("held-segments", held),
+				zap.Int("archive-lag-segments", w.archiver.Lag()),
+			)
+		}
+	}
+
 	if smaller <= 0 {
 		return nil
 	}
@@ -905,6 +969,11 @@ func (w *WAL) Close() error {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
+	if w.archiver != nil {
+		w.archiver.Stop()
+		w.archiver = nil
+	}
+
 	if w.fp != nil {
 		w.fp.Close()
 		w.fp = nil
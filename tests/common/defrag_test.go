@@ -16,6 +16,7 @@ package common
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -48,3 +49,35 @@ func TestDefragOnline(t *testing.T) {
 		}
 	})
 }
+
+func TestDefragIncremental(t *testing.T) {
+	testRunner.BeforeTest(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	options := config.DefragOption{Timeout: 10 * time.Second, Incremental: true}
+	clus := testRunner.NewCluster(ctx, t)
+	cc := testutils.MustClient(clus.Client())
+	testutils.ExecuteUntil(ctx, t, func() {
+		defer clus.Close()
+		for i := 0; i < 100; i++ {
+			if err := cc.Put(ctx, fmt.Sprintf("key%d", i), "val", config.PutOptions{}); err != nil {
+				t.Fatalf("defrag_test: put kv error (%v)", err)
+			}
+		}
+		_, err := cc.Compact(ctx, 101, config.CompactOption{Physical: true, Timeout: 10 * time.Second})
+		if err != nil {
+			t.Fatalf("defrag_test: compact with revision error (%v)", err)
+		}
+
+		if err = cc.Defragment(ctx, options); err != nil {
+			t.Fatalf("defrag_test: defrag error (%v)", err)
+		}
+		resp, err := cc.Get(ctx, "key", config.GetOptions{Prefix: true, CountOnly: true})
+		if err != nil {
+			t.Fatalf("defrag_test: get error (%v)", err)
+		}
+		if resp.Count != 100 {
+			t.Fatalf("defrag_test: count = %d, want 100", resp.Count)
+		}
+	})
+}
@@ -61,7 +61,8 @@ type CompactOption struct {
 }
 
 type DefragOption struct {
-	Timeout time.Duration
+	Timeout     time.Duration
+	Incremental bool
 }
 
 type LeaseOption struct {
@@ -448,6 +448,9 @@ func (ctl *EtcdctlV3) Defragment(ctx context.Context, o config.DefragOption) err
 	if o.Timeout != 0 {
 		args = append(args, fmt.Sprintf("--command-timeout=%s", o.Timeout))
 	}
+	if o.Incremental {
+		args = append(args, "--incremental")
+	}
 	lines := make([]expect.ExpectedResponse, len(ctl.endpoints))
 	for i := range lines {
 		lines[i] = expect.ExpectedResponse{Value: "Finished defragmenting etcd member"}
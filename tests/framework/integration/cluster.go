@@ -172,9 +172,19 @@ type ClusterConfig struct {
 	LeaseCheckpointPersist  bool
 
 	WatchProgressNotifyInterval time.Duration
+	WatchResumeRetention        time.Duration
+	WatchLagCancelRevisions     int64
+	WatchLagCancelBytes         int64
+	CompactionPrefixRetentions  []config.PrefixRetention
+	AutoDefragRatio             float64
+	AutoDefragCheckTime         time.Duration
 	ExperimentalMaxLearners     int
 	DisableStrictReconfigCheck  bool
 	CorruptCheckTime            time.Duration
+	LeaderLeaseReads            bool
+
+	LearnerAutoPromoteMaxLag       uint64
+	LearnerAutoPromoteStableWindow time.Duration
 }
 
 type Cluster struct {
@@ -289,9 +299,19 @@ func (c *Cluster) mustNewMember(t testutil.TB) *Member {
 			LeaseCheckpointInterval:     c.Cfg.LeaseCheckpointInterval,
 			LeaseCheckpointPersist:      c.Cfg.LeaseCheckpointPersist,
 			WatchProgressNotifyInterval: c.Cfg.WatchProgressNotifyInterval,
+			WatchResumeRetention:        c.Cfg.WatchResumeRetention,
+			WatchLagCancelRevisions:     c.Cfg.WatchLagCancelRevisions,
+			WatchLagCancelBytes:         c.Cfg.WatchLagCancelBytes,
+			CompactionPrefixRetentions:  c.Cfg.CompactionPrefixRetentions,
+			AutoDefragRatio:             c.Cfg.AutoDefragRatio,
+			AutoDefragCheckTime:         c.Cfg.AutoDefragCheckTime,
 			ExperimentalMaxLearners:     c.Cfg.ExperimentalMaxLearners,
 			DisableStrictReconfigCheck:  c.Cfg.DisableStrictReconfigCheck,
 			CorruptCheckTime:            c.Cfg.CorruptCheckTime,
+			LeaderLeaseReads:            c.Cfg.LeaderLeaseReads,
+
+			LearnerAutoPromoteMaxLag:       c.Cfg.LearnerAutoPromoteMaxLag,
+			LearnerAutoPromoteStableWindow: c.Cfg.LearnerAutoPromoteStableWindow,
 		})
 	m.DiscoveryURL = c.Cfg.DiscoveryURL
 	return m
@@ -614,9 +634,19 @@ type MemberConfig struct {
 	LeaseCheckpointInterval     time.Duration
 	LeaseCheckpointPersist      bool
 	WatchProgressNotifyInterval time.Duration
+	WatchResumeRetention        time.Duration
+	WatchLagCancelRevisions     int64
+	WatchLagCancelBytes         int64
+	CompactionPrefixRetentions  []config.PrefixRetention
+	AutoDefragRatio             float64
+	AutoDefragCheckTime         time.Duration
 	ExperimentalMaxLearners     int
 	DisableStrictReconfigCheck  bool
 	CorruptCheckTime            time.Duration
+	LeaderLeaseReads            bool
+
+	LearnerAutoPromoteMaxLag       uint64
+	LearnerAutoPromoteStableWindow time.Duration
 }
 
 // MustNewMember return an inited member with the given name. If peerTLS is
@@ -720,6 +750,13 @@ func MustNewMember(t testutil.TB, mcfg MemberConfig) *Member {
 	m.LeaseCheckpointPersist = mcfg.LeaseCheckpointPersist
 
 	m.WatchProgressNotifyInterval = mcfg.WatchProgressNotifyInterval
+	m.WatchResumeRetention = mcfg.WatchResumeRetention
+	m.WatchLagCancelRevisions = mcfg.WatchLagCancelRevisions
+	m.WatchLagCancelBytes = mcfg.WatchLagCancelBytes
+	m.WatchMaxQueuedBytes = embed.DefaultWatchMaxQueuedBytes
+	m.CompactionPrefixRetentions = mcfg.CompactionPrefixRetentions
+	m.AutoDefragRatio = mcfg.AutoDefragRatio
+	m.AutoDefragCheckTime = mcfg.AutoDefragCheckTime
 
 	m.InitialCorruptCheck = true
 	if mcfg.CorruptCheckTime > time.Duration(0) {
@@ -738,6 +775,16 @@ func MustNewMember(t testutil.TB, mcfg MemberConfig) *Member {
 	m.ServerFeatureGate = features.NewDefaultServerFeatureGate(m.Name, m.Logger)
 
 	m.StrictReconfigCheck = !mcfg.DisableStrictReconfigCheck
+	m.LeaderLeaseReads = mcfg.LeaderLeaseReads
+	m.LeaderLeaseMaxClockDrift = framecfg.TickDuration
+	m.LearnerAutoPromoteMaxLag = embed.DefaultLearnerAutoPromoteMaxLag
+	if mcfg.LearnerAutoPromoteMaxLag != 0 {
+		m.LearnerAutoPromoteMaxLag = mcfg.LearnerAutoPromoteMaxLag
+	}
+	m.LearnerAutoPromoteStableWindow = embed.DefaultLearnerAutoPromoteStableWindow
+	if mcfg.LearnerAutoPromoteStableWindow != 0 {
+		m.LearnerAutoPromoteStableWindow = mcfg.LearnerAutoPromoteStableWindow
+	}
 	if err := m.listenGRPC(); err != nil {
 		t.Fatalf("listenGRPC FAILED: %v", err)
 	}
@@ -1584,14 +1631,32 @@ func (c *Cluster) GetLearnerMembers() ([]*pb.Member, error) {
 func (c *Cluster) AddAndLaunchLearnerMember(t testutil.TB) {
 	m := c.mustNewMember(t)
 	m.IsLearner = true
+	c.addAndLaunchMember(t, m, c.Client(0).MemberAddAsLearner)
+}
+
+// AddAndLaunchAutoPromoteLearnerMember creates a learner member with
+// auto-promote, adds it to Cluster via v3 MemberAdd API, launches the new
+// member, and then waits until the leader promoted it.
+func (c *Cluster) AddAndLaunchAutoPromoteLearnerMember(t testutil.TB) {
+	m := c.mustNewMember(t)
+	c.addAndLaunchMember(t, m, c.Client(0).MemberAddAsAutoPromoteLearner)
+}
 
+// AddAndLaunchWitnessMember creates a witness member, adds it to Cluster
+// via v3 MemberAdd API, and then launches the new member.
+func (c *Cluster) AddAndLaunchWitnessMember(t testutil.TB) {
+	m := c.mustNewMember(t)
+	m.Witness = true
+	c.addAndLaunchMember(t, m, c.Client(0).MemberAddAsWitness)
+}
+
+func (c *Cluster) addAndLaunchMember(t testutil.TB, m *Member, memberAdd func(context.Context, []string) (*clientv3.MemberAddResponse, error)) {
 	scheme := SchemeFromTLSInfo(c.Cfg.PeerTLS)
 	peerURLs := []string{scheme + "://" + m.PeerListeners[0].Addr().String()}
 
-	cli := c.Client(0)
-	_, err := cli.MemberAddAsLearner(context.Background(), peerURLs)
+	_, err := memberAdd(context.Background(), peerURLs)
 	if err != nil {
-		t.Fatalf("failed to add learner member %v", err)
+		t.Fatalf("failed to add member %v", err)
 	}
 
 	m.InitialPeerURLsMap = types.URLsMap{}
@@ -1619,6 +1684,7 @@ func (c *Cluster) getMembers() []*pb.Member {
 			PeerURLs:   m.PeerURLs.StringSlice(),
 			ClientURLs: m.ClientURLs.StringSlice(),
 			IsLearner:  m.IsLearner,
+			IsWitness:  m.Witness,
 		}
 		mems = append(mems, mem)
 	}
@@ -1685,6 +1751,7 @@ func (p SortableProtoMemberSliceByPeerURLs) Swap(i, j int) { p[i], p[j] = p[j],
 func (c *Cluster) MustNewMember(t testutil.TB, resp *clientv3.MemberAddResponse) *Member {
 	m := c.mustNewMember(t)
 	m.IsLearner = resp.Member.IsLearner
+	m.Witness = resp.Member.IsWitness
 	m.NewCluster = false
 
 	m.InitialPeerURLsMap = types.URLsMap{}
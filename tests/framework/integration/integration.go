@@ -254,7 +254,12 @@ func (c integrationClient) Defragment(ctx context.Context, o config.DefragOption
 		defer cancel()
 	}
 	for _, ep := range c.Endpoints() {
-		_, err := c.Client.Defragment(ctx, ep)
+		var err error
+		if o.Incremental {
+			_, err = c.Client.DefragmentIncremental(ctx, ep)
+		} else {
+			_, err = c.Client.Defragment(ctx, ep)
+		}
 		if err != nil {
 			return err
 		}
@@ -16,14 +16,21 @@ package clientv3test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
+	"os"
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/client/pkg/v3/types"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	errorspkg "go.etcd.io/etcd/server/v3/etcdserver/errors"
 	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
 )
 
@@ -216,6 +223,169 @@ func TestMemberAddForLearner(t *testing.T) {
 	}
 }
 
+// TestMemberAddForWitness ensures that a witness acknowledges the raft log
+// without storing the key-value data, and catches up from raft snapshots alone.
+func TestMemberAddForWitness(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 2, SnapshotCount: 10, SnapshotCatchUpEntries: 5, DisableStrictReconfigCheck: true})
+	defer clus.Terminate(t)
+
+	capi := clus.Client(0)
+	urls := []string{"http://127.0.0.1:1234"}
+	_, err := capi.MemberReconfigure(context.Background(), []*pb.MemberAddRequest{{PeerURLs: urls, IsLearner: true, IsWitness: true}}, nil, nil)
+	if !errors.Is(err, rpctypes.ErrWitnessLearner) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrWitnessLearner, err)
+	}
+
+	clus.AddAndLaunchWitnessMember(t)
+	witness := clus.Members[2]
+	if _, err = os.Stat(witness.BackendPath()); !os.IsNotExist(err) {
+		t.Fatalf("expected no backend file on the witness, got %v", err)
+	}
+	if _, err = clus.Client(2).Get(context.Background(), "foo"); err == nil || err.Error() != rpctypes.ErrorDesc(rpctypes.ErrGRPCNotSupportedForWitness) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCNotSupportedForWitness, err)
+	}
+	if _, err = clus.Client(2).Status(context.Background(), witness.GRPCURL); err != nil {
+		t.Fatalf("failed to get the status of the witness %v", err)
+	}
+
+	leaderIdx := clus.WaitLeader(t)
+	leader := clus.Members[leaderIdx].Server
+	if err = leader.MoveLeader(context.Background(), uint64(leader.MemberID()), uint64(witness.Server.MemberID())); !errors.Is(err, errorspkg.ErrBadLeaderTransferee) {
+		t.Fatalf("expected leadership transfer to the witness to fail, got %v", err)
+	}
+
+	// the witness catches up from a raft snapshot once the leader compacted its log
+	witness.Stop(t)
+	for i := 0; i < 30; i++ {
+		if _, err = capi.Put(context.Background(), fmt.Sprintf("foo%d", i), "bar"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = witness.Restart(t); err != nil {
+		t.Fatal(err)
+	}
+	clus.WaitMembersForLeader(t, clus.Members)
+	// and restarts from it
+	witness.Stop(t)
+	if err = witness.Restart(t); err != nil {
+		t.Fatal(err)
+	}
+	leaderIdx = clus.WaitMembersForLeader(t, clus.Members)
+	if leaderIdx == 2 {
+		t.Fatal("witness became leader")
+	}
+
+	// the witness acknowledges the raft log in place of the stopped member
+	clus.Members[1-leaderIdx].Stop(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	_, err = clus.Client(leaderIdx).Put(ctx, "foo", "bar")
+	cancel()
+	if err != nil {
+		t.Fatalf("failed to put with the witness %v", err)
+	}
+	if _, err = os.Stat(witness.BackendPath()); !os.IsNotExist(err) {
+		t.Fatalf("expected no backend file on the witness, got %v", err)
+	}
+}
+
+// TestWitnessTransfersLeadership ensures that a full member with a shorter log
+// than the one of the witness is elected through the witness, which wins the
+// election and transfers the leadership to it.
+func TestWitnessTransfersLeadership(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 2, DisableStrictReconfigCheck: true})
+	defer clus.Terminate(t)
+
+	clus.AddAndLaunchWitnessMember(t)
+	leaderIdx := clus.WaitMembersForLeader(t, clus.Members)
+	if leaderIdx == 2 {
+		t.Fatal("witness became leader")
+	}
+	leader, follower := clus.Members[leaderIdx], clus.Members[1-leaderIdx]
+
+	// the witness gets a longer log than the follower
+	follower.Stop(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for i := 0; i < 10; i++ {
+		if _, err := clus.Client(leaderIdx).Put(ctx, fmt.Sprintf("foo%d", i), "bar"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	leader.Stop(t)
+	if err := follower.Restart(t); err != nil {
+		t.Fatal(err)
+	}
+
+	for follower.Server.Leader() != follower.Server.MemberID() {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("follower was not elected, leader is %s", follower.Server.Leader())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	resp, err := clus.Client(1-leaderIdx).Get(ctx, "foo", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 10 {
+		t.Fatalf("expected the 10 keys written while the follower was stopped, got %d", len(resp.Kvs))
+	}
+}
+
+// TestMemberAddAsAutoPromoteLearner ensures that the leader promotes a learner
+// added with auto-promote once it caught up, and only then.
+func TestMemberAddAsAutoPromoteLearner(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3, DisableStrictReconfigCheck: true, LearnerAutoPromoteStableWindow: time.Second})
+	defer clus.Terminate(t)
+
+	capi := clus.RandClient()
+	urls := []string{"http://127.0.0.1:1234"}
+	_, err := capi.MemberReconfigure(context.Background(), []*pb.MemberAddRequest{{PeerURLs: urls, AutoPromote: true}}, nil, nil)
+	if !errors.Is(err, rpctypes.ErrAutoPromoteNotLearner) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrAutoPromoteNotLearner, err)
+	}
+
+	// a learner which is not started is never promoted
+	resp, err := capi.MemberAddAsAutoPromoteLearner(context.Background(), urls)
+	if err != nil {
+		t.Fatalf("failed to add auto-promote learner %v", err)
+	}
+	if !resp.Member.IsLearner || !resp.Member.AutoPromote {
+		t.Fatalf("expected an auto-promote learner, got %v", resp.Member)
+	}
+	time.Sleep(2 * time.Second)
+	lresp, err := capi.MemberList(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list member %v", err)
+	}
+	for _, m := range lresp.Members {
+		if m.ID == resp.Member.ID && (!m.IsLearner || !m.AutoPromote) {
+			t.Fatalf("expected the member not started to stay an auto-promote learner, got %v", m)
+		}
+	}
+	if _, err = capi.MemberRemove(context.Background(), resp.Member.ID); err != nil {
+		t.Fatalf("failed to remove member %v", err)
+	}
+
+	// waits until the learner is promoted
+	clus.AddAndLaunchAutoPromoteLearnerMember(t)
+	lresp, err = capi.MemberList(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list member %v", err)
+	}
+	for _, m := range lresp.Members {
+		if m.IsLearner || m.AutoPromote {
+			t.Fatalf("expected all members to be voting members, got %v", m)
+		}
+	}
+}
+
 func TestMemberPromote(t *testing.T) {
 	integration2.BeforeTest(t)
 
@@ -292,6 +462,65 @@ func TestMemberPromote(t *testing.T) {
 	}
 }
 
+// TestMemberReconfigure ensures that a member is replaced at once through joint consensus.
+func TestMemberReconfigure(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3, DisableStrictReconfigCheck: true})
+	defer clus.Terminate(t)
+
+	capi := clus.Client(0)
+	rmvID := uint64(clus.Members[2].Server.MemberID())
+	urls := []string{"http://127.0.0.1:1234"}
+	resp, err := capi.MemberReconfigure(context.Background(), []*pb.MemberAddRequest{{PeerURLs: urls}}, []uint64{rmvID}, nil)
+	if err != nil {
+		t.Fatalf("failed to reconfigure members %v", err)
+	}
+	if len(resp.Added) != 1 || !reflect.DeepEqual(resp.Added[0].PeerURLs, urls) || resp.Added[0].IsLearner {
+		t.Fatalf("added = %v, want a voting member with peer URLs %v", resp.Added, urls)
+	}
+	if len(resp.Members) != 3 {
+		t.Fatalf("number of members = %d, want %d", len(resp.Members), 3)
+	}
+	for _, m := range resp.Members {
+		if m.ID == rmvID {
+			t.Fatalf("removed member %x is still a member", rmvID)
+		}
+	}
+
+	// the removed member stops once the joint configuration is left
+	select {
+	case <-clus.Members[2].Server.StopNotify():
+	case <-time.After(10 * time.Second):
+		t.Fatalf("removed member %x did not stop", rmvID)
+	}
+	clus.Members[2].Client.Close()
+	clus.Members[2].Terminate(t)
+	clus.Members = clus.Members[:2]
+
+	listResp, err := capi.MemberList(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list member %v", err)
+	}
+	var ids []uint64
+	for _, m := range listResp.Members {
+		ids = append(ids, m.ID)
+	}
+	if len(ids) != 3 || !slices.Contains(ids, resp.Added[0].ID) || slices.Contains(ids, rmvID) {
+		t.Fatalf("member IDs = %x, want %x added and %x removed", ids, resp.Added[0].ID, rmvID)
+	}
+
+	// changing a member twice is rejected
+	_, err = capi.MemberReconfigure(context.Background(), nil, []uint64{resp.Added[0].ID, resp.Added[0].ID}, nil)
+	if !errors.Is(err, rpctypes.ErrMemberIDDuplicated) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrMemberIDDuplicated, err)
+	}
+	_, err = capi.MemberReconfigure(context.Background(), nil, nil, nil)
+	if !errors.Is(err, rpctypes.ErrNoMemberChange) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrNoMemberChange, err)
+	}
+}
+
 // TestMemberPromoteMemberNotLearner ensures that promoting a voting member fails.
 func TestMemberPromoteMemberNotLearner(t *testing.T) {
 	integration2.BeforeTest(t, integration2.WithFailpoint("raftBeforeAdvance", `sleep(100)`))
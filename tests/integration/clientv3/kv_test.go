@@ -289,6 +289,210 @@ func TestKVRange(t *testing.T) {
 	}
 }
 
+// TestKVRangeContinueToken ensures paginating with continue tokens returns
+// every key exactly once at the revision of the first page.
+func TestKVRangeContinueToken(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	var want []string
+	for i := 0; i < 10; i++ {
+		key := fmt.Sprintf("foo/%02d", i)
+		if _, err := kv.Put(ctx, key, "v1"); err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, key)
+	}
+
+	resp, err := kv.Get(ctx, "foo/", clientv3.WithPrefix(), clientv3.WithLimit(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rev := resp.Header.Revision
+	// changes made after the first page must not leak into later pages
+	if _, err = kv.Put(ctx, "foo/05", "v2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kv.Delete(ctx, "foo/09"); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for {
+		for _, kv := range resp.Kvs {
+			got = append(got, string(kv.Key))
+			if string(kv.Value) != "v1" {
+				t.Fatalf("expected value of %q at revision %d, got %q", kv.Key, rev, kv.Value)
+			}
+		}
+		if !resp.More {
+			break
+		}
+		if len(resp.ContinueToken) == 0 {
+			t.Fatal("expected continue token when more is set")
+		}
+		resp, err = kv.Get(ctx, "foo/", clientv3.WithPrefix(), clientv3.WithLimit(3), clientv3.WithContinueToken(resp.ContinueToken))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Fatalf("expected keys %v, got %v", want, got)
+	}
+
+	_, err = kv.Get(ctx, "foo/", clientv3.WithPrefix(), clientv3.WithContinueToken([]byte("bad")))
+	if !errors.Is(err, rpctypes.ErrInvalidContinueToken) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrInvalidContinueToken, err)
+	}
+}
+
+func TestKVMultiGet(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	for _, key := range []string{"a/1", "a/2", "b/1", "c"} {
+		if _, err := kv.Put(ctx, key, "v1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	presp, err := kv.Put(ctx, "a/1", "v2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rev := presp.Header.Revision
+
+	tests := []struct {
+		ops      []clientv3.Op
+		wantKeys [][]string
+		wantVal  string
+	}{
+		{
+			ops:      []clientv3.Op{clientv3.OpGet("a/", clientv3.WithPrefix()), clientv3.OpGet("c"), clientv3.OpGet("d")},
+			wantKeys: [][]string{{"a/1", "a/2"}, {"c"}, nil},
+			wantVal:  "v2",
+		},
+		{
+			ops:      []clientv3.Op{clientv3.OpGet("a/1", clientv3.WithRev(rev-1)), clientv3.OpGet("b/", clientv3.WithPrefix(), clientv3.WithRev(rev-1))},
+			wantKeys: [][]string{{"a/1"}, {"b/1"}},
+			wantVal:  "v1",
+		},
+		{
+			ops:      []clientv3.Op{clientv3.OpGet("a/1", clientv3.WithSerializable()), clientv3.OpGet("c", clientv3.WithSerializable())},
+			wantKeys: [][]string{{"a/1"}, {"c"}},
+			wantVal:  "v2",
+		},
+	}
+	for i, tt := range tests {
+		resp, err := kv.MultiGet(ctx, tt.ops...)
+		if err != nil {
+			t.Fatalf("#%d: couldn't multi-get (%v)", i, err)
+		}
+		gets := resp.Gets()
+		if len(gets) != len(tt.wantKeys) {
+			t.Fatalf("#%d: expected %d responses, got %d", i, len(tt.wantKeys), len(gets))
+		}
+		for j, get := range gets {
+			var keys []string
+			for _, kv := range get.Kvs {
+				keys = append(keys, string(kv.Key))
+			}
+			if !reflect.DeepEqual(tt.wantKeys[j], keys) {
+				t.Errorf("#%d.%d: expected keys %v, got %v", i, j, tt.wantKeys[j], keys)
+			}
+		}
+		if v := string(gets[0].Kvs[0].Value); v != tt.wantVal {
+			t.Errorf("#%d: expected value %q, got %q", i, tt.wantVal, v)
+		}
+	}
+
+	_, err = kv.MultiGet(ctx, clientv3.OpGet("a/1", clientv3.WithRev(rev)), clientv3.OpGet("c", clientv3.WithRev(rev-1)))
+	if !errors.Is(err, rpctypes.ErrInvalidMultiRange) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrInvalidMultiRange, err)
+	}
+}
+
+func TestKVGetMaxStaleness(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	leadIdx := clus.WaitLeader(t)
+	followerIdx := (leadIdx + 1) % 3
+	ctx := context.TODO()
+	staleReads := func(mode string) int {
+		v, err := clus.Members[followerIdx].Metric("etcd_server_bounded_staleness_reads_total", fmt.Sprintf("mode=%q", mode))
+		if err != nil {
+			t.Fatal(err)
+		}
+		n, _ := strconv.Atoi(v)
+		return n
+	}
+
+	var rev int64
+	for i := 0; i < 10; i++ {
+		presp, err := clus.Client(leadIdx).Put(ctx, "foo", strconv.Itoa(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		rev = presp.Header.Revision
+	}
+	// let the follower learn the progress of the leader from its heartbeats
+	time.Sleep(100 * time.Millisecond)
+
+	local, forward := staleReads("local")+staleReads("wait"), staleReads("forward")
+	resp, err := clus.Client(followerIdx).Get(ctx, "foo", clientv3.WithMaxStaleness(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Header.Revision != rev {
+		t.Errorf("expected revision %d, got %d", rev, resp.Header.Revision)
+	}
+	if n := staleReads("local") + staleReads("wait") - local; n != 1 {
+		t.Errorf("expected 1 read served locally, got %d", n)
+	}
+
+	// the revision of the leader is only trusted within a heartbeat interval
+	// of its heartbeat, so the read may be forwarded until it lands in one.
+	local = staleReads("local")
+	for i := 0; i < 100 && staleReads("local") == local; i++ {
+		resp, err = clus.Client(followerIdx).Get(ctx, "foo", clientv3.WithMaxStalenessRevisions(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Header.Revision < rev-1 {
+			t.Errorf("expected revision >= %d, got %d", rev-1, resp.Header.Revision)
+		}
+	}
+	if staleReads("local") == local {
+		t.Errorf("expected a read within revisions served locally")
+	}
+
+	// the leader serves the reads as linearizable ones
+	forward = staleReads("forward")
+	if _, err = clus.Client(leadIdx).Get(ctx, "foo", clientv3.WithMaxStaleness(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if n := staleReads("forward") - forward; n != 1 {
+		t.Errorf("expected 1 forwarded read, got %d", n)
+	}
+
+	_, err = clus.Client(followerIdx).Get(ctx, "foo", clientv3.WithMaxStalenessRevisions(-1))
+	if !errors.Is(err, rpctypes.ErrInvalidMaxStaleness) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrInvalidMaxStaleness, err)
+	}
+}
+
 func TestKVGetErrConnClosed(t *testing.T) {
 	integration2.BeforeTest(t)
 
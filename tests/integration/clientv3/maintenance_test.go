@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -446,3 +447,54 @@ func TestMaintenanceStatus(t *testing.T) {
 		t.Fatal("no leader found")
 	}
 }
+
+// TestMaintenanceRevisionAt ensures the revision current at a time can be
+// looked up on every member alike, and that compacted revisions are not
+// returned.
+func TestMaintenanceRevisionAt(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx := context.Background()
+
+	if _, err := cli.RevisionAt(ctx, clus.Members[0].GRPCURL, time.Unix(1, 0)); !errors.Is(err, rpctypes.ErrRevisionTimeUnknown) {
+		t.Fatalf("expected %v before any recorded revision, got %v", rpctypes.ErrRevisionTimeUnknown, err)
+	}
+
+	presp, err := cli.Put(ctx, "foo", "1")
+	require.NoError(t, err)
+	// the leader records the revision within a second
+	var at time.Time
+	require.Eventually(t, func() bool {
+		at = time.Now()
+		resp, err := cli.RevisionAt(ctx, clus.Members[0].GRPCURL, at)
+		return err == nil && resp.Revision == presp.Header.Revision
+	}, 5*time.Second, 100*time.Millisecond)
+	presp2, err := cli.Put(ctx, "foo", "2")
+	require.NoError(t, err)
+
+	for _, m := range clus.Members {
+		resp, err := cli.RevisionAt(ctx, m.GRPCURL, at)
+		require.NoError(t, err)
+		assert.Equal(t, presp.Header.Revision, resp.Revision)
+		assert.False(t, time.Unix(0, resp.Timestamp).After(at))
+	}
+
+	gresp, err := cli.Get(ctx, "foo", clientv3.WithRev(presp.Header.Revision))
+	require.NoError(t, err)
+	require.Len(t, gresp.Kvs, 1)
+	assert.Equal(t, "1", string(gresp.Kvs[0].Value))
+
+	_, err = cli.Compact(ctx, presp2.Header.Revision, clientv3.WithCompactPhysical())
+	require.NoError(t, err)
+	for _, m := range clus.Members {
+		// followers may apply the compaction later
+		require.Eventually(t, func() bool {
+			_, err = cli.RevisionAt(ctx, m.GRPCURL, at)
+			return errors.Is(err, rpctypes.ErrCompacted)
+		}, 5*time.Second, 100*time.Millisecond, "expected %v for a compacted revision", rpctypes.ErrCompacted)
+	}
+}
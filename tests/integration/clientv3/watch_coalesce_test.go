@@ -0,0 +1,87 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !cluster_proxy
+
+package clientv3test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestWatchWithCoalesce ensures a coalescing watcher only receives the
+// latest event of each key, counting the events it skipped.
+func TestWatchWithCoalesce(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx := context.Background()
+
+	put := func(key, val string) {
+		if _, err := cli.Put(ctx, key, val); err != nil {
+			t.Fatal(err)
+		}
+	}
+	put("a", "0")
+	put("b", "0")
+	put("a", "1")
+	put("a", "2")
+
+	// the history is sent in a single response to a catching up watcher
+	wch := cli.Watch(ctx, "a", clientv3.WithRange("c"), clientv3.WithRev(1), clientv3.WithCoalesce(0))
+	select {
+	case wresp := <-wch:
+		want := []string{"b=0 (0)", "a=2 (2)"}
+		if got := skippedEvents(wresp.Events); !reflect.DeepEqual(got, want) {
+			t.Errorf("expected events %v, got %v", want, got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for events")
+	}
+
+	wch = cli.Watch(ctx, "a", clientv3.WithRange("c"), clientv3.WithCoalesce(time.Second))
+	put("a", "3")
+	put("b", "1")
+	put("a", "4")
+	if _, err := cli.Delete(ctx, "b"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case wresp := <-wch:
+		want := []string{"a=4 (1)", "b= (1)"}
+		if got := skippedEvents(wresp.Events); !reflect.DeepEqual(got, want) {
+			t.Errorf("expected events %v, got %v", want, got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for events")
+	}
+}
+
+func skippedEvents(evs []*clientv3.Event) []string {
+	ss := make([]string, len(evs))
+	for i, ev := range evs {
+		ss[i] = fmt.Sprintf("%s=%s (%d)", ev.Kv.Key, ev.Kv.Value, ev.SkippedRevisions)
+	}
+	return ss
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !cluster_proxy
+
+package clientv3test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestWatchGrantCredits ensures the events of a watch stream are held back
+// until the client grants credits for them.
+func TestWatchGrantCredits(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := cli.GrantCredits(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	wch := cli.Watch(ctx, "foo")
+	// the watcher is created after the credits are granted
+	if err := cli.RequestProgress(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if wresp := <-wch; !wresp.IsProgressNotify() {
+		t.Fatalf("expected progress notification, got %+v", wresp)
+	}
+
+	for _, v := range []string{"1", "2", "3"} {
+		if _, err := cli.Put(ctx, "foo", v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	select {
+	case wresp := <-wch:
+		if len(wresp.Events) != 1 || string(wresp.Events[0].Kv.Value) != "1" {
+			t.Fatalf("expected the first put, got %+v", wresp)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the first put")
+	}
+	select {
+	case wresp := <-wch:
+		t.Fatalf("unexpected response without credits %+v", wresp)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	if err := cli.GrantCredits(ctx, 10); err != nil {
+		t.Fatal(err)
+	}
+	var vals []string
+	for len(vals) < 2 {
+		select {
+		case wresp := <-wch:
+			for _, ev := range wresp.Events {
+				vals = append(vals, string(ev.Kv.Value))
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the held back puts, got %v", vals)
+		}
+	}
+	if vals[0] != "2" || vals[1] != "3" {
+		t.Fatalf("expected the held back puts [2 3], got %v", vals)
+	}
+}
+
+// TestWatchGrantCreditsInitialState ensures the initial state of a watcher
+// is held back like events until the client grants credits for it.
+func TestWatchGrantCreditsInitialState(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, k := range []string{"/a", "/b"} {
+		if _, err := cli.Put(ctx, k, "1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := cli.GrantCredits(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	wch := cli.Watch(ctx, "/", clientv3.WithPrefix(), clientv3.WithInitialState())
+	select {
+	case wresp := <-wch:
+		if !wresp.InitialState || len(wresp.Events) != 2 {
+			t.Fatalf("expected the initial state, got %+v", wresp)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the initial state")
+	}
+	if _, err := cli.Put(ctx, "/c", "1"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case wresp := <-wch:
+		t.Fatalf("unexpected response without credits %+v", wresp)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	if err := cli.GrantCredits(ctx, 10); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"done", "/c"} {
+		select {
+		case wresp := <-wch:
+			switch {
+			case want == "done" && !wresp.InitialStateDone:
+				t.Fatalf("expected the end of the initial state, got %+v", wresp)
+			case want == "/c" && (len(wresp.Events) != 1 || string(wresp.Events[0].Kv.Key) != "/c"):
+				t.Fatalf("expected the put of /c, got %+v", wresp)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %s", want)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !cluster_proxy
+
+package clientv3test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestWatchWithInitialState ensures the watcher first receives the watched
+// range at the revision before the watch starts, then the events after it.
+func TestWatchWithInitialState(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx := context.Background()
+
+	var revs []int64
+	for _, kv := range [][2]string{{"/a", "1"}, {"/b", "1"}, {"/a", "2"}, {"/c", "1"}} {
+		resp, err := cli.Put(ctx, kv[0], kv[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		revs = append(revs, resp.Header.Revision)
+	}
+	if _, err := cli.Put(ctx, "/d", "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		opts []clientv3.OpOption
+
+		// put is put after the initial state is received
+		put string
+
+		wantState []string
+		wantRev   int64
+		wantNext  string
+	}{
+		{
+			opts:      []clientv3.OpOption{clientv3.WithRev(revs[2])},
+			wantState: []string{"/a=1", "/b=1"},
+			wantRev:   revs[1],
+			wantNext:  "/a=2",
+		},
+		{
+			opts:      []clientv3.OpOption{clientv3.WithRev(revs[3]), clientv3.WithFilterPut()},
+			wantState: nil,
+			wantRev:   revs[2],
+		},
+		{
+			put:       "/e",
+			wantState: []string{"/a=2", "/b=1", "/c=1", "/d=1"},
+			wantRev:   revs[3] + 1,
+			wantNext:  "/e=1",
+		},
+		{
+			opts:     []clientv3.OpOption{clientv3.WithRev(1)},
+			wantNext: "/a=1",
+		},
+	}
+	for i, tt := range tests {
+		wctx, cancel := context.WithCancel(ctx)
+		wch := cli.Watch(wctx, "/", append(tt.opts, clientv3.WithPrefix(), clientv3.WithInitialState())...)
+
+		var state []string
+		var done *clientv3.WatchResponse
+		for done == nil {
+			select {
+			case wresp := <-wch:
+				if err := wresp.Err(); err != nil {
+					t.Fatalf("#%d: unexpected watch error (%v)", i, err)
+				}
+				if wresp.InitialStateDone {
+					done = &wresp
+					break
+				}
+				if !wresp.InitialState {
+					t.Fatalf("#%d: expected initial state before the marker, got %+v", i, wresp)
+				}
+				for _, ev := range wresp.Events {
+					state = append(state, fmt.Sprintf("%s=%s", ev.Kv.Key, ev.Kv.Value))
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("#%d: timed out waiting for initial state, got %v", i, state)
+			}
+		}
+		if !reflect.DeepEqual(tt.wantState, state) {
+			t.Errorf("#%d: expected initial state %v, got %v", i, tt.wantState, state)
+		}
+		if done.Header.Revision != tt.wantRev {
+			t.Errorf("#%d: expected initial state revision %d, got %d", i, tt.wantRev, done.Header.Revision)
+		}
+		if done.IsProgressNotify() {
+			t.Errorf("#%d: initial state marker must not be a progress notification", i)
+		}
+
+		if tt.wantNext != "" {
+			if tt.put != "" {
+				if _, err := cli.Put(ctx, tt.put, "1"); err != nil {
+					t.Fatal(err)
+				}
+			}
+			select {
+			case wresp := <-wch:
+				if wresp.InitialState || len(wresp.Events) == 0 {
+					t.Fatalf("#%d: expected events, got %+v", i, wresp)
+				}
+				ev := wresp.Events[0]
+				if got := fmt.Sprintf("%s=%s", ev.Kv.Key, ev.Kv.Value); got != tt.wantNext {
+					t.Errorf("#%d: expected first event %s, got %s", i, tt.wantNext, got)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("#%d: timed out waiting for events", i)
+			}
+		}
+		cancel()
+	}
+
+	if _, err := cli.Compact(ctx, revs[2]); err != nil {
+		t.Fatal(err)
+	}
+	wresp := <-cli.Watch(ctx, "/", clientv3.WithPrefix(), clientv3.WithRev(revs[1]), clientv3.WithInitialState())
+	if !errors.Is(wresp.Err(), rpctypes.ErrCompacted) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrCompacted, wresp.Err())
+	}
+}
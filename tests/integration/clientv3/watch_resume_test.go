@@ -0,0 +1,124 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !cluster_proxy
+
+package clientv3test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestWatchResumeToken ensures a watcher can be resumed with the resume token
+// of its last response, and that the token revision is held from compaction.
+func TestWatchResumeToken(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1, WatchResumeRetention: time.Minute})
+	defer clus.Terminate(t)
+
+	holds := clus.Members[0].Server.WatchResumeHolds()
+	cli := clus.RandClient()
+	ctx := context.Background()
+
+	// the first watcher is dropped with its client, without being canceled
+	wcli, err := integration2.NewClient(t, clientv3.Config{Endpoints: []string{clus.Members[0].GRPCURL}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wch := wcli.Watch(ctx, "foo", clientv3.WithCreatedNotify())
+	wresp := <-wch
+	if !wresp.Created || len(wresp.ResumeToken) == 0 {
+		t.Fatalf("expected created response with resume token, got %+v", wresp)
+	}
+
+	presp, err := cli.Put(ctx, "foo", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case wresp = <-wch:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	if len(wresp.Events) != 1 || len(wresp.ResumeToken) == 0 {
+		t.Fatalf("expected one event with resume token, got %+v", wresp)
+	}
+	tok := wresp.ResumeToken
+	wcli.Close()
+
+	// the watch stream is gone, but the revision after the event is held
+	if rev := holds.MinRev(); rev != presp.Header.Revision+1 {
+		t.Fatalf("expected held revision %d, got %d", presp.Header.Revision+1, rev)
+	}
+
+	if _, err = cli.Put(ctx, "foo", "2"); err != nil {
+		t.Fatal(err)
+	}
+	wch = cli.Watch(ctx, "foo", clientv3.WithResumeToken(tok))
+	select {
+	case wresp = <-wch:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	if len(wresp.Events) != 1 || string(wresp.Events[0].Kv.Value) != "2" {
+		t.Fatalf("expected resumed watcher to get the second put, got %+v", wresp)
+	}
+
+	// the resumed watcher moves the hold of the token
+	if rev := holds.MinRev(); rev != wresp.Header.Revision+1 {
+		t.Fatalf("expected held revision %d, got %d", wresp.Header.Revision+1, rev)
+	}
+}
+
+// TestWatchResumeTokenHeldOnLeader ensures the revision held by a watcher on a
+// follower is reported to the leader, which is the member compacting.
+func TestWatchResumeTokenHeldOnLeader(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3, WatchResumeRetention: 3 * time.Second})
+	defer clus.Terminate(t)
+
+	lead := clus.WaitLeader(t)
+	follower := clus.Members[(lead+1)%3]
+	cli := follower.Client
+	ctx := context.Background()
+
+	wch := cli.Watch(ctx, "foo", clientv3.WithCreatedNotify())
+	wresp := <-wch
+	if !wresp.Created || len(wresp.ResumeToken) == 0 {
+		t.Fatalf("expected created response with resume token, got %+v", wresp)
+	}
+	want := follower.Server.WatchResumeHolds().LocalMinRev()
+	if want == 0 {
+		t.Fatal("expected the follower to hold the revision of the watcher")
+	}
+
+	holds := clus.Members[lead].Server.WatchResumeHolds()
+	if rev := holds.LocalMinRev(); rev != 0 {
+		t.Fatalf("expected no revision held on the leader itself, got %d", rev)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for holds.MinRev() != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected held revision %d reported to the leader, got %d", want, holds.MinRev())
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -16,6 +16,7 @@ package clientv3test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"reflect"
@@ -933,6 +934,99 @@ func TestWatchWithFilter(t *testing.T) {
 	}
 }
 
+func TestWatchWithEventFilters(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	cluster := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer cluster.Terminate(t)
+
+	client := cluster.RandClient()
+	ctx := context.Background()
+
+	lresp, err := client.Grant(ctx, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		opts []clientv3.OpOption
+		want []string
+	}{
+		{
+			opts: []clientv3.OpOption{clientv3.WithFilterUnchanged()},
+			want: []string{"PUT a=1", "PUT a=2", "PUT b.txt=1", "DELETE a"},
+		},
+		{
+			opts: []clientv3.OpOption{clientv3.WithFilterUpdate()},
+			want: []string{"PUT a=1", "PUT b.txt=1", "DELETE a"},
+		},
+		{
+			opts: []clientv3.OpOption{clientv3.WithFilterUpdate(), clientv3.WithFilterDelete()},
+			want: []string{"PUT a=1", "PUT b.txt=1"},
+		},
+		{
+			opts: []clientv3.OpOption{clientv3.WithMatchKeyGlob("/*/*.txt")},
+			want: []string{"PUT b.txt=1"},
+		},
+		{
+			opts: []clientv3.OpOption{clientv3.WithMatchKeyRegex(`/a$`)},
+			want: []string{"PUT a=1", "PUT a=1", "PUT a=2", "DELETE a"},
+		},
+		{
+			opts: []clientv3.OpOption{clientv3.WithMatchLease(lresp.ID)},
+			want: []string{"PUT b.txt=1", "DELETE a"},
+		},
+	}
+	for i, tt := range tests {
+		pfx := fmt.Sprintf("/%d/", i)
+		wch := client.Watch(ctx, pfx, append(tt.opts, clientv3.WithPrefix())...)
+
+		if _, err := client.Put(ctx, pfx+"a", "1"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Put(ctx, pfx+"a", "1"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Put(ctx, pfx+"a", "2"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Put(ctx, pfx+"b.txt", "1", clientv3.WithLease(lresp.ID)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Delete(ctx, pfx+"a"); err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for len(got) < len(tt.want) {
+			select {
+			case wresp := <-wch:
+				if err := wresp.Err(); err != nil {
+					t.Fatalf("#%d: unexpected watch error (%v)", i, err)
+				}
+				for _, ev := range wresp.Events {
+					key := string(ev.Kv.Key[len(pfx):])
+					if ev.Type == clientv3.EventTypeDelete {
+						got = append(got, "DELETE "+key)
+					} else {
+						got = append(got, fmt.Sprintf("PUT %s=%s", key, ev.Kv.Value))
+					}
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("#%d: timed out waiting for events, got %v", i, got)
+			}
+		}
+		if !reflect.DeepEqual(tt.want, got) {
+			t.Errorf("#%d: expected events %v, got %v", i, tt.want, got)
+		}
+	}
+
+	wresp := <-client.Watch(ctx, "a", clientv3.WithMatchKeyRegex("("))
+	if !errors.Is(wresp.Err(), rpctypes.ErrInvalidWatchFilter) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrInvalidWatchFilter, wresp.Err())
+	}
+}
+
 // TestWatchWithCreatedNotification checks that WithCreatedNotify returns a
 // Created watch response.
 func TestWatchWithCreatedNotification(t *testing.T) {
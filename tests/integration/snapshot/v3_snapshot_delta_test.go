@@ -0,0 +1,144 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/client/pkg/v3/testutil"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	clientsnapshot "go.etcd.io/etcd/client/v3/snapshot"
+	"go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"go.etcd.io/etcd/server/v3/embed"
+	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestSnapshotV3RestoreDelta tests restoring a member from a full snapshot
+// with a delta snapshot layered on it.
+func TestSnapshotV3RestoreDelta(t *testing.T) {
+	integration2.BeforeTest(t)
+	testutil.SkipTestIfShortMode(t,
+		"Snapshot creation tests are depending on embedded etcd server so are integration-level tests.")
+
+	urls := newEmbedURLs(t, 2)
+	cfg := integration2.NewEmbedConfig(t, "default")
+	cfg.ClusterState = "new"
+	cfg.ListenClientUrls, cfg.AdvertiseClientUrls = urls[:1], urls[:1]
+	cfg.ListenPeerUrls, cfg.AdvertisePeerUrls = urls[1:], urls[1:]
+	cfg.InitialCluster = fmt.Sprintf("%s=%s", cfg.Name, urls[1].String())
+	srv, err := embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	defer srv.Close()
+	select {
+	case <-srv.Server.ReadyNotify():
+	case <-time.After(3 * time.Second):
+		t.Fatalf("failed to start embed.Etcd for creating snapshots")
+	}
+
+	ccfg := clientv3.Config{Endpoints: []string{cfg.AdvertiseClientUrls[0].String()}}
+	cli, err := integration2.NewClient(t, ccfg)
+	require.NoError(t, err)
+	defer cli.Close()
+	ctx := context.Background()
+
+	for _, k := range []string{"foo1", "foo2", "foo3"} {
+		_, err = cli.Put(ctx, k, "bar")
+		require.NoError(t, err)
+	}
+	lg := zaptest.NewLogger(t)
+	sp := snapshot.NewV3(lg)
+	basePath := filepath.Join(t.TempDir(), "base.db")
+	_, err = sp.Save(ctx, ccfg, basePath)
+	require.NoError(t, err)
+	status, err := sp.Status(basePath)
+	require.NoError(t, err)
+
+	_, err = cli.Put(ctx, "foo1", "baz")
+	require.NoError(t, err)
+	_, err = cli.Delete(ctx, "foo2")
+	require.NoError(t, err)
+	_, err = cli.Put(ctx, "foo4", "bar")
+	require.NoError(t, err)
+	deltaPath := filepath.Join(t.TempDir(), "delta.db")
+	_, err = clientsnapshot.SaveSinceWithVersion(ctx, lg, ccfg, deltaPath, status.Revision)
+	require.NoError(t, err)
+	deltaStatus, err := sp.Status(deltaPath)
+	require.NoError(t, err)
+
+	_, err = cli.Put(ctx, "foo5", "bar")
+	require.NoError(t, err)
+	resp, err := cli.Get(ctx, "foo", clientv3.WithPrefix())
+	require.NoError(t, err)
+	_, err = cli.Compact(ctx, resp.Header.Revision)
+	require.NoError(t, err)
+	compactedPath := filepath.Join(t.TempDir(), "compacted.db")
+	_, err = clientsnapshot.SaveSinceWithVersion(ctx, lg, ccfg, compactedPath, status.Revision)
+	require.NoError(t, err)
+	compactedStatus, err := sp.Status(compactedPath)
+	require.NoError(t, err)
+
+	restoreDelta(t, sp, basePath, deltaPath, deltaStatus.Revision, map[string]string{"foo1": "baz", "foo3": "bar", "foo4": "bar"})
+	// foo2 is deleted before the compaction, so its tombstone is compacted
+	restoreDelta(t, sp, basePath, compactedPath, compactedStatus.Revision, map[string]string{"foo1": "baz", "foo3": "bar", "foo4": "bar", "foo5": "bar"})
+}
+
+// restoreDelta restores a member from the snapshot at basePath with the delta
+// at deltaPath layered on it, and checks its keys and revision.
+func restoreDelta(t *testing.T, sp snapshot.Manager, basePath, deltaPath string, rev int64, kvs map[string]string) {
+	rurls := newEmbedURLs(t, 2)
+	rcfg := integration2.NewEmbedConfig(t, "s1")
+	rcfg.InitialClusterToken = testClusterTkn
+	rcfg.ClusterState = "existing"
+	rcfg.ListenClientUrls, rcfg.AdvertiseClientUrls = rurls[:1], rurls[:1]
+	rcfg.ListenPeerUrls, rcfg.AdvertisePeerUrls = rurls[1:], rurls[1:]
+	rcfg.InitialCluster = fmt.Sprintf("%s=%s", rcfg.Name, rurls[1].String())
+	require.NoError(t, sp.Restore(snapshot.RestoreConfig{
+		SnapshotPath:        basePath,
+		DeltaPaths:          []string{deltaPath},
+		Name:                rcfg.Name,
+		OutputDataDir:       rcfg.Dir,
+		InitialCluster:      rcfg.InitialCluster,
+		InitialClusterToken: rcfg.InitialClusterToken,
+		PeerURLs:            []string{rurls[1].String()},
+	}))
+
+	rsrv, err := embed.StartEtcd(rcfg)
+	require.NoError(t, err)
+	defer rsrv.Close()
+	select {
+	case <-rsrv.Server.ReadyNotify():
+	case <-time.After(3 * time.Second):
+		t.Fatalf("failed to start restored etcd member")
+	}
+
+	rcli, err := integration2.NewClient(t, clientv3.Config{Endpoints: []string{rcfg.AdvertiseClientUrls[0].String()}})
+	require.NoError(t, err)
+	defer rcli.Close()
+	gresp, err := rcli.Get(context.Background(), "foo", clientv3.WithPrefix())
+	require.NoError(t, err)
+	got := make(map[string]string)
+	for _, kv := range gresp.Kvs {
+		got[string(kv.Key)] = string(kv.Value)
+	}
+	require.Equal(t, kvs, got)
+	require.Equal(t, rev, gresp.Header.Revision)
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !cluster_proxy
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestV3AutoDefrag ensures every member defragments automatically once its
+// backend crosses the ratio, without writing to the keyspace.
+func TestV3AutoDefrag(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{
+		Size:                3,
+		AutoDefragRatio:     0.5,
+		AutoDefragCheckTime: 100 * time.Millisecond,
+	})
+	defer clus.Terminate(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	cli := clus.RandClient()
+	val := strings.Repeat("v", 1024)
+	for i := 0; i < 2000; i++ {
+		if _, err := cli.Put(ctx, fmt.Sprintf("key%d", i), val); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp, err := cli.Delete(ctx, "key", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Compact(ctx, resp.Header.Revision, clientv3.WithCompactPhysical()); err != nil {
+		t.Fatal(err)
+	}
+
+	sizes := make([]int64, len(clus.Members))
+	for i, m := range clus.Members {
+		sizes[i] = m.Server.Backend().Size()
+	}
+	for i, m := range clus.Members {
+		for m.Server.Backend().Size() >= sizes[i] {
+			select {
+			case <-ctx.Done():
+				t.Fatalf("member %d was not defragmented, size %d", i, m.Server.Backend().Size())
+			case <-time.After(100 * time.Millisecond):
+			}
+		}
+	}
+
+	// automatic defragmentation must not write to the keyspace
+	gresp, err := cli.Get(ctx, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gresp.Header.Revision != resp.Header.Revision {
+		t.Fatalf("revision = %d, want %d", gresp.Header.Revision, resp.Header.Revision)
+	}
+}
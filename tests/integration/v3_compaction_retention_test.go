@@ -0,0 +1,107 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !cluster_proxy
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/config"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestV3CompactionPrefixRetention ensures compactions keep the history of a
+// prefix with a retention on every member, whichever member receives them,
+// and report compaction errors for its keys at the revision the prefix is
+// compacted at.
+func TestV3CompactionPrefixRetention(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{
+		Size:                       3,
+		CompactionPrefixRetentions: []config.PrefixRetention{{Prefix: "/audit/", Revisions: 4}},
+	})
+	defer clus.Terminate(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// the leader proposes the policy of the cluster
+	for _, m := range clus.Members {
+		require.Eventually(t, func() bool {
+			tx := m.Server.Backend().ReadTx()
+			tx.RLock()
+			defer tx.RUnlock()
+			p, err := schema.UnsafeReadCompactionRetentionPolicy(tx)
+			require.NoError(t, err)
+			return p != nil
+		}, 10*time.Second, 100*time.Millisecond)
+	}
+
+	// the compaction is received by a follower
+	cli := clus.Client((clus.WaitLeader(t) + 1) % len(clus.Members))
+	var rev int64
+	for i := 0; i < 5; i++ {
+		for _, key := range []string{"/audit/a", "/tmp/a"} {
+			resp, err := cli.Put(ctx, key, "v")
+			if err != nil {
+				t.Fatal(err)
+			}
+			rev = resp.Header.Revision
+		}
+	}
+	if _, err := cli.Compact(ctx, rev, clientv3.WithCompactPhysical()); err != nil {
+		t.Fatal(err)
+	}
+	retainRev := rev - 4
+
+	for i := range clus.Members {
+		c := clus.Client(i)
+		if _, err := c.Get(ctx, "/audit/a", clientv3.WithRev(retainRev)); err != nil {
+			t.Fatalf("expected the history of /audit/ retained, got %v", err)
+		}
+		if _, err := c.Get(ctx, "/audit/", clientv3.WithPrefix(), clientv3.WithRev(retainRev)); err != nil {
+			t.Fatalf("expected the history of /audit/ retained, got %v", err)
+		}
+		if _, err := c.Get(ctx, "/audit/a", clientv3.WithRev(retainRev-1)); !errors.Is(err, rpctypes.ErrCompacted) {
+			t.Fatalf("expected %v before the retained revision, got %v", rpctypes.ErrCompacted, err)
+		}
+		if _, err := c.Get(ctx, "/tmp/a", clientv3.WithRev(retainRev)); !errors.Is(err, rpctypes.ErrCompacted) {
+			t.Fatalf("expected %v for /tmp/a, got %v", rpctypes.ErrCompacted, err)
+		}
+	}
+
+	wch := cli.Watch(ctx, "/audit/", clientv3.WithPrefix(), clientv3.WithRev(retainRev))
+	wresp := <-wch
+	// /audit/a was put at revisions rev-3 and rev-1 since
+	if err := wresp.Err(); err != nil || len(wresp.Events) != 2 || wresp.Events[0].Kv.ModRevision != rev-3 {
+		t.Fatalf("expected events since revision %d, got %+v (%v)", retainRev, wresp, err)
+	}
+
+	wch = cli.Watch(ctx, "/tmp/", clientv3.WithPrefix(), clientv3.WithRev(retainRev))
+	wresp = <-wch
+	if wresp.CompactRevision != rev {
+		t.Fatalf("expected compact revision %d, got %+v", rev, wresp)
+	}
+}
@@ -17,10 +17,12 @@ package integration
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
 	"golang.org/x/sync/errgroup"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
@@ -98,6 +100,48 @@ func testMoveLeader(t *testing.T, auto bool) {
 	}
 }
 
+// TestLeaderLeaseReads ensures the linearizable reads are served with the
+// leader lease on the leader, see the writes made through the followers, and
+// fall back to ReadIndex once the leadership is transferred.
+func TestLeaderLeaseReads(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3, LeaderLeaseReads: true})
+	defer clus.Terminate(t)
+
+	leadIdx := clus.WaitLeader(t)
+	leader := clus.Members[leadIdx]
+	follower := clus.Members[(leadIdx+1)%3]
+	leaseReads := func() float64 {
+		v, err := leader.Metric("etcd_server_linearizable_reads_total", `mode="lease"`)
+		require.NoError(t, err)
+		if v == "" {
+			return 0
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		require.NoError(t, err)
+		return f
+	}
+
+	before := leaseReads()
+	for i := 0; i < 10; i++ {
+		_, err := clus.Client((leadIdx+1)%3).Put(context.TODO(), "foo", strconv.Itoa(i))
+		require.NoError(t, err)
+		resp, err := clus.Client(leadIdx).Get(context.TODO(), "foo")
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+		require.Equal(t, strconv.Itoa(i), string(resp.Kvs[0].Value))
+	}
+	require.Positive(t, leaseReads()-before)
+
+	err := leader.Server.MoveLeader(context.TODO(), uint64(leader.Server.MemberID()), uint64(follower.Server.MemberID()))
+	require.NoError(t, err)
+	before = leaseReads()
+	_, err = clus.Client(leadIdx).Get(context.TODO(), "foo")
+	require.NoError(t, err)
+	require.Zero(t, leaseReads()-before)
+}
+
 // TestMoveLeaderError ensures that request to non-leader fail.
 func TestMoveLeaderError(t *testing.T) {
 	integration.BeforeTest(t)
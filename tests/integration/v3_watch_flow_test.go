@@ -0,0 +1,166 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !cluster_proxy
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestV3WatchFlowControl ensures events are held back while the client of a
+// watch stream has no credits, and that the held back events are reported.
+func TestV3WatchFlowControl(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	wc := integration.ToGRPC(clus.RandClient())
+	ws, recv := newCreditedWatchStream(ctx, t, wc.Watch, 1)
+
+	kvc := wc.KV
+	for _, v := range []string{"1", "2", "3"} {
+		if _, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte("foo"), Value: []byte(v)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the single credit only lets the first event through
+	if resp := waitWatchResponse(t, recv); len(resp.Events) != 1 || string(resp.Events[0].Kv.Value) != "1" {
+		t.Fatalf("expected the first put, got %+v", resp)
+	}
+	select {
+	case resp := <-recv:
+		t.Fatalf("unexpected response without credits %+v", resp)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	lag := watchStreamLag(ctx, t, wc.Maintenance)
+	if lag.BufferedBytes == 0 || lag.Credits != 0 || lag.RevisionGap != 1 {
+		t.Fatalf("expected buffered events one revision behind without credits, got %+v", lag)
+	}
+
+	if err := ws.Send(&pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreditRequest{
+		CreditRequest: &pb.WatchCreditRequest{Credits: 10}}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []string{"2", "3"} {
+		if resp := waitWatchResponse(t, recv); len(resp.Events) != 1 || string(resp.Events[0].Kv.Value) != v {
+			t.Fatalf("expected put of %q, got %+v", v, resp)
+		}
+	}
+
+	lag = watchStreamLag(ctx, t, wc.Maintenance)
+	if lag.BufferedBytes != 0 || lag.Credits != 8 || lag.RevisionGap != 0 {
+		t.Fatalf("expected nothing buffered with 8 credits left, got %+v", lag)
+	}
+}
+
+// TestV3WatchLagCancel ensures a watcher whose held back events fall too
+// far behind is canceled with the lag cancel reason.
+func TestV3WatchLagCancel(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1, WatchLagCancelRevisions: 2})
+	defer clus.Terminate(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	wc := integration.ToGRPC(clus.RandClient())
+	_, recv := newCreditedWatchStream(ctx, t, wc.Watch, 1)
+
+	for i := 0; i < 5; i++ {
+		if _, err := wc.KV.Put(ctx, &pb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if resp := waitWatchResponse(t, recv); len(resp.Events) != 1 {
+		t.Fatalf("expected the first put, got %+v", resp)
+	}
+	resp := waitWatchResponse(t, recv)
+	if !resp.Canceled || resp.CancelReason != rpctypes.ErrorDesc(rpctypes.ErrGRPCWatchLagged) {
+		t.Fatalf("expected watcher canceled for lagging, got %+v", resp)
+	}
+}
+
+// newCreditedWatchStream opens a watch stream granting the given credits,
+// and creates a watcher on "foo". The responses after the created response
+// are sent to the returned channel.
+func newCreditedWatchStream(ctx context.Context, t *testing.T, wAPI pb.WatchClient, credits int64) (pb.Watch_WatchClient, <-chan *pb.WatchResponse) {
+	ws, err := wAPI.Watch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ws.Send(&pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreditRequest{
+		CreditRequest: &pb.WatchCreditRequest{Credits: credits}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err = ws.Send(&pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{
+		CreateRequest: &pb.WatchCreateRequest{Key: []byte("foo")}}}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, rerr := ws.Recv(); rerr != nil || !resp.Created {
+		t.Fatalf("expected created response, got %+v (%v)", resp, rerr)
+	}
+
+	recv := make(chan *pb.WatchResponse, 16)
+	go func() {
+		for {
+			resp, rerr := ws.Recv()
+			if rerr != nil {
+				return
+			}
+			recv <- resp
+		}
+	}()
+	return ws, recv
+}
+
+func waitWatchResponse(t *testing.T, recv <-chan *pb.WatchResponse) *pb.WatchResponse {
+	select {
+	case resp := <-recv:
+		return resp
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for watch response")
+	}
+	return nil
+}
+
+// watchStreamLag returns the lag of the only flow controlled watch stream.
+func watchStreamLag(ctx context.Context, t *testing.T, mc pb.MaintenanceClient) *pb.WatchStreamLag {
+	resp, err := mc.WatchLag(ctx, &pb.WatchLagRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, lag := range resp.Streams {
+		if lag.FlowControl {
+			return lag
+		}
+	}
+	t.Fatalf("no flow controlled watch stream in %+v", resp.Streams)
+	return nil
+}
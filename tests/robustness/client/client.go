@@ -22,6 +22,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/tests/v3/robustness/identity"
@@ -221,6 +222,20 @@ func (c *RecordingClient) MemberAddAsLearner(ctx context.Context, peerAddrs []st
 	return resp, err
 }
 
+func (c *RecordingClient) MemberAddAsAutoPromoteLearner(ctx context.Context, peerAddrs []string) (*clientv3.MemberAddResponse, error) {
+	c.kvMux.Lock()
+	defer c.kvMux.Unlock()
+	resp, err := c.client.MemberAddAsAutoPromoteLearner(ctx, peerAddrs)
+	return resp, err
+}
+
+func (c *RecordingClient) MemberAddAsWitness(ctx context.Context, peerAddrs []string) (*clientv3.MemberAddResponse, error) {
+	c.kvMux.Lock()
+	defer c.kvMux.Unlock()
+	resp, err := c.client.MemberAddAsWitness(ctx, peerAddrs)
+	return resp, err
+}
+
 func (c *RecordingClient) MemberRemove(ctx context.Context, id uint64) (*clientv3.MemberRemoveResponse, error) {
 	c.kvMux.Lock()
 	defer c.kvMux.Unlock()
@@ -242,6 +257,13 @@ func (c *RecordingClient) MemberPromote(ctx context.Context, id uint64) (*client
 	return resp, err
 }
 
+func (c *RecordingClient) MemberReconfigure(ctx context.Context, add []*etcdserverpb.MemberAddRequest, remove []uint64, promote []uint64) (*clientv3.MemberReconfigureResponse, error) {
+	c.kvMux.Lock()
+	defer c.kvMux.Unlock()
+	resp, err := c.client.MemberReconfigure(ctx, add, remove, promote)
+	return resp, err
+}
+
 func (c *RecordingClient) Status(ctx context.Context, endpoint string) (*clientv3.StatusResponse, error) {
 	c.kvMux.Lock()
 	defer c.kvMux.Unlock()
@@ -143,6 +143,7 @@ func exceptionCheck(key []byte) bool {
 		"members":         {},
 		"members_removed": {},
 		"meta":            {},
+		"revisionTime":    {},
 	}
 
 	_, ok := whiteKeyList[string(key)]
@@ -193,7 +193,7 @@ type EntryFilter func(e raftpb.Entry) (bool, string)
 // The 9 pass functions below takes the raftpb.Entry and return if the entry should be printed and the type of entry,
 // the type of the entry will used in the following print function
 func passConfChange(entry raftpb.Entry) (bool, string) {
-	return entry.Type == raftpb.EntryConfChange, "ConfigChange"
+	return entry.Type == raftpb.EntryConfChange || entry.Type == raftpb.EntryConfChangeV2, "ConfigChange"
 }
 
 func passInternalRaftRequest(entry raftpb.Entry) (bool, string) {
@@ -277,6 +277,19 @@ func printUnknownNormal(entry raftpb.Entry) {
 func printConfChange(entry raftpb.Entry) {
 	fmt.Printf("%4d\t%10d", entry.Term, entry.Index)
 	fmt.Print("\tconf")
+	if entry.Type == raftpb.EntryConfChangeV2 {
+		var r raftpb.ConfChangeV2
+		if err := r.Unmarshal(entry.Data); err != nil {
+			fmt.Print("\t???")
+		} else if r.LeaveJoint() {
+			fmt.Print("\tmethod=LeaveJoint")
+		} else {
+			for _, c := range r.Changes {
+				fmt.Printf("\tmethod=%s id=%s", c.Type, types.ID(c.NodeID))
+			}
+		}
+		return
+	}
 	var r raftpb.ConfChange
 	if err := r.Unmarshal(entry.Data); err != nil {
 		fmt.Print("\t???")